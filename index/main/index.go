@@ -37,6 +37,7 @@ var (
 var rankingsCache server.RankingsMap
 var yrTotalsCache server.YrTotalsMap
 var searchDataCache server.SearchDataMap
+var totalsMap map[string]float32
 
 var database *dynamo.DbInfo
 var metadata *server.IndexData
@@ -63,6 +64,7 @@ func main() {
 		os.Exit(1)
 	}
 	rankingsCache = rankings
+	totalsMap = server.CreateTotalsMap(rankingsCache)
 
 	totals, err := server.GetYrTotalsFromDisk()
 	if err != nil {
@@ -190,23 +192,40 @@ func (s *indexServer) SearchIndex(ctx context.Context, in *pb.SearchIndexRequest
 	// find matching search results
 	txt := in.GetText()
 	fmt.Println("SEARCH QUERY: ", txt)
-	common, err := server.SearchData(metadata, txt)
+	hits, err := server.SearchData(metadata, txt)
+	if (err != nil && err.Error() == "NO_RESULTS") || (err == nil && len(hits.IDs) == 0) {
+		// retry with misspelled terms replaced by closest index terms
+		fuzzy, corrected, ferr := server.FuzzySearchData(metadata, txt)
+		if ferr == nil && len(fuzzy.IDs) > 0 {
+			hits, err = fuzzy, nil
+			out.Approximate = true
+			out.Corrected = corrected
+		}
+	}
 	if err != nil {
 		fmt.Println(err)
 		out.Msg = fmt.Sprintf("%s", err.Error())
 		return out, err
 	}
-	sds, err := server.GetSearchResults(database, common, searchDataCache)
+	rankTxt := txt
+	if out.Approximate {
+		rankTxt = out.Corrected
+	}
+	// default and max page size set by indexing.PageResults
+	page, err := server.GetSearchPage(rankTxt, hits, searchDataCache, totalsMap, int(in.GetPageSize()), in.GetCursor())
 	if err != nil {
 		errMsg := fmt.Errorf("%v\tSearchQuery failed: %v\tUID: %s", time.Now(), err, out.UID)
 		fmt.Println(err)
 		out.Msg = fmt.Sprintf("%s", errMsg)
 		return out, errMsg
 	}
+	out.TotalHits = int32(page.TotalHits)
+	out.Estimated = page.Estimated
+	out.NextCursor = page.NextCursor
 
 	// convert to SearchResult message
 	var results []*pb.SearchResult
-	for _, sd := range sds {
+	for _, sd := range page.Results {
 		res := &pb.SearchResult{
 			ID:       sd.ID,
 			Bucket:   sd.Bucket,
@@ -227,6 +246,52 @@ func (s *indexServer) SearchIndex(ctx context.Context, in *pb.SearchIndexRequest
 	return out, nil
 }
 
+// return the best-ranked names beginning with the prefix
+func (s *indexServer) Autocomplete(ctx context.Context, in *pb.AutocompleteRequest) (*pb.AutocompleteResponse, error) {
+	// intitialize response object
+	out := &pb.AutocompleteResponse{
+		UID:    in.GetUID(),
+		Prefix: in.GetPrefix(),
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tAutocomplete failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(err)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+	out.Timestamp = ts
+
+	// default and max # of results set by indexing.Autocomplete
+	sds, err := server.Autocomplete(in.GetPrefix(), int(in.GetLimit()))
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tAutocomplete failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(err)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+
+	// convert to SearchResult message
+	for _, sd := range sds {
+		res := &pb.SearchResult{
+			ID:       sd.ID,
+			Bucket:   sd.Bucket,
+			Name:     sd.Name,
+			City:     sd.City,
+			State:    sd.State,
+			Employer: sd.Employer,
+			Years:    sd.Years,
+		}
+		out.Results = append(out.Results, res)
+	}
+	out.Msg = "SUCCESS"
+	if len(out.Results) == 0 {
+		out.Msg = "NO_RESULTS"
+	}
+
+	return out, nil
+}
+
 func (s *indexServer) LookupObjects(ctx context.Context, in *pb.LookupObjRequest) (*pb.LookupObjResponse, error) {
 	fmt.Println("called LookupObjByID...")
 	// intitialize response object
@@ -302,8 +367,14 @@ func (s *indexServer) GetIndividual(ctx context.Context, in *pb.LookupIndvReques
 	out.Timestamp = ts
 
 	// Get object binary and return in response
-	/* support for multiple years and aggregated datasets will be available in future version */
-	years := in.GetYears()
+	// datasets for multiple years are merged into a single object
+	years, err := server.LookupYears(in.GetYears(), in.GetStartYear(), in.GetEndYear())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tLookupIndividual failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
 	if len(years) == 0 {
 		err := "NO_YEAR_SET"
 		errMsg := fmt.Errorf("%v\tLookupIndividual failed: %v\tUID: %s", time.Now(), err, out.UID)
@@ -313,14 +384,14 @@ func (s *indexServer) GetIndividual(ctx context.Context, in *pb.LookupIndvReques
 	}
 
 	q := server.CreateQueryFromSearchData(sd[0])
-	objs, err := server.GetObjectFromDynamo(database, q, sd[0].Bucket, years)
+	obj, err := server.GetLookupObject(database, q, sd[0].ID, sd[0].Bucket, years)
 	if err != nil {
 		errMsg := fmt.Errorf("%v\tLookupIndividual failed: %v\tUID: %s", time.Now(), err, out.UID)
 		fmt.Println(errMsg)
 		out.Msg = fmt.Sprintf("%s", errMsg)
 		return out, errMsg
 	}
-	indv := objs[0].(server.Individual)
+	indv := obj.(server.Individual)
 
 	recAmtsSrt := util.SortMapObjectTotals(indv.RecipientsAmt)
 	recAmts := []*pb.TotalsMap{}
@@ -394,11 +465,17 @@ func (s *indexServer) GetCommittee(ctx context.Context, in *pb.LookupCmteRequest
 	out.Years = sd[0].Years
 
 	// Get object binary and return in response
-	/* support for multiple years and aggregated datasets will be available in future version */
-	years := in.GetYears()
+	// datasets for multiple years are merged into a single object
+	years, err := server.LookupYears(in.GetYears(), in.GetStartYear(), in.GetEndYear())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tLookupCommittee failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
 	query := server.CreateQueryFromSearchData(sd[0])
 	st := time.Now()
-	objs, err := server.GetObjectFromDynamo(database, query, sd[0].Bucket, years)
+	obj, err := server.GetLookupObject(database, query, sd[0].ID, sd[0].Bucket, years)
 	if err != nil {
 		if err.Error() == "TABLE_NOT_FOUND" {
 			out.Msg = err.Error()
@@ -410,7 +487,7 @@ func (s *indexServer) GetCommittee(ctx context.Context, in *pb.LookupCmteRequest
 		return out, errMsg
 	}
 	fmt.Println("get obj from dynamo time: ", time.Since(st))
-	cmte := objs[0].(server.Committee)
+	cmte := obj.(server.Committee)
 
 	cmtePb := pb.Committee{
 		ID:           cmte.ID,
@@ -432,8 +509,7 @@ func (s *indexServer) GetCommittee(ctx context.Context, in *pb.LookupCmteRequest
 	sd[0].Bucket = "cmte_tx_data"
 	query = server.CreateQueryFromSearchData(sd[0])
 	st = time.Now()
-	objs, err = server.GetObjectFromDynamo(database, query, sd[0].Bucket, years)
-	// obj, err = server.GetObjectFromDisk(year, in.GetObjectID(), "cmte_tx_data")
+	obj, err = server.GetLookupObject(database, query, sd[0].ID, sd[0].Bucket, years)
 	if err != nil {
 		errMsg := fmt.Errorf("%v\tLookupCommittee failed: %v\tUID: %s", time.Now(), err, out.UID)
 		fmt.Println(errMsg)
@@ -441,7 +517,7 @@ func (s *indexServer) GetCommittee(ctx context.Context, in *pb.LookupCmteRequest
 		return out, errMsg
 	}
 	fmt.Println("get obj from dynamo time: ", time.Since(st))
-	cmteTx := objs[0].(server.CmteTxData)
+	cmteTx := obj.(server.CmteTxData)
 	indvAmtsSrt := util.SortMapObjectTotals(cmteTx.TopIndvContributorsAmt)
 	indvAmts := []*pb.TotalsMap{}
 	for _, e := range indvAmtsSrt {
@@ -497,6 +573,21 @@ func (s *indexServer) GetCommittee(ctx context.Context, in *pb.LookupCmteRequest
 		TransferRecsTxs:           cmteTx.TransferRecsTxs,
 		TopExpRecipientsAmt:       expAmts,
 		TopExpRecipientsTxs:       cmteTx.TopExpRecipientsTxs,
+		ElectionsInAmt:            cmteTx.ElectionsInAmt,
+		ElectionsInTxs:            cmteTx.ElectionsInTxs,
+		SizeBinsAmt:               cmteTx.SizeBinsAmt,
+		SizeBinsTxs:               cmteTx.SizeBinsTxs,
+		ConduitsInAmt:             cmteTx.ConduitsInAmt,
+		ConduitsInTxs:             cmteTx.ConduitsInTxs,
+		EarmarkedInAmt:            cmteTx.EarmarkedInAmt,
+		EarmarkedInTxs:            cmteTx.EarmarkedInTxs,
+		EarmarkedOutAmt:           cmteTx.EarmarkedOutAmt,
+		EarmarkedOutTxs:           cmteTx.EarmarkedOutTxs,
+		EarmarkRecipientsAmt:      cmteTx.EarmarkRecipientsAmt,
+		EarmarkRecipientsTxs:      cmteTx.EarmarkRecipientsTxs,
+		TxKindsAmt:                cmteTx.TxKindsAmt,
+		TxKindsTxs:                cmteTx.TxKindsTxs,
+		SizePercentiles:           cmteTx.SizePercentiles,
 	}
 	out.TxData = &cmteTxPb
 
@@ -568,12 +659,17 @@ func (s *indexServer) GetCandidate(ctx context.Context, in *pb.LookupCandRequest
 	out.Years = sd[0].Years
 
 	// Get object binary and return in response
-	/* support for multiple years and aggregated datasets will be available in future version */
-	years := in.GetYears()
+	// datasets for multiple years are merged into a single object
+	years, err := server.LookupYears(in.GetYears(), in.GetStartYear(), in.GetEndYear())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tViewCandidate failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
 	query := server.CreateQueryFromSearchData(sd[0])
 	st := time.Now()
-	obj, err := server.GetObjectFromDynamo(database, query, sd[0].Bucket, years)
-	// obj, err := server.GetObjectFromDisk(year, in.GetObjectID(), "candidates")
+	obj, err := server.GetLookupObject(database, query, sd[0].ID, sd[0].Bucket, years)
 	if err != nil {
 		errMsg := fmt.Errorf("%v\tViewCandidate failed: %v\tUID: %s", time.Now(), err, out.UID)
 		fmt.Println(errMsg)
@@ -581,7 +677,7 @@ func (s *indexServer) GetCandidate(ctx context.Context, in *pb.LookupCandRequest
 		return out, errMsg
 	}
 	fmt.Println("get obj from dynamo time: ", time.Since(st))
-	cand := obj[0].(server.Candidate)
+	cand := obj.(server.Candidate)
 	recAmtsSrt := util.SortMapObjectTotals(cand.DirectRecipientsAmts)
 	recAmts := []*pb.TotalsMap{}
 	for _, e := range recAmtsSrt {
@@ -621,6 +717,79 @@ func (s *indexServer) GetCandidate(ctx context.Context, in *pb.LookupCandRequest
 	}
 	out.Candidate = &candPb
 
+	// get rollup of all linked committees' datasets
+	// rollups are derived per election cycle and only returned for single year lookups
+	rollup := server.CandRollup{}
+	if len(years) == 1 {
+		query = server.CreateRollupQuery(cand)
+		st = time.Now()
+		objs, err := server.GetObjectFromDynamo(database, query, "cand_rollup", years)
+		if err != nil && err.Error() != "TABLE_NOT_FOUND" { // no rollups created for year
+			errMsg := fmt.Errorf("%v\tViewCandidate failed: %v\tUID: %s", time.Now(), err, out.UID)
+			fmt.Println(errMsg)
+			out.Msg = fmt.Sprintf("%s", errMsg)
+			return out, errMsg
+		}
+		fmt.Println("get obj from dynamo time: ", time.Since(st))
+		if len(objs) > 0 {
+			rollup = objs[0].(server.CandRollup)
+		}
+	}
+	if rollup.CandID != "" { // object exists
+		rollupPb := pb.CandRollup{
+			CandID:                    rollup.CandID,
+			Party:                     rollup.Party,
+			Committees:                rollup.Committees,
+			ContributionsInAmt:        rollup.ContributionsInAmt,
+			ContributionsInTxs:        rollup.ContributionsInTxs,
+			AvgContributionIn:         rollup.AvgContributionIn,
+			OtherReceiptsInAmt:        rollup.OtherReceiptsInAmt,
+			OtherReceiptsInTxs:        rollup.OtherReceiptsInTxs,
+			AvgOtherIn:                rollup.AvgOtherIn,
+			InternalTransfersAmt:      rollup.InternalTransfersAmt,
+			InternalTransfersTxs:      rollup.InternalTransfersTxs,
+			TotalIncomingAmt:          rollup.TotalIncomingAmt,
+			TotalIncomingTxs:          rollup.TotalIncomingTxs,
+			AvgIncoming:               rollup.AvgIncoming,
+			TransfersAmt:              rollup.TransfersAmt,
+			TransfersTxs:              rollup.TransfersTxs,
+			AvgTransfer:               rollup.AvgTransfer,
+			ExpendituresAmt:           rollup.ExpendituresAmt,
+			ExpendituresTxs:           rollup.ExpendituresTxs,
+			AvgExpenditure:            rollup.AvgExpenditure,
+			TotalOutgoingAmt:          rollup.TotalOutgoingAmt,
+			TotalOutgoingTxs:          rollup.TotalOutgoingTxs,
+			AvgOutgoing:               rollup.AvgOutgoing,
+			NetBalance:                rollup.NetBalance,
+			TopIndvContributorsAmt:    sortTotals(rollup.TopIndvContributorsAmt),
+			TopIndvContributorsTxs:    rollup.TopIndvContributorsTxs,
+			TopCmteOrgContributorsAmt: sortTotals(rollup.TopCmteOrgContributorsAmt),
+			TopCmteOrgContributorsTxs: rollup.TopCmteOrgContributorsTxs,
+			TransferRecsAmt:           sortTotals(rollup.TransferRecsAmt),
+			TransferRecsTxs:           rollup.TransferRecsTxs,
+			TopExpRecipientsAmt:       sortTotals(rollup.TopExpRecipientsAmt),
+			TopExpRecipientsTxs:       rollup.TopExpRecipientsTxs,
+			ElectionsInAmt:            rollup.ElectionsInAmt,
+			ElectionsInTxs:            rollup.ElectionsInTxs,
+			SizeBinsAmt:               rollup.SizeBinsAmt,
+			SizeBinsTxs:               rollup.SizeBinsTxs,
+			ConduitsInAmt:             rollup.ConduitsInAmt,
+			ConduitsInTxs:             rollup.ConduitsInTxs,
+			CandContsAmt:              rollup.CandContsAmt,
+			CandLoansAmt:              rollup.CandLoansAmt,
+			CandLoanRepayAmt:          rollup.CandLoanRepayAmt,
+			SelfFundedAmt:             rollup.SelfFundedAmt,
+			SelfFundedShare:           rollup.SelfFundedShare,
+			CandLoansOutstanding:      rollup.CandLoansOutstanding,
+			CandLoansByYear:           rollup.CandLoansByYear,
+			CandRepaysByYear:          rollup.CandRepaysByYear,
+			TxKindsAmt:                rollup.TxKindsAmt,
+			TxKindsTxs:                rollup.TxKindsTxs,
+			SizePercentiles:           rollup.SizePercentiles,
+		}
+		out.Rollup = &rollupPb
+	}
+
 	/* obj, err = server.GetObjectFromDisk(year, in.GetObjectID(), "cmpn_fin")
 	if err != nil {
 		errMsg := fmt.Errorf("%v\tViewCandidate failed: %v\tUID: %s", time.Now(), err, out.UID)
@@ -668,3 +837,143 @@ func (s *indexServer) GetCandidate(ctx context.Context, in *pb.LookupCandRequest
 
 	return out, nil
 }
+
+// find the top money-flow paths between two entities from disk
+func (s *indexServer) FindPaths(ctx context.Context, in *pb.FindPathsRequest) (*pb.FindPathsResponse, error) {
+	fmt.Println("called FindPaths...")
+	out := &pb.FindPathsResponse{
+		UID: in.GetUID(),
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tFindPaths failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+	out.Timestamp = ts
+
+	// default to top 5 paths of up to 4 hops ranked by bottleneck amount
+	k, maxHops, rankBy := int(in.GetK()), int(in.GetMaxHops()), in.GetRankBy()
+	if k == 0 {
+		k = 5
+	}
+	if maxHops == 0 {
+		maxHops = 4
+	}
+	if rankBy == "" {
+		rankBy = "bottleneck"
+	}
+
+	paths, err := server.FindPaths(in.GetYear(), in.GetSourceID(), in.GetTargetID(), k, maxHops, rankBy, in.GetMinAmount())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tFindPaths failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+
+	// convert to FlowPath messages
+	for _, p := range paths {
+		path := &pb.FlowPath{
+			Bottleneck: p.Bottleneck,
+			Attributed: p.Attributed,
+			Share:      p.Share,
+		}
+		for _, h := range p.Hops {
+			hop := &pb.FlowHop{
+				From:     h.From,
+				FromName: h.FromName,
+				To:       h.To,
+				ToName:   h.ToName,
+				Type:     h.Type,
+				Amount:   h.Amt,
+				Txs:      h.Txs,
+			}
+			path.Hops = append(path.Hops, hop)
+		}
+		out.Paths = append(out.Paths, path)
+	}
+	out.Msg = "SUCCESS"
+	if len(out.Paths) == 0 {
+		out.Msg = "NO_RESULTS"
+	}
+
+	return out, nil
+}
+
+// get a page of the money-flow network graph from the root object across the given years
+func (s *indexServer) GetNetwork(ctx context.Context, in *pb.GetNetworkRequest) (*pb.GetNetworkResponse, error) {
+	fmt.Println("called GetNetwork...")
+	out := &pb.GetNetworkResponse{
+		UID:      in.GetUID(),
+		ObjectID: in.GetObjectID(),
+		Years:    in.GetYears(),
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tGetNetwork failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+	out.Timestamp = ts
+
+	// default depth, node and page size caps set by server.GetNetwork
+	page, err := server.GetNetwork(
+		in.GetYears(), in.GetObjectID(), int(in.GetDepth()), in.GetMinAmount(),
+		int(in.GetMaxNodes()), int(in.GetPageSize()), in.GetPageToken(),
+	)
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tGetNetwork failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+
+	// convert to NetworkNode/NetworkEdge messages
+	for _, n := range page.Nodes {
+		node := &pb.NetworkNode{
+			ID:          n.ID,
+			Name:        n.Name,
+			Type:        n.Type,
+			Party:       n.Party,
+			State:       n.State,
+			TotalInAmt:  n.TotalInAmt,
+			TotalOutAmt: n.TotalOutAmt,
+			Depth:       n.Depth,
+		}
+		out.Nodes = append(out.Nodes, node)
+	}
+	for _, e := range page.Edges {
+		edge := &pb.NetworkEdge{
+			From:   e.From,
+			To:     e.To,
+			Type:   e.Type,
+			Amount: e.Amt,
+			Txs:    e.Txs,
+		}
+		out.Edges = append(out.Edges, edge)
+	}
+	out.TotalNodes = page.TotalNodes
+	out.TotalEdges = page.TotalEdges
+	out.Truncated = page.Truncated
+	out.NextPageToken = page.NextPageToken
+	out.Msg = "SUCCESS"
+	if len(out.Nodes) == 0 {
+		out.Msg = "NO_RESULTS"
+	}
+
+	return out, nil
+}
+
+// sortTotals converts a map of totals to a list of TotalsMap messages sorted by value
+func sortTotals(m map[string]float32) []*pb.TotalsMap {
+	srt := util.SortMapObjectTotals(m)
+	totals := []*pb.TotalsMap{}
+	for _, e := range srt {
+		entry := &pb.TotalsMap{ID: e.ID, Total: e.Total}
+		totals = append(totals, entry)
+	}
+	return totals
+}
@@ -196,7 +196,7 @@ func (s *viewServer) SearchQuery(ctx context.Context, in *pb.SearchRequest) (*pb
 
 	// make RPC call to Index service to find matching search results
 	txt := in.GetText()
-	resp, err := searchIndex(client, txt, hostname)
+	resp, err := searchIndex(client, txt, in.GetPageSize(), in.GetCursor(), hostname)
 	if err != nil {
 		fmt.Println("search index err: ", err)
 		fmt.Println("msg: ", resp.GetMsg())
@@ -218,6 +218,11 @@ func (s *viewServer) SearchQuery(ctx context.Context, in *pb.SearchRequest) (*pb
 	}
 
 	out.Results = results
+	out.Approximate = resp.GetApproximate()
+	out.Corrected = resp.GetCorrected()
+	out.TotalHits = resp.GetTotalHits()
+	out.Estimated = resp.GetEstimated()
+	out.NextCursor = resp.GetNextCursor()
 	out.Msg = "SUCCESS"
 
 	if len(out.Results) == 0 {
@@ -227,6 +232,49 @@ func (s *viewServer) SearchQuery(ctx context.Context, in *pb.SearchRequest) (*pb
 	return out, nil
 }
 
+// Autocomplete retrieves name suggestions for a partial search query from the Index service
+func (s *viewServer) Autocomplete(ctx context.Context, in *pb.AutocompleteRequest) (*pb.AutocompleteResponse, error) {
+	// intitialize response object
+	out := &pb.AutocompleteResponse{
+		UID:    in.GetUID(),
+		Prefix: in.GetPrefix(),
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tAutocomplete failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(err)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+	out.Timestamp = ts
+
+	// make RPC call to Index service to find matching names
+	resp, err := autocomplete(client, in.GetPrefix(), in.GetLimit(), hostname)
+	if err != nil {
+		fmt.Println("autocomplete err: ", err)
+		out.Msg = resp.GetMsg()
+		return out, err
+	}
+	for _, r := range resp.GetResults() {
+		wrap := &pb.SearchResult{
+			ID:       r.GetID(),
+			Name:     r.GetName(),
+			City:     r.GetCity(),
+			State:    r.GetState(),
+			Employer: r.GetEmployer(),
+			Bucket:   r.GetBucket(),
+			Years:    r.GetYears(),
+		}
+		out.Results = append(out.Results, wrap)
+	}
+	out.Msg = "SUCCESS"
+	if len(out.Results) == 0 {
+		out.Msg = "NO_RESULTS"
+	}
+
+	return out, nil
+}
+
 // LookupObjByID finds object summary data for a list of IDs
 func (s *viewServer) LookupObjByID(ctx context.Context, in *pb.LookupRequest) (*pb.LookupResponse, error) {
 	fmt.Println("called LookupObjByID...")
@@ -510,6 +558,19 @@ func (s *viewServer) ViewCommittee(ctx context.Context, in *pb.GetCmteRequest) (
 		TransferRecsTxs:           cmteTx.GetTransferRecsTxs(),
 		TopExpRecipientsAmt:       wrapTotals(cmteTx.GetTopExpRecipientsAmt()),
 		TopExpRecipientsTxs:       cmteTx.GetTopExpRecipientsTxs(),
+		SizeBinsAmt:               cmteTx.GetSizeBinsAmt(),
+		SizeBinsTxs:               cmteTx.GetSizeBinsTxs(),
+		SizePercentiles:           cmteTx.GetSizePercentiles(),
+		ConduitsInAmt:             cmteTx.GetConduitsInAmt(),
+		ConduitsInTxs:             cmteTx.GetConduitsInTxs(),
+		EarmarkedInAmt:            cmteTx.GetEarmarkedInAmt(),
+		EarmarkedInTxs:            cmteTx.GetEarmarkedInTxs(),
+		EarmarkedOutAmt:           cmteTx.GetEarmarkedOutAmt(),
+		EarmarkedOutTxs:           cmteTx.GetEarmarkedOutTxs(),
+		EarmarkRecipientsAmt:      cmteTx.GetEarmarkRecipientsAmt(),
+		EarmarkRecipientsTxs:      cmteTx.GetEarmarkRecipientsTxs(),
+		TxKindsAmt:                cmteTx.GetTxKindsAmt(),
+		TxKindsTxs:                cmteTx.GetTxKindsTxs(),
 	}
 	out.TxData = &cmteTxPb
 	out.Msg = "SUCCESS"
@@ -553,6 +614,7 @@ func (s *viewServer) ViewCandidate(ctx context.Context, in *pb.GetCandRequest) (
 	out.Years = resp.GetYears() // years available
 
 	cand := resp.GetCandidate()
+	rollup := resp.GetRollup() // nil if not found
 	candPb := pb.Candidate{
 		ID:                   cand.ID,
 		Name:                 cand.Name,
@@ -576,14 +638,137 @@ func (s *viewServer) ViewCandidate(ctx context.Context, in *pb.GetCandRequest) (
 		DirectRecipientsTxs:  cand.DirectRecipientsTxs,
 		DirectSendersAmts:    wrapTotals(cand.GetDirectSendersAmts()),
 		DirectSendersTxs:     cand.DirectSendersTxs,
+		// size distribution of contributions to all linked committees
+		SizeBinsAmt:     rollup.GetSizeBinsAmt(),
+		SizeBinsTxs:     rollup.GetSizeBinsTxs(),
+		SizePercentiles: rollup.GetSizePercentiles(),
 	}
 	out.Candidate = &candPb
+	if rollup != nil {
+		rollupPb := pb.CandRollup{
+			CandID:                    rollup.CandID,
+			Party:                     rollup.Party,
+			Committees:                rollup.Committees,
+			ContributionsInAmt:        rollup.ContributionsInAmt,
+			ContributionsInTxs:        rollup.ContributionsInTxs,
+			AvgContributionIn:         rollup.AvgContributionIn,
+			OtherReceiptsInAmt:        rollup.OtherReceiptsInAmt,
+			OtherReceiptsInTxs:        rollup.OtherReceiptsInTxs,
+			AvgOtherIn:                rollup.AvgOtherIn,
+			InternalTransfersAmt:      rollup.InternalTransfersAmt,
+			InternalTransfersTxs:      rollup.InternalTransfersTxs,
+			TotalIncomingAmt:          rollup.TotalIncomingAmt,
+			TotalIncomingTxs:          rollup.TotalIncomingTxs,
+			AvgIncoming:               rollup.AvgIncoming,
+			TransfersAmt:              rollup.TransfersAmt,
+			TransfersTxs:              rollup.TransfersTxs,
+			AvgTransfer:               rollup.AvgTransfer,
+			ExpendituresAmt:           rollup.ExpendituresAmt,
+			ExpendituresTxs:           rollup.ExpendituresTxs,
+			AvgExpenditure:            rollup.AvgExpenditure,
+			TotalOutgoingAmt:          rollup.TotalOutgoingAmt,
+			TotalOutgoingTxs:          rollup.TotalOutgoingTxs,
+			AvgOutgoing:               rollup.AvgOutgoing,
+			NetBalance:                rollup.NetBalance,
+			TopIndvContributorsAmt:    wrapTotals(rollup.GetTopIndvContributorsAmt()),
+			TopIndvContributorsTxs:    rollup.TopIndvContributorsTxs,
+			TopCmteOrgContributorsAmt: wrapTotals(rollup.GetTopCmteOrgContributorsAmt()),
+			TopCmteOrgContributorsTxs: rollup.TopCmteOrgContributorsTxs,
+			TransferRecsAmt:           wrapTotals(rollup.GetTransferRecsAmt()),
+			TransferRecsTxs:           rollup.TransferRecsTxs,
+			TopExpRecipientsAmt:       wrapTotals(rollup.GetTopExpRecipientsAmt()),
+			TopExpRecipientsTxs:       rollup.TopExpRecipientsTxs,
+			ElectionsInAmt:            rollup.ElectionsInAmt,
+			ElectionsInTxs:            rollup.ElectionsInTxs,
+			SizeBinsAmt:               rollup.SizeBinsAmt,
+			SizeBinsTxs:               rollup.SizeBinsTxs,
+			SizePercentiles:           rollup.SizePercentiles,
+			ConduitsInAmt:             rollup.ConduitsInAmt,
+			ConduitsInTxs:             rollup.ConduitsInTxs,
+			CandContsAmt:              rollup.CandContsAmt,
+			CandLoansAmt:              rollup.CandLoansAmt,
+			CandLoanRepayAmt:          rollup.CandLoanRepayAmt,
+			SelfFundedAmt:             rollup.SelfFundedAmt,
+			SelfFundedShare:           rollup.SelfFundedShare,
+			CandLoansOutstanding:      rollup.CandLoansOutstanding,
+			CandLoansByYear:           rollup.CandLoansByYear,
+			CandRepaysByYear:          rollup.CandRepaysByYear,
+			TxKindsAmt:                rollup.TxKindsAmt,
+			TxKindsTxs:                rollup.TxKindsTxs,
+		}
+		out.Rollup = &rollupPb
+	}
 
 	out.Msg = "SUCCESS"
 
 	return out, nil
 }
 
+// ViewNetwork retrieves a page of the money-flow network graph from the Index service
+func (s *viewServer) ViewNetwork(ctx context.Context, in *pb.GetNetworkRequest) (*pb.GetNetworkResponse, error) {
+	fmt.Println("called ViewNetwork...")
+	out := &pb.GetNetworkResponse{
+		UID:      in.GetUID(),
+		ObjectID: in.GetObjectID(),
+		Years:    in.GetYears(),
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tViewNetwork failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+	out.Timestamp = ts
+
+	if len(out.Years) == 0 {
+		err := "NO_YEAR_SET"
+		errMsg := fmt.Errorf("%v\tViewNetwork failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+	// rpc call to index service
+	resp, err := getNetwork(client, in, hostname)
+	if err != nil {
+		errMsg := fmt.Errorf("%v\tViewNetwork failed: %v\tUID: %s", time.Now(), err, out.UID)
+		fmt.Println(errMsg)
+		out.Msg = fmt.Sprintf("%s", errMsg)
+		return out, errMsg
+	}
+
+	for _, n := range resp.GetNodes() {
+		node := &pb.NetworkNode{
+			ID:          n.GetID(),
+			Name:        n.GetName(),
+			Type:        n.GetType(),
+			Party:       n.GetParty(),
+			State:       n.GetState(),
+			TotalInAmt:  n.GetTotalInAmt(),
+			TotalOutAmt: n.GetTotalOutAmt(),
+			Depth:       n.GetDepth(),
+		}
+		out.Nodes = append(out.Nodes, node)
+	}
+	for _, e := range resp.GetEdges() {
+		edge := &pb.NetworkEdge{
+			From:   e.GetFrom(),
+			To:     e.GetTo(),
+			Type:   e.GetType(),
+			Amount: e.GetAmount(),
+			Txs:    e.GetTxs(),
+		}
+		out.Edges = append(out.Edges, edge)
+	}
+	out.TotalNodes = resp.GetTotalNodes()
+	out.TotalEdges = resp.GetTotalEdges()
+	out.Truncated = resp.GetTruncated()
+	out.NextPageToken = resp.GetNextPageToken()
+	out.Msg = resp.GetMsg()
+
+	return out, nil
+}
+
 // NoOp - One empty request, ZERO processing, followed by one empty response
 func (s viewServer) NoOp(ctx context.Context, in *pb.Empty) (*pb.Empty, error) {
 	return &pb.Empty{}, nil
@@ -627,11 +812,11 @@ func createGetCachesRequest(hostname string) ind.GetCachesRequest {
 
 	return req
 }
-func searchIndex(client ind.IndexClient, query, hostname string, opts ...grpc.CallOption) (*ind.SearchIndexResponse, error) {
+func searchIndex(client ind.IndexClient, query string, pageSize int32, cursor, hostname string, opts ...grpc.CallOption) (*ind.SearchIndexResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	req := createSearchIndexRequest(query, hostname)
+	req := createSearchIndexRequest(query, pageSize, cursor, hostname)
 	resp, err := client.SearchIndex(ctx, &req)
 	if err != nil {
 		fmt.Println("search index err: ", err.Error())
@@ -647,11 +832,13 @@ func searchIndex(client ind.IndexClient, query, hostname string, opts ...grpc.Ca
 	return resp, nil
 }
 
-func createSearchIndexRequest(query, hostname string) ind.SearchIndexRequest {
+func createSearchIndexRequest(query string, pageSize int32, cursor, hostname string) ind.SearchIndexRequest {
 	req := ind.SearchIndexRequest{
 		UID:      "test007",
 		ServerID: hostname,
 		Text:     query,
+		PageSize: pageSize,
+		Cursor:   cursor,
 		Msg:      "new-search-req",
 	}
 	ts, err := ptypes.TimestampProto(time.Now())
@@ -664,6 +851,39 @@ func createSearchIndexRequest(query, hostname string) ind.SearchIndexRequest {
 	return req
 }
 
+// suggestions are returned as the user types; use short timeout
+func autocomplete(client ind.IndexClient, prefix string, limit int32, hostname string, opts ...grpc.CallOption) (*ind.AutocompleteResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	req := createAutocompleteRequest(prefix, limit, hostname)
+	resp, err := client.Autocomplete(ctx, &req)
+	if err != nil {
+		fmt.Println("autocomplete (client) failed: ", err)
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func createAutocompleteRequest(prefix string, limit int32, hostname string) ind.AutocompleteRequest {
+	req := ind.AutocompleteRequest{
+		UID:      "test007",
+		ServerID: hostname,
+		Prefix:   prefix,
+		Limit:    limit,
+		Msg:      "new-autocomplete-req",
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		fmt.Println("createAutocompleteRequest failed: ", err)
+		os.Exit(1)
+	}
+	req.Timestamp = ts
+
+	return req
+}
+
 func lookupObjects(client ind.IndexClient, ids []string, hostname string, opts ...grpc.CallOption) (*ind.LookupObjResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -793,3 +1013,41 @@ func createLookupCandRequest(ID, hostname string, years []string) ind.LookupCand
 
 	return req
 }
+
+// graphs not yet saved to disk are built on request; allow longer timeout
+func getNetwork(client ind.IndexClient, in *pb.GetNetworkRequest, hostname string, opts ...grpc.CallOption) (*ind.GetNetworkResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := createGetNetworkRequest(in, hostname)
+	resp, err := client.GetNetwork(ctx, &req)
+	if err != nil {
+		fmt.Println("getNetwork (client) failed: ", err)
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func createGetNetworkRequest(in *pb.GetNetworkRequest, hostname string) ind.GetNetworkRequest {
+	req := ind.GetNetworkRequest{
+		UID:       "test007",
+		ServerID:  hostname,
+		ObjectID:  in.GetObjectID(),
+		Years:     in.GetYears(),
+		Depth:     in.GetDepth(),
+		MinAmount: in.GetMinAmount(),
+		MaxNodes:  in.GetMaxNodes(),
+		PageSize:  in.GetPageSize(),
+		PageToken: in.GetPageToken(),
+		Msg:       "new-get-network-req",
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		fmt.Println("createGetNetworkRequest failed: ", err)
+		os.Exit(1)
+	}
+	req.Timestamp = ts
+
+	return req
+}
@@ -32,7 +32,7 @@ func Upload() error {
 	persist.OUTPUT_PATH = path
 	indexing.OUTPUT_PATH = path
 
-	opts := []string{"individuals", "committees", "cmte_tx_data", "candidates", "top_overall", "yearly_totals", "all", "index", "lookup", "Return"}
+	opts := []string{"individuals", "committees", "cmte_tx_data", "candidates", "cand_rollup", "top_overall", "yearly_totals", "all", "index", "lookup", "Return"}
 	menu := ui.CreateMenu("admin-upload-category", opts)
 
 	fmt.Println("Choose year: ")
@@ -60,7 +60,7 @@ func Upload() error {
 			return nil
 		case "all":
 			// upload all categories for given year; return when complete
-			for _, cat := range opts[:7] {
+			for _, cat := range opts[:8] {
 				err := uploadFromDisk(db, year, cat, 1000)
 				if err != nil {
 					fmt.Println(err)
@@ -742,6 +742,7 @@ func initTableObjs(db *dynamo.DbInfo, year string) {
 	cand := "cf-" + year + "-candidates"       // pk = State
 	cmte := "cf-" + year + "-committees"       // pk = State
 	cmteData := "cf-" + year + "-cmte_tx_data" // pk = Party
+	rollup := "cf-" + year + "-cand_rollup"    // pk = Party
 	// cmteFin := "cf-" + year + "-cmte_financials" // pk = First Letter of Name
 	topOverall := "cf-" + year + "-top_overall" // pk = Year
 	yrTotals := "cf-" + year + "-yearly_totals" // pk = Year
@@ -764,6 +765,10 @@ func initTableObjs(db *dynamo.DbInfo, year string) {
 	t = dynamo.CreateNewTableObj(cmteData, "Party", "string", "CmteID", "string")
 	db.AddTable(t)
 
+	// create candidate rollup table
+	t = dynamo.CreateNewTableObj(rollup, "Party", "string", "CandID", "string")
+	db.AddTable(t)
+
 	// create TopOverall table
 	t = dynamo.CreateNewTableObj(topOverall, "Year", "string", "ID", "string")
 	db.AddTable(t)
@@ -870,6 +875,7 @@ func getTableName(year, bucket string) string {
 		"candidates":    "cf-" + year + "-candidates",
 		"committees":    "cf-" + year + "-committees",
 		"cmte_tx_data":  "cf-" + year + "-cmte_tx_data",
+		"cand_rollup":   "cf-" + year + "-cand_rollup",
 		"cmte_fin":      "cf-" + year + "-cmte_financials",
 		"top_overall":   "cf-" + year + "-top_overall",
 		"yearly_totals": "cf-" + year + "-yearly_totals",
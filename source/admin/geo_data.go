@@ -0,0 +1,178 @@
+// Package admin contains operations for running the local admin console service.
+// Only the functions in this package are exposed to the admin service; lower
+// level source packages remain encapsulated.
+// This file contains operations for building and viewing the geographic datasets
+// (receipts by state/zip for each committee and candidate, national totals by state).
+// NOTE: logic is not UX optimized and may contain unresolved errors.
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elections/source/databuilder"
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+	"github.com/elections/source/ui"
+	"github.com/elections/source/util"
+)
+
+// createGeoDatasets derives the candidate GeoData objects and the national
+// GeoTotal objects for each state for the given year from the committee
+// GeoData created from each contribution in the primary pass.
+// Candidate GeoData is merged from the GeoData of each committee in the candidate's CandRollup.
+func createGeoDatasets(year string) error {
+	fmt.Println("Creating geographic datasets...")
+	n := 100000
+	curr := ""
+	geo := make(map[string]*donations.GeoData)
+	totals := make(map[string]*donations.GeoTotal)
+
+	// scan committee GeoData and update national totals
+	// each batch after the first starts with the previous batch's last key;
+	// the repeated object is skipped so each committee is added once
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "geo_data", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createGeoDatasets failed: %v", err)
+		}
+		last := len(objs) < n
+		if curr != "" && len(objs) > 0 {
+			objs = objs[1:] // start key was read at the end of the previous batch
+		}
+		curr = key
+		for _, obj := range objs {
+			g := obj.(*donations.GeoData)
+			if g.Bucket != "cmte_tx_data" {
+				continue // candidate GeoData from previous run
+			}
+			geo[g.ID] = g
+			databuilder.UpdateGeoTotals(year, g, totals)
+		}
+		if last {
+			break
+		}
+	}
+
+	// merge committee GeoData for each candidate
+	n = 10000
+	curr = ""
+	candGeo := []interface{}{}
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "cand_rollup", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createGeoDatasets failed: %v", err)
+		}
+		last := len(objs) < n
+		if curr != "" && len(objs) > 0 {
+			objs = objs[1:] // start key was read at the end of the previous batch
+		}
+		curr = key
+		for _, obj := range objs {
+			rollup := obj.(*donations.CandRollup)
+			set := []*donations.GeoData{}
+			for _, id := range rollup.Committees {
+				if geo[id] != nil {
+					set = append(set, geo[id])
+				}
+			}
+			g := databuilder.MergeGeoData(rollup.CandID, "candidates", set)
+			databuilder.ClipGeoData(g)
+			candGeo = append(candGeo, g)
+		}
+		if last {
+			break
+		}
+	}
+
+	// save
+	// committee GeoData is left unclipped so later primary pass updates are not lost
+	objs := candGeo
+	for _, gt := range totals {
+		objs = append(objs, gt)
+	}
+	err := persist.SaveGeoData(year, objs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createGeoDatasets failed: %v", err)
+	}
+	fmt.Printf("geographic datasets created: %d committees, %d candidates, %d states\n", len(geo), len(candGeo), len(totals))
+
+	return nil
+}
+
+// viewGeoData prints the national totals by state or the
+// receipts by state/zip code for a given committee or candidate
+func viewGeoData() error {
+	opts := []string{"National Totals by State", "Committee/Candidate by ID", "Return"}
+	menu := ui.CreateMenu("admin-view-geo", opts)
+
+	for {
+		year := ui.GetYear()
+		ch, err := ui.Ask4MenuChoice(menu)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("viewGeoData failed: %v", err)
+		}
+
+		switch menu.OptionsMap[ch] {
+		case "National Totals by State":
+			objs, err := persist.GetGeoTotals(year)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewGeoData failed: %v", err)
+			}
+			amts := make(map[string]float32)
+			txs := make(map[string]float32)
+			for _, obj := range objs {
+				gt := obj.(*donations.GeoTotal)
+				amts[gt.State] = gt.Total
+				txs[gt.State] = gt.Txs
+			}
+			fmt.Printf("Individual Contributions by State - %s:\n", year)
+			printGeoTotals(util.SortMapObjectTotals(amts), txs, len(amts))
+		case "Committee/Candidate by ID":
+			fmt.Println("Enter committee or candidate ID: ")
+			id := strings.TrimSpace(ui.GetQuery())
+			obj, err := persist.GetObject(year, "geo_data", id)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewGeoData failed: %v", err)
+			}
+			g := obj.(*donations.GeoData)
+			if g.ID == "" {
+				fmt.Println("no geographic data found for ID: ", id)
+				break
+			}
+			fmt.Printf("Receipts by State - %s (%s):\n", g.ID, year)
+			printGeoTotals(util.SortMapObjectTotals(g.StateAmt), g.StateTxs, len(g.StateAmt))
+			fmt.Println("Receipts by 3-Digit Zip Code (Top 25):")
+			printGeoTotals(util.SortMapObjectTotals(g.Zip3Amt), g.Zip3Txs, 25)
+			fmt.Println("Receipts by 5-Digit Zip Code (Top 25):")
+			printGeoTotals(util.SortMapObjectTotals(g.Zip5Amt), g.Zip5Txs, 25)
+		case "Return":
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+
+		fmt.Println("View more geographic data?")
+		yes := ui.Ask4confirm()
+		if !yes {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+	}
+}
+
+// print the top n entries of a sorted geographic totals map
+func printGeoTotals(sorted util.SortedTotalsMap, txs map[string]float32, n int) {
+	for i, e := range sorted {
+		if i == n {
+			break
+		}
+		fmt.Printf("%d) %s:\tTotal $: %.2f\t# Txs: %.0f\n", i+1, e.ID, e.Total, txs[e.ID])
+	}
+	fmt.Println()
+}
@@ -8,6 +8,7 @@ package admin
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/elections/source/persist"
 
@@ -27,7 +28,7 @@ func BuildIndexFromYear() error {
 	indexing.OUTPUT_PATH = output
 	persist.OUTPUT_PATH = output
 	// create submenu
-	opts := []string{"Build New Index", "Update Index", "Write Out Index", "Return"}
+	opts := []string{"Build New Index", "Update Index", "Write Out Index", "Check/Compact Index", "Return"}
 	menu := ui.CreateMenu("admin-index-options", opts)
 
 	for {
@@ -42,6 +43,14 @@ func BuildIndexFromYear() error {
 			fmt.Println("Returning to menu...")
 			return nil
 		}
+		if choice == "Check/Compact Index" {
+			err := checkIndex()
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("BuildIndexFromYear failed: %v", err)
+			}
+			continue
+		}
 		fmt.Println("Choose year: ")
 		year := ui.GetYear()
 
@@ -121,3 +130,56 @@ func update(year string) error {
 		}
 	}
 }
+
+// checkIndex verifies the search index and optionally compacts the shards.
+func checkIndex() error {
+	fmt.Println("Checking index...")
+	r, err := indexing.CheckIndex()
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("checkIndex failed: %v", err)
+	}
+	printIndexReport(r)
+	if r.OK() {
+		return nil
+	}
+
+	fmt.Println("Compact index?")
+	if !ui.Ask4confirm() {
+		return nil
+	}
+	fmt.Println("Remove IDs missing from lookup data?")
+	prune := ui.Ask4confirm()
+	r, err = indexing.CompactIndex(prune)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("checkIndex failed: %v", err)
+	}
+	fmt.Println("Index compacted!")
+	printIndexReport(r)
+	return nil
+}
+
+// printIndexReport prints the totals and issues found by the index check.
+func printIndexReport(r *indexing.IndexReport) {
+	fmt.Println()
+	fmt.Println("***** INDEX CHECK *****")
+	fmt.Printf("Terms: %d\tShards: %d\tReferences: %d\tLookup entries: %d\n", r.Terms, r.Shards, r.Refs, r.LookupEntries)
+	if r.OK() {
+		fmt.Println("No issues found.")
+		fmt.Println()
+		return
+	}
+	issues := []string{}
+	for issue := range r.Issues {
+		issues = append(issues, issue)
+	}
+	sort.Strings(issues)
+	for _, issue := range issues {
+		fmt.Printf("%s: %d\n", issue, r.Issues[issue])
+		for _, key := range r.Samples[issue] {
+			fmt.Printf("\t%s\n", key)
+		}
+	}
+	fmt.Println()
+}
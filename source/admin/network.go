@@ -0,0 +1,221 @@
+// Package admin contains operations for running the local admin console service.
+// Only the functions in this package are exposed to the admin service; lower
+// level source packages remain encapsulated.
+// This file contains operations for building money-flow network graphs from
+// the datasets stored on the local disk, exporting them for analysis, finding
+// the money-flow paths between two entities, and viewing the communities of
+// committees on the committee transfer graph.
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elections/source/network"
+	"github.com/elections/source/persist"
+	"github.com/elections/source/ui"
+)
+
+// exportNetwork builds the money-flow graph from a root Individual/Committee/Candidate
+// or the committee transfer graph for the year, saves the graph to network_db.db,
+// and exports the graph to the OUTPUT_PATH/network directory in the chosen format.
+func exportNetwork() error {
+	opts := []string{"Graph from Root ID", "Committee Transfer Graph", "Return"}
+	menu := ui.CreateMenu("admin-export-network", opts)
+	fmtMenu := ui.CreateMenu("admin-export-network-fmt", network.ExportFormats)
+
+	for {
+		ch, err := ui.Ask4MenuChoice(menu)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("exportNetwork failed: %v", err)
+		}
+		if menu.OptionsMap[ch] == "Return" {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+
+		year := ui.GetYear()
+		if year == "cancel" || year == "all-time" {
+			fmt.Println("Graphs must be built for a single year")
+			continue
+		}
+
+		var graph *network.NetworkGraph
+		switch menu.OptionsMap[ch] {
+		case "Graph from Root ID":
+			fmt.Println("Enter individual, committee or candidate ID: ")
+			id := strings.TrimSpace(ui.GetQuery())
+			depth := int(ui.GetNumber("Enter search depth (# of hops from root)"))
+			minAmt := float32(ui.GetNumber("Enter minimum edge amount ($)"))
+			graph, err = network.CreateGraph(year, id, depth, minAmt)
+		case "Committee Transfer Graph":
+			minAmt := float32(ui.GetNumber("Enter minimum transfer amount ($)"))
+			graph, err = network.CreateTransferGraph(year, minAmt)
+		}
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("exportNetwork failed: %v", err)
+		}
+		fmt.Printf("Graph %s: %d nodes, %d edges\n", graph.ID, len(graph.Nodes), len(graph.Edges()))
+
+		if err := network.PersistGraph(graph); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("exportNetwork failed: %v", err)
+		}
+
+		fmt.Println("Choose export format:")
+		fch, err := ui.Ask4MenuChoice(fmtMenu)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("exportNetwork failed: %v", err)
+		}
+		path, err := network.ExportGraph(graph, fmtMenu.OptionsMap[fch], persist.OUTPUT_PATH+"/network")
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("exportNetwork failed: %v", err)
+		}
+		fmt.Println("Graph exported to: ", path)
+
+		fmt.Println("Export another graph?")
+		yes := ui.Ask4confirm()
+		if !yes {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+	}
+}
+
+// viewPaths prints the top money-flow paths from a source ID to a target ID
+// for the given year with the $ value of each hop.
+func viewPaths() error {
+	rankMenu := ui.CreateMenu("admin-view-paths-rank", []string{network.RankBottleneck, network.RankShare})
+
+	for {
+		year := ui.GetYear()
+		if year == "cancel" || year == "all-time" {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+		fmt.Println("Enter source individual, committee or candidate ID: ")
+		from := strings.TrimSpace(ui.GetQuery())
+		fmt.Println("Enter target committee or candidate ID: ")
+		to := strings.TrimSpace(ui.GetQuery())
+		k := int(ui.GetNumber("Enter # of paths"))
+		maxHops := int(ui.GetNumber("Enter max # of hops"))
+		minAmt := float32(ui.GetNumber("Enter minimum hop amount ($)"))
+		fmt.Println("Rank paths by:")
+		ch, err := ui.Ask4MenuChoice(rankMenu)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("viewPaths failed: %v", err)
+		}
+
+		paths, err := network.FindPaths(year, from, to, k, maxHops, rankMenu.OptionsMap[ch], minAmt)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("viewPaths failed: %v", err)
+		}
+		if len(paths) == 0 {
+			fmt.Printf("No paths found from %s to %s\n", from, to)
+		}
+		for i, p := range paths {
+			fmt.Printf("%d) Bottleneck: $%.2f\tAttributed: $%.2f\tShare: %.4f%%\n", i+1, p.Bottleneck, p.Attributed, p.Share*100)
+			for _, h := range p.Hops {
+				fmt.Printf("\t%s (%s) -> %s (%s)\t%s\t$%.2f\t%.0f txs\n", h.FromName, h.From, h.ToName, h.To, h.Type, h.Amt, h.Txs)
+			}
+		}
+		fmt.Println()
+
+		fmt.Println("Find more paths?")
+		yes := ui.Ask4confirm()
+		if !yes {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+	}
+}
+
+// viewCommunities detects the communities of committees on the year's committee
+// transfer graph, or retrieves the communities saved to network_db.db, and prints
+// the summary of the largest communities with the option to export the results.
+func viewCommunities() error {
+	for {
+		year := ui.GetYear()
+		if year == "cancel" || year == "all-time" {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+		minAmt := float32(ui.GetNumber("Enter minimum transfer amount ($)"))
+		graphID := network.TransferGraphID(year, minAmt)
+
+		comms, err := network.GetCommunities(graphID)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("viewCommunities failed: %v", err)
+		}
+		var graph *network.NetworkGraph
+		if comms == nil {
+			fmt.Println("Detecting communities...")
+			graph, comms, err = network.CreateCommunities(year, minAmt)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewCommunities failed: %v", err)
+			}
+			if err := network.PersistGraph(graph); err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewCommunities failed: %v", err)
+			}
+			if err := network.PersistCommunities(comms); err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewCommunities failed: %v", err)
+			}
+		} else {
+			graph, err = network.GetGraph(graphID)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewCommunities failed: %v", err)
+			}
+		}
+
+		fmt.Printf("Graph %s: %d committees, %d communities, modularity %.4f\n",
+			graphID, len(comms.Assignments), len(comms.Communities), comms.Modularity)
+		n := int(ui.GetNumber("Enter # of communities to view"))
+		for i, comm := range comms.Communities {
+			if i == n {
+				break
+			}
+			fmt.Printf("%d) Community %d: %d members\tInternal: $%.2f (%.0f txs)\tIn: $%.2f\tOut: $%.2f\tParties: %v\n",
+				i+1, comm.ID, len(comm.Members), comm.InternalAmt, comm.InternalTxs, comm.InAmt, comm.OutAmt, comm.Parties)
+			for j, id := range comm.Members {
+				if j == 5 {
+					fmt.Printf("\t... %d more\n", len(comm.Members)-j)
+					break
+				}
+				name := ""
+				if node := graph.Nodes[id]; node != nil {
+					name = node.Name
+				}
+				fmt.Printf("\t%s\t%s\n", id, name)
+			}
+		}
+		fmt.Println()
+
+		fmt.Println("Export communities?")
+		if ui.Ask4confirm() {
+			paths, err := network.ExportCommunities(graph, comms, persist.OUTPUT_PATH+"/network")
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewCommunities failed: %v", err)
+			}
+			fmt.Println("Communities exported to: ", strings.Join(paths, ", "))
+		}
+
+		fmt.Println("View more communities?")
+		yes := ui.Ask4confirm()
+		if !yes {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+	}
+}
@@ -2,7 +2,8 @@
 // Only the functions in this package are exposed to the admin service; lower
 // level source packages remain encapsulated.
 // This file contains operations for building the secondary datasets (overall rankings, totals)
-// from the primary data (individuals, committees, candidates).
+// from the primary data (individuals, committees, candidates), including the adjacency
+// index and network centrality rankings computed from the year's money-flow graph.
 // NOTE: logic is not UX optimized and may contain unresolved errors.
 package admin
 
@@ -11,6 +12,7 @@ import (
 
 	"github.com/elections/source/databuilder"
 	"github.com/elections/source/donations"
+	"github.com/elections/source/network"
 	"github.com/elections/source/persist"
 	"github.com/elections/source/ui"
 )
@@ -19,7 +21,7 @@ type odMapping map[string]map[string]map[string]*donations.TopOverallData
 type ytMapping map[string]map[string]*donations.YearlyTotal
 
 // CreateSecondaryDatasets processes objects created from raw data
-// and creates the TopOverall and YearlyTotals datasets
+// and creates the TopOverall, YearlyTotals, geographic, and sector datasets
 func createSecondaryDatasets() error {
 	fmt.Println("***** PROCESS SECONDARY DATA *****")
 	path, err := getPath(false)
@@ -46,8 +48,18 @@ func createSecondaryDatasets() error {
 		}
 	}
 
-	// initialize TopOverallData objects & mappings
-	topOverall, yearlyTotals := donations.InitSecondaryDataObjs(year)
+	// initialize TopOverallData objects & mappings from the ranking definitions
+	defs, err := persist.LoadRankingDefs()
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	err = databuilder.ValidateRankingDefs(defs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	topOverall, yearlyTotals := donations.InitSecondaryDataObjs(year, defs)
 
 	odMap := make(odMapping)
 	for _, intf := range topOverall {
@@ -81,6 +93,13 @@ func createSecondaryDatasets() error {
 		return nil
 	}
 
+	// credit recipients with earmarked contributions missing a 15E receipt
+	err = reconcileEarmarks(year)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+
 	for _, b := range buckets {
 		fmt.Println("Processing bucket: ", b)
 		err := deriveDatabyBucket(year, b, odMap, ytMap)
@@ -91,6 +110,47 @@ func createSecondaryDatasets() error {
 	}
 	fmt.Println("Top Overall Rankings  and Yearly Totals complete!")
 
+	// index money-flow edges for graph queries
+	fmt.Println("Creating adjacency index...")
+	err = network.BuildAdjacency(year)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	fmt.Println("Adjacency index complete!")
+
+	// cached graphs built from the previous data are stale
+	n, err := network.DeleteGraphs(year)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	fmt.Println("cached network graphs deleted: ", n)
+
+	// rank committees & individuals by network centrality
+	err = createCentralityRankings(year, odMap)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	fmt.Println("Centrality rankings complete!")
+
+	// derive geographic breakdowns from individuals & candidate rollups
+	err = createGeoDatasets(year)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	fmt.Println("Geographic datasets complete!")
+
+	// classify individuals by sector & derive sector breakdowns
+	err = createSectorDatasets(year)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSecondaryDatasets failed: %v", err)
+	}
+	fmt.Println("Sector datasets complete!")
+
 	return nil
 }
 
@@ -119,9 +179,16 @@ func getAllTime(odMap odMapping, ytMap ytMapping) error {
 	return nil
 }
 
-// deriveDatabyCat finds and records the top rankings for each party for a given year/bucket/category
+// deriveDatabyBucket finds and records the rankings defined for a given year/bucket
 func deriveDatabyBucket(year, bucket string, odm odMapping, ytm ytMapping) error {
-	cats := []string{"rec", "donor", "exp"}
+	// aggregate linked committees' data for each candidate
+	if bucket == "candidates" {
+		err := createCandRollups(year)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("DeriveTopOverall failed: %v", err)
+		}
+	}
 
 	// scan every object each category
 	// update TopOverall/YearlyTotal objects
@@ -131,22 +198,24 @@ func deriveDatabyBucket(year, bucket string, odm odMapping, ytm ytMapping) error
 		return fmt.Errorf("DeriveTopOverall failed: %v", err)
 	}
 
-	for _, cat := range cats {
-		ods := []interface{}{}
-		yts := []interface{}{}
-		for _, od := range odm[bucket][cat] {
+	// save TopOverall objects
+	// overwrite any previously existing data
+	ods := []interface{}{}
+	for _, cat := range odm[bucket] {
+		for _, od := range cat {
 			ods = append(ods, od)
 		}
-		for _, yt := range ytm[cat] {
-			yts = append(yts, yt)
-		}
+	}
+	err = persist.SaveTopOverall(year, bucket, ods)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("DeriveTopOverall failed: %v", err)
+	}
 
-		// save TopOverall objects
-		// overwrite any previously existing data
-		err = persist.SaveTopOverall(year, bucket, ods)
-		if err != nil {
-			fmt.Println(err)
-			return fmt.Errorf("DeriveTopOverall failed: %v", err)
+	for cat, m := range ytm {
+		yts := []interface{}{}
+		for _, yt := range m {
+			yts = append(yts, yt)
 		}
 		err = persist.SaveYearlyTotals(year, cat, yts)
 		if err != nil {
@@ -166,12 +235,26 @@ func scanObjects(year, bucket string, ods map[string]map[string]*donations.TopOv
 	}
 	start := ""
 	curr := start
-	cmteTotals := map[string]map[string]float32{
-		"rec":   make(map[string]float32),
-		"donor": make(map[string]float32),
-		"exp":   make(map[string]float32),
+
+	rankings := []*donations.TopOverallData{}
+	for cat, m := range ods {
+		if databuilder.IsCentralityCategory(bucket, cat) {
+			continue // ranked by createCentralityRankings
+		}
+		for _, od := range m {
+			rankings = append(rankings, od)
+		}
+	}
+	if len(rankings) == 0 && bucket != "cmte_tx_data" {
+		return nil // no rankings defined for bucket
+	}
+
+	// committee parties & states used by party/state filters
+	cmteParties, cmteStates, err := getCmteAttrs(year, bucket, rankings)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("scanObjects failed: %v", err)
 	}
-	cats := []string{"rec", "donor", "exp"}
 
 	for {
 		objs, key, err := persist.BatchGetSequential(year, bucket, curr, n)
@@ -181,85 +264,398 @@ func scanObjects(year, bucket string, ods map[string]map[string]*donations.TopOv
 		}
 		curr = key
 
-		// add funds raised by candidate's PCC to direct amts
+		// use funds raised by all of candidate's linked committees
+		rollups := make(map[string]*donations.CandRollup)
 		if bucket == "candidates" {
-			// get corresponding CmteTxData for each candidate
+			// get corresponding CandRollup for each candidate
 			ids := []string{}
 			for _, obj := range objs {
-				ids = append(ids, obj.(*donations.Candidate).PCC)
+				ids = append(ids, obj.(*donations.Candidate).ID)
 			}
-			cmtes, _, err := persist.BatchGetByID(year, "cmte_tx_data", ids)
+			rs, _, err := persist.BatchGetByID(year, "cand_rollup", ids)
 			if err != nil {
 				fmt.Println(err)
 				return fmt.Errorf("scanObjects failed: %v", err)
 			}
-			// get total for each cmte
-			for _, c := range cmtes {
-				for _, cat := range cats {
-					id, _, total, err := deriveTotal(c, cat)
-					if err != nil {
-						fmt.Println(err)
-						return fmt.Errorf("scanObjects failed: %v", err)
-					}
-					cmteTotals[cat][id] = total
-				}
+			for _, obj := range rs {
+				r := obj.(*donations.CandRollup)
+				rollups[r.CandID] = r
 			}
 		}
 
-		// get totals and add/compare to rankings list
+		// get totals and add/compare to each ranking's list
 		// update yearly totals
 		for _, obj := range objs {
-			for _, cat := range cats {
-				if bucket == "individuals" && cat == "exp" {
-					continue // non-existent category
+			var id, pty, state string
+			var ranked interface{} = obj
+			switch t := obj.(type) {
+			case *donations.Individual:
+				id, pty, state = t.ID, "ALL", t.State
+			case *donations.CmteTxData:
+				id, pty, state = t.CmteID, getParty(t.Party), cmteStates[t.CmteID]
+			case *donations.Candidate:
+				id, pty, state = t.ID, getParty(t.Party), t.OfficeState
+				if rollups[t.ID] == nil {
+					continue // no linked committees
 				}
-				// update ALL
-				all := ods[cat][year+"-"+bucket+"-"+cat+"-ALL"]
-				id, pty, total, err := deriveTotal(obj, cat)
-				if err != nil {
-					fmt.Println(err)
-					return fmt.Errorf("scanObjects failed: %v", err)
+				ranked = rollups[t.ID]
+			default:
+				return fmt.Errorf("scanObjects failed: invalid interface type")
+			}
+
+			for _, od := range rankings {
+				if od.State != "" && od.State != state {
+					continue
 				}
-				if bucket == "candidates" {
-					total = cmteTotals[cat][obj.(*donations.Candidate).PCC]
+				var total float32
+				switch {
+				case bucket == "individuals" && od.Party != "ALL":
+					total = databuilder.PartyTotal(obj.(*donations.Individual), od.Category, od.Party, cmteParties)
+				case od.Party != "ALL" && od.Party != pty:
+					continue
+				default:
+					total, err = databuilder.RankingMetric(ranked, od.Bucket, od.Category, od.Metric)
+					if err != nil {
+						fmt.Println(err)
+						return fmt.Errorf("scanObjects failed: %v", err)
+					}
 				}
-				err = databuilder.CompareTopOverall(id, total, all)
+				err = databuilder.CompareTopOverall(id, total, od)
 				if err != nil {
 					fmt.Println(err)
 					return fmt.Errorf("scanObjects failed: %v", err)
 				}
+			}
 
-				if bucket == "individuals" {
-					continue // no party specific objects
+			// update yearly totals while processing cmtes
+			if bucket == "cmte_tx_data" {
+				for cat, m := range yts {
+					_, _, total, err := deriveTotal(obj, cat)
+					if err != nil {
+						fmt.Println(err)
+						return fmt.Errorf("scanObjects failed: %v", err)
+					}
+					for _, yt := range m {
+						if yt.Party == "ALL" || yt.Party == pty {
+							databuilder.UpdateYearlyTotal(total, yt)
+						}
+					}
 				}
+			}
+		}
 
-				// update Party-specific
-				ptyOd := ods[cat][year+"-"+bucket+"-"+cat+"-"+pty]
-				err = databuilder.CompareTopOverall(id, total, ptyOd)
+		if len(objs) < n {
+			break
+		}
+
+	}
+	return nil
+}
+
+// createCentralityRankings builds the year's money-flow graph, computes the centrality
+// of each node, and records and saves the centrality rankings for each bucket.
+func createCentralityRankings(year string, odm odMapping) error {
+	rankings := make(map[string][]*donations.TopOverallData)
+	for bucket, cats := range odm {
+		for cat, m := range cats {
+			if !databuilder.IsCentralityCategory(bucket, cat) {
+				continue
+			}
+			for _, od := range m {
+				rankings[bucket] = append(rankings[bucket], od)
+			}
+		}
+	}
+	if len(rankings) == 0 {
+		return nil // no centrality rankings defined
+	}
+
+	fmt.Println("Computing network centrality...")
+	graph, err := network.CreateFlowGraph(year, 0)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createCentralityRankings failed: %v", err)
+	}
+	scores := network.ComputeCentrality(graph)
+
+	for bucket, list := range rankings {
+		ods := []interface{}{}
+		for _, od := range list {
+			for id, score := range scores[od.Category] {
+				node := graph.Nodes[id]
+				switch {
+				case bucket == "individuals" && node.Type != network.NodeIndv:
+					continue
+				case bucket == "cmte_tx_data" && node.Type != network.NodePAC && node.Type != network.NodePCC:
+					continue
+				case od.Party != "ALL" && od.Party != getParty(node.Party):
+					continue
+				case od.State != "" && od.State != node.State:
+					continue
+				}
+				err := databuilder.CompareTopOverall(id, score, od)
 				if err != nil {
 					fmt.Println(err)
-					return fmt.Errorf("scanObjects failed: %v", err)
+					return fmt.Errorf("createCentralityRankings failed: %v", err)
 				}
+			}
+			ods = append(ods, od)
+		}
+		err := persist.SaveTopOverall(year, bucket, ods)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createCentralityRankings failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// getCmteAttrs maps each committee ID to the committee's party and state if required
+// by the rankings' filters: individual rankings filtered by party require each recipient/sender
+// committee's party; committee rankings filtered by state require each committee's state.
+func getCmteAttrs(year, bucket string, rankings []*donations.TopOverallData) (map[string]string, map[string]string, error) {
+	parties := make(map[string]string)
+	states := make(map[string]string)
+	required := false
+	for _, od := range rankings {
+		if (bucket == "individuals" && od.Party != "ALL") || (bucket == "cmte_tx_data" && od.State != "") {
+			required = true
+		}
+	}
+	if !required {
+		return parties, states, nil
+	}
+
+	n := 10000
+	curr := ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "committees", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return nil, nil, fmt.Errorf("getCmteAttrs failed: %v", err)
+		}
+		curr = key
+		for _, obj := range objs {
+			cmte := obj.(*donations.Committee)
+			parties[cmte.ID] = getParty(cmte.Party)
+			states[cmte.ID] = cmte.State
+		}
+		if len(objs) < n {
+			break
+		}
+	}
+	return parties, states, nil
+}
+
+// createCandRollups aggregates the CmteTxData of each candidate's linked committees
+// (PCC, OtherAffiliates, and committees listing the candidate's ID) and saves
+// the resulting CandRollup objects for the given year.
+func createCandRollups(year string) error {
+	fmt.Println("Creating candidate rollups...")
+	n := 10000
+	curr := ""
+	total := 0
+
+	// map committees to linked candidates
+	links := make(map[string][]string)
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "committees", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createCandRollups failed: %v", err)
+		}
+		curr = key
+		for _, obj := range objs {
+			cmte := obj.(*donations.Committee)
+			if cmte.CandID != "" {
+				links[cmte.CandID] = append(links[cmte.CandID], cmte.ID)
+			}
+		}
+		if len(objs) < n {
+			break
+		}
+	}
 
-				// update yearly totals while processing cmtes
-				if bucket == "cmte_tx_data" {
-					ytAll := yts[cat][year+"-"+cat+"-ALL"]
-					databuilder.UpdateYearlyTotal(total, ytAll)
-					ytPty := yts[cat][year+"-"+cat+"-"+pty]
-					databuilder.UpdateYearlyTotal(total, ytPty)
+	curr = ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "candidates", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createCandRollups failed: %v", err)
+		}
+		curr = key
+
+		// get CmteTxData for every linked committee in batch
+		ids := []string{}
+		seen := make(map[string]bool)
+		for _, obj := range objs {
+			for _, id := range linkedCmtes(obj.(*donations.Candidate), links) {
+				if !seen[id] {
+					ids = append(ids, id)
+					seen[id] = true
 				}
 			}
+		}
+		cmtes, _, err := persist.BatchGetByID(year, "cmte_tx_data", ids)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createCandRollups failed: %v", err)
+		}
+		txData := make(map[string]interface{})
+		for _, c := range cmtes {
+			txData[c.(*donations.CmteTxData).CmteID] = c
+		}
 
+		// get campaign financials for each candidate in current & prior cycles
+		fins, err := getCmpnFinHistory(year, objs)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createCandRollups failed: %v", err)
 		}
 
+		// aggregate & save
+		rollups := []interface{}{}
+		for _, obj := range objs {
+			cand := obj.(*donations.Candidate)
+			set := []interface{}{}
+			for _, id := range linkedCmtes(cand, links) {
+				if txData[id] != nil {
+					set = append(set, txData[id])
+				}
+			}
+			rollup, err := databuilder.CandidateRollup(cand, set)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("createCandRollups failed: %v", err)
+			}
+			databuilder.SelfFundingUpdate(rollup, year, fins[cand.ID])
+			rollups = append(rollups, rollup)
+		}
+		err = persist.SaveCandRollups(year, rollups)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createCandRollups failed: %v", err)
+		}
+		total += len(rollups)
+
 		if len(objs) < n {
 			break
 		}
+	}
+	fmt.Println("candidate rollups created: ", total)
+
+	return nil
+}
 
+// reconcileEarmarks credits each recipient committee with the earmarked contributions
+// forwarded by conduits with no corresponding 15E receipt filed by the recipient.
+func reconcileEarmarks(year string) error {
+	fmt.Println("Reconciling earmarked contributions...")
+	n := 10000
+	curr := ""
+	total := 0
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "cmte_tx_data", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("reconcileEarmarks failed: %v", err)
+		}
+		curr = key
+
+		// get recipients of earmarked contributions forwarded by conduits in batch
+		ids := []string{}
+		seen := make(map[string]bool)
+		for _, obj := range objs {
+			for id := range obj.(*donations.CmteTxData).EarmarkRecipientsAmt {
+				if !seen[id] {
+					ids = append(ids, id)
+					seen[id] = true
+				}
+			}
+		}
+		if len(ids) > 0 {
+			recs, _, err := persist.BatchGetByID(year, "cmte_tx_data", ids)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("reconcileEarmarks failed: %v", err)
+			}
+			recipients := make(map[string]*donations.CmteTxData)
+			for _, r := range recs {
+				recipients[r.(*donations.CmteTxData).CmteID] = r.(*donations.CmteTxData)
+			}
+
+			updated := make(map[string]*donations.CmteTxData)
+			for _, obj := range objs {
+				for _, r := range databuilder.ReconcileEarmarks(obj.(*donations.CmteTxData), recipients) {
+					updated[r.CmteID] = r
+				}
+			}
+			save := []interface{}{}
+			for _, r := range updated {
+				save = append(save, r)
+			}
+			if len(save) > 0 {
+				err = persist.StoreObjects(year, save)
+				if err != nil {
+					fmt.Println(err)
+					return fmt.Errorf("reconcileEarmarks failed: %v", err)
+				}
+			}
+			total += len(save)
+		}
+
+		if len(objs) < n {
+			break
+		}
 	}
+	fmt.Println("recipients credited: ", total)
+
 	return nil
 }
 
+// getCmpnFinHistory maps each candidate's ID to the candidate's CmpnFinancials
+// for the given year and each prior election cycle by year.
+func getCmpnFinHistory(year string, cands []interface{}) (map[string]map[string]*donations.CmpnFinancials, error) {
+	ids := []string{}
+	for _, obj := range cands {
+		ids = append(ids, obj.(*donations.Candidate).ID)
+	}
+	years := []string{}
+	for _, yr := range getRemainingYrs(0) {
+		if yr <= year {
+			years = append(years, yr)
+		}
+	}
+	objs, err := persist.BatchGetByYear(years, "cmpn_fin", ids)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getCmpnFinHistory failed: %v", err)
+	}
+
+	fins := make(map[string]map[string]*donations.CmpnFinancials)
+	for yr, list := range objs {
+		for _, obj := range list {
+			fin := obj.(*donations.CmpnFinancials)
+			if fins[fin.CandID] == nil {
+				fins[fin.CandID] = make(map[string]*donations.CmpnFinancials)
+			}
+			fins[fin.CandID][yr] = fin
+		}
+	}
+	return fins, nil
+}
+
+// linkedCmtes returns the IDs of all committees linked to the candidate.
+func linkedCmtes(cand *donations.Candidate, links map[string][]string) []string {
+	ids := []string{}
+	if cand.PCC != "" {
+		ids = append(ids, cand.PCC)
+	}
+	ids = append(ids, cand.OtherAffiliates...)
+	ids = append(ids, links[cand.ID]...)
+	return ids
+}
+
 func deriveTotal(obj interface{}, cat string) (string, string, float32, error) {
 	var ID string
 	var pty string
@@ -288,6 +684,19 @@ func deriveTotal(obj interface{}, cat string) (string, string, float32, error) {
 		default:
 			return "", "", 0, fmt.Errorf("deriveTotal failed: invalid category")
 		}
+	case *donations.CandRollup:
+		ID = obj.(*donations.CandRollup).CandID
+		pty = getParty(obj.(*donations.CandRollup).Party)
+		switch {
+		case cat == "rec":
+			return ID, pty, obj.(*donations.CandRollup).TotalIncomingAmt, nil
+		case cat == "donor":
+			return ID, pty, obj.(*donations.CandRollup).TransfersAmt, nil
+		case cat == "exp":
+			return ID, pty, obj.(*donations.CandRollup).ExpendituresAmt, nil
+		default:
+			return "", "", 0, fmt.Errorf("deriveTotal failed: invalid category")
+		}
 	case *donations.Candidate:
 		ID = obj.(*donations.Candidate).ID
 		pty = getParty(obj.(*donations.Candidate).Party)
@@ -0,0 +1,231 @@
+// Package admin contains operations for running the local admin console service.
+// Only the functions in this package are exposed to the admin service; lower
+// level source packages remain encapsulated.
+// This file contains operations for classifying individual donors by sector/industry
+// and building and viewing the sector datasets (receipts by sector for each committee
+// and candidate, national totals and rankings by sector).
+// NOTE: logic is not UX optimized and may contain unresolved errors.
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elections/source/classify"
+	"github.com/elections/source/databuilder"
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+	"github.com/elections/source/ui"
+	"github.com/elections/source/util"
+)
+
+// createSectorDatasets classifies each individual donor by sector and industry using
+// the rules files in the OUTPUT_PATH/config directory and derives the SectorData
+// objects for each committee and candidate and the SectorTotal objects for each sector.
+// Individuals are re-saved with the derived Sector and Industry codes.
+func createSectorDatasets(year string) error {
+	fmt.Println("Creating sector datasets...")
+	classify.OUTPUT_PATH = persist.OUTPUT_PATH
+	c, err := classify.LoadClassifier()
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSectorDatasets failed: %v", err)
+	}
+	n := 100000
+	curr := ""
+	sectors := make(map[string]*donations.SectorData)
+	totals := make(map[string]*donations.SectorTotal)
+
+	// classify individuals and update recipient committees' SectorData
+	// each batch after the first starts with the previous batch's last key;
+	// the repeated object is skipped so each donor is added once
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "individuals", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createSectorDatasets failed: %v", err)
+		}
+		last := len(objs) < n
+		if curr != "" && len(objs) > 0 {
+			objs = objs[1:] // start key was read at the end of the previous batch
+		}
+		curr = key
+		for _, obj := range objs {
+			indv := obj.(*donations.Individual)
+			indv.Sector, indv.Industry = c.Classify(indv.Employer, indv.Occupation)
+			databuilder.UpdateSectorData(indv, sectors)
+			databuilder.UpdateSectorTotals(year, indv, totals)
+		}
+		err = persist.StoreObjects(year, objs)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createSectorDatasets failed: %v", err)
+		}
+		if last {
+			break
+		}
+	}
+
+	// merge committee SectorData for each candidate
+	n = 10000
+	curr = ""
+	candSectors := []interface{}{}
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "cand_rollup", curr, n)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("createSectorDatasets failed: %v", err)
+		}
+		last := len(objs) < n
+		if curr != "" && len(objs) > 0 {
+			objs = objs[1:] // start key was read at the end of the previous batch
+		}
+		curr = key
+		for _, obj := range objs {
+			rollup := obj.(*donations.CandRollup)
+			set := []*donations.SectorData{}
+			for _, id := range rollup.Committees {
+				if sectors[id] != nil {
+					set = append(set, sectors[id])
+				}
+			}
+			sd := databuilder.MergeSectorData(rollup.CandID, "candidates", set)
+			databuilder.UpdateSectorRecipients(sd, totals)
+			candSectors = append(candSectors, sd)
+		}
+		if last {
+			break
+		}
+	}
+
+	// save
+	objs := []interface{}{}
+	for _, sd := range sectors {
+		databuilder.UpdateSectorRecipients(sd, totals)
+		objs = append(objs, sd)
+	}
+	objs = append(objs, candSectors...)
+	for _, st := range totals {
+		databuilder.ClipSectorTotal(st)
+		objs = append(objs, st)
+	}
+	err = persist.SaveSectorData(year, objs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("createSectorDatasets failed: %v", err)
+	}
+	fmt.Printf("sector datasets created: %d committees, %d candidates, %d sectors\n", len(sectors), len(candSectors), len(totals))
+
+	return nil
+}
+
+// viewSectorData prints the sector rankings, the totals and top
+// recipients/donors for a given sector, or the receipts by
+// sector/industry for a given committee or candidate
+func viewSectorData() error {
+	opts := []string{"Sector Rankings", "Sector by Code", "Committee/Candidate by ID", "Return"}
+	menu := ui.CreateMenu("admin-view-sector", opts)
+	classify.OUTPUT_PATH = persist.OUTPUT_PATH
+	c, err := classify.LoadClassifier()
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("viewSectorData failed: %v", err)
+	}
+
+	for {
+		year := ui.GetYear()
+		ch, err := ui.Ask4MenuChoice(menu)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("viewSectorData failed: %v", err)
+		}
+
+		switch menu.OptionsMap[ch] {
+		case "Sector Rankings":
+			objs, err := persist.GetSectorTotals(year)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewSectorData failed: %v", err)
+			}
+			amts := make(map[string]float32)
+			txs := make(map[string]float32)
+			for _, obj := range objs {
+				st := obj.(*donations.SectorTotal)
+				amts[st.Sector] = st.Total
+				txs[st.Sector] = st.Txs
+			}
+			fmt.Printf("Individual Contributions by Sector - %s:\n", year)
+			for i, e := range util.SortMapObjectTotals(amts) {
+				fmt.Printf("%d) %s - %s:\tTotal $: %.2f\t# Txs: %.0f\n", i+1, e.ID, c.SectorName(e.ID), e.Total, txs[e.ID])
+			}
+			fmt.Println()
+		case "Sector by Code":
+			fmt.Println("Enter sector code: ")
+			code := strings.ToUpper(strings.TrimSpace(ui.GetQuery()))
+			obj, err := persist.GetObject(year, "sector_totals", year+"-"+code)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewSectorData failed: %v", err)
+			}
+			st := obj.(*donations.SectorTotal)
+			if st.Sector == "" {
+				fmt.Println("no sector data found for code: ", code)
+				break
+			}
+			fmt.Printf("%s - %s (%s):\tTotal $: %.2f\t# Txs: %.0f\n", st.Sector, c.SectorName(st.Sector), year, st.Total, st.Txs)
+			fmt.Println("Contributions by Industry:")
+			for i, e := range util.SortMapObjectTotals(st.IndustryAmt) {
+				fmt.Printf("%d) %s - %s:\tTotal $: %.2f\n", i+1, e.ID, c.IndustryName(e.ID), e.Total)
+			}
+			fmt.Println("Top Committees (Top 25):")
+			printSectorRankings(st.TopCmtes, 25)
+			fmt.Println("Top Candidates (Top 25):")
+			printSectorRankings(st.TopCands, 25)
+			fmt.Println("Top Donors (Top 25):")
+			printSectorRankings(st.TopDonors, 25)
+		case "Committee/Candidate by ID":
+			fmt.Println("Enter committee or candidate ID: ")
+			id := strings.TrimSpace(ui.GetQuery())
+			obj, err := persist.GetObject(year, "sector_data", id)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("viewSectorData failed: %v", err)
+			}
+			sd := obj.(*donations.SectorData)
+			if sd.ID == "" {
+				fmt.Println("no sector data found for ID: ", id)
+				break
+			}
+			fmt.Printf("Receipts by Sector - %s (%s):\n", sd.ID, year)
+			for i, e := range util.SortMapObjectTotals(sd.SectorAmt) {
+				fmt.Printf("%d) %s - %s:\tTotal $: %.2f\t# Txs: %.0f\n", i+1, e.ID, c.SectorName(e.ID), e.Total, sd.SectorTxs[e.ID])
+			}
+			fmt.Println("Receipts by Industry:")
+			for i, e := range util.SortMapObjectTotals(sd.IndustryAmt) {
+				fmt.Printf("%d) %s - %s:\tTotal $: %.2f\t# Txs: %.0f\n", i+1, e.ID, c.IndustryName(e.ID), e.Total, sd.IndustryTxs[e.ID])
+			}
+			fmt.Println()
+		case "Return":
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+
+		fmt.Println("View more sector data?")
+		yes := ui.Ask4confirm()
+		if !yes {
+			fmt.Println("Returning to menu...")
+			return nil
+		}
+	}
+}
+
+// print the top n entries of a sector rankings map
+func printSectorRankings(m map[string]float32, n int) {
+	for i, e := range util.SortMapObjectTotals(m) {
+		if i == n {
+			break
+		}
+		fmt.Printf("%d) %s:\tTotal $: %.2f\n", i+1, e.ID, e.Total)
+	}
+	fmt.Println()
+}
@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/elections/source/databuilder"
 	"github.com/elections/source/donations"
 	"github.com/elections/source/indexing"
 	"github.com/elections/source/persist"
@@ -39,6 +40,11 @@ func ViewMenu() error {
 		"View Data by Year/Bucket",
 		"View Top Rankings",
 		"View Yearly Totals",
+		"View Geographic Data",
+		"View Sector Data",
+		"Export Network Graph",
+		"Find Money-Flow Paths",
+		"View Committee Communities",
 		"View Search Index",
 		"View Lookup Objects",
 		"View Index Metadata",
@@ -89,6 +95,36 @@ func ViewMenu() error {
 				fmt.Println(err)
 				return fmt.Errorf("ViewMenu failed: %v", err)
 			}
+		case menu.OptionsMap[ch] == "View Geographic Data":
+			err := viewGeoData()
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("ViewMenu failed: %v", err)
+			}
+		case menu.OptionsMap[ch] == "View Sector Data":
+			err := viewSectorData()
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("ViewMenu failed: %v", err)
+			}
+		case menu.OptionsMap[ch] == "Export Network Graph":
+			err := exportNetwork()
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("ViewMenu failed: %v", err)
+			}
+		case menu.OptionsMap[ch] == "Find Money-Flow Paths":
+			err := viewPaths()
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("ViewMenu failed: %v", err)
+			}
+		case menu.OptionsMap[ch] == "View Committee Communities":
+			err := viewCommunities()
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("ViewMenu failed: %v", err)
+			}
 		case menu.OptionsMap[ch] == "View Search Index":
 			err := indexing.ViewIndex()
 			if err != nil {
@@ -168,11 +204,16 @@ func queryData() error {
 			fmt.Println(err)
 			return fmt.Errorf("searchData failed: %v", err)
 		}
-		sds, err := indexing.LookupSearchData(res)
+		// top 100 results ranked by term match quality
+		srs, err := indexing.RankResults(q, res, nil, nil, 100)
 		if err != nil {
 			fmt.Println(err)
 			return fmt.Errorf("searchData failed: %v", err)
 		}
+		sds := []indexing.SearchData{}
+		for _, sr := range srs {
+			sds = append(sds, sr.SearchData)
+		}
 		resMap := make(map[string]indexing.SearchData)
 
 		// crate submenus for selecting dataset from search results
@@ -330,35 +371,19 @@ func lookupByID() error {
 
 // routine for viewing rankings by year/category/party
 func viewRankings() error {
-	cats := []string{
-		"Individual Donors", "Individual Recipients", "Committee Donors", "Committee Recipients",
-		"Committee Spenders", "Candidate Recipients", "Candidate Donors", "Candidate Spenders",
-	}
-	catMap := map[string][]string{
-		"Individual Donors":     []string{"individuals", "donor"},
-		"Individual Recipients": []string{"individuals", "rec"},
-		"Committee Donors":      []string{"cmte_tx_data", "donor"},
-		"Committee Recipients":  []string{"cmte_tx_data", "rec"},
-		"Committee Spenders":    []string{"cmte_tx_data", "exp"},
-		"Candidate Recipients":  []string{"candidates", "rec"},
-		"Candidate Donors":      []string{"candidates", "donor"},
-		"Candidate Spenders":    []string{"candidates", "exp"},
-	}
-	catMenu := ui.CreateMenu("admin-rankings-cats", cats)
-	ptyMap := map[string][]string{
-		"Individual Donors":     []string{"cancel", "ALL"},
-		"Individual Recipients": []string{"cancel", "ALL"},
-		"Committee Donors":      []string{"cancel", "ALL", "DEM", "REP", "IND", "OTH", "UNK"},
-		"Committee Recipients":  []string{"cancel", "ALL", "DEM", "REP", "IND", "OTH", "UNK"},
-		"Committee Spenders":    []string{"cancel", "ALL", "DEM", "REP", "IND", "OTH", "UNK"},
-		"Candidate Recipients":  []string{"cancel", "ALL", "DEM", "REP", "IND", "OTH", "UNK"},
-		"Candidate Donors":      []string{"cancel", "ALL", "DEM", "REP", "IND", "OTH", "UNK"},
-		"Candidate Spenders":    []string{"cancel", "ALL", "DEM", "REP", "IND", "OTH", "UNK"},
+	// category & filter menus derived from the ranking definitions
+	defs, err := persist.LoadRankingDefs()
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("viewRankings failed: %v", err)
 	}
 
 	for {
 		// get Year
 		year := ui.GetYear()
+		allTime := year == "all-time" || year == "all_time"
+		cats, filters, rankingDefs := rankingMenus(defs, allTime)
+		catMenu := ui.CreateMenu("admin-rankings-cats", cats)
 
 		// get category
 		ch, err := ui.Ask4MenuChoice(catMenu)
@@ -367,33 +392,29 @@ func viewRankings() error {
 			return fmt.Errorf("viewRankings failed: %v", err)
 		}
 		cat := catMenu.OptionsMap[ch]
-		b, c := catMap[cat][0], catMap[cat][1]
 
-		// get party sub category
-		ptys := ptyMap[cat]
-		ptysMenu := ui.CreateMenu("admin-rankings-pty", ptys)
-		ch, err = ui.Ask4MenuChoice(ptysMenu)
+		// get party/state/metric sub category
+		fMenu := ui.CreateMenu("admin-rankings-pty", filters[cat])
+		ch, err = ui.Ask4MenuChoice(fMenu)
 		if err != nil {
 			fmt.Println(err)
 			return fmt.Errorf("viewRankings failed: %v", err)
 		}
-
-		// get party
-		pty := ptysMenu.OptionsMap[ch]
-		fmt.Println("selection: ", pty)
-		if pty == "cancel" {
+		f := fMenu.OptionsMap[ch]
+		fmt.Println("selection: ", f)
+		if f == "cancel" {
 			fmt.Println("Returning to menu...")
 			return nil
 		}
 
 		// get object
-		id := year + "-" + b + "-" + c + "-" + pty
+		id := rankingDefs[cat+"|"+f].ID(year)
 		obj, err := persist.GetObject(year, "top_overall", id)
-		rankings := obj.(*donations.TopOverallData)
 		if err != nil {
 			fmt.Println(err)
 			return fmt.Errorf("viewRankings failed: %v", err)
 		}
+		rankings := obj.(*donations.TopOverallData)
 
 		// print sorted list of top overall entities
 		sorted := util.SortMapObjectTotals(rankings.Amts)
@@ -425,6 +446,44 @@ func viewRankings() error {
 
 }
 
+// rankingMenus derives the category & filter menu options from the ranking definitions
+// and maps each category label + filter label to its ranking definition.
+// Centrality rankings are not created for all time and are omitted if allTime == true.
+func rankingMenus(defs *donations.RankingDefs, allTime bool) ([]string, map[string][]string, map[string]donations.RankingDef) {
+	cats := []string{}
+	filters := make(map[string][]string)                 // category label: filter labels
+	rankingDefs := make(map[string]donations.RankingDef) // category label + filter label: ranking
+	for _, d := range defs.Rankings {
+		if allTime && databuilder.IsCentralityCategory(d.Bucket, d.Category) {
+			continue
+		}
+		cat := rankingLabel(d.Bucket, d.Category)
+		if filters[cat] == nil {
+			cats = append(cats, cat)
+			filters[cat] = []string{"cancel"}
+		}
+		f := strings.TrimPrefix(d.ID(""), "-"+d.Bucket+"-"+d.Category+"-")
+		filters[cat] = append(filters[cat], f)
+		rankingDefs[cat+"|"+f] = d
+	}
+	return cats, filters, rankingDefs
+}
+
+// rankingLabel returns the menu label for the bucket's ranking category.
+// Categories without a label are shown by name.
+func rankingLabel(bucket, cat string) string {
+	buckets := map[string]string{"individuals": "Individual", "cmte_tx_data": "Committee", "candidates": "Candidate"}
+	labels := map[string]string{
+		"donor": "Donors", "rec": "Recipients", "exp": "Spenders", "self": "Self-Funders",
+		"pagerank": "PageRank", "betweenness": "Betweenness", "instrength": "In-Strength", "outstrength": "Out-Strength",
+	}
+	label := labels[cat]
+	if label == "" {
+		label = cat
+	}
+	return buckets[bucket] + " " + label
+}
+
 // routine for viewing rankings by year/category/party
 func viewYrTotals() error {
 	// menu options
@@ -767,6 +826,15 @@ func printCommittee(year string, obj *donations.Committee) error {
 	fmt.Println("Total Incoming Txs: ", txd.TotalIncomingTxs)
 	fmt.Println("Avg. Incoming: ", txd.AvgIncoming)
 	fmt.Println()
+	fmt.Println("Contributions by Election: ")
+	printElections(txd.ElectionsInAmt, txd.ElectionsInTxs)
+	fmt.Println("Individual Contribution Sizes: ")
+	printSizes(txd.SizeBinsAmt, txd.SizeBinsTxs, txd.SizeCounts)
+	fmt.Println("Refunds, Adjustments & Memo Entries: ")
+	for _, kind := range databuilder.TxKinds {
+		fmt.Printf("%s:\tTotal $: %.2f\t# Txs: %.0f\n", kind, txd.TxKindsAmt[kind], txd.TxKindsTxs[kind])
+	}
+	fmt.Println()
 	fmt.Println("Transfers $: ", txd.TransfersAmt)
 	fmt.Println("Transfers Txs: ", txd.TransfersTxs)
 	fmt.Println("Avg. Transfer: ", txd.AvgTransfer)
@@ -805,10 +873,62 @@ func printCommittee(year string, obj *donations.Committee) error {
 		fmt.Println(err)
 		return fmt.Errorf("printCommittee failed: %v", err)
 	}
+	fmt.Println("Earmarked Contributions by Conduit: ")
+	cndSrt := util.SortMapObjectTotals(txd.ConduitsInAmt)
+	err = printSortedEntities(cndSrt, txd.ConduitsInAmt, txd.ConduitsInTxs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("printCommittee failed: %v", err)
+	}
+	if txd.EarmarkedInTxs != 0 || txd.EarmarkedOutTxs != 0 {
+		fmt.Println("Conduit Earmarked Received $: ", txd.EarmarkedInAmt)
+		fmt.Println("Conduit Earmarked Received Txs: ", txd.EarmarkedInTxs)
+		fmt.Println("Conduit Earmarked Forwarded $: ", txd.EarmarkedOutAmt)
+		fmt.Println("Conduit Earmarked Forwarded Txs: ", txd.EarmarkedOutTxs)
+		fmt.Println("Earmarked Recipients: ")
+		emSrt := util.SortMapObjectTotals(txd.EarmarkRecipientsAmt)
+		err = printSortedEntities(emSrt, txd.EarmarkRecipientsAmt, txd.EarmarkRecipientsTxs)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("printCommittee failed: %v", err)
+		}
+	}
 	fmt.Println()
 	return nil
 }
 
+// print contributions received for each election in chronological order
+func printElections(amts, txs map[string]float32) {
+	keys := []string{}
+	for k := range amts {
+		keys = append(keys, k)
+	}
+	// sort by election year, then election type
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][1:] != keys[j][1:] {
+			return keys[i][1:] < keys[j][1:]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
+		fmt.Printf("%s:\tTotal $: %.2f\t# Txs: %.0f\n", databuilder.ElectionName(k), amts[k], txs[k])
+	}
+	fmt.Println()
+}
+
+// print the contribution size histogram and percentile contribution amounts
+func printSizes(amts, txs, counts map[string]float32) {
+	for _, bin := range databuilder.SizeBins {
+		fmt.Printf("%s:\tTotal $: %.2f\t# Txs: %.0f\n", bin, amts[bin], txs[bin])
+	}
+	pctls := databuilder.SizePercentiles(counts)
+	for _, p := range databuilder.SizePctls {
+		fmt.Printf("%s: $%.0f\t", p, pctls[p])
+	}
+	fmt.Println()
+	fmt.Println()
+}
+
 // lookup corresponding SearchData object for each ID in rankings and print data
 func printSortedEntities(sorted util.SortedTotalsMap, orig, txs map[string]float32) error {
 	ids := []string{}
@@ -47,6 +47,7 @@ func createCacheFromContribution(year string, txQueue []*donations.Contribution)
 		"committees":   make(map[string]interface{}),
 		"cmte_tx_data": make(map[string]interface{}),
 		"candidates":   make(map[string]interface{}),
+		"geo_data":     make(map[string]interface{}),
 	}
 	filerIDs := []string{}
 	otherIDs := map[string][]string{
@@ -57,26 +58,18 @@ func createCacheFromContribution(year string, txQueue []*donations.Contribution)
 	seen := make(map[string]bool)
 
 	for _, tx := range txQueue {
-		// edge case - earmarked transaction type, treat as incoming transaction type to OtherID cmte
-		if tx.TxType == "24I" {
-			if tx.OtherID != "" {
-				tx.CmteID = tx.OtherID
-				tx.TxType = "15E"
-			} else { // edge case
-				fmt.Println("WARNING: NIL RECIPIENT - txID: ", tx.TxID)
-				// transaction will be treated as incoming/memo transaction
-				// from individual to intermediary (filing cmte)
-				tx.TxType = "15E"
-			}
-		}
-
 		// get filer IDs for obj lookup
 		if !seen[tx.CmteID] {
 			filerIDs = append(filerIDs, tx.CmteID)
 			seen[tx.CmteID] = true
 		}
 
+		// earmarked transaction - record conduit & ultimate recipient
+		// before OtherID is replaced with the contributing Individual's ID
 		em := earmark(tx.TxType)
+		if em {
+			setEarmarkIDs(tx)
+		}
 
 		// get otherIDs
 		// initialize placeholder objects for Individuals
@@ -123,6 +116,17 @@ func createCacheFromContribution(year string, txQueue []*donations.Contribution)
 		}
 	}
 
+	// batch get filer GeoData, add to cache
+	// GeoData is created on the filer's first individual contribution if not found
+	geo, _, err := persist.BatchGetByID(year, "geo_data", filerIDs)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("createCacheFromContribution failed: %v", err)
+	}
+	for _, g := range geo {
+		cache["geo_data"][getObjID(g)] = g
+	}
+
 	// batch get other objs, add to cache
 	for bkt, objIDs := range otherIDs {
 		others, nilIDs, err := persist.BatchGetByID(year, bkt, objIDs)
@@ -285,6 +289,8 @@ func getObjID(obj interface{}) string {
 		return t.CmteID
 	case *donations.Candidate:
 		return t.ID
+	case *donations.GeoData:
+		return t.ID
 	default:
 		return ""
 	}
@@ -296,6 +302,29 @@ func earmark(code string) bool {
 		"15I": true,
 		"15T": true,
 		"24I": true,
+		"24T": true,
 	}
 	return check[code]
 }
+
+// setEarmarkIDs sets the conduit and ultimate recipient committee IDs of an earmarked transaction.
+// 15E: recipient (filer) receives contribution via conduit (OtherID)
+// 15I/15T: conduit (filer) receives contribution for recipient (OtherID)
+// 24I/24T: conduit (filer) forwards contribution to recipient (OtherID)
+func setEarmarkIDs(tx *donations.Contribution) {
+	other := ""
+	if strings.HasPrefix(tx.OtherID, "C") {
+		other = tx.OtherID
+	}
+	switch tx.TxType {
+	case "15E":
+		tx.ConduitID = other
+		tx.EarmarkRecipID = tx.CmteID
+	default:
+		tx.ConduitID = tx.CmteID
+		tx.EarmarkRecipID = other
+		if other == "" && (tx.TxType == "24I" || tx.TxType == "24T") {
+			fmt.Println("WARNING: NIL RECIPIENT - txID: ", tx.TxID)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Package classify contains operations for classifying donors by
+// industry and sector from the raw Employer and Occupation strings.
+// Classification rules are read from a JSON rules file on disk and
+// may be overridden by an analyst-maintained override file.
+// This file contains the Classifier and the operations for matching
+// Employer/Occupation text to industry and sector codes.
+package classify
+
+import (
+	"regexp"
+	"strings"
+)
+
+// UNKNOWN is the sector and industry code assigned
+// to donors that are not matched by any rule.
+const UNKNOWN = "UNK"
+
+var nonAlnum = regexp.MustCompile(`[^A-Z0-9&]+`)
+
+// Classifier maps Employer/Occupation text to industry and sector codes
+// using the exact-match and keyword tables of a Rules object.
+type Classifier struct {
+	rules *Rules
+}
+
+// NewClassifier returns a Classifier for the given rules.
+// Exact-match table keys and keywords are normalized in place.
+func NewClassifier(rules *Rules) *Classifier {
+	rules.normalize()
+	return &Classifier{rules: rules}
+}
+
+// Classify returns the sector and industry codes for the given employer and occupation.
+// Rules are applied in order of precedence: employer exact match, occupation exact match,
+// employer keyword, occupation keyword. The first keyword listed in a table that matches
+// is used. UNKNOWN is returned for both codes if no rule matches.
+func (c *Classifier) Classify(employer, occupation string) (string, string) {
+	emp := Normalize(employer)
+	occ := Normalize(occupation)
+
+	if ind, ok := c.rules.EmployerExact[emp]; ok && emp != "" {
+		return c.sector(ind), ind
+	}
+	if ind, ok := c.rules.OccupationExact[occ]; ok && occ != "" {
+		return c.sector(ind), ind
+	}
+	if ind := matchKeyword(emp, c.rules.EmployerKeywords); ind != "" {
+		return c.sector(ind), ind
+	}
+	if ind := matchKeyword(occ, c.rules.OccupationKeywords); ind != "" {
+		return c.sector(ind), ind
+	}
+	return UNKNOWN, UNKNOWN
+}
+
+// SectorName returns the display name for the given sector code.
+func (c *Classifier) SectorName(code string) string {
+	if name, ok := c.rules.Sectors[code]; ok {
+		return name
+	}
+	return code
+}
+
+// IndustryName returns the display name for the given industry code.
+func (c *Classifier) IndustryName(code string) string {
+	if ind, ok := c.rules.Industries[code]; ok {
+		return ind.Name
+	}
+	return code
+}
+
+// sector returns the parent sector code for the given industry code
+func (c *Classifier) sector(industry string) string {
+	if ind, ok := c.rules.Industries[industry]; ok && ind.Sector != "" {
+		return ind.Sector
+	}
+	return UNKNOWN
+}
+
+// Normalize upper-cases the text, replaces punctuation with
+// spaces, and removes leading/trailing/repeated whitespace.
+func Normalize(text string) string {
+	text = nonAlnum.ReplaceAllString(strings.ToUpper(text), " ")
+	return strings.TrimSpace(text)
+}
+
+// matchKeyword returns the industry code of the first keyword contained
+// in the text as a whole word or phrase; returns an empty string if none match.
+func matchKeyword(text string, keywords []Keyword) string {
+	if text == "" {
+		return ""
+	}
+	padded := " " + text + " "
+	for _, kw := range keywords {
+		if kw.Keyword == "" {
+			continue
+		}
+		if strings.Contains(padded, " "+kw.Keyword+" ") {
+			return kw.Industry
+		}
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+package classify
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	c := NewClassifier(DefaultRules())
+	var tests = []struct {
+		employer, occupation string
+		sector, industry     string
+	}{
+		{"Retired", "Retired", "RET", "RET-GEN"},
+		{"SELF-EMPLOYED", "attorney", "LAW", "LAW-ATT"},
+		{"FIRST NATIONAL BANK", "CEO", "FIN", "FIN-BNK"},
+		{"Smith & Jones, LLP", "PARTNER", "LAW", "LAW-ATT"},
+		{"SELF", "REGISTERED NURSE", "HLT", "HLT-NUR"},
+		{"BANKSY ARTS", "PAINTER", UNKNOWN, UNKNOWN},
+		{"", "", UNKNOWN, UNKNOWN},
+	}
+
+	for _, test := range tests {
+		sector, industry := c.Classify(test.employer, test.occupation)
+		if sector != test.sector || industry != test.industry {
+			t.Errorf("Classify(%q, %q) = %s, %s; want %s, %s",
+				test.employer, test.occupation, sector, industry, test.sector, test.industry)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	rules := DefaultRules()
+	rules.Merge(&Rules{
+		Industries:       map[string]Industry{"FIN-CRY": {Name: "Cryptocurrency", Sector: "FIN"}},
+		EmployerExact:    map[string]string{"retired": "FIN-CRY"},
+		EmployerKeywords: []Keyword{{Keyword: "first national", Industry: "FIN-CRY"}},
+	})
+	c := NewClassifier(rules)
+
+	if s, i := c.Classify("RETIRED", ""); s != "FIN" || i != "FIN-CRY" {
+		t.Errorf("override exact match failed: %s, %s", s, i)
+	}
+	if s, i := c.Classify("FIRST NATIONAL BANK", ""); s != "FIN" || i != "FIN-CRY" {
+		t.Errorf("override keyword precedence failed: %s, %s", s, i)
+	}
+	if s, i := c.Classify("", "ATTORNEY"); s != "LAW" || i != "LAW-ATT" {
+		t.Errorf("base rules not retained: %s, %s", s, i)
+	}
+}
@@ -0,0 +1,339 @@
+// Package classify contains operations for classifying donors by
+// industry and sector from the raw Employer and Occupation strings.
+// Classification rules are read from a JSON rules file on disk and
+// may be overridden by an analyst-maintained override file.
+// This file contains the Rules tables and operations for reading
+// and writing the rules files.
+package classify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+// OUTPUT_PATH is the root directory of the processed data.
+// Rules files are stored in the OUTPUT_PATH/config directory.
+var OUTPUT_PATH = "." // default value
+
+// RULES_FILE contains the base classification rules.
+// The default rules are written to this file if it does not exist.
+const RULES_FILE = "sector_rules.json"
+
+// OVERRIDE_FILE contains optional analyst-maintained rules.
+// Entries in the override file take precedence over the base rules.
+const OVERRIDE_FILE = "sector_rules_override.json"
+
+// Rules contains the sector and industry code tables and the
+// exact-match and keyword tables used to classify donors.
+// Exact-match tables map a normalized Employer or Occupation
+// string to an industry code; each industry belongs to one sector.
+type Rules struct {
+	Sectors            map[string]string   // sector code -> display name
+	Industries         map[string]Industry // industry code -> industry
+	EmployerExact      map[string]string   // employer -> industry code
+	OccupationExact    map[string]string   // occupation -> industry code
+	EmployerKeywords   []Keyword           // ordered by precedence
+	OccupationKeywords []Keyword           // ordered by precedence
+}
+
+// Industry contains the display name and parent sector code of an industry code.
+type Industry struct {
+	Name   string
+	Sector string
+}
+
+// Keyword maps a word or phrase found in Employer/Occupation text to an industry code.
+type Keyword struct {
+	Keyword  string
+	Industry string
+}
+
+// LoadClassifier reads the base rules file and the optional override file from
+// the OUTPUT_PATH/config directory and returns a Classifier for the merged rules.
+// The default rules are written to the base rules file if it does not exist.
+func LoadClassifier() (*Classifier, error) {
+	dir := OUTPUT_PATH + "/config"
+	rules, err := readRules(dir + "/" + RULES_FILE)
+	if os.IsNotExist(err) {
+		rules = DefaultRules()
+		err = WriteRules(dir+"/"+RULES_FILE, rules)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("LoadClassifier failed: %v", err)
+	}
+
+	override, err := readRules(dir + "/" + OVERRIDE_FILE)
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Println(err)
+		return nil, fmt.Errorf("LoadClassifier failed: %v", err)
+	}
+	if err == nil {
+		rules.Merge(override)
+	}
+
+	return NewClassifier(rules), nil
+}
+
+// WriteRules writes the rules to the given path as indented JSON.
+func WriteRules(path string, rules *Rules) error {
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteRules failed: %v", err)
+	}
+	if err := os.MkdirAll(OUTPUT_PATH+"/config", 0755); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteRules failed: %v", err)
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteRules failed: %v", err)
+	}
+	return nil
+}
+
+// Merge adds the override rules to the rules. Code and exact-match table entries
+// in the override replace existing entries; override keywords are checked first.
+func (r *Rules) Merge(override *Rules) {
+	r.normalize()
+	override.normalize()
+	r.Sectors = mergeStrings(r.Sectors, override.Sectors)
+	r.EmployerExact = mergeStrings(r.EmployerExact, override.EmployerExact)
+	r.OccupationExact = mergeStrings(r.OccupationExact, override.OccupationExact)
+	if r.Industries == nil {
+		r.Industries = make(map[string]Industry)
+	}
+	for k, v := range override.Industries {
+		r.Industries[k] = v
+	}
+	r.EmployerKeywords = append(append([]Keyword{}, override.EmployerKeywords...), r.EmployerKeywords...)
+	r.OccupationKeywords = append(append([]Keyword{}, override.OccupationKeywords...), r.OccupationKeywords...)
+}
+
+// readRules reads a rules file; returns an os.IsNotExist error if the file does not exist
+func readRules(path string) (*Rules, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules := &Rules{}
+	if err := json.Unmarshal(data, rules); err != nil {
+		return nil, fmt.Errorf("readRules failed: %s: %v", path, err)
+	}
+	return rules, nil
+}
+
+// normalize normalizes the exact-match table keys and keywords so that
+// analysts may enter rules in any case or punctuation
+func (r *Rules) normalize() {
+	r.EmployerExact = normalizeKeys(r.EmployerExact)
+	r.OccupationExact = normalizeKeys(r.OccupationExact)
+	for i := range r.EmployerKeywords {
+		r.EmployerKeywords[i].Keyword = Normalize(r.EmployerKeywords[i].Keyword)
+	}
+	for i := range r.OccupationKeywords {
+		r.OccupationKeywords[i].Keyword = Normalize(r.OccupationKeywords[i].Keyword)
+	}
+}
+
+func normalizeKeys(m map[string]string) map[string]string {
+	norm := make(map[string]string)
+	for k, v := range m {
+		norm[Normalize(k)] = v
+	}
+	return norm
+}
+
+func mergeStrings(base, override map[string]string) map[string]string {
+	if base == nil {
+		base = make(map[string]string)
+	}
+	for k, v := range override {
+		base[k] = v
+	}
+	return base
+}
+
+// DefaultRules returns the default classification rules
+// written to the base rules file on first use.
+func DefaultRules() *Rules {
+	return &Rules{
+		Sectors: map[string]string{
+			"AGR": "Agribusiness",
+			"COM": "Communications/Electronics",
+			"CON": "Construction",
+			"DEF": "Defense",
+			"EDU": "Education",
+			"ENR": "Energy & Natural Resources",
+			"FIN": "Finance, Insurance & Real Estate",
+			"GOV": "Government",
+			"HLT": "Health",
+			"LAB": "Labor",
+			"LAW": "Lawyers & Lobbyists",
+			"BUS": "Misc Business",
+			"TRN": "Transportation",
+			"NEM": "Not Employed",
+			"RET": "Retired",
+			"UNK": "Unknown",
+		},
+		Industries: map[string]Industry{
+			"AGR-GEN": {Name: "Agriculture", Sector: "AGR"},
+			"COM-TEC": {Name: "Computers/Internet", Sector: "COM"},
+			"COM-TEL": {Name: "Telecommunications", Sector: "COM"},
+			"COM-MED": {Name: "TV/Movies/Music/Publishing", Sector: "COM"},
+			"CON-GEN": {Name: "Construction", Sector: "CON"},
+			"CON-ARC": {Name: "Architects & Engineers", Sector: "CON"},
+			"DEF-GEN": {Name: "Defense Contractors", Sector: "DEF"},
+			"DEF-MIL": {Name: "Military", Sector: "DEF"},
+			"EDU-GEN": {Name: "Education", Sector: "EDU"},
+			"ENR-OIL": {Name: "Oil & Gas", Sector: "ENR"},
+			"ENR-UTL": {Name: "Electric Utilities", Sector: "ENR"},
+			"ENR-MIN": {Name: "Mining", Sector: "ENR"},
+			"FIN-BNK": {Name: "Banking", Sector: "FIN"},
+			"FIN-SEC": {Name: "Securities & Investment", Sector: "FIN"},
+			"FIN-INS": {Name: "Insurance", Sector: "FIN"},
+			"FIN-RE":  {Name: "Real Estate", Sector: "FIN"},
+			"FIN-ACC": {Name: "Accountants", Sector: "FIN"},
+			"GOV-GEN": {Name: "Government Employees", Sector: "GOV"},
+			"HLT-PHY": {Name: "Physicians & Health Professionals", Sector: "HLT"},
+			"HLT-NUR": {Name: "Nurses", Sector: "HLT"},
+			"HLT-HOS": {Name: "Hospitals & Health Services", Sector: "HLT"},
+			"HLT-PHR": {Name: "Pharmaceuticals", Sector: "HLT"},
+			"LAB-GEN": {Name: "Labor Unions", Sector: "LAB"},
+			"LAW-ATT": {Name: "Lawyers & Law Firms", Sector: "LAW"},
+			"LAW-LOB": {Name: "Lobbyists", Sector: "LAW"},
+			"BUS-RTL": {Name: "Retail", Sector: "BUS"},
+			"BUS-MFG": {Name: "Manufacturing", Sector: "BUS"},
+			"BUS-GEN": {Name: "Business Services", Sector: "BUS"},
+			"TRN-AIR": {Name: "Air Transport", Sector: "TRN"},
+			"TRN-AUT": {Name: "Automotive", Sector: "TRN"},
+			"TRN-GEN": {Name: "Trucking & Shipping", Sector: "TRN"},
+			"NEM-GEN": {Name: "Not Employed", Sector: "NEM"},
+			"RET-GEN": {Name: "Retired", Sector: "RET"},
+		},
+		EmployerExact: map[string]string{
+			"RETIRED":         "RET-GEN",
+			"NOT EMPLOYED":    "NEM-GEN",
+			"NONE":            "NEM-GEN",
+			"UNEMPLOYED":      "NEM-GEN",
+			"HOMEMAKER":       "NEM-GEN",
+			"STUDENT":         "NEM-GEN",
+			"US ARMY":         "DEF-MIL",
+			"US NAVY":         "DEF-MIL",
+			"US AIR FORCE":    "DEF-MIL",
+			"US MARINE CORPS": "DEF-MIL",
+		},
+		OccupationExact: map[string]string{
+			"RETIRED":      "RET-GEN",
+			"NOT EMPLOYED": "NEM-GEN",
+			"NONE":         "NEM-GEN",
+			"UNEMPLOYED":   "NEM-GEN",
+			"HOMEMAKER":    "NEM-GEN",
+			"STUDENT":      "NEM-GEN",
+			"ATTORNEY":     "LAW-ATT",
+			"LAWYER":       "LAW-ATT",
+			"PHYSICIAN":    "HLT-PHY",
+			"TEACHER":      "EDU-GEN",
+			"PROFESSOR":    "EDU-GEN",
+		},
+		EmployerKeywords: []Keyword{
+			{Keyword: "LAW FIRM", Industry: "LAW-ATT"},
+			{Keyword: "LLP", Industry: "LAW-ATT"},
+			{Keyword: "LOBBYING", Industry: "LAW-LOB"},
+			{Keyword: "UNION", Industry: "LAB-GEN"},
+			{Keyword: "AFL CIO", Industry: "LAB-GEN"},
+			{Keyword: "UNIVERSITY", Industry: "EDU-GEN"},
+			{Keyword: "COLLEGE", Industry: "EDU-GEN"},
+			{Keyword: "SCHOOL", Industry: "EDU-GEN"},
+			{Keyword: "SCHOOLS", Industry: "EDU-GEN"},
+			{Keyword: "HOSPITAL", Industry: "HLT-HOS"},
+			{Keyword: "MEDICAL CENTER", Industry: "HLT-HOS"},
+			{Keyword: "HEALTH", Industry: "HLT-HOS"},
+			{Keyword: "PHARMACEUTICAL", Industry: "HLT-PHR"},
+			{Keyword: "PHARMACEUTICALS", Industry: "HLT-PHR"},
+			{Keyword: "BANK", Industry: "FIN-BNK"},
+			{Keyword: "BANCORP", Industry: "FIN-BNK"},
+			{Keyword: "CAPITAL", Industry: "FIN-SEC"},
+			{Keyword: "INVESTMENTS", Industry: "FIN-SEC"},
+			{Keyword: "ASSET MANAGEMENT", Industry: "FIN-SEC"},
+			{Keyword: "SECURITIES", Industry: "FIN-SEC"},
+			{Keyword: "INSURANCE", Industry: "FIN-INS"},
+			{Keyword: "REALTY", Industry: "FIN-RE"},
+			{Keyword: "REAL ESTATE", Industry: "FIN-RE"},
+			{Keyword: "PROPERTIES", Industry: "FIN-RE"},
+			{Keyword: "OIL", Industry: "ENR-OIL"},
+			{Keyword: "PETROLEUM", Industry: "ENR-OIL"},
+			{Keyword: "ENERGY", Industry: "ENR-UTL"},
+			{Keyword: "ELECTRIC", Industry: "ENR-UTL"},
+			{Keyword: "MINING", Industry: "ENR-MIN"},
+			{Keyword: "CONSTRUCTION", Industry: "CON-GEN"},
+			{Keyword: "ENGINEERING", Industry: "CON-ARC"},
+			{Keyword: "ARCHITECTS", Industry: "CON-ARC"},
+			{Keyword: "FARM", Industry: "AGR-GEN"},
+			{Keyword: "FARMS", Industry: "AGR-GEN"},
+			{Keyword: "RANCH", Industry: "AGR-GEN"},
+			{Keyword: "SOFTWARE", Industry: "COM-TEC"},
+			{Keyword: "TECHNOLOGIES", Industry: "COM-TEC"},
+			{Keyword: "GOOGLE", Industry: "COM-TEC"},
+			{Keyword: "MICROSOFT", Industry: "COM-TEC"},
+			{Keyword: "APPLE", Industry: "COM-TEC"},
+			{Keyword: "AMAZON", Industry: "COM-TEC"},
+			{Keyword: "TELECOM", Industry: "COM-TEL"},
+			{Keyword: "COMMUNICATIONS", Industry: "COM-TEL"},
+			{Keyword: "MEDIA", Industry: "COM-MED"},
+			{Keyword: "ENTERTAINMENT", Industry: "COM-MED"},
+			{Keyword: "AIRLINES", Industry: "TRN-AIR"},
+			{Keyword: "MOTORS", Industry: "TRN-AUT"},
+			{Keyword: "TRUCKING", Industry: "TRN-GEN"},
+			{Keyword: "DEFENSE", Industry: "DEF-GEN"},
+			{Keyword: "AEROSPACE", Industry: "DEF-GEN"},
+			{Keyword: "ARMY", Industry: "DEF-MIL"},
+			{Keyword: "NAVY", Industry: "DEF-MIL"},
+			{Keyword: "COUNTY", Industry: "GOV-GEN"},
+			{Keyword: "CITY OF", Industry: "GOV-GEN"},
+			{Keyword: "STATE OF", Industry: "GOV-GEN"},
+			{Keyword: "DEPARTMENT OF", Industry: "GOV-GEN"},
+			{Keyword: "MANUFACTURING", Industry: "BUS-MFG"},
+			{Keyword: "STORES", Industry: "BUS-RTL"},
+			{Keyword: "CONSULTING", Industry: "BUS-GEN"},
+		},
+		OccupationKeywords: []Keyword{
+			{Keyword: "ATTORNEY", Industry: "LAW-ATT"},
+			{Keyword: "LAWYER", Industry: "LAW-ATT"},
+			{Keyword: "LOBBYIST", Industry: "LAW-LOB"},
+			{Keyword: "PHYSICIAN", Industry: "HLT-PHY"},
+			{Keyword: "DOCTOR", Industry: "HLT-PHY"},
+			{Keyword: "SURGEON", Industry: "HLT-PHY"},
+			{Keyword: "DENTIST", Industry: "HLT-PHY"},
+			{Keyword: "MD", Industry: "HLT-PHY"},
+			{Keyword: "NURSE", Industry: "HLT-NUR"},
+			{Keyword: "RN", Industry: "HLT-NUR"},
+			{Keyword: "PHARMACIST", Industry: "HLT-PHR"},
+			{Keyword: "TEACHER", Industry: "EDU-GEN"},
+			{Keyword: "PROFESSOR", Industry: "EDU-GEN"},
+			{Keyword: "EDUCATOR", Industry: "EDU-GEN"},
+			{Keyword: "BANKER", Industry: "FIN-BNK"},
+			{Keyword: "INVESTOR", Industry: "FIN-SEC"},
+			{Keyword: "INVESTMENT", Industry: "FIN-SEC"},
+			{Keyword: "FINANCIAL ADVISOR", Industry: "FIN-SEC"},
+			{Keyword: "INSURANCE", Industry: "FIN-INS"},
+			{Keyword: "REALTOR", Industry: "FIN-RE"},
+			{Keyword: "REAL ESTATE", Industry: "FIN-RE"},
+			{Keyword: "ACCOUNTANT", Industry: "FIN-ACC"},
+			{Keyword: "CPA", Industry: "FIN-ACC"},
+			{Keyword: "SOFTWARE", Industry: "COM-TEC"},
+			{Keyword: "PROGRAMMER", Industry: "COM-TEC"},
+			{Keyword: "ENGINEER", Industry: "CON-ARC"},
+			{Keyword: "ARCHITECT", Industry: "CON-ARC"},
+			{Keyword: "CONTRACTOR", Industry: "CON-GEN"},
+			{Keyword: "FARMER", Industry: "AGR-GEN"},
+			{Keyword: "RANCHER", Industry: "AGR-GEN"},
+			{Keyword: "PILOT", Industry: "TRN-AIR"},
+			{Keyword: "MILITARY", Industry: "DEF-MIL"},
+			{Keyword: "CONSULTANT", Industry: "BUS-GEN"},
+		},
+	}
+}
@@ -3,144 +3,312 @@
 // primary datasets from the raw input, followed by the secondary
 // datasets.
 // This file contains operations for aggregating multi-year datasets
-// for a single entity into one object and is still in progress.
-// These operations are inteneded to be used by the index service
-// in a future version and may be moved to a separate package.
+// for a single entity into one object. Merged objects are cached in
+// memory so repeated multi-year views are not re-merged on each request.
 package databuilder
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
 )
 
-/* may move code to separate "aggregate" package */
-// basic function tests - successful
-// panics if year does not contain corresponding data (nil interface)
-// refactor to implement DynamoDB API calls to retreive objects
-// re-do unit tests w/ edge cases
-// removed org references
-
-// MergeData merges multi-year data sets into one interface object
-func MergeData(years []string, ID, bucket string) (interface{}, error) {
-	var merged interface{}
-	switch {
-	case bucket == "individuals":
-		// get corresponding objects for each year
-		set, err := createMergeSet(years, bucket, ID)
-		if err != nil {
-			fmt.Println("MergeData failed: ", err)
-			return nil, fmt.Errorf("MergeData failed: %v", err)
-		}
+// MERGE_CACHE_SIZE is the max # of merged objects kept in the merge cache.
+const MERGE_CACHE_SIZE = 1000
 
-		// merge object values into one object
-		mergedIndv := *set[years[0]].(*donations.Individual)
-		for year, obj := range set {
-			if year == years[0] {
-				continue
-			}
-			if obj == nil {
-				continue
-			}
-			compIndv := *obj.(*donations.Individual)
-			indvTotalsMerge(&mergedIndv, &compIndv)
-			indvMapMerge(&mergedIndv, &compIndv)
-		}
+// MergeConfig contains the options used to merge multi-year datasets.
+// TopN limits each merged rankings map to the Top N entries by $ value;
+// FieldTopN overrides TopN for the given map fields (ex: "RecipientsAmt").
+// A limit of 0 or less keeps every entry.
+type MergeConfig struct {
+	TopN      int
+	FieldTopN map[string]int
+}
 
-		// Filter Top 100 entries
-		if len(mergedIndv.RecipientsAmt) > 100 {
-			mergedIndv.RecipientsAmt, mergedIndv.RecipientsTxs = sort100(mergedIndv.RecipientsAmt, mergedIndv.RecipientsTxs)
-		}
-		if len(mergedIndv.SendersAmt) > 100 {
-			mergedIndv.SendersAmt, mergedIndv.SendersTxs = sort100(mergedIndv.SendersAmt, mergedIndv.SendersTxs)
-		}
+// ObjectGetter retrieves the dataset for the given year, bucket, and ID.
+// A nil object is returned if no dataset exists for the given year.
+type ObjectGetter func(year, bucket, ID string) (interface{}, error)
+
+// mergeCache stores merged objects by bucket, ID, years, and config.
+// keys are stored in insertion order and evicted first in, first out.
+var mergeCache = struct {
+	sync.Mutex
+	objs map[string]interface{}
+	keys []string
+}{objs: make(map[string]interface{})}
+
+// DefaultMergeConfig returns a MergeConfig limiting each rankings map to the Top 100 entries.
+func DefaultMergeConfig() *MergeConfig {
+	return &MergeConfig{TopN: 100, FieldTopN: make(map[string]int)}
+}
+
+// YearRange returns each two-year election cycle from start to end
+// inclusive in ascending order (ex: "2008", "2020" -> "2008", "2010"... "2020").
+func YearRange(start, end string) ([]string, error) {
+	s, err := strconv.Atoi(Cycle(start))
+	if err != nil {
+		return nil, fmt.Errorf("YearRange failed: invalid start year: %s", start)
+	}
+	e, err := strconv.Atoi(Cycle(end))
+	if err != nil {
+		return nil, fmt.Errorf("YearRange failed: invalid end year: %s", end)
+	}
+	if s > e {
+		s, e = e, s
+	}
+	years := []string{}
+	for yr := s; yr <= e; yr += 2 {
+		years = append(years, strconv.Itoa(yr))
+	}
+	return years, nil
+}
+
+// MergeData merges the entity's datasets on disk for each of the given years into one object.
+// Merged objects are cached and must not be modified by the caller.
+func MergeData(years []string, ID, bucket string, cfg *MergeConfig) (interface{}, error) {
+	merged, err := MergeDataWith(getFromDisk, years, ID, bucket, cfg)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("MergeData failed: %v", err)
+	}
+	return merged, nil
+}
+
+// MergeDataWith merges the entity's datasets retrieved by the given ObjectGetter for each of
+// the given years into one object. Years are merged in ascending order, so the earliest year
+// with an existing dataset is always used as the base object regardless of the input order.
+// Merged objects are cached and must not be modified by the caller.
+func MergeDataWith(get ObjectGetter, years []string, ID, bucket string, cfg *MergeConfig) (interface{}, error) {
+	if cfg == nil {
+		cfg = DefaultMergeConfig()
+	}
+	years = sortYears(years)
+	key := mergeKey(years, ID, bucket, cfg)
+	if merged := getCachedMerge(key); merged != nil {
+		return merged, nil
+	}
 
-		merged = mergedIndv
-	case bucket == "cmte_tx_data":
-		set, err := createMergeSet(years, bucket, ID)
+	set := []interface{}{}
+	for _, year := range years {
+		obj, err := get(year, bucket, ID)
 		if err != nil {
-			fmt.Println("MergeData failed: ", err)
-			return nil, fmt.Errorf("MergeData failed: %v", err)
+			fmt.Println(err)
+			return nil, fmt.Errorf("MergeDataWith failed: %v", err)
 		}
-
-		mergedCmte := *set[years[0]].(*donations.CmteTxData)
-		for year, obj := range set {
-			if year == years[0] {
-				continue
-			}
-			if obj == nil {
-				continue
-			}
-			compCmte := *obj.(*donations.CmteTxData)
-			cmteTxTotalsMerge(&mergedCmte, &compCmte)
-			cmteTxMapMerge(&mergedCmte, &compCmte)
+		if obj == nil {
+			continue
 		}
+		set = append(set, obj)
+	}
+	if len(set) == 0 {
+		return nil, fmt.Errorf("MergeDataWith failed: no datasets found for %s", ID)
+	}
+
+	merged, err := MergeObjects(bucket, set, cfg)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("MergeDataWith failed: %v", err)
+	}
+	putCachedMerge(key, merged)
+
+	return merged, nil
+}
+
+// MergeObjects merges a list of datasets for a single entity ordered from the
+// earliest year to the latest year into one new object. Stock values reported
+// for a period (cash on hand, debts) are taken from the first and last datasets.
+func MergeObjects(bucket string, set []interface{}, cfg *MergeConfig) (interface{}, error) {
+	if len(set) == 0 {
+		return nil, fmt.Errorf("MergeObjects failed: empty set")
+	}
+	if cfg == nil {
+		cfg = DefaultMergeConfig()
+	}
 
-		// Filter Top 100 entries
-		if len(mergedCmte.TopIndvContributorsAmt) > 3 {
-			mergedCmte.TopIndvContributorsAmt, mergedCmte.TopIndvContributorsTxs = sort3(mergedCmte.TopIndvContributorsAmt, mergedCmte.TopIndvContributorsTxs)
+	switch bucket {
+	case "individuals":
+		merged := initIndvMerge(set[0].(*donations.Individual))
+		for _, obj := range set[1:] {
+			indv := obj.(*donations.Individual)
+			indvTotalsMerge(merged, indv)
+			indvMapMerge(merged, indv)
 		}
-		if len(mergedCmte.TopCmteOrgContributorsAmt) > 3 {
-			mergedCmte.TopCmteOrgContributorsAmt, mergedCmte.TopCmteOrgContributorsTxs = sort3(mergedCmte.TopCmteOrgContributorsAmt, mergedCmte.TopCmteOrgContributorsTxs)
+		merged.RecipientsAmt, merged.RecipientsTxs = clipMerge(merged.RecipientsAmt, merged.RecipientsTxs, cfg.limit("RecipientsAmt"))
+		merged.SendersAmt, merged.SendersTxs = clipMerge(merged.SendersAmt, merged.SendersTxs, cfg.limit("SendersAmt"))
+		merged.ElectionsAmt, _ = clipMerge(merged.ElectionsAmt, nil, cfg.limit("ElectionsAmt"))
+		return merged, nil
+	case "cmte_tx_data":
+		merged := initCmteTxMerge(set[0].(*donations.CmteTxData))
+		for _, obj := range set[1:] {
+			cmte := obj.(*donations.CmteTxData)
+			cmteTxTotalsMerge(merged, cmte)
+			cmteTxMapMerge(merged, cmte)
 		}
-		if len(mergedCmte.TransferRecsAmt) > 3 {
-			mergedCmte.TransferRecsAmt, mergedCmte.TransferRecsTxs = sort3(mergedCmte.TransferRecsAmt, mergedCmte.TransferRecsTxs)
+		merged.TopIndvContributorsAmt, merged.TopIndvContributorsTxs = clipMerge(merged.TopIndvContributorsAmt, merged.TopIndvContributorsTxs, cfg.limit("TopIndvContributorsAmt"))
+		merged.TopCmteOrgContributorsAmt, merged.TopCmteOrgContributorsTxs = clipMerge(merged.TopCmteOrgContributorsAmt, merged.TopCmteOrgContributorsTxs, cfg.limit("TopCmteOrgContributorsAmt"))
+		merged.TransferRecsAmt, merged.TransferRecsTxs = clipMerge(merged.TransferRecsAmt, merged.TransferRecsTxs, cfg.limit("TransferRecsAmt"))
+		merged.TopExpRecipientsAmt, merged.TopExpRecipientsTxs = clipMerge(merged.TopExpRecipientsAmt, merged.TopExpRecipientsTxs, cfg.limit("TopExpRecipientsAmt"))
+		return merged, nil
+	case "candidates":
+		merged := initCandMerge(set[0].(*donations.Candidate))
+		for _, obj := range set[1:] {
+			cand := obj.(*donations.Candidate)
+			candTotalsMerge(merged, cand)
+			candMapMerge(merged, cand)
 		}
-		if len(mergedCmte.TopExpRecipientsAmt) > 3 {
-			mergedCmte.TopExpRecipientsAmt, mergedCmte.TopExpRecipientsTxs = sort3(mergedCmte.TopExpRecipientsAmt, mergedCmte.TopExpRecipientsTxs)
+		merged.DirectRecipientsAmts, merged.DirectRecipientsTxs = clipMerge(merged.DirectRecipientsAmts, merged.DirectRecipientsTxs, cfg.limit("DirectRecipientsAmts"))
+		merged.DirectSendersAmts, merged.DirectSendersTxs = clipMerge(merged.DirectSendersAmts, merged.DirectSendersTxs, cfg.limit("DirectSendersAmts"))
+		return merged, nil
+	case "cmte_fin":
+		merged := *set[0].(*donations.CmteFinancials)
+		for _, obj := range set[1:] {
+			cmteFinMerge(&merged, obj.(*donations.CmteFinancials))
 		}
-
-		merged = mergedCmte
-	case bucket == "candidates":
-		set, err := createMergeSet(years, bucket, ID)
-		if err != nil {
-			fmt.Println("MergeData failed: ", err)
-			return nil, fmt.Errorf("MergeData failed: %v", err)
+		return &merged, nil
+	case "cmpn_fin":
+		merged := *set[0].(*donations.CmpnFinancials)
+		for _, obj := range set[1:] {
+			cmpnFinMerge(&merged, obj.(*donations.CmpnFinancials))
 		}
+		return &merged, nil
+	default:
+		return nil, fmt.Errorf("MergeObjects failed: invalid bucket type")
+	}
+}
 
-		mergedCand := *set[years[0]].(*donations.Candidate)
-		for year, obj := range set {
-			if year == years[0] {
-				continue
-			}
-			if obj == nil {
-				continue
-			}
-			compCand := *obj.(*donations.Candidate)
-			candTotalsMerge(&mergedCand, &compCand)
-			candMapMerge(&mergedCand, &compCand)
-		}
+// ClearMergeCache removes all merged objects from the merge cache.
+func ClearMergeCache() {
+	mergeCache.Lock()
+	defer mergeCache.Unlock()
+	mergeCache.objs = make(map[string]interface{})
+	mergeCache.keys = []string{}
+}
 
-		if len(mergedCand.DirectRecipientsAmts) > 100 {
-			mergedCand.DirectRecipientsAmts, mergedCand.DirectRecipientsTxs = sort100(mergedCand.DirectRecipientsAmts, mergedCand.DirectRecipientsTxs)
-		}
-		if len(mergedCand.DirectSendersAmts) > 100 {
-			mergedCand.DirectSendersAmts, mergedCand.DirectSendersTxs = sort100(mergedCand.DirectSendersAmts, mergedCand.DirectSendersTxs)
+// getFromDisk retrieves the object from disk; returns nil if the
+// object does not exist or the year is not loaded on disk
+func getFromDisk(year, bucket, ID string) (interface{}, error) {
+	objs, err := persist.BatchGetByYear([]string{year}, bucket, []string{ID})
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getFromDisk failed: %v", err)
+	}
+	if len(objs[year]) == 0 {
+		return nil, nil
+	}
+	return objs[year][0], nil
+}
+
+// limit returns the max # of entries for the given map field;
+// negative limits are treated as unlimited (0)
+func (c *MergeConfig) limit(field string) int {
+	n, ok := c.FieldTopN[field]
+	if !ok {
+		n = c.TopN
+	}
+	if n < 0 {
+		return 0
+	}
+	return n
+}
+
+// sortYears returns a sorted copy of the years list with duplicates removed
+func sortYears(years []string) []string {
+	seen := make(map[string]bool)
+	sorted := []string{}
+	for _, yr := range years {
+		if !seen[yr] {
+			seen[yr] = true
+			sorted = append(sorted, yr)
 		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
 
-		merged = mergedCand
-	default:
-		return nil, fmt.Errorf("MergeData failed: invalid bucket type")
+// mergeKey derives the merge cache key for the given merge parameters
+func mergeKey(years []string, ID, bucket string, cfg *MergeConfig) string {
+	fields := []string{}
+	for f, n := range cfg.FieldTopN {
+		fields = append(fields, f+"="+strconv.Itoa(n))
 	}
+	sort.Strings(fields)
+	return fmt.Sprintf("%s:%s:%s:%d:%s", bucket, ID, strings.Join(years, ","), cfg.TopN, strings.Join(fields, ","))
+}
 
-	return merged, nil
+func getCachedMerge(key string) interface{} {
+	mergeCache.Lock()
+	defer mergeCache.Unlock()
+	return mergeCache.objs[key]
 }
 
-func createMergeSet(years []string, bucket, ID string) (map[string]interface{}, error) {
-	set := make(map[string]interface{})
-	for _, year := range years {
-		// OBJECT MUST BE RETREIVED FROM DynamoDB API CALL
-		// obj := DbSim[year][bucket][ID] // test only
-		obj := &donations.Individual{} // test only
-		// verify obj != nil before adding to set
-		set[year] = obj
+func putCachedMerge(key string, obj interface{}) {
+	mergeCache.Lock()
+	defer mergeCache.Unlock()
+	if _, ok := mergeCache.objs[key]; ok {
+		return
+	}
+	if len(mergeCache.keys) >= MERGE_CACHE_SIZE {
+		delete(mergeCache.objs, mergeCache.keys[0])
+		mergeCache.keys = mergeCache.keys[1:]
 	}
-	return set, nil
+	mergeCache.objs[key] = obj
+	mergeCache.keys = append(mergeCache.keys, key)
 }
 
-func createMergeObj(obj interface{}) interface{} {
-	merge := obj
-	return merge
+// initIndvMerge copies the base object so the source object's maps & lists are not modified
+func initIndvMerge(base *donations.Individual) *donations.Individual {
+	merged := *base
+	merged.Transactions = append([]string{}, base.Transactions...)
+	merged.RecipientsAmt = mapMerge(nil, base.RecipientsAmt)
+	merged.RecipientsTxs = mapMerge(nil, base.RecipientsTxs)
+	merged.SendersAmt = mapMerge(nil, base.SendersAmt)
+	merged.SendersTxs = mapMerge(nil, base.SendersTxs)
+	merged.ElectionsAmt = mapMerge(nil, base.ElectionsAmt)
+	merged.EarmarkedAmt = mapMerge(nil, base.EarmarkedAmt)
+	return &merged
+}
+
+func initCmteTxMerge(base *donations.CmteTxData) *donations.CmteTxData {
+	merged := *base
+	merged.TransfersList = append([]string{}, base.TransfersList...)
+	merged.TopIndvContributorsAmt = mapMerge(nil, base.TopIndvContributorsAmt)
+	merged.TopIndvContributorsTxs = mapMerge(nil, base.TopIndvContributorsTxs)
+	merged.TopCmteOrgContributorsAmt = mapMerge(nil, base.TopCmteOrgContributorsAmt)
+	merged.TopCmteOrgContributorsTxs = mapMerge(nil, base.TopCmteOrgContributorsTxs)
+	merged.TransferRecsAmt = mapMerge(nil, base.TransferRecsAmt)
+	merged.TransferRecsTxs = mapMerge(nil, base.TransferRecsTxs)
+	merged.TopExpRecipientsAmt = mapMerge(nil, base.TopExpRecipientsAmt)
+	merged.TopExpRecipientsTxs = mapMerge(nil, base.TopExpRecipientsTxs)
+	merged.ElectionsInAmt = mapMerge(nil, base.ElectionsInAmt)
+	merged.ElectionsInTxs = mapMerge(nil, base.ElectionsInTxs)
+	merged.ElectionsCmteInAmt = mapMerge(nil, base.ElectionsCmteInAmt)
+	merged.ElectionsCmteInTxs = mapMerge(nil, base.ElectionsCmteInTxs)
+	merged.SizeBinsAmt = mapMerge(nil, base.SizeBinsAmt)
+	merged.SizeBinsTxs = mapMerge(nil, base.SizeBinsTxs)
+	merged.SizeCounts = mapMerge(nil, base.SizeCounts)
+	merged.ConduitsInAmt = mapMerge(nil, base.ConduitsInAmt)
+	merged.ConduitsInTxs = mapMerge(nil, base.ConduitsInTxs)
+	merged.EarmarkRecipientsAmt = mapMerge(nil, base.EarmarkRecipientsAmt)
+	merged.EarmarkRecipientsTxs = mapMerge(nil, base.EarmarkRecipientsTxs)
+	merged.TxKindsAmt = mapMerge(nil, base.TxKindsAmt)
+	merged.TxKindsTxs = mapMerge(nil, base.TxKindsTxs)
+	return &merged
+}
+
+func initCandMerge(base *donations.Candidate) *donations.Candidate {
+	merged := *base
+	merged.OtherAffiliates = append([]string{}, base.OtherAffiliates...)
+	merged.TransactionsList = append([]string{}, base.TransactionsList...)
+	merged.DirectRecipientsAmts = mapMerge(nil, base.DirectRecipientsAmts)
+	merged.DirectRecipientsTxs = mapMerge(nil, base.DirectRecipientsTxs)
+	merged.DirectSendersAmts = mapMerge(nil, base.DirectSendersAmts)
+	merged.DirectSendersTxs = mapMerge(nil, base.DirectSendersTxs)
+	return &merged
 }
 
 func mapMerge(merge, source map[string]float32) map[string]float32 {
@@ -160,10 +328,10 @@ func indvTotalsMerge(merge, indv *donations.Individual) {
 	merge.Transactions = append(merge.Transactions, indv.Transactions...)
 	merge.TotalOutAmt += indv.TotalOutAmt
 	merge.TotalOutTxs += indv.TotalOutTxs
-	merge.AvgTxOut = merge.TotalOutAmt / merge.TotalOutTxs
+	merge.AvgTxOut = avg(merge.TotalOutAmt, merge.TotalOutTxs)
 	merge.TotalInAmt += indv.TotalInAmt
 	merge.TotalInTxs += indv.TotalInTxs
-	merge.AvgTxIn = merge.TotalInAmt / merge.TotalInTxs
+	merge.AvgTxIn = avg(merge.TotalInAmt, merge.TotalInTxs)
 	merge.NetBalance = merge.TotalInAmt - merge.TotalOutAmt
 }
 
@@ -172,30 +340,38 @@ func indvMapMerge(merge, indv *donations.Individual) {
 	merge.RecipientsTxs = mapMerge(merge.RecipientsTxs, indv.RecipientsTxs)
 	merge.SendersAmt = mapMerge(merge.SendersAmt, indv.SendersAmt)
 	merge.SendersTxs = mapMerge(merge.SendersTxs, indv.SendersTxs)
+	merge.ElectionsAmt = mapMerge(merge.ElectionsAmt, indv.ElectionsAmt)
+	merge.EarmarkedAmt = mapMerge(merge.EarmarkedAmt, indv.EarmarkedAmt)
 }
 
 func cmteTxTotalsMerge(merge, cmte *donations.CmteTxData) {
 	merge.ContributionsInAmt += cmte.ContributionsInAmt
 	merge.ContributionsInTxs += cmte.ContributionsInTxs
-	merge.AvgContributionIn = merge.ContributionsInAmt / merge.ContributionsInTxs
+	merge.AvgContributionIn = avg(merge.ContributionsInAmt, merge.ContributionsInTxs)
 	merge.OtherReceiptsInAmt += cmte.OtherReceiptsInAmt
 	merge.OtherReceiptsInTxs += cmte.OtherReceiptsInTxs
-	merge.AvgOtherIn = merge.OtherReceiptsInAmt / merge.OtherReceiptsInTxs
+	merge.AvgOtherIn = avg(merge.OtherReceiptsInAmt, merge.OtherReceiptsInTxs)
 	merge.TotalIncomingAmt = merge.ContributionsInAmt + merge.OtherReceiptsInAmt
 	merge.TotalIncomingTxs = merge.ContributionsInTxs + merge.OtherReceiptsInTxs
-	merge.AvgIncoming = merge.TotalIncomingAmt / merge.TotalIncomingTxs
+	merge.AvgIncoming = avg(merge.TotalIncomingAmt, merge.TotalIncomingTxs)
 
 	merge.TransfersAmt += cmte.TransfersAmt
 	merge.TransfersTxs += cmte.TransfersTxs
-	merge.AvgTransfer = merge.TransfersAmt / merge.TransfersTxs
+	merge.AvgTransfer = avg(merge.TransfersAmt, merge.TransfersTxs)
+	merge.TransfersList = append(merge.TransfersList, cmte.TransfersList...)
 	merge.ExpendituresAmt += cmte.ExpendituresAmt
 	merge.ExpendituresTxs += cmte.ExpendituresTxs
-	merge.AvgExpenditure = merge.ExpendituresAmt / merge.ExpendituresTxs
+	merge.AvgExpenditure = avg(merge.ExpendituresAmt, merge.ExpendituresTxs)
 	merge.TotalOutgoingAmt = merge.TransfersAmt + merge.ExpendituresAmt
 	merge.TotalOutgoingTxs = merge.TransfersTxs + merge.ExpendituresTxs
-	merge.AvgOutgoing = merge.TotalOutgoingAmt / merge.TotalOutgoingTxs
+	merge.AvgOutgoing = avg(merge.TotalOutgoingAmt, merge.TotalOutgoingTxs)
 
 	merge.NetBalance = merge.TotalIncomingAmt - merge.TotalOutgoingAmt
+
+	merge.EarmarkedInAmt += cmte.EarmarkedInAmt
+	merge.EarmarkedInTxs += cmte.EarmarkedInTxs
+	merge.EarmarkedOutAmt += cmte.EarmarkedOutAmt
+	merge.EarmarkedOutTxs += cmte.EarmarkedOutTxs
 }
 
 func cmteTxMapMerge(merge, cmte *donations.CmteTxData) {
@@ -214,16 +390,38 @@ func cmteTxMapMerge(merge, cmte *donations.CmteTxData) {
 	// Top Expenditure Recipients
 	merge.TopExpRecipientsAmt = mapMerge(merge.TopExpRecipientsAmt, cmte.TopExpRecipientsAmt)
 	merge.TopExpRecipientsTxs = mapMerge(merge.TopExpRecipientsTxs, cmte.TopExpRecipientsTxs)
+
+	// Contributions by Election
+	merge.ElectionsInAmt = mapMerge(merge.ElectionsInAmt, cmte.ElectionsInAmt)
+	merge.ElectionsInTxs = mapMerge(merge.ElectionsInTxs, cmte.ElectionsInTxs)
+	merge.ElectionsCmteInAmt = mapMerge(merge.ElectionsCmteInAmt, cmte.ElectionsCmteInAmt)
+	merge.ElectionsCmteInTxs = mapMerge(merge.ElectionsCmteInTxs, cmte.ElectionsCmteInTxs)
+
+	// Contribution Sizes
+	merge.SizeBinsAmt = mapMerge(merge.SizeBinsAmt, cmte.SizeBinsAmt)
+	merge.SizeBinsTxs = mapMerge(merge.SizeBinsTxs, cmte.SizeBinsTxs)
+	merge.SizeCounts = mapMerge(merge.SizeCounts, cmte.SizeCounts)
+
+	// Earmarked Contributions
+	merge.ConduitsInAmt = mapMerge(merge.ConduitsInAmt, cmte.ConduitsInAmt)
+	merge.ConduitsInTxs = mapMerge(merge.ConduitsInTxs, cmte.ConduitsInTxs)
+	merge.EarmarkRecipientsAmt = mapMerge(merge.EarmarkRecipientsAmt, cmte.EarmarkRecipientsAmt)
+	merge.EarmarkRecipientsTxs = mapMerge(merge.EarmarkRecipientsTxs, cmte.EarmarkRecipientsTxs)
+
+	// Refunds, Adjustments & Memo Entries
+	merge.TxKindsAmt = mapMerge(merge.TxKindsAmt, cmte.TxKindsAmt)
+	merge.TxKindsTxs = mapMerge(merge.TxKindsTxs, cmte.TxKindsTxs)
 }
 
 func candTotalsMerge(merge, cand *donations.Candidate) {
-	merge.OtherAffiliates = append(merge.OtherAffiliates, cand.OtherAffiliates...)
+	merge.OtherAffiliates = appendUnique(merge.OtherAffiliates, cand.OtherAffiliates)
+	merge.TransactionsList = append(merge.TransactionsList, cand.TransactionsList...)
 	merge.TotalDirectInAmt += cand.TotalDirectInAmt
 	merge.TotalDirectInTxs += cand.TotalDirectInTxs
-	merge.AvgDirectIn = merge.TotalDirectInAmt / merge.TotalDirectInTxs
+	merge.AvgDirectIn = avg(merge.TotalDirectInAmt, merge.TotalDirectInTxs)
 	merge.TotalDirectOutAmt += cand.TotalDirectOutAmt
 	merge.TotalDirectOutTxs += cand.TotalDirectOutTxs
-	merge.AvgDirectOut = merge.TotalDirectOutAmt / merge.TotalDirectOutTxs
+	merge.AvgDirectOut = avg(merge.TotalDirectOutAmt, merge.TotalDirectOutTxs)
 	merge.NetBalanceDirectTx = merge.TotalDirectInAmt - merge.TotalDirectOutAmt
 }
 
@@ -234,31 +432,92 @@ func candMapMerge(merge, cand *donations.Candidate) {
 	merge.DirectSendersTxs = mapMerge(merge.DirectSendersTxs, cand.DirectSendersTxs)
 }
 
-// Sort maps and derive top 100 entries by value
-func sort100(amts, txs map[string]float32) (map[string]float32, map[string]float32) {
-	topAmts := make(map[string]float32)
-	topTxs := make(map[string]float32)
-	es := sortTopX(amts)
+// cmteFinMerge sums the flow values for each period; cash at the beginning
+// of the period is kept from the base (earliest) object and cash at the
+// end of the period/debts owed are replaced by the later period's values.
+func cmteFinMerge(merge, fin *donations.CmteFinancials) {
+	merge.TotalReceipts += fin.TotalReceipts
+	merge.TxsFromAff += fin.TxsFromAff
+	merge.IndvConts += fin.IndvConts
+	merge.OtherConts += fin.OtherConts
+	merge.CandCont += fin.CandCont
+	merge.CandLoans += fin.CandLoans
+	merge.TotalLoans += fin.TotalLoans
+	merge.TotalDisb += fin.TotalDisb
+	merge.TxToAff += fin.TxToAff
+	merge.IndvRefunds += fin.IndvRefunds
+	merge.OtherRefunds += fin.OtherRefunds
+	merge.LoanRepay += fin.LoanRepay
+	merge.NonFedTxsRecvd += fin.NonFedTxsRecvd
+	merge.ContToOtherCmte += fin.ContToOtherCmte
+	merge.IndExp += fin.IndExp
+	merge.PartyExp += fin.PartyExp
+	merge.NonFedSharedExp += fin.NonFedSharedExp
+	merge.CashCOP = fin.CashCOP
+	merge.DebtsOwed = fin.DebtsOwed
+	merge.CovgEndDate = fin.CovgEndDate
+}
 
-	for _, e := range es[:100] {
-		topAmts[e.ID] = e.Total
-		topTxs[e.ID] = txs[e.ID]
-	}
+// cmpnFinMerge sums the flow values for each period; cash on hand at the beginning
+// of the period is kept from the base (earliest) object and cash on hand at the end
+// of the period, debts owed, and election results are replaced by the later period's values.
+func cmpnFinMerge(merge, fin *donations.CmpnFinancials) {
+	merge.TotalReceipts += fin.TotalReceipts
+	merge.TransFrAuth += fin.TransFrAuth
+	merge.TotalDisbsmts += fin.TotalDisbsmts
+	merge.TransToAuth += fin.TransToAuth
+	merge.CandConts += fin.CandConts
+	merge.CandLoans += fin.CandLoans
+	merge.OtherLoans += fin.OtherLoans
+	merge.CandLoanRepay += fin.CandLoanRepay
+	merge.OtherLoanRepay += fin.OtherLoanRepay
+	merge.TotalIndvConts += fin.TotalIndvConts
+	merge.OtherCmteConts += fin.OtherCmteConts
+	merge.PtyConts += fin.PtyConts
+	merge.IndvRefunds += fin.IndvRefunds
+	merge.CmteRefunds += fin.CmteRefunds
+	merge.COHCOP = fin.COHCOP
+	merge.DebtsOwedBy = fin.DebtsOwedBy
+	merge.CvgEndDate = fin.CvgEndDate
+	merge.SpecElection = fin.SpecElection
+	merge.PrimElection = fin.PrimElection
+	merge.RunElection = fin.RunElection
+	merge.GenElection = fin.GenElection
+	merge.GenElectionPct = fin.GenElectionPct
+}
 
-	return topAmts, topTxs
+// appendUnique appends each value in source not already contained in list
+func appendUnique(list, source []string) []string {
+	seen := make(map[string]bool)
+	for _, s := range list {
+		seen[s] = true
+	}
+	for _, s := range source {
+		if !seen[s] {
+			seen[s] = true
+			list = append(list, s)
+		}
+	}
+	return list
 }
 
-// TEST ONLY
-// Sort maps and derive top 5 entries by value
-func sort3(amts, txs map[string]float32) (map[string]float32, map[string]float32) {
+// clipMerge derives the Top n entries by value of a merged Amt map and the
+// corresponding entries of the Txs map; maps are returned as is if n <= 0
+func clipMerge(amts, txs map[string]float32, n int) (map[string]float32, map[string]float32) {
+	if n <= 0 || len(amts) <= n {
+		return amts, txs
+	}
 	topAmts := make(map[string]float32)
 	topTxs := make(map[string]float32)
-	es := sortTopX(amts)
-
-	for _, e := range es[:3] {
+	for _, e := range sortTopX(amts)[:n] {
 		topAmts[e.ID] = e.Total
 		topTxs[e.ID] = txs[e.ID]
 	}
 
 	return topAmts, topTxs
 }
+
+// Sort maps and derive top 100 entries by value
+func sort100(amts, txs map[string]float32) (map[string]float32, map[string]float32) {
+	return clipMerge(amts, txs, 100)
+}
@@ -0,0 +1,245 @@
+package databuilder
+
+import (
+	"os"
+	"testing"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+)
+
+// fakeGetter returns an ObjectGetter reading from the given objects by year and
+// counting the # of calls made.
+func fakeGetter(objs map[string]interface{}, calls *int) ObjectGetter {
+	return func(year, bucket, ID string) (interface{}, error) {
+		*calls++
+		return objs[year], nil
+	}
+}
+
+func TestYearRange(t *testing.T) {
+	var tests = []struct {
+		start, end string
+		want       []string
+	}{
+		{"2016", "2020", []string{"2016", "2018", "2020"}},
+		{"2020", "2016", []string{"2016", "2018", "2020"}},
+		{"2015", "2019", []string{"2016", "2018", "2020"}}, // odd years -> following cycle
+		{"2020", "2020", []string{"2020"}},
+	}
+	for _, test := range tests {
+		got, err := YearRange(test.start, test.end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("YearRange(%q, %q) = %v; want %v", test.start, test.end, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("YearRange(%q, %q) = %v; want %v", test.start, test.end, got, test.want)
+				break
+			}
+		}
+	}
+	if _, err := YearRange("bad", "2020"); err == nil {
+		t.Errorf("YearRange(\"bad\", \"2020\") returned nil error")
+	}
+}
+
+func TestMergeDataWithBaseYear(t *testing.T) {
+	objs := map[string]interface{}{
+		"2016": &donations.Individual{ID: "i001", Name: "OLD NAME", TotalOutAmt: 100, TotalOutTxs: 1},
+		"2020": &donations.Individual{ID: "i001", Name: "NEW NAME", TotalOutAmt: 300, TotalOutTxs: 1},
+	}
+	var tests = []struct {
+		years []string
+		want  string
+	}{
+		{[]string{"2016", "2020"}, "OLD NAME"},
+		{[]string{"2020", "2016"}, "OLD NAME"},
+		{[]string{"2020", "2018", "2016", "2020"}, "OLD NAME"}, // missing year & duplicates
+		{[]string{"2018", "2020"}, "NEW NAME"},
+	}
+	for _, test := range tests {
+		ClearMergeCache()
+		calls := 0
+		merged, err := MergeDataWith(fakeGetter(objs, &calls), test.years, "i001", "individuals", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		indv := merged.(*donations.Individual)
+		if indv.Name != test.want {
+			t.Errorf("MergeDataWith(%v) base name = %q; want %q", test.years, indv.Name, test.want)
+		}
+	}
+
+	// source objects are not modified
+	if objs["2016"].(*donations.Individual).TotalOutAmt != 100 {
+		t.Errorf("MergeDataWith() modified base object")
+	}
+	if _, err := MergeDataWith(fakeGetter(objs, new(int)), []string{"2018"}, "i001", "individuals", nil); err == nil {
+		t.Errorf("MergeDataWith() with no datasets returned nil error")
+	}
+}
+
+func TestMergeDataWithClip(t *testing.T) {
+	objs := map[string]interface{}{
+		"2018": &donations.CmteTxData{
+			CmteID:                 "C001",
+			TopIndvContributorsAmt: map[string]float32{"a": 50, "b": 40, "c": 30},
+			TopIndvContributorsTxs: map[string]float32{"a": 1, "b": 1, "c": 1},
+			TopExpRecipientsAmt:    map[string]float32{"x": 10, "y": 20},
+			TopExpRecipientsTxs:    map[string]float32{"x": 1, "y": 1},
+		},
+		"2020": &donations.CmteTxData{
+			CmteID:                 "C001",
+			TopIndvContributorsAmt: map[string]float32{"c": 30, "d": 5},
+			TopIndvContributorsTxs: map[string]float32{"c": 2, "d": 1},
+			TopExpRecipientsAmt:    map[string]float32{"z": 15},
+			TopExpRecipientsTxs:    map[string]float32{"z": 1},
+		},
+	}
+	var tests = []struct {
+		cfg      *MergeConfig
+		wantIndv map[string]float32
+		wantExp  int
+	}{
+		{&MergeConfig{TopN: 2}, map[string]float32{"c": 60, "a": 50}, 2},
+		{&MergeConfig{TopN: 2, FieldTopN: map[string]int{"TopIndvContributorsAmt": 1}}, map[string]float32{"c": 60}, 2},
+		{&MergeConfig{TopN: 0}, map[string]float32{"a": 50, "b": 40, "c": 60, "d": 5}, 3},
+		{&MergeConfig{TopN: -1}, map[string]float32{"a": 50, "b": 40, "c": 60, "d": 5}, 3}, // negative -> unlimited
+		{&MergeConfig{TopN: 2, FieldTopN: map[string]int{"TopIndvContributorsAmt": -5}}, map[string]float32{"a": 50, "b": 40, "c": 60, "d": 5}, 2},
+	}
+	for _, test := range tests {
+		ClearMergeCache()
+		merged, err := MergeDataWith(fakeGetter(objs, new(int)), []string{"2018", "2020"}, "C001", "cmte_tx_data", test.cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmte := merged.(*donations.CmteTxData)
+		if len(cmte.TopIndvContributorsAmt) != len(test.wantIndv) {
+			t.Errorf("cfg %+v: TopIndvContributorsAmt = %v; want %v", test.cfg, cmte.TopIndvContributorsAmt, test.wantIndv)
+		}
+		for k, v := range test.wantIndv {
+			if cmte.TopIndvContributorsAmt[k] != v {
+				t.Errorf("cfg %+v: TopIndvContributorsAmt = %v; want %v", test.cfg, cmte.TopIndvContributorsAmt, test.wantIndv)
+				break
+			}
+		}
+		for k := range cmte.TopIndvContributorsAmt {
+			if _, ok := cmte.TopIndvContributorsTxs[k]; !ok || len(cmte.TopIndvContributorsTxs) != len(cmte.TopIndvContributorsAmt) {
+				t.Errorf("cfg %+v: TopIndvContributorsTxs = %v; want keys of %v", test.cfg, cmte.TopIndvContributorsTxs, cmte.TopIndvContributorsAmt)
+				break
+			}
+		}
+		if len(cmte.TopExpRecipientsAmt) != test.wantExp {
+			t.Errorf("cfg %+v: len(TopExpRecipientsAmt) = %d; want %d", test.cfg, len(cmte.TopExpRecipientsAmt), test.wantExp)
+		}
+	}
+}
+
+func TestMergeDataWithFinancials(t *testing.T) {
+	objs := map[string]interface{}{
+		"2018": &donations.CmpnFinancials{CandID: "H0XX00001", TotalReceipts: 1000, CandLoans: 200, COHBOP: 10, COHCOP: 50, DebtsOwedBy: 200, GenElection: "L"},
+		"2020": &donations.CmpnFinancials{CandID: "H0XX00001", TotalReceipts: 500, CandLoanRepay: 100, COHBOP: 50, COHCOP: 75, DebtsOwedBy: 100, GenElection: "W"},
+	}
+	merged, err := MergeDataWith(fakeGetter(objs, new(int)), []string{"2020", "2018"}, "H0XX00001", "cmpn_fin", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fin := merged.(*donations.CmpnFinancials)
+	var tests = []struct {
+		field     string
+		got, want interface{}
+	}{
+		{"TotalReceipts", fin.TotalReceipts, float32(1500)},
+		{"CandLoans", fin.CandLoans, float32(200)},
+		{"CandLoanRepay", fin.CandLoanRepay, float32(100)},
+		{"COHBOP", fin.COHBOP, float32(10)},
+		{"COHCOP", fin.COHCOP, float32(75)},
+		{"DebtsOwedBy", fin.DebtsOwedBy, float32(100)},
+		{"GenElection", fin.GenElection, "W"},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("merged cmpn_fin %s = %v; want %v", test.field, test.got, test.want)
+		}
+	}
+	if objs["2018"].(*donations.CmpnFinancials).TotalReceipts != 1000 {
+		t.Errorf("MergeDataWith() modified base object")
+	}
+}
+
+func TestMergeDataWithCache(t *testing.T) {
+	ClearMergeCache()
+	objs := map[string]interface{}{
+		"2018": &donations.Candidate{ID: "H0XX00001", TotalDirectInAmt: 10},
+		"2020": &donations.Candidate{ID: "H0XX00001", TotalDirectInAmt: 20},
+	}
+	var tests = []struct {
+		years     []string
+		cfg       *MergeConfig
+		wantCalls int
+	}{
+		{[]string{"2018", "2020"}, nil, 2},
+		{[]string{"2018", "2020"}, nil, 2},                   // cache hit
+		{[]string{"2020", "2018"}, nil, 2},                   // same years in a different order
+		{[]string{"2018", "2020"}, &MergeConfig{TopN: 5}, 4}, // different config
+		{[]string{"2018", "2020"}, &MergeConfig{TopN: 5}, 4},
+	}
+	calls := 0
+	get := fakeGetter(objs, &calls)
+	for _, test := range tests {
+		merged, err := MergeDataWith(get, test.years, "H0XX00001", "candidates", test.cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if calls != test.wantCalls {
+			t.Errorf("MergeDataWith(%v, %+v) getter calls = %d; want %d", test.years, test.cfg, calls, test.wantCalls)
+		}
+		if amt := merged.(*donations.Candidate).TotalDirectInAmt; amt != 30 {
+			t.Errorf("MergeDataWith(%v) TotalDirectInAmt = %v; want 30", test.years, amt)
+		}
+	}
+
+	ClearMergeCache()
+	if _, err := MergeDataWith(get, []string{"2018", "2020"}, "H0XX00001", "candidates", nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 6 {
+		t.Errorf("getter calls after ClearMergeCache() = %d; want 6", calls)
+	}
+}
+
+func TestMergeDataMissingYears(t *testing.T) {
+	persist.OUTPUT_PATH = "."
+	if err := persist.Init("2020"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./db")
+	if err := persist.PutObject("2020", &donations.Individual{ID: "i001", TotalOutAmt: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		years   []string
+		wantErr bool
+	}{
+		{[]string{"2008", "2010"}, true}, // years not loaded on disk
+		{[]string{"2008", "2020"}, false},
+		{[]string{"2020"}, false},
+	}
+	for _, test := range tests {
+		ClearMergeCache()
+		merged, err := MergeData(test.years, "i001", "individuals", nil)
+		if (err != nil) != test.wantErr {
+			t.Errorf("MergeData(%v) err = %v; want error: %v", test.years, err, test.wantErr)
+			continue
+		}
+		if !test.wantErr && merged.(*donations.Individual).TotalOutAmt != 100 {
+			t.Errorf("MergeData(%v) TotalOutAmt = %v; want 100", test.years, merged.(*donations.Individual).TotalOutAmt)
+		}
+	}
+}
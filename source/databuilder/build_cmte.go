@@ -58,6 +58,21 @@ func contributionUpdate(year string, conts []*donations.Contribution, cache map[
 			return nil
 		}
 
+		// earmarked contributions received/forwarded by conduits are
+		// credited once by the recipient's corresponding 15E receipt
+		if conduitTx(cont) {
+			conduitUpdate(cont, filer.(*donations.CmteTxData), memo)
+			continue
+		}
+
+		// record refunds, adjustments & memo entries in their own totals;
+		// refunds and adjustments are excluded from all other totals and maps
+		kind := TxKind(cont)
+		txKindUpdate(cont, filer.(*donations.CmteTxData), kind)
+		if adjustment(kind) || refund(kind) {
+			continue
+		}
+
 		// update incoming/outgoing tx data
 		if incoming {
 			err := incomingTxUpdate(cont, filer.(*donations.CmteTxData), other, transfer, memo)
@@ -66,6 +81,15 @@ func contributionUpdate(year string, conts []*donations.Contribution, cache map[
 				fmt.Println("tx: ", cont.TxID)
 				return fmt.Errorf("contributionUpdate failed: %v", err)
 			}
+			// credit contributions to the election designated by the primary-general indicator
+			if !memo && (cont.TxType < "16" || cont.TxType > "18") {
+				electionUpdate(year, cont, filer.(*donations.CmteTxData), other)
+				sizeUpdate(year, cont, filer.(*donations.CmteTxData), other)
+				earmarkUpdate(cont, filer.(*donations.CmteTxData), other)
+				if bucket == "individuals" {
+					geoUpdate(cont, cache)
+				}
+			}
 		} else {
 			err := outgoingTxUpdate(cont, filer.(*donations.CmteTxData), other, transfer, memo)
 			if err != nil {
@@ -0,0 +1,131 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for attributing earmarked contributions
+// to both the intermediary (conduit) committee and the ultimate recipient.
+package databuilder
+
+import (
+	"github.com/elections/source/donations"
+)
+
+/*
+	EARMARK CRITERIA
+	An earmarked contribution is reported by both the conduit and the ultimate recipient:
+		- 15I/15T: conduit receives the earmarked contribution from the individual
+		- 24I/24T: conduit forwards the earmarked contribution to the recipient
+		- 15E: recipient receives the earmarked contribution from the individual via the conduit
+	Only the recipient's 15E receipt is credited to the recipient's and the individual's totals
+	so that the contribution is counted once; the conduit's 15I/15T/24I/24T transactions
+	are recorded in the conduit's EarmarkedIn/EarmarkedOut statistics only.
+	Recipients do not always file a 15E for every forwarded contribution. After every
+	transaction is processed, ReconcileEarmarks credits each recipient with the amount
+	forwarded to it by a conduit (EarmarkRecipientsAmt) in excess of the 15E receipts
+	it reported from that conduit (ConduitsInAmt). The individual contributors of these
+	contributions are not known and only the recipient's totals are credited.
+*/
+
+// conduitTx returns true if the transaction is an earmarked contribution
+// received or forwarded by the filing committee as an intermediary.
+func conduitTx(cont *donations.Contribution) bool {
+	switch cont.TxType {
+	case "15I", "15T", "24I", "24T":
+		return true
+	default:
+		return false
+	}
+}
+
+// conduitUpdate records an earmarked contribution received or forwarded by the
+// filing conduit committee without crediting the contributor's totals.
+func conduitUpdate(cont *donations.Contribution, filerData *donations.CmteTxData, memo bool) {
+	if memo {
+		return
+	}
+	if cont.TxType == "15I" || cont.TxType == "15T" {
+		filerData.EarmarkedInAmt += cont.TxAmt
+		filerData.EarmarkedInTxs++
+		return
+	}
+
+	filerData.EarmarkedOutAmt += cont.TxAmt
+	filerData.EarmarkedOutTxs++
+	if cont.EarmarkRecipID == "" {
+		return
+	}
+	if filerData.EarmarkRecipientsAmt == nil {
+		filerData.EarmarkRecipientsAmt = make(map[string]float32)
+		filerData.EarmarkRecipientsTxs = make(map[string]float32)
+	}
+	filerData.EarmarkRecipientsAmt[cont.EarmarkRecipID] += cont.TxAmt
+	filerData.EarmarkRecipientsTxs[cont.EarmarkRecipID]++
+}
+
+// earmarkUpdate attributes an earmarked contribution received by the filing recipient
+// committee (15E) to the conduit it was received through and records the conduit
+// and recipient in the individual sender's earmarked contributions.
+func earmarkUpdate(cont *donations.Contribution, filerData *donations.CmteTxData, sender interface{}) {
+	if cont.TxType != "15E" || cont.ConduitID == "" {
+		return
+	}
+	if filerData.ConduitsInAmt == nil {
+		filerData.ConduitsInAmt = make(map[string]float32)
+		filerData.ConduitsInTxs = make(map[string]float32)
+	}
+	filerData.ConduitsInAmt[cont.ConduitID] += cont.TxAmt
+	filerData.ConduitsInTxs[cont.ConduitID]++
+
+	indv, ok := sender.(*donations.Individual)
+	if !ok {
+		return
+	}
+	if indv.EarmarkedAmt == nil {
+		indv.EarmarkedAmt = make(map[string]float32)
+	}
+	indv.EarmarkedAmt[cont.ConduitID+"-"+filerData.CmteID] += cont.TxAmt
+}
+
+// ReconcileEarmarks credits each recipient committee with the earmarked contributions forwarded
+// to it by the conduit that have no corresponding 15E receipt filed by the recipient.
+// Returns the recipients updated. Reconciled amounts are added to the recipient's
+// ConduitsInAmt and are not credited again if called more than once.
+func ReconcileEarmarks(conduit *donations.CmteTxData, recipients map[string]*donations.CmteTxData) []*donations.CmteTxData {
+	updated := []*donations.CmteTxData{}
+	for id, fwdAmt := range conduit.EarmarkRecipientsAmt {
+		recip := recipients[id]
+		if recip == nil || id == conduit.CmteID {
+			continue
+		}
+		amt := fwdAmt - recip.ConduitsInAmt[conduit.CmteID]
+		if amt < 1 { // receipts reported; ignore rounding differences
+			continue
+		}
+		txs := conduit.EarmarkRecipientsTxs[id] - recip.ConduitsInTxs[conduit.CmteID]
+		if txs < 1 {
+			txs = 1
+		}
+		creditEarmarked(recip, conduit.CmteID, amt, txs)
+		updated = append(updated, recip)
+	}
+	return updated
+}
+
+// creditEarmarked credits the recipient's contributions received with earmarked
+// contributions forwarded by the conduit.
+func creditEarmarked(recip *donations.CmteTxData, conduitID string, amt, txs float32) {
+	recip.ContributionsInAmt += amt
+	recip.ContributionsInTxs += txs
+	recip.AvgContributionIn = recip.ContributionsInAmt / recip.ContributionsInTxs
+	recip.TotalIncomingAmt = recip.ContributionsInAmt + recip.OtherReceiptsInAmt
+	recip.TotalIncomingTxs = recip.ContributionsInTxs + recip.OtherReceiptsInTxs
+	recip.AvgIncoming = recip.TotalIncomingAmt / recip.TotalIncomingTxs
+	recip.NetBalance = recip.TotalIncomingAmt - recip.TotalOutgoingAmt
+
+	if recip.ConduitsInAmt == nil {
+		recip.ConduitsInAmt = make(map[string]float32)
+		recip.ConduitsInTxs = make(map[string]float32)
+	}
+	recip.ConduitsInAmt[conduitID] += amt
+	recip.ConduitsInTxs[conduitID] += txs
+}
@@ -0,0 +1,92 @@
+package databuilder
+
+import (
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+// earmarkCache returns a cache with a conduit (C001), a recipient (C002) and an individual (i001).
+func earmarkCache() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		"individuals": {"i001": &donations.Individual{ID: "i001"}},
+		"cmte_tx_data": {
+			"C001": &donations.CmteTxData{CmteID: "C001"},
+			"C002": &donations.CmteTxData{CmteID: "C002", CandID: "H0XX00001"},
+		},
+		"candidates": {},
+	}
+}
+
+// earmarkTx returns an earmarked contribution from i001 through C001 to C002 filed by the filer.
+func earmarkTx(filer, txType string, amt float32) *donations.Contribution {
+	return &donations.Contribution{
+		CmteID: filer, TxType: txType, TxPGI: "P2020", TxAmt: amt, OtherID: "i001",
+		ConduitID: "C001", EarmarkRecipID: "C002",
+	}
+}
+
+func TestEarmarkCountedOnce(t *testing.T) {
+	cache := earmarkCache()
+	txs := []*donations.Contribution{
+		earmarkTx("C001", "15I", 100),
+		earmarkTx("C001", "24I", 100),
+		earmarkTx("C002", "15E", 100),
+	}
+	if err := TransactionUpdate("2020", txs, cache); err != nil {
+		t.Fatal(err)
+	}
+	conduit := cache["cmte_tx_data"]["C001"].(*donations.CmteTxData)
+	recip := cache["cmte_tx_data"]["C002"].(*donations.CmteTxData)
+	indv := cache["individuals"]["i001"].(*donations.Individual)
+
+	if recip.ContributionsInAmt != 100 || indv.TotalOutAmt != 100 {
+		t.Errorf("recipient, individual totals = %v, %v; want 100, 100", recip.ContributionsInAmt, indv.TotalOutAmt)
+	}
+	if conduit.ContributionsInAmt != 0 || conduit.TotalOutgoingAmt != 0 {
+		t.Errorf("conduit totals in, out = %v, %v; want 0, 0", conduit.ContributionsInAmt, conduit.TotalOutgoingAmt)
+	}
+	if conduit.EarmarkedInAmt != 100 || conduit.EarmarkedOutAmt != 100 || conduit.EarmarkRecipientsAmt["C002"] != 100 {
+		t.Errorf("conduit earmarked in, out = %v, %v; want 100, 100", conduit.EarmarkedInAmt, conduit.EarmarkedOutAmt)
+	}
+	if recip.ConduitsInAmt["C001"] != 100 {
+		t.Errorf("recipient ConduitsInAmt = %v; want C001: 100", recip.ConduitsInAmt)
+	}
+
+	// recipient filed 15E; nothing to reconcile
+	recips := map[string]*donations.CmteTxData{"C002": recip}
+	if updated := ReconcileEarmarks(conduit, recips); len(updated) != 0 || recip.ContributionsInAmt != 100 {
+		t.Errorf("ReconcileEarmarks() updated %d; recipient total = %v; want 0, 100", len(updated), recip.ContributionsInAmt)
+	}
+}
+
+func TestEarmarkNoReceipt(t *testing.T) {
+	cache := earmarkCache()
+	txs := []*donations.Contribution{
+		earmarkTx("C001", "24I", 250),
+		earmarkTx("C001", "24I", 50),
+	}
+	if err := TransactionUpdate("2020", txs, cache); err != nil {
+		t.Fatal(err)
+	}
+	conduit := cache["cmte_tx_data"]["C001"].(*donations.CmteTxData)
+	recip := cache["cmte_tx_data"]["C002"].(*donations.CmteTxData)
+	if recip.ContributionsInAmt != 0 {
+		t.Fatalf("recipient total before reconcile = %v; want 0", recip.ContributionsInAmt)
+	}
+
+	recips := map[string]*donations.CmteTxData{"C002": recip}
+	updated := ReconcileEarmarks(conduit, recips)
+	if len(updated) != 1 || recip.ContributionsInAmt != 300 || recip.ContributionsInTxs != 2 || recip.TotalIncomingAmt != 300 {
+		t.Errorf("ReconcileEarmarks() updated %d; recipient total = %v (%v txs); want 1, 300 (2 txs)",
+			len(updated), recip.ContributionsInAmt, recip.ContributionsInTxs)
+	}
+	if recip.ConduitsInAmt["C001"] != 300 {
+		t.Errorf("recipient ConduitsInAmt = %v; want C001: 300", recip.ConduitsInAmt)
+	}
+
+	// reconciled amounts are not credited again
+	if updated := ReconcileEarmarks(conduit, recips); len(updated) != 0 || recip.ContributionsInAmt != 300 {
+		t.Errorf("second ReconcileEarmarks() updated %d; recipient total = %v; want 0, 300", len(updated), recip.ContributionsInAmt)
+	}
+}
@@ -0,0 +1,107 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for deriving the election a transaction
+// is designated for from the primary-general indicator and the two-year
+// election cycle it belongs to.
+package databuilder
+
+import (
+	"strconv"
+
+	"github.com/elections/source/donations"
+)
+
+// election type codes used by the FEC primary-general indicator
+var electionTypes = map[string]string{
+	"P": "Primary",
+	"G": "General",
+	"R": "Runoff",
+	"S": "Special",
+	"C": "Convention",
+	"E": "Recount",
+	"O": "Other",
+}
+
+// maxElectionEntries is the maximum # of CmteID-Election entries kept in an
+// Individual's ElectionsAmt map; the Top maxElectionEntries/2 entries by value
+// are kept when exceeded.
+const maxElectionEntries = 1000
+
+// Election returns the election key ("G2020") for the given primary-general
+// indicator. The election year defaults to the two-year cycle of the file year
+// if the indicator does not contain a valid year; the election type defaults
+// to "O" (Other) if the indicator is missing or invalid.
+func Election(pgi, year string) string {
+	typ := "O"
+	if len(pgi) > 0 && electionTypes[pgi[:1]] != "" {
+		typ = pgi[:1]
+	}
+	if len(pgi) == 5 {
+		if _, err := strconv.Atoi(pgi[1:]); err == nil {
+			return typ + pgi[1:]
+		}
+	}
+	return typ + Cycle(year)
+}
+
+// ElectionName returns the display name for the given election key ("General 2020").
+func ElectionName(key string) string {
+	if len(key) < 1 || electionTypes[key[:1]] == "" {
+		return key
+	}
+	return electionTypes[key[:1]] + " " + key[1:]
+}
+
+// Cycle returns the two-year election cycle for the given year.
+// Cycles are named by the even-numbered year in which they end;
+// the year is returned as is if it is not a valid year.
+func Cycle(year string) string {
+	yr, err := strconv.Atoi(year)
+	if err != nil {
+		return year
+	}
+	if yr%2 == 1 {
+		yr++
+	}
+	return strconv.Itoa(yr)
+}
+
+// electionUpdate adds the contribution to the filing committee's receipts for the
+// election it is designated for and to an individual sender's contributions
+// to the filing committee for the election. Receipts from committees are also
+// recorded by sender so transfers between a candidate's linked committees can
+// be removed from the candidate's rollup.
+// The sender's ElectionsAmt map is clipped when it exceeds maxElectionEntries.
+func electionUpdate(year string, cont *donations.Contribution, filerData *donations.CmteTxData, sender interface{}) {
+	key := Election(cont.TxPGI, year)
+	if filerData.ElectionsInAmt == nil {
+		filerData.ElectionsInAmt = make(map[string]float32)
+		filerData.ElectionsInTxs = make(map[string]float32)
+	}
+	filerData.ElectionsInAmt[key] += cont.TxAmt
+	filerData.ElectionsInTxs[key]++
+
+	if cmte, ok := sender.(*donations.CmteTxData); ok {
+		if filerData.ElectionsCmteInAmt == nil {
+			filerData.ElectionsCmteInAmt = make(map[string]float32)
+			filerData.ElectionsCmteInTxs = make(map[string]float32)
+		}
+		filerData.ElectionsCmteInAmt[cmte.CmteID+"-"+key] += cont.TxAmt
+		filerData.ElectionsCmteInTxs[cmte.CmteID+"-"+key]++
+		return
+	}
+
+	indv, ok := sender.(*donations.Individual)
+	if !ok {
+		return
+	}
+	if indv.ElectionsAmt == nil {
+		indv.ElectionsAmt = make(map[string]float32)
+	}
+	indv.ElectionsAmt[filerData.CmteID+"-"+key] += cont.TxAmt
+	if len(indv.ElectionsAmt) > maxElectionEntries {
+		indv.ElectionsAmt = clipTop(indv.ElectionsAmt, maxElectionEntries/2)
+	}
+}
@@ -0,0 +1,129 @@
+package databuilder
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+func TestCycle(t *testing.T) {
+	var tests = []struct {
+		year, want string
+	}{
+		{"2020", "2020"},
+		{"2019", "2020"}, // odd year -> following cycle
+		{"2001", "2002"},
+		{"2000", "2000"},
+		{"", ""},
+		{"bad", "bad"},
+	}
+	for _, test := range tests {
+		if got := Cycle(test.year); got != test.want {
+			t.Errorf("Cycle(%q) = %q; want %q", test.year, got, test.want)
+		}
+	}
+}
+
+func TestElection(t *testing.T) {
+	var tests = []struct {
+		pgi, year, want string
+	}{
+		{"P2020", "2020", "P2020"},
+		{"G2020", "2020", "G2020"},
+		{"R2018", "2020", "R2018"}, // indicator year is used as is
+		{"S2019", "2020", "S2019"}, // special elections in odd years
+		{"C2020", "2020", "C2020"},
+		{"E2020", "2020", "E2020"},
+		{"O2020", "2020", "O2020"},
+		{"P", "2020", "P2020"},     // missing year -> file cycle
+		{"G", "2019", "G2020"},     // odd file year -> following cycle
+		{"P20XX", "2020", "P2020"}, // invalid year
+		{"P202", "2020", "P2020"},
+		{"X2020", "2020", "O2020"}, // invalid type -> Other
+		{"", "2019", "O2020"},
+	}
+	for _, test := range tests {
+		if got := Election(test.pgi, test.year); got != test.want {
+			t.Errorf("Election(%q, %q) = %q; want %q", test.pgi, test.year, got, test.want)
+		}
+	}
+}
+
+func TestElectionName(t *testing.T) {
+	var tests = []struct {
+		key, want string
+	}{
+		{"P2020", "Primary 2020"},
+		{"G2020", "General 2020"},
+		{"R2020", "Runoff 2020"},
+		{"S2019", "Special 2019"},
+		{"C2020", "Convention 2020"},
+		{"O2020", "Other 2020"},
+		{"X2020", "X2020"},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if got := ElectionName(test.key); got != test.want {
+			t.Errorf("ElectionName(%q) = %q; want %q", test.key, got, test.want)
+		}
+	}
+}
+
+func TestElectionUpdateClip(t *testing.T) {
+	var tests = []struct {
+		cmtes int
+		want  int
+	}{
+		{10, 10},
+		{maxElectionEntries, maxElectionEntries},
+		{maxElectionEntries + 1, maxElectionEntries / 2},
+		{maxElectionEntries + 10, maxElectionEntries/2 + 9},
+	}
+	for _, test := range tests {
+		indv := &donations.Individual{ID: "i001"}
+		for i := 0; i < test.cmtes; i++ {
+			cont := &donations.Contribution{CmteID: fmt.Sprintf("C%03d", i), TxPGI: "P2020", TxAmt: float32(i + 1)}
+			electionUpdate("2020", cont, &donations.CmteTxData{CmteID: cont.CmteID}, indv)
+		}
+		if len(indv.ElectionsAmt) != test.want {
+			t.Errorf("electionUpdate() x %d: len(ElectionsAmt) = %d; want %d", test.cmtes, len(indv.ElectionsAmt), test.want)
+		}
+		// largest entry is kept
+		if top := fmt.Sprintf("C%03d-P2020", test.cmtes-1); indv.ElectionsAmt[top] != float32(test.cmtes) {
+			t.Errorf("electionUpdate() x %d: ElectionsAmt[%s] = %v; want %d", test.cmtes, top, indv.ElectionsAmt[top], test.cmtes)
+		}
+	}
+}
+
+func TestMergeObjectsElectionsClip(t *testing.T) {
+	set := []interface{}{
+		&donations.Individual{ID: "i001", ElectionsAmt: map[string]float32{"C001-P2018": 100, "C002-G2018": 50}},
+		&donations.Individual{ID: "i001", ElectionsAmt: map[string]float32{"C001-P2020": 75, "C002-G2018": 10}},
+	}
+	var tests = []struct {
+		cfg  *MergeConfig
+		want map[string]float32
+	}{
+		{&MergeConfig{TopN: 0}, map[string]float32{"C001-P2018": 100, "C002-G2018": 60, "C001-P2020": 75}},
+		{&MergeConfig{TopN: 2}, map[string]float32{"C001-P2018": 100, "C001-P2020": 75}},
+		{&MergeConfig{TopN: 5, FieldTopN: map[string]int{"ElectionsAmt": 1}}, map[string]float32{"C001-P2018": 100}},
+	}
+	for _, test := range tests {
+		merged, err := MergeObjects("individuals", set, test.cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := merged.(*donations.Individual).ElectionsAmt
+		if len(got) != len(test.want) {
+			t.Errorf("cfg %+v: ElectionsAmt = %v; want %v", test.cfg, got, test.want)
+			continue
+		}
+		for k, v := range test.want {
+			if got[k] != v {
+				t.Errorf("cfg %+v: ElectionsAmt = %v; want %v", test.cfg, got, test.want)
+				break
+			}
+		}
+	}
+}
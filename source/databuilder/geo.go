@@ -0,0 +1,137 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for deriving the geographic breakdown
+// of funds received by committees and candidates from individual donors.
+// Committee GeoData is updated from each contribution's state and zip code
+// during the primary pass; candidate GeoData and national totals are
+// derived from the committee GeoData in the secondary pass.
+package databuilder
+
+import (
+	"github.com/elections/source/donations"
+)
+
+// geoUpdate adds an individual contribution to the filing committee's GeoData
+// by the state and zip code listed on the contribution.
+// The filer's GeoData is created if not yet in the cache; contributions
+// are not recorded if the cache has no geo_data bucket.
+func geoUpdate(cont *donations.Contribution, cache map[string]map[string]interface{}) {
+	geo := cache["geo_data"]
+	if geo == nil {
+		return
+	}
+	g, ok := geo[cont.CmteID].(*donations.GeoData)
+	if !ok {
+		g = InitGeoData(cont.CmteID, "cmte_tx_data")
+		geo[cont.CmteID] = g
+	}
+	initGeoMaps(g)
+
+	state := formatState(cont.State)
+	g.StateAmt[state] += cont.TxAmt
+	g.StateTxs[state]++
+	zip3, zip5 := formatZip(cont.Zip)
+	if zip5 == "" {
+		return
+	}
+	g.Zip3Amt[zip3] += cont.TxAmt
+	g.Zip3Txs[zip3]++
+	g.Zip5Amt[zip5] += cont.TxAmt
+	g.Zip5Txs[zip5]++
+}
+
+// UpdateGeoTotals adds the committee's receipts by state to the year's GeoTotal for each state.
+func UpdateGeoTotals(year string, g *donations.GeoData, totals map[string]*donations.GeoTotal) {
+	for state, amt := range g.StateAmt {
+		gt := totals[state]
+		if gt == nil {
+			gt = &donations.GeoTotal{ID: year + "-" + state, Year: year, State: state}
+			totals[state] = gt
+		}
+		gt.Total += amt
+		gt.Txs += g.StateTxs[state]
+	}
+}
+
+// MergeGeoData merges the GeoData of each of the given committees into
+// one GeoData object for the given ID and bucket.
+func MergeGeoData(ID, bucket string, set []*donations.GeoData) *donations.GeoData {
+	merged := InitGeoData(ID, bucket)
+	for _, g := range set {
+		merged.StateAmt = mapMerge(merged.StateAmt, g.StateAmt)
+		merged.StateTxs = mapMerge(merged.StateTxs, g.StateTxs)
+		merged.Zip3Amt = mapMerge(merged.Zip3Amt, g.Zip3Amt)
+		merged.Zip3Txs = mapMerge(merged.Zip3Txs, g.Zip3Txs)
+		merged.Zip5Amt = mapMerge(merged.Zip5Amt, g.Zip5Amt)
+		merged.Zip5Txs = mapMerge(merged.Zip5Txs, g.Zip5Txs)
+	}
+	return merged
+}
+
+// ClipGeoData limits the GeoData's 5-digit zip code maps to the Top 1000 entries by $ value.
+func ClipGeoData(g *donations.GeoData) {
+	if len(g.Zip5Amt) <= 1000 {
+		return
+	}
+	amts := make(map[string]float32)
+	txs := make(map[string]float32)
+	for _, e := range sortTopX(g.Zip5Amt)[:1000] {
+		amts[e.ID] = e.Total
+		txs[e.ID] = g.Zip5Txs[e.ID]
+	}
+	g.Zip5Amt, g.Zip5Txs = amts, txs
+}
+
+// InitGeoData initializes a GeoData object with empty maps.
+func InitGeoData(ID, bucket string) *donations.GeoData {
+	return &donations.GeoData{
+		ID:       ID,
+		Bucket:   bucket,
+		StateAmt: make(map[string]float32),
+		StateTxs: make(map[string]float32),
+		Zip3Amt:  make(map[string]float32),
+		Zip3Txs:  make(map[string]float32),
+		Zip5Amt:  make(map[string]float32),
+		Zip5Txs:  make(map[string]float32),
+	}
+}
+
+// initGeoMaps initializes nil maps of GeoData decoded from disk
+func initGeoMaps(g *donations.GeoData) {
+	if g.StateAmt == nil {
+		g.StateAmt = make(map[string]float32)
+		g.StateTxs = make(map[string]float32)
+	}
+	if g.Zip3Amt == nil {
+		g.Zip3Amt = make(map[string]float32)
+		g.Zip3Txs = make(map[string]float32)
+	}
+	if g.Zip5Amt == nil {
+		g.Zip5Amt = make(map[string]float32)
+		g.Zip5Txs = make(map[string]float32)
+	}
+}
+
+// formatState returns "???" for unknown states
+func formatState(state string) string {
+	if state == "" {
+		return "???"
+	}
+	return state
+}
+
+// formatZip returns the 3-digit prefix and 5-digit zip code for
+// a 5 or 9 digit zip code; returns empty strings if invalid
+func formatZip(zip string) (string, string) {
+	if len(zip) < 5 {
+		return "", ""
+	}
+	for _, r := range zip[:5] {
+		if r < '0' || r > '9' {
+			return "", ""
+		}
+	}
+	return zip[:3], zip[:5]
+}
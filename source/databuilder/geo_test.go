@@ -0,0 +1,117 @@
+package databuilder
+
+import (
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+// geoCache returns a cache with a recipient committee (C001) and its donors.
+func geoCache() map[string]map[string]interface{} {
+	return map[string]map[string]interface{}{
+		"individuals": {
+			"i001": &donations.Individual{ID: "i001", State: "NY", Zip: "10001"},
+			"i002": &donations.Individual{ID: "i002", State: "CA", Zip: "90210"},
+		},
+		"cmte_tx_data": {"C001": &donations.CmteTxData{CmteID: "C001"}},
+		"candidates":   {},
+		"geo_data":     {"C001": &donations.GeoData{ID: "C001", Bucket: "cmte_tx_data"}}, // decoded from disk w/ nil maps
+	}
+}
+
+func TestGeoUpdate(t *testing.T) {
+	txs := []*donations.Contribution{
+		// row geography is used rather than the donor's
+		{CmteID: "C001", OtherID: "i001", TxType: "15", TxPGI: "P2020", TxAmt: 100, City: "NEWARK", State: "NJ", Zip: "071021234"},
+		{CmteID: "C001", OtherID: "i001", TxType: "15", TxPGI: "G2020", TxAmt: 50, City: "NEW YORK", State: "NY", Zip: "10001"},
+		{CmteID: "C001", OtherID: "i002", TxType: "15", TxPGI: "P2020", TxAmt: 25, State: "CA", Zip: "9021"},
+		{CmteID: "C001", OtherID: "i002", TxType: "15", TxPGI: "P2020", TxAmt: 500, State: "CA", Zip: "90210", MemoCode: "X"},
+		{CmteID: "C001", OtherID: "i002", TxType: "22Y", TxPGI: "P2020", TxAmt: 40, State: "CA", Zip: "90210"},
+		{CmteID: "C001", OtherID: "i002", TxType: "15", TxPGI: "P2020", TxAmt: 10, Zip: "ABCDE"},
+	}
+	cache := geoCache()
+	if err := TransactionUpdate("2020", txs, cache); err != nil {
+		t.Fatal(err)
+	}
+	g := cache["geo_data"]["C001"].(*donations.GeoData)
+
+	var tests = []struct {
+		field     string
+		got, want float32
+	}{
+		{"StateAmt[NJ]", g.StateAmt["NJ"], 100},
+		{"StateAmt[NY]", g.StateAmt["NY"], 50},
+		{"StateAmt[CA]", g.StateAmt["CA"], 25},
+		{"StateTxs[CA]", g.StateTxs["CA"], 1},
+		{"StateAmt[???]", g.StateAmt["???"], 10},
+		{"Zip3Amt[071]", g.Zip3Amt["071"], 100},
+		{"Zip5Amt[07102]", g.Zip5Amt["07102"], 100},
+		{"Zip5Amt[10001]", g.Zip5Amt["10001"], 50},
+		{"Zip5Amt[90210]", g.Zip5Amt["90210"], 0},
+		{"len(Zip5Amt)", float32(len(g.Zip5Amt)), 2},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("geoUpdate() %s = %v; want %v", test.field, test.got, test.want)
+		}
+	}
+
+	// caches without a geo_data bucket are not updated
+	cache = geoCache()
+	delete(cache, "geo_data")
+	if err := TransactionUpdate("2020", txs[:1], cache); err != nil {
+		t.Fatal(err)
+	}
+	if cache["geo_data"] != nil {
+		t.Errorf("geoUpdate() created geo_data bucket: %v", cache["geo_data"])
+	}
+}
+
+func TestUpdateGeoTotals(t *testing.T) {
+	var tests = []struct {
+		geo       []*donations.GeoData
+		state     string
+		want, txs float32
+	}{
+		{[]*donations.GeoData{{StateAmt: map[string]float32{"NY": 100}, StateTxs: map[string]float32{"NY": 2}}}, "NY", 100, 2},
+		{[]*donations.GeoData{
+			{StateAmt: map[string]float32{"NY": 100, "CA": 10}, StateTxs: map[string]float32{"NY": 2, "CA": 1}},
+			{StateAmt: map[string]float32{"NY": 50}, StateTxs: map[string]float32{"NY": 1}},
+		}, "NY", 150, 3},
+		{[]*donations.GeoData{{}}, "NY", 0, 0},
+	}
+	for _, test := range tests {
+		totals := make(map[string]*donations.GeoTotal)
+		for _, g := range test.geo {
+			UpdateGeoTotals("2020", g, totals)
+		}
+		gt := totals[test.state]
+		if gt == nil {
+			if test.want != 0 {
+				t.Errorf("UpdateGeoTotals() %s = nil; want %v", test.state, test.want)
+			}
+			continue
+		}
+		if gt.Total != test.want || gt.Txs != test.txs || gt.ID != "2020-"+test.state {
+			t.Errorf("UpdateGeoTotals() %s = %+v; want Total: %v, Txs: %v", test.state, gt, test.want, test.txs)
+		}
+	}
+}
+
+func TestFormatZip(t *testing.T) {
+	var tests = []struct {
+		zip, zip3, zip5 string
+	}{
+		{"10001", "100", "10001"},
+		{"100011234", "100", "10001"},
+		{"1000", "", ""},
+		{"1000A", "", ""},
+		{"", "", ""},
+	}
+	for _, test := range tests {
+		zip3, zip5 := formatZip(test.zip)
+		if zip3 != test.zip3 || zip5 != test.zip5 {
+			t.Errorf("formatZip(%q) = %q, %q; want %q, %q", test.zip, zip3, zip5, test.zip3, test.zip5)
+		}
+	}
+}
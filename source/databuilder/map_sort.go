@@ -16,9 +16,15 @@ import (
 // Entries is a list of entries to be sorted.
 type Entries []*donations.Entry
 
-func (s Entries) Len() int           { return len(s) }
-func (s Entries) Less(i, j int) bool { return s[i].Total > s[j].Total }
-func (s Entries) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s Entries) Len() int { return len(s) }
+func (s Entries) Less(i, j int) bool {
+	// break ties by ID for deterministic ordering
+	if s[i].Total == s[j].Total {
+		return s[i].ID < s[j].ID
+	}
+	return s[i].Total > s[j].Total
+}
+func (s Entries) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 
 // PopLeast pops the smalles value from the list of least values.
 func (s *Entries) popLeast() *donations.Entry {
@@ -0,0 +1,150 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for validating the ranking definitions
+// read from the rankings config file and deriving the $ value an object
+// is ranked by for a given ranking. Network centrality rankings are scored
+// from the year's money-flow graph rather than an object field.
+package databuilder
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/elections/source/donations"
+)
+
+// default metric ranked for each bucket/category when RankingDef.Metric is not set;
+// candidates are ranked by the funds raised/spent by all linked committees (CandRollup)
+var defaultMetrics = map[string]map[string]string{
+	"individuals":  {"rec": "TotalInAmt", "donor": "TotalOutAmt"},
+	"cmte_tx_data": {"rec": "TotalIncomingAmt", "donor": "TransfersAmt", "exp": "ExpendituresAmt"},
+	"candidates":   {"rec": "TotalIncomingAmt", "donor": "TransfersAmt", "exp": "ExpendituresAmt", "self": "SelfFundedAmt"},
+}
+
+// network centrality categories ranked for each bucket
+var centralityCats = map[string]map[string]bool{
+	"individuals":  {"pagerank": true, "betweenness": true, "instrength": true, "outstrength": true},
+	"cmte_tx_data": {"pagerank": true, "betweenness": true, "instrength": true, "outstrength": true},
+}
+
+// object type ranked for each bucket
+var rankedObjs = map[string]interface{}{
+	"individuals":  donations.Individual{},
+	"cmte_tx_data": donations.CmteTxData{},
+	"candidates":   donations.CandRollup{},
+}
+
+var rankingParties = map[string]bool{"ALL": true, "REP": true, "DEM": true, "IND": true, "OTH": true, "UNK": true}
+
+// ValidateRankingDefs checks each ranking and yearly total definition for a valid bucket,
+// category, party, state, size limit, and metric, and checks for duplicate definitions.
+func ValidateRankingDefs(defs *donations.RankingDefs) error {
+	seen := make(map[string]bool)
+	for _, d := range defs.Rankings {
+		id := d.ID("")
+		switch {
+		case rankedObjs[d.Bucket] == nil:
+			return fmt.Errorf("ValidateRankingDefs failed: %s: invalid bucket", id)
+		case seen[id]:
+			return fmt.Errorf("ValidateRankingDefs failed: %s: duplicate ranking", id)
+		case IsCentralityCategory(d.Bucket, d.Category):
+			if err := validateCentralityDef(d); err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("ValidateRankingDefs failed: %v", err)
+			}
+			seen[id] = true
+			continue
+		case defaultMetrics[d.Bucket][d.Category] == "":
+			return fmt.Errorf("ValidateRankingDefs failed: %s: invalid category", id)
+		case !rankingParties[d.Party]:
+			return fmt.Errorf("ValidateRankingDefs failed: %s: invalid party", id)
+		case d.State != "" && len(d.State) != 2:
+			return fmt.Errorf("ValidateRankingDefs failed: %s: invalid state", id)
+		case d.SizeLimit < 1:
+			return fmt.Errorf("ValidateRankingDefs failed: %s: invalid size limit", id)
+		case d.Bucket == "individuals" && d.Party != "ALL" && d.Metric != "":
+			return fmt.Errorf("ValidateRankingDefs failed: %s: party specific individual rankings do not support metrics", id)
+		}
+		if _, err := RankingMetric(rankedObjs[d.Bucket], d.Bucket, d.Category, d.Metric); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("ValidateRankingDefs failed: %v", err)
+		}
+		seen[id] = true
+	}
+
+	for _, d := range defs.YearlyTotals {
+		id := d.Category + "-" + d.Party
+		switch {
+		case defaultMetrics["cmte_tx_data"][d.Category] == "":
+			return fmt.Errorf("ValidateRankingDefs failed: yearly total %s: invalid category", id)
+		case !rankingParties[d.Party]:
+			return fmt.Errorf("ValidateRankingDefs failed: yearly total %s: invalid party", id)
+		case seen[id]:
+			return fmt.Errorf("ValidateRankingDefs failed: yearly total %s: duplicate total", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// IsCentralityCategory returns true if the bucket/category
+// is ranked by a network centrality measure.
+func IsCentralityCategory(bucket, cat string) bool {
+	return centralityCats[bucket][cat]
+}
+
+// validateCentralityDef checks the centrality ranking definition's filters.
+// Centrality rankings do not support metrics; individual centrality rankings
+// do not support party or state filters.
+func validateCentralityDef(d donations.RankingDef) error {
+	id := d.ID("")
+	switch {
+	case d.Metric != "":
+		return fmt.Errorf("validateCentralityDef failed: %s: centrality rankings do not support metrics", id)
+	case d.Bucket == "individuals" && (d.Party != "ALL" || d.State != ""):
+		return fmt.Errorf("validateCentralityDef failed: %s: individual centrality rankings do not support filters", id)
+	case !rankingParties[d.Party]:
+		return fmt.Errorf("validateCentralityDef failed: %s: invalid party", id)
+	case d.State != "" && len(d.State) != 2:
+		return fmt.Errorf("validateCentralityDef failed: %s: invalid state", id)
+	case d.SizeLimit < 1:
+		return fmt.Errorf("validateCentralityDef failed: %s: invalid size limit", id)
+	}
+	return nil
+}
+
+// RankingMetric returns the $ value of the object's metric field.
+// The bucket/category's default metric is used if metric is empty.
+func RankingMetric(obj interface{}, bucket, cat, metric string) (float32, error) {
+	if metric == "" {
+		metric = defaultMetrics[bucket][cat]
+	}
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return 0, fmt.Errorf("RankingMetric failed: invalid object type")
+	}
+	f := v.FieldByName(metric)
+	if !f.IsValid() || f.Kind() != reflect.Float32 {
+		return 0, fmt.Errorf("RankingMetric failed: %s: invalid metric for bucket %s", metric, bucket)
+	}
+	return float32(f.Float()), nil
+}
+
+// PartyTotal returns the $ value of the individual's contributions to ("donor")
+// or receipts from ("rec") committees of the given party.
+// cmteParties maps each committee ID to the committee's party.
+func PartyTotal(indv *donations.Individual, cat, pty string, cmteParties map[string]string) float32 {
+	m := indv.RecipientsAmt
+	if cat == "rec" {
+		m = indv.SendersAmt
+	}
+	total := float32(0.0)
+	for id, amt := range m {
+		if cmteParties[id] == pty {
+			total += amt
+		}
+	}
+	return total
+}
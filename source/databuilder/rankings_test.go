@@ -0,0 +1,80 @@
+package databuilder
+
+import (
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+func TestValidateRankingDefs(t *testing.T) {
+	if err := ValidateRankingDefs(donations.DefaultRankingDefs()); err != nil {
+		t.Fatalf("ValidateRankingDefs(defaults) = %v", err)
+	}
+
+	var tests = []struct {
+		def   donations.RankingDef
+		valid bool
+	}{
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "DEM", State: "CA", SizeLimit: 10}, true},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "ALL", Metric: "ContributionsInAmt", SizeLimit: 10}, true},
+		{donations.RankingDef{Bucket: "candidates", Category: "rec", Party: "ALL", Metric: "CandLoansAmt", SizeLimit: 10}, true},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "pagerank", Party: "REP", SizeLimit: 10}, true},
+		{donations.RankingDef{Bucket: "committees", Category: "rec", Party: "ALL", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "individuals", Category: "exp", Party: "ALL", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "GRN", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "ALL", State: "CAL", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "ALL"}, false},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "ALL", Metric: "CmteID", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "ALL", Metric: "Missing", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "individuals", Category: "donor", Party: "DEM", Metric: "TotalInAmt", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "individuals", Category: "pagerank", Party: "DEM", SizeLimit: 10}, false},
+		{donations.RankingDef{Bucket: "cmte_tx_data", Category: "pagerank", Party: "ALL", Metric: "TotalIncomingAmt", SizeLimit: 10}, false},
+	}
+	for _, test := range tests {
+		defs := &donations.RankingDefs{Rankings: []donations.RankingDef{test.def}}
+		if err := ValidateRankingDefs(defs); (err == nil) != test.valid {
+			t.Errorf("ValidateRankingDefs(%+v) = %v; want valid: %v", test.def, err, test.valid)
+		}
+	}
+
+	// duplicates
+	d := donations.RankingDef{Bucket: "cmte_tx_data", Category: "rec", Party: "ALL", SizeLimit: 10}
+	if err := ValidateRankingDefs(&donations.RankingDefs{Rankings: []donations.RankingDef{d, d}}); err == nil {
+		t.Errorf("ValidateRankingDefs(duplicate rankings) = nil; want error")
+	}
+	totals := []donations.TotalDef{{Category: "rec", Party: "ALL"}, {Category: "rec", Party: "ALL"}}
+	if err := ValidateRankingDefs(&donations.RankingDefs{YearlyTotals: totals}); err == nil {
+		t.Errorf("ValidateRankingDefs(duplicate totals) = nil; want error")
+	}
+	if err := ValidateRankingDefs(&donations.RankingDefs{YearlyTotals: []donations.TotalDef{{Category: "self", Party: "ALL"}}}); err == nil {
+		t.Errorf("ValidateRankingDefs(invalid total category) = nil; want error")
+	}
+}
+
+func TestRankingMetric(t *testing.T) {
+	cmte := &donations.CmteTxData{TotalIncomingAmt: 100, ContributionsInAmt: 80, TransfersAmt: 20}
+	rollup := &donations.CandRollup{SelfFundedAmt: 5000}
+	var tests = []struct {
+		obj         interface{}
+		bucket, cat string
+		metric      string
+		want        float32
+		valid       bool
+	}{
+		{cmte, "cmte_tx_data", "rec", "", 100, true},
+		{cmte, "cmte_tx_data", "donor", "", 20, true},
+		{cmte, "cmte_tx_data", "rec", "ContributionsInAmt", 80, true},
+		{*cmte, "cmte_tx_data", "rec", "", 100, true},
+		{rollup, "candidates", "self", "", 5000, true},
+		{cmte, "cmte_tx_data", "rec", "CmteID", 0, false},
+		{cmte, "cmte_tx_data", "rec", "Missing", 0, false},
+		{cmte, "cmte_tx_data", "pagerank", "", 0, false},
+		{"C00000001", "cmte_tx_data", "rec", "", 0, false},
+	}
+	for _, test := range tests {
+		got, err := RankingMetric(test.obj, test.bucket, test.cat, test.metric)
+		if (err == nil) != test.valid || got != test.want {
+			t.Errorf("RankingMetric(%s, %s, %q) = %v, %v; want %v (valid: %v)", test.bucket, test.cat, test.metric, got, err, test.want, test.valid)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for aggregating the CmteTxData
+// of each committee linked to a candidate into a single
+// candidate level dataset.
+package databuilder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elections/source/donations"
+)
+
+// CandidateRollup aggregates the CmteTxData objects for each of the candidate's
+// linked committees (PCC + OtherAffiliates) into one CandRollup object.
+// Transfers between the linked committees are excluded from the rollup's
+// incoming/outgoing totals, election totals and Top X maps and recorded as
+// internal transfers.
+func CandidateRollup(cand *donations.Candidate, cmtes []interface{}) (*donations.CandRollup, error) {
+	rollup := &donations.CandRollup{
+		CandID:                    cand.ID,
+		Party:                     cand.Party,
+		TopIndvContributorsAmt:    make(map[string]float32),
+		TopIndvContributorsTxs:    make(map[string]float32),
+		TopCmteOrgContributorsAmt: make(map[string]float32),
+		TopCmteOrgContributorsTxs: make(map[string]float32),
+		TransferRecsAmt:           make(map[string]float32),
+		TransferRecsTxs:           make(map[string]float32),
+		TopExpRecipientsAmt:       make(map[string]float32),
+		TopExpRecipientsTxs:       make(map[string]float32),
+		ElectionsInAmt:            make(map[string]float32),
+		ElectionsInTxs:            make(map[string]float32),
+		SizeBinsAmt:               make(map[string]float32),
+		SizeBinsTxs:               make(map[string]float32),
+		SizeCounts:                make(map[string]float32),
+		ConduitsInAmt:             make(map[string]float32),
+		ConduitsInTxs:             make(map[string]float32),
+		TxKindsAmt:                make(map[string]float32),
+		TxKindsTxs:                make(map[string]float32),
+	}
+
+	// committees included in rollup
+	linked := make(map[string]bool)
+	for _, obj := range cmtes {
+		cmte, ok := obj.(*donations.CmteTxData)
+		if !ok {
+			return nil, fmt.Errorf("CandidateRollup failed: wrong interface type")
+		}
+		if linked[cmte.CmteID] {
+			continue
+		}
+		linked[cmte.CmteID] = true
+		rollup.Committees = append(rollup.Committees, cmte.CmteID)
+	}
+
+	seen := make(map[string]bool)
+	recvAmt, recvTxs := float32(0.0), float32(0.0)
+	for _, obj := range cmtes {
+		cmte := obj.(*donations.CmteTxData)
+		if seen[cmte.CmteID] {
+			continue
+		}
+		seen[cmte.CmteID] = true
+		rollupTotalsMerge(rollup, cmte, linked)
+		rollupMapMerge(rollup, cmte, linked)
+		amt, txs := internalReceipts(cmte, linked)
+		recvAmt += amt
+		recvTxs += txs
+	}
+	rollupTotalsUpdate(rollup, recvAmt, recvTxs)
+
+	// Filter Top 100 entries
+	if len(rollup.TopIndvContributorsAmt) > 100 {
+		rollup.TopIndvContributorsAmt, rollup.TopIndvContributorsTxs = sort100(rollup.TopIndvContributorsAmt, rollup.TopIndvContributorsTxs)
+	}
+	if len(rollup.TopCmteOrgContributorsAmt) > 100 {
+		rollup.TopCmteOrgContributorsAmt, rollup.TopCmteOrgContributorsTxs = sort100(rollup.TopCmteOrgContributorsAmt, rollup.TopCmteOrgContributorsTxs)
+	}
+	if len(rollup.TransferRecsAmt) > 100 {
+		rollup.TransferRecsAmt, rollup.TransferRecsTxs = sort100(rollup.TransferRecsAmt, rollup.TransferRecsTxs)
+	}
+	if len(rollup.TopExpRecipientsAmt) > 100 {
+		rollup.TopExpRecipientsAmt, rollup.TopExpRecipientsTxs = sort100(rollup.TopExpRecipientsAmt, rollup.TopExpRecipientsTxs)
+	}
+
+	return rollup, nil
+}
+
+// add committee totals to rollup; transfers to linked committees
+// are moved from the TransfersAmt/Txs totals to InternalTransfersAmt/Txs
+func rollupTotalsMerge(rollup *donations.CandRollup, cmte *donations.CmteTxData, linked map[string]bool) {
+	rollup.ContributionsInAmt += cmte.ContributionsInAmt
+	rollup.ContributionsInTxs += cmte.ContributionsInTxs
+	rollup.OtherReceiptsInAmt += cmte.OtherReceiptsInAmt
+	rollup.OtherReceiptsInTxs += cmte.OtherReceiptsInTxs
+	rollup.TransfersAmt += cmte.TransfersAmt
+	rollup.TransfersTxs += cmte.TransfersTxs
+	rollup.ExpendituresAmt += cmte.ExpendituresAmt
+	rollup.ExpendituresTxs += cmte.ExpendituresTxs
+
+	for id, amt := range cmte.TransferRecsAmt {
+		if !linked[id] || id == cmte.CmteID {
+			continue
+		}
+		rollup.InternalTransfersAmt += amt
+		rollup.InternalTransfersTxs += cmte.TransferRecsTxs[id]
+		rollup.TransfersAmt -= amt
+		rollup.TransfersTxs -= cmte.TransferRecsTxs[id]
+	}
+}
+
+// internalReceipts returns the $ value and # of contributions the committee
+// received from the other linked committees
+func internalReceipts(cmte *donations.CmteTxData, linked map[string]bool) (float32, float32) {
+	amt, txs := float32(0.0), float32(0.0)
+	for id, a := range cmte.TopCmteOrgContributorsAmt {
+		if !linked[id] || id == cmte.CmteID {
+			continue
+		}
+		amt += a
+		txs += cmte.TopCmteOrgContributorsTxs[id]
+	}
+	return amt, txs
+}
+
+// derive combined totals and averages once all committees are merged;
+// internal transfers are received as committee contributions (18G/18K)
+// and are removed from the contribution totals so each total only counts
+// money entering the candidate's committees once. Only transfers with a
+// matching receipt by a linked committee (recvAmt/Txs) are removed.
+func rollupTotalsUpdate(rollup *donations.CandRollup, recvAmt, recvTxs float32) {
+	rollup.ContributionsInAmt -= minFloat(rollup.InternalTransfersAmt, recvAmt, rollup.ContributionsInAmt)
+	rollup.ContributionsInTxs -= minFloat(rollup.InternalTransfersTxs, recvTxs, rollup.ContributionsInTxs)
+	rollup.AvgContributionIn = avg(rollup.ContributionsInAmt, rollup.ContributionsInTxs)
+	rollup.AvgOtherIn = avg(rollup.OtherReceiptsInAmt, rollup.OtherReceiptsInTxs)
+	rollup.TotalIncomingAmt = rollup.ContributionsInAmt + rollup.OtherReceiptsInAmt
+	rollup.TotalIncomingTxs = rollup.ContributionsInTxs + rollup.OtherReceiptsInTxs
+	rollup.AvgIncoming = avg(rollup.TotalIncomingAmt, rollup.TotalIncomingTxs)
+
+	rollup.AvgTransfer = avg(rollup.TransfersAmt, rollup.TransfersTxs)
+	rollup.AvgExpenditure = avg(rollup.ExpendituresAmt, rollup.ExpendituresTxs)
+	rollup.TotalOutgoingAmt = rollup.TransfersAmt + rollup.ExpendituresAmt
+	rollup.TotalOutgoingTxs = rollup.TransfersTxs + rollup.ExpendituresTxs
+	rollup.AvgOutgoing = avg(rollup.TotalOutgoingAmt, rollup.TotalOutgoingTxs)
+
+	rollup.NetBalance = rollup.TotalIncomingAmt - rollup.TotalOutgoingAmt
+}
+
+// merge committee Top X maps; linked committees are omitted
+// from the contributor and transfer recipient maps
+func rollupMapMerge(rollup *donations.CandRollup, cmte *donations.CmteTxData, linked map[string]bool) {
+	// Top Individual Contributors
+	rollup.TopIndvContributorsAmt = mapMerge(rollup.TopIndvContributorsAmt, cmte.TopIndvContributorsAmt)
+	rollup.TopIndvContributorsTxs = mapMerge(rollup.TopIndvContributorsTxs, cmte.TopIndvContributorsTxs)
+
+	// Top Committee/Organization Contributors
+	rollup.TopCmteOrgContributorsAmt = mapMergeExcl(rollup.TopCmteOrgContributorsAmt, cmte.TopCmteOrgContributorsAmt, linked)
+	rollup.TopCmteOrgContributorsTxs = mapMergeExcl(rollup.TopCmteOrgContributorsTxs, cmte.TopCmteOrgContributorsTxs, linked)
+
+	// Transfers Recipients
+	rollup.TransferRecsAmt = mapMergeExcl(rollup.TransferRecsAmt, cmte.TransferRecsAmt, linked)
+	rollup.TransferRecsTxs = mapMergeExcl(rollup.TransferRecsTxs, cmte.TransferRecsTxs, linked)
+
+	// Top Expenditure Recipients
+	rollup.TopExpRecipientsAmt = mapMerge(rollup.TopExpRecipientsAmt, cmte.TopExpRecipientsAmt)
+	rollup.TopExpRecipientsTxs = mapMerge(rollup.TopExpRecipientsTxs, cmte.TopExpRecipientsTxs)
+	rollup.ElectionsInAmt = mapMerge(rollup.ElectionsInAmt, cmte.ElectionsInAmt)
+	rollup.ElectionsInTxs = mapMerge(rollup.ElectionsInTxs, cmte.ElectionsInTxs)
+	rollupElectionsExcl(rollup, cmte, linked)
+	rollup.SizeBinsAmt = mapMerge(rollup.SizeBinsAmt, cmte.SizeBinsAmt)
+	rollup.SizeBinsTxs = mapMerge(rollup.SizeBinsTxs, cmte.SizeBinsTxs)
+	rollup.SizeCounts = mapMerge(rollup.SizeCounts, cmte.SizeCounts)
+	rollup.ConduitsInAmt = mapMerge(rollup.ConduitsInAmt, cmte.ConduitsInAmt)
+	rollup.ConduitsInTxs = mapMerge(rollup.ConduitsInTxs, cmte.ConduitsInTxs)
+	rollup.TxKindsAmt = mapMerge(rollup.TxKindsAmt, cmte.TxKindsAmt)
+	rollup.TxKindsTxs = mapMerge(rollup.TxKindsTxs, cmte.TxKindsTxs)
+}
+
+// rollupElectionsExcl removes the committee's receipts from linked committees
+// (joint fundraising/affiliate transfers) from the rollup's election totals;
+// the funds are counted by the election totals of the linked sender
+func rollupElectionsExcl(rollup *donations.CandRollup, cmte *donations.CmteTxData, linked map[string]bool) {
+	for k, amt := range cmte.ElectionsCmteInAmt {
+		i := strings.LastIndex(k, "-")
+		if i < 0 {
+			continue
+		}
+		sender, election := k[:i], k[i+1:]
+		if !linked[sender] || sender == cmte.CmteID {
+			continue
+		}
+		rollup.ElectionsInAmt[election] -= amt
+		rollup.ElectionsInTxs[election] -= cmte.ElectionsCmteInTxs[k]
+		if rollup.ElectionsInAmt[election] <= 0 {
+			delete(rollup.ElectionsInAmt, election)
+			delete(rollup.ElectionsInTxs, election)
+		}
+	}
+}
+
+// mapMergeExcl merges the source map into the merge map, skipping excluded keys
+func mapMergeExcl(merge, source map[string]float32, excl map[string]bool) map[string]float32 {
+	filtered := make(map[string]float32)
+	for k, v := range source {
+		if excl[k] {
+			continue
+		}
+		filtered[k] = v
+	}
+	return mapMerge(merge, filtered)
+}
+
+// minFloat returns the smallest of the given values or 0 if the smallest value is negative
+func minFloat(vals ...float32) float32 {
+	min := vals[0]
+	for _, v := range vals[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	if min < 0 {
+		return 0
+	}
+	return min
+}
+
+// avg returns the average transaction value or 0 if no transactions exist
+func avg(amt, txs float32) float32 {
+	if txs == 0 {
+		return 0
+	}
+	return amt / txs
+}
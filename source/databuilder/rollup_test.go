@@ -0,0 +1,206 @@
+package databuilder
+
+import (
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+// rollupCmtes returns a PCC (C001) transferring 200 to an affiliate (C002)
+// and 50 to an unlinked committee (C009).
+func rollupCmtes() []interface{} {
+	return []interface{}{
+		&donations.CmteTxData{
+			CmteID:                 "C001",
+			ContributionsInAmt:     1000,
+			ContributionsInTxs:     10,
+			OtherReceiptsInAmt:     100,
+			OtherReceiptsInTxs:     1,
+			TransfersAmt:           250,
+			TransfersTxs:           2,
+			ExpendituresAmt:        300,
+			ExpendituresTxs:        3,
+			TopIndvContributorsAmt: map[string]float32{"i001": 500},
+			TopIndvContributorsTxs: map[string]float32{"i001": 2},
+			TransferRecsAmt:        map[string]float32{"C002": 200, "C009": 50},
+			TransferRecsTxs:        map[string]float32{"C002": 1, "C009": 1},
+		},
+		&donations.CmteTxData{
+			CmteID:                    "C002",
+			ContributionsInAmt:        400,
+			ContributionsInTxs:        3,
+			ExpendituresAmt:           100,
+			ExpendituresTxs:           1,
+			TopIndvContributorsAmt:    map[string]float32{"i001": 100, "i002": 100},
+			TopIndvContributorsTxs:    map[string]float32{"i001": 1, "i002": 1},
+			TopCmteOrgContributorsAmt: map[string]float32{"C001": 200, "C008": 10},
+			TopCmteOrgContributorsTxs: map[string]float32{"C001": 1, "C008": 1},
+		},
+	}
+}
+
+func TestCandidateRollup(t *testing.T) {
+	cand := &donations.Candidate{ID: "H0XX00001", Party: "DEM", PCC: "C001", OtherAffiliates: []string{"C002"}}
+	cmtes := append(rollupCmtes(), rollupCmtes()[0]) // duplicate committee is merged once
+	rollup, err := CandidateRollup(cand, cmtes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		field     string
+		got, want float32
+	}{
+		{"InternalTransfersAmt", rollup.InternalTransfersAmt, 200},
+		{"InternalTransfersTxs", rollup.InternalTransfersTxs, 1},
+		{"ContributionsInAmt", rollup.ContributionsInAmt, 1200},
+		{"ContributionsInTxs", rollup.ContributionsInTxs, 12},
+		{"AvgContributionIn", rollup.AvgContributionIn, 100},
+		{"TotalIncomingAmt", rollup.TotalIncomingAmt, 1300},
+		{"TotalIncomingTxs", rollup.TotalIncomingTxs, 13},
+		{"TransfersAmt", rollup.TransfersAmt, 50},
+		{"TotalOutgoingAmt", rollup.TotalOutgoingAmt, 450},
+		{"NetBalance", rollup.NetBalance, 850},
+		{"TopIndvContributorsAmt[i001]", rollup.TopIndvContributorsAmt["i001"], 600},
+		{"TopCmteOrgContributorsAmt[C001]", rollup.TopCmteOrgContributorsAmt["C001"], 0},
+		{"TopCmteOrgContributorsAmt[C008]", rollup.TopCmteOrgContributorsAmt["C008"], 10},
+		{"TransferRecsAmt[C002]", rollup.TransferRecsAmt["C002"], 0},
+		{"TransferRecsAmt[C009]", rollup.TransferRecsAmt["C009"], 50},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("CandidateRollup() %s = %v; want %v", test.field, test.got, test.want)
+		}
+	}
+	if len(rollup.Committees) != 2 || rollup.CandID != cand.ID || rollup.Party != cand.Party {
+		t.Errorf("CandidateRollup() = %s, %s, %v; want %s, %s, [C001 C002]", rollup.CandID, rollup.Party, rollup.Committees, cand.ID, cand.Party)
+	}
+}
+
+func TestCandidateRollupInternalReceipts(t *testing.T) {
+	pcc := func() *donations.CmteTxData {
+		return &donations.CmteTxData{
+			CmteID:             "C001",
+			ContributionsInAmt: 1000,
+			ContributionsInTxs: 10,
+			TransferRecsAmt:    map[string]float32{"C002": 200},
+			TransferRecsTxs:    map[string]float32{"C002": 1},
+		}
+	}
+	var tests = []struct {
+		name     string
+		affil    *donations.CmteTxData
+		amt, txs float32
+	}{
+		{"receipt filed", &donations.CmteTxData{
+			CmteID: "C002", ContributionsInAmt: 400, ContributionsInTxs: 3,
+			TopCmteOrgContributorsAmt: map[string]float32{"C001": 200},
+			TopCmteOrgContributorsTxs: map[string]float32{"C001": 1},
+		}, 1200, 12},
+		{"partial receipt filed", &donations.CmteTxData{
+			CmteID: "C002", ContributionsInAmt: 400, ContributionsInTxs: 3,
+			TopCmteOrgContributorsAmt: map[string]float32{"C001": 50},
+			TopCmteOrgContributorsTxs: map[string]float32{"C001": 1},
+		}, 1350, 12},
+		{"receipt not filed", &donations.CmteTxData{CmteID: "C002"}, 1000, 10},
+		{"receipt exceeds contributions", &donations.CmteTxData{
+			CmteID: "C002", ContributionsInAmt: 0, ContributionsInTxs: 0,
+			TopCmteOrgContributorsAmt: map[string]float32{"C001": 5000},
+			TopCmteOrgContributorsTxs: map[string]float32{"C001": 20},
+		}, 800, 9},
+	}
+	cand := &donations.Candidate{ID: "H0XX00001", PCC: "C001", OtherAffiliates: []string{"C002"}}
+	for _, test := range tests {
+		rollup, err := CandidateRollup(cand, []interface{}{pcc(), test.affil})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rollup.ContributionsInAmt != test.amt || rollup.ContributionsInTxs != test.txs {
+			t.Errorf("%s: ContributionsIn = %v (%v txs); want %v (%v txs)", test.name,
+				rollup.ContributionsInAmt, rollup.ContributionsInTxs, test.amt, test.txs)
+		}
+	}
+}
+
+func TestCandidateRollupErrors(t *testing.T) {
+	cand := &donations.Candidate{ID: "H0XX00001"}
+	var tests = []struct {
+		cmtes   []interface{}
+		wantErr bool
+	}{
+		{[]interface{}{}, false},
+		{[]interface{}{&donations.Individual{ID: "i001"}}, true},
+		{[]interface{}{&donations.CmteTxData{CmteID: "C001"}, "C002"}, true},
+	}
+	for _, test := range tests {
+		_, err := CandidateRollup(cand, test.cmtes)
+		if (err != nil) != test.wantErr {
+			t.Errorf("CandidateRollup(%v) err = %v; want error: %v", test.cmtes, err, test.wantErr)
+		}
+	}
+}
+
+func TestCandidateRollupElections(t *testing.T) {
+	// JFC (C003) raises 500 for the primary and transfers 300 to the PCC (C001) as an 18G receipt
+	jfc := &donations.CmteTxData{
+		CmteID:          "C003",
+		ElectionsInAmt:  map[string]float32{"P2020": 500},
+		ElectionsInTxs:  map[string]float32{"P2020": 5},
+		TransferRecsAmt: map[string]float32{"C001": 300},
+		TransferRecsTxs: map[string]float32{"C001": 1},
+	}
+	pcc := &donations.CmteTxData{
+		CmteID:             "C001",
+		ElectionsInAmt:     map[string]float32{"P2020": 1300, "G2020": 200},
+		ElectionsInTxs:     map[string]float32{"P2020": 11, "G2020": 2},
+		ElectionsCmteInAmt: map[string]float32{"C003-P2020": 300, "C008-G2020": 100},
+		ElectionsCmteInTxs: map[string]float32{"C003-P2020": 1, "C008-G2020": 1},
+	}
+	cand := &donations.Candidate{ID: "H0XX00001", PCC: "C001"}
+
+	var tests = []struct {
+		name      string
+		cmtes     []interface{}
+		election  string
+		amt, txs  float32
+		wantFound bool
+	}{
+		{"linked JFC primary", []interface{}{pcc, jfc}, "P2020", 1500, 15, true},
+		{"linked JFC merged first", []interface{}{jfc, pcc}, "P2020", 1500, 15, true},
+		{"unlinked sender kept", []interface{}{pcc, jfc}, "G2020", 200, 2, true},
+		{"JFC not linked", []interface{}{pcc}, "P2020", 1300, 11, true},
+	}
+	for _, test := range tests {
+		rollup, err := CandidateRollup(cand, test.cmtes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		amt, ok := rollup.ElectionsInAmt[test.election]
+		if ok != test.wantFound || amt != test.amt || rollup.ElectionsInTxs[test.election] != test.txs {
+			t.Errorf("%s: ElectionsIn[%s] = %v (%v txs); want %v (%v txs)", test.name, test.election,
+				amt, rollup.ElectionsInTxs[test.election], test.amt, test.txs)
+		}
+	}
+}
+
+func TestElectionUpdateCmteSender(t *testing.T) {
+	filer := &donations.CmteTxData{CmteID: "C001"}
+	sender := &donations.CmteTxData{CmteID: "C003"}
+	var tests = []struct {
+		pgi      string
+		txAmt    float32
+		key      string
+		amt, txs float32
+	}{
+		{"P2020", 100, "C003-P2020", 100, 1},
+		{"P2020", 200, "C003-P2020", 300, 2},
+		{"G2020", 50, "C003-G2020", 50, 1},
+	}
+	for _, test := range tests {
+		electionUpdate("2020", &donations.Contribution{CmteID: "C001", TxPGI: test.pgi, TxAmt: test.txAmt}, filer, sender)
+		if filer.ElectionsCmteInAmt[test.key] != test.amt || filer.ElectionsCmteInTxs[test.key] != test.txs {
+			t.Errorf("electionUpdate() ElectionsCmteIn[%s] = %v (%v txs); want %v (%v txs)", test.key,
+				filer.ElectionsCmteInAmt[test.key], filer.ElectionsCmteInTxs[test.key], test.amt, test.txs)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for deriving the sector/industry breakdown
+// of funds received by committees and candidates from individual donors
+// and the sector-level totals and rankings.
+package databuilder
+
+import (
+	"github.com/elections/source/donations"
+)
+
+// UpdateSectorData adds the individual's contributions to each recipient
+// committee's SectorData by the individual's sector and industry.
+// SectorData objects are created for committees not yet in the sectors map.
+func UpdateSectorData(indv *donations.Individual, sectors map[string]*donations.SectorData) {
+	sector, industry := formatSector(indv.Sector), formatSector(indv.Industry)
+	for id, amt := range indv.RecipientsAmt {
+		sd := sectors[id]
+		if sd == nil {
+			sd = InitSectorData(id, "cmte_tx_data")
+			sectors[id] = sd
+		}
+		txs := indv.RecipientsTxs[id]
+		sd.SectorAmt[sector] += amt
+		sd.SectorTxs[sector] += txs
+		sd.IndustryAmt[industry] += amt
+		sd.IndustryTxs[industry] += txs
+	}
+}
+
+// UpdateSectorTotals adds the individual's outgoing funds to the year's SectorTotal
+// for the individual's sector and updates the sector's Top Donors.
+func UpdateSectorTotals(year string, indv *donations.Individual, totals map[string]*donations.SectorTotal) {
+	if indv.TotalOutAmt == 0 {
+		return
+	}
+	sector := formatSector(indv.Sector)
+	st := totals[sector]
+	if st == nil {
+		st = InitSectorTotal(year, sector)
+		totals[sector] = st
+	}
+	st.Total += indv.TotalOutAmt
+	st.Txs += indv.TotalOutTxs
+	st.IndustryAmt[formatSector(indv.Industry)] += indv.TotalOutAmt
+	st.TopDonors[indv.ID] = indv.TotalOutAmt
+	// each donor is added once; clip periodically to bound memory use
+	if len(st.TopDonors) > 1000 {
+		st.TopDonors = clipTop(st.TopDonors, 100)
+	}
+}
+
+// UpdateSectorRecipients adds the committee's or candidate's receipts from each
+// sector to the corresponding SectorTotal's Top Committees or Top Candidates.
+func UpdateSectorRecipients(sd *donations.SectorData, totals map[string]*donations.SectorTotal) {
+	for sector, amt := range sd.SectorAmt {
+		st := totals[sector]
+		if st == nil {
+			continue
+		}
+		if sd.Bucket == "candidates" {
+			st.TopCands[sd.ID] = amt
+			continue
+		}
+		st.TopCmtes[sd.ID] = amt
+	}
+}
+
+// ClipSectorTotal limits the SectorTotal's Top Committees, Top Candidates,
+// and Top Donors maps to the Top 100 entries by $ value.
+func ClipSectorTotal(st *donations.SectorTotal) {
+	st.TopCmtes = clipTop(st.TopCmtes, 100)
+	st.TopCands = clipTop(st.TopCands, 100)
+	st.TopDonors = clipTop(st.TopDonors, 100)
+}
+
+// MergeSectorData merges the SectorData of each of the given committees
+// into one SectorData object for the given ID and bucket.
+func MergeSectorData(ID, bucket string, set []*donations.SectorData) *donations.SectorData {
+	merged := InitSectorData(ID, bucket)
+	for _, sd := range set {
+		merged.SectorAmt = mapMerge(merged.SectorAmt, sd.SectorAmt)
+		merged.SectorTxs = mapMerge(merged.SectorTxs, sd.SectorTxs)
+		merged.IndustryAmt = mapMerge(merged.IndustryAmt, sd.IndustryAmt)
+		merged.IndustryTxs = mapMerge(merged.IndustryTxs, sd.IndustryTxs)
+	}
+	return merged
+}
+
+// InitSectorData initializes a SectorData object with empty maps.
+func InitSectorData(ID, bucket string) *donations.SectorData {
+	return &donations.SectorData{
+		ID:          ID,
+		Bucket:      bucket,
+		SectorAmt:   make(map[string]float32),
+		SectorTxs:   make(map[string]float32),
+		IndustryAmt: make(map[string]float32),
+		IndustryTxs: make(map[string]float32),
+	}
+}
+
+// InitSectorTotal initializes a SectorTotal object with empty maps.
+func InitSectorTotal(year, sector string) *donations.SectorTotal {
+	return &donations.SectorTotal{
+		ID:          year + "-" + sector,
+		Year:        year,
+		Sector:      sector,
+		IndustryAmt: make(map[string]float32),
+		TopCmtes:    make(map[string]float32),
+		TopCands:    make(map[string]float32),
+		TopDonors:   make(map[string]float32),
+	}
+}
+
+// formatSector returns "UNK" for unclassified donors
+func formatSector(code string) string {
+	if code == "" {
+		return "UNK"
+	}
+	return code
+}
+
+// clipTop returns the Top n entries of the map by value
+func clipTop(m map[string]float32, n int) map[string]float32 {
+	if len(m) <= n {
+		return m
+	}
+	clip := make(map[string]float32)
+	for _, e := range sortTopX(m)[:n] {
+		clip[e.ID] = e.Total
+	}
+	return clip
+}
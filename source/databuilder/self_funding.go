@@ -0,0 +1,67 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for deriving a candidate's self-funding
+// metrics and candidate loan history from the campaign financial data.
+package databuilder
+
+import (
+	"sort"
+
+	"github.com/elections/source/donations"
+)
+
+// SelfFundingUpdate derives the candidate's self-funding metrics for the given year
+// and the candidate's loan/repayment history across each election cycle up to the year.
+// fins maps each year to the candidate's CmpnFinancials for the year; years the
+// candidate did not file for are omitted.
+// SelfFundedShare is derived from the campaign's FEC summary totals
+// ((CandConts + CandLoans) / TotalReceipts) and clamped to [0, 1].
+func SelfFundingUpdate(rollup *donations.CandRollup, year string, fins map[string]*donations.CmpnFinancials) {
+	rollup.CandLoansByYear = make(map[string]float32)
+	rollup.CandRepaysByYear = make(map[string]float32)
+
+	// outstanding candidate loans carried over from each prior cycle
+	years := []string{}
+	for yr := range fins {
+		if yr <= year {
+			years = append(years, yr)
+		}
+	}
+	sort.Strings(years)
+	outstanding := float32(0.0)
+	for _, yr := range years {
+		fin := fins[yr]
+		if fin.CandLoans != 0 {
+			rollup.CandLoansByYear[yr] = fin.CandLoans
+		}
+		if fin.CandLoanRepay != 0 {
+			rollup.CandRepaysByYear[yr] = fin.CandLoanRepay
+		}
+		outstanding += fin.CandLoans - fin.CandLoanRepay
+		if outstanding < 0 {
+			outstanding = 0 // repayment of loans made prior to first cycle on file
+		}
+	}
+	rollup.CandLoansOutstanding = outstanding
+
+	fin := fins[year]
+	if fin == nil {
+		return
+	}
+	rollup.CandContsAmt = fin.CandConts
+	rollup.CandLoansAmt = fin.CandLoans
+	rollup.CandLoanRepayAmt = fin.CandLoanRepay
+	rollup.SelfFundedAmt = fin.CandConts + fin.CandLoans
+	rollup.SelfFundedShare = 0
+	if fin.TotalReceipts > 0 {
+		rollup.SelfFundedShare = rollup.SelfFundedAmt / fin.TotalReceipts
+	}
+	if rollup.SelfFundedShare < 0 {
+		rollup.SelfFundedShare = 0
+	}
+	if rollup.SelfFundedShare > 1 {
+		rollup.SelfFundedShare = 1
+	}
+}
@@ -0,0 +1,103 @@
+package databuilder
+
+import (
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+func TestSelfFundingUpdate(t *testing.T) {
+	var tests = []struct {
+		name        string
+		year        string
+		fins        map[string]*donations.CmpnFinancials
+		outstanding float32
+		selfAmt     float32
+		share       float32
+	}{
+		{
+			"loans repaid over cycles",
+			"2020",
+			map[string]*donations.CmpnFinancials{
+				"2016": {CandLoans: 1000},
+				"2018": {CandLoanRepay: 400},
+				"2020": {CandConts: 100, CandLoans: 200, CandLoanRepay: 300, TotalReceipts: 1000},
+			},
+			500, 300, 0.3,
+		},
+		{
+			"repayment of loans made before first cycle on file",
+			"2020",
+			map[string]*donations.CmpnFinancials{
+				"2016": {CandLoanRepay: 500},
+				"2018": {CandLoans: 200},
+				"2020": {CandLoans: 100, TotalReceipts: 1000},
+			},
+			300, 100, 0.1,
+		},
+		{
+			"over-repayment does not carry into later cycles",
+			"2020",
+			map[string]*donations.CmpnFinancials{
+				"2016": {CandLoans: 100},
+				"2018": {CandLoanRepay: 600},
+				"2020": {CandLoans: 50, CandLoanRepay: 25, TotalReceipts: 1000},
+			},
+			25, 50, 0.05,
+		},
+		{
+			"later cycles are excluded",
+			"2018",
+			map[string]*donations.CmpnFinancials{
+				"2016": {CandLoans: 100},
+				"2018": {CandConts: 50, TotalReceipts: 1000},
+				"2020": {CandLoanRepay: 100},
+			},
+			100, 50, 0.05,
+		},
+		{
+			"share clamped to 1",
+			"2020",
+			map[string]*donations.CmpnFinancials{
+				"2020": {CandConts: 500, CandLoans: 1000, TotalReceipts: 1200},
+			},
+			1000, 1500, 1,
+		},
+		{
+			"negative contributions",
+			"2020",
+			map[string]*donations.CmpnFinancials{
+				"2020": {CandConts: -100, TotalReceipts: 1000},
+			},
+			0, -100, 0,
+		},
+		{
+			"no filing for year",
+			"2020",
+			map[string]*donations.CmpnFinancials{
+				"2018": {CandLoans: 100},
+			},
+			100, 0, 0,
+		},
+	}
+	for _, test := range tests {
+		rollup := &donations.CandRollup{TotalIncomingAmt: 50} // itemized totals are not used
+		SelfFundingUpdate(rollup, test.year, test.fins)
+		if rollup.CandLoansOutstanding != test.outstanding {
+			t.Errorf("%s: CandLoansOutstanding = %v; want %v", test.name, rollup.CandLoansOutstanding, test.outstanding)
+		}
+		if rollup.SelfFundedAmt != test.selfAmt {
+			t.Errorf("%s: SelfFundedAmt = %v; want %v", test.name, rollup.SelfFundedAmt, test.selfAmt)
+		}
+		if rollup.SelfFundedShare != test.share {
+			t.Errorf("%s: SelfFundedShare = %v; want %v", test.name, rollup.SelfFundedShare, test.share)
+		}
+	}
+
+	// no receipts
+	rollup := &donations.CandRollup{TotalIncomingAmt: 1000}
+	SelfFundingUpdate(rollup, "2020", map[string]*donations.CmpnFinancials{"2020": {CandLoans: 100}})
+	if rollup.SelfFundedShare != 0 {
+		t.Errorf("SelfFundedShare with no total receipts = %v; want 0", rollup.SelfFundedShare)
+	}
+}
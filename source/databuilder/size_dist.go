@@ -0,0 +1,143 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for deriving the size distribution of
+// individual contributions received by committees: a histogram of
+// contribution sizes and the median/percentile contribution amounts.
+package databuilder
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/elections/source/donations"
+)
+
+// contribution size histogram bins
+const (
+	SizeSmall  = "UNDER_200"   // < $200 (unitemized proxy)
+	SizeMedium = "200_999"     // $200 - $999
+	SizeLarge  = "1000_LIMIT"  // $1000 - individual contribution limit
+	SizeMax    = "MAX"         // max-out contributions (limit up to 2x limit for primary + general)
+	SizeAbove  = "ABOVE_LIMIT" // > max-out amount
+)
+
+// SizeBins lists the contribution size histogram bins in ascending order.
+var SizeBins = []string{SizeSmall, SizeMedium, SizeLarge, SizeMax, SizeAbove}
+
+// SizePctls lists the percentiles returned by SizePercentiles.
+var SizePctls = []string{"P10", "P25", "P50", "P75", "P90"}
+
+var pctlRanks = map[string]float32{"P10": 0.10, "P25": 0.25, "P50": 0.50, "P75": 0.75, "P90": 0.90}
+
+// per-election limit on individual contributions to candidate committees by election cycle
+var contributionLimits = map[string]float32{
+	"2004": 2000, "2006": 2100, "2008": 2300, "2010": 2400,
+	"2012": 2500, "2014": 2600, "2016": 2700, "2018": 2700,
+	"2020": 2800, "2022": 2900, "2024": 3300,
+}
+
+// calendar year limit on individual contributions to PACs; not indexed to inflation
+const pacContributionLimit = 5000
+
+// ContributionLimit returns the individual contribution limit for the given year's
+// election cycle. Candidate committees have a per-election limit (the pre-2004 limit
+// of $1000 is returned for earlier years); other committees have the PAC limit.
+func ContributionLimit(year string, candCmte bool) float32 {
+	if !candCmte {
+		return pacContributionLimit
+	}
+	cycle := Cycle(year)
+	if limit, ok := contributionLimits[cycle]; ok {
+		return limit
+	}
+	if cycle > "2024" {
+		return contributionLimits["2024"]
+	}
+	return 1000
+}
+
+// MaxOutAmt returns the largest single contribution within the individual contribution limit.
+// Candidate committees may receive a combined primary + general contribution of 2x the limit.
+func MaxOutAmt(limit float32, candCmte bool) float32 {
+	if candCmte {
+		return 2 * limit
+	}
+	return limit
+}
+
+// SizeBin returns the histogram bin for the contribution amount given the individual contribution
+// limit and max-out amount; contributions from the limit to the max-out amount are max-out contributions.
+func SizeBin(amt, limit, maxOut float32) string {
+	switch {
+	case amt < 200:
+		return SizeSmall
+	case amt < 1000:
+		return SizeMedium
+	case amt > maxOut:
+		return SizeAbove
+	case amt >= limit:
+		return SizeMax
+	default:
+		return SizeLarge
+	}
+}
+
+// SizePercentiles derives the percentile contribution amounts (P50 = median)
+// from the # of contributions received of each whole-dollar amount.
+func SizePercentiles(counts map[string]float32) map[string]float32 {
+	pctls := make(map[string]float32)
+	type amtCount struct {
+		amt   float32
+		count float32
+	}
+	amts := []amtCount{}
+	total := float32(0.0)
+	for k, n := range counts {
+		amt, err := strconv.ParseFloat(k, 32)
+		if err != nil || n <= 0 {
+			continue
+		}
+		amts = append(amts, amtCount{float32(amt), n})
+		total += n
+	}
+	if total == 0 {
+		return pctls
+	}
+	sort.Slice(amts, func(i, j int) bool { return amts[i].amt < amts[j].amt })
+
+	for _, p := range SizePctls {
+		rank := pctlRanks[p] * total
+		cum := float32(0.0)
+		for _, a := range amts {
+			cum += a.count
+			if cum >= rank {
+				pctls[p] = a.amt
+				break
+			}
+		}
+	}
+	return pctls
+}
+
+// sizeUpdate adds an individual's contribution to the filing committee's size histogram
+// and to the # of contributions received of the contribution's whole-dollar amount.
+func sizeUpdate(year string, cont *donations.Contribution, filerData *donations.CmteTxData, sender interface{}) {
+	if _, ok := sender.(*donations.Individual); !ok || cont.TxAmt <= 0 {
+		return
+	}
+	if filerData.SizeBinsAmt == nil {
+		filerData.SizeBinsAmt = make(map[string]float32)
+		filerData.SizeBinsTxs = make(map[string]float32)
+	}
+	if filerData.SizeCounts == nil {
+		filerData.SizeCounts = make(map[string]float32)
+	}
+	cand := filerData.CandID != "" // candidate committee
+	limit := ContributionLimit(year, cand)
+	bin := SizeBin(cont.TxAmt, limit, MaxOutAmt(limit, cand))
+	filerData.SizeBinsAmt[bin] += cont.TxAmt
+	filerData.SizeBinsTxs[bin]++
+	filerData.SizeCounts[strconv.Itoa(int(cont.TxAmt+0.5))]++
+}
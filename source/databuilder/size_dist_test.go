@@ -0,0 +1,86 @@
+package databuilder
+
+import "testing"
+
+func TestContributionLimit(t *testing.T) {
+	var tests = []struct {
+		year     string
+		candCmte bool
+		want     float32
+	}{
+		{"2020", true, 2800},
+		{"2019", true, 2800}, // odd year -> following cycle
+		{"2004", true, 2000},
+		{"2001", true, 1000}, // pre-2004
+		{"2030", true, 3300}, // latest known limit
+		{"2020", false, 5000},
+		{"2001", false, 5000},
+	}
+	for _, test := range tests {
+		if got := ContributionLimit(test.year, test.candCmte); got != test.want {
+			t.Errorf("ContributionLimit(%q, %v) = %v; want %v", test.year, test.candCmte, got, test.want)
+		}
+	}
+}
+
+func TestSizeBin(t *testing.T) {
+	var tests = []struct {
+		amt      float32
+		candCmte bool
+		want     string
+	}{
+		{25, true, SizeSmall},
+		{199.99, true, SizeSmall},
+		{200, true, SizeMedium},
+		{999, true, SizeMedium},
+		{1000, true, SizeLarge},
+		{2799, true, SizeLarge},
+		{2800, true, SizeMax},
+		{4000, true, SizeMax}, // combined primary + general
+		{5600, true, SizeMax},
+		{5601, true, SizeAbove},
+		{2800, false, SizeLarge},
+		{5000, false, SizeMax},
+		{5600, false, SizeAbove},
+	}
+	for _, test := range tests {
+		limit := ContributionLimit("2020", test.candCmte)
+		if got := SizeBin(test.amt, limit, MaxOutAmt(limit, test.candCmte)); got != test.want {
+			t.Errorf("SizeBin(%v) cand: %v = %s; want %s", test.amt, test.candCmte, got, test.want)
+		}
+	}
+}
+
+func TestSizePercentiles(t *testing.T) {
+	var tests = []struct {
+		counts map[string]float32
+		want   map[string]float32
+	}{
+		{
+			map[string]float32{"25": 5, "100": 3, "2800": 2},
+			map[string]float32{"P10": 25, "P25": 25, "P50": 25, "P75": 100, "P90": 2800},
+		},
+		{
+			map[string]float32{"50": 1},
+			map[string]float32{"P10": 50, "P25": 50, "P50": 50, "P75": 50, "P90": 50},
+		},
+		{
+			map[string]float32{"10": 1, "20": 1, "30": 1, "40": 1, "bad": 4, "50": 0},
+			map[string]float32{"P10": 10, "P25": 10, "P50": 20, "P75": 30, "P90": 40},
+		},
+		{map[string]float32{}, map[string]float32{}},
+	}
+	for _, test := range tests {
+		got := SizePercentiles(test.counts)
+		if len(got) != len(test.want) {
+			t.Errorf("SizePercentiles(%v) = %v; want %v", test.counts, got, test.want)
+			continue
+		}
+		for p, v := range test.want {
+			if got[p] != v {
+				t.Errorf("SizePercentiles(%v) = %v; want %v", test.counts, got, test.want)
+				break
+			}
+		}
+	}
+}
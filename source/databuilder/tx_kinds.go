@@ -0,0 +1,85 @@
+// Package databuilder conatins operations for updating datasets in memory.
+// This package is primarily used by the admin service to create the
+// primary datasets from the raw input, followed by the secondary
+// datasets.
+// This file contains operations for classifying refunds, redesignations,
+// reattributions, negative amounts, and memo entries as distinct
+// transaction kinds so that they are excluded from the contribution
+// totals and averages.
+package databuilder
+
+import (
+	"strings"
+
+	"github.com/elections/source/donations"
+)
+
+// transaction kinds
+const (
+	KindContribution  = "contribution"  // genuine contribution/receipt/disbursement
+	KindRefundIn      = "refund_in"     // refund/rebate received by the filing committee
+	KindRefundOut     = "refund_out"    // contribution refunded by the filing committee
+	KindRedesignation = "redesignation" // contribution redesignated to another election
+	KindReattribution = "reattribution" // contribution reattributed to another contributor
+	KindNegative      = "negative"      // other negative amount adjustments
+	KindMemo          = "memo"          // memo entries (MemoCode "X")
+)
+
+// TxKinds lists the transaction kinds recorded in the TxKindsAmt/Txs totals.
+var TxKinds = []string{KindRefundIn, KindRefundOut, KindRedesignation, KindReattribution, KindNegative, KindMemo}
+
+var refundInCodes = map[string]bool{"17R": true, "17U": true, "17Y": true, "17Z": true}
+
+var refundOutCodes = map[string]bool{
+	"20Y": true, "21Y": true, "22R": true, "22Y": true, "22Z": true,
+	"23Y": true, "28L": true, "40Y": true, "41Y": true, "42Y": true,
+}
+
+// TxKind returns the transaction kind of the contribution.
+// Redesignations and reattributions are identified by the memo text
+// and take precedence over the memo code.
+func TxKind(cont *donations.Contribution) string {
+	text := strings.ToUpper(cont.MemoText)
+	switch {
+	case strings.Contains(text, "REDESIGNAT"):
+		return KindRedesignation
+	case strings.Contains(text, "REATTRIBUT"):
+		return KindReattribution
+	case cont.MemoCode == "X":
+		return KindMemo
+	case refundInCodes[cont.TxType]:
+		return KindRefundIn
+	case refundOutCodes[cont.TxType]:
+		return KindRefundOut
+	case cont.TxAmt < 0:
+		return KindNegative
+	default:
+		return KindContribution
+	}
+}
+
+// adjustment returns true if the transaction kind is recorded in the
+// TxKindsAmt/Txs totals only and excluded from all other totals and maps.
+func adjustment(kind string) bool {
+	return kind == KindRedesignation || kind == KindReattribution || kind == KindNegative
+}
+
+// refund returns true if the transaction kind is a refund. Refunds are recorded in the
+// TxKindsAmt/Txs totals only and excluded from the contribution, receipt, transfer and
+// expenditure totals and averages and from the sender/recipient maps.
+func refund(kind string) bool {
+	return kind == KindRefundIn || kind == KindRefundOut
+}
+
+// txKindUpdate adds the transaction to the filing committee's totals for the transaction's kind.
+func txKindUpdate(cont *donations.Contribution, filerData *donations.CmteTxData, kind string) {
+	if kind == KindContribution {
+		return
+	}
+	if filerData.TxKindsAmt == nil {
+		filerData.TxKindsAmt = make(map[string]float32)
+		filerData.TxKindsTxs = make(map[string]float32)
+	}
+	filerData.TxKindsAmt[kind] += cont.TxAmt
+	filerData.TxKindsTxs[kind]++
+}
@@ -0,0 +1,77 @@
+package databuilder
+
+import (
+	"testing"
+
+	"github.com/elections/source/donations"
+)
+
+func TestTxKind(t *testing.T) {
+	var tests = []struct {
+		txType, memoCode, memoText string
+		amt                        float32
+		want                       string
+	}{
+		{"15", "", "", 100, KindContribution},
+		{"15E", "", "EARMARKED", 100, KindContribution},
+		{"24K", "", "", 5000, KindContribution},
+		{"17R", "", "", 50, KindRefundIn},
+		{"20Y", "", "", 250, KindRefundOut},
+		{"22Y", "", "", 250, KindRefundOut},
+		{"15", "", "", -100, KindNegative},
+		{"15", "X", "", 100, KindMemo},
+		{"22Y", "X", "", 250, KindMemo},
+		{"15", "", "Redesignation to General", -2800, KindRedesignation},
+		{"15", "X", "REATTRIBUTION TO SPOUSE", 1000, KindReattribution},
+	}
+	for _, test := range tests {
+		cont := &donations.Contribution{TxType: test.txType, MemoCode: test.memoCode, MemoText: test.memoText, TxAmt: test.amt}
+		if got := TxKind(cont); got != test.want {
+			t.Errorf("TxKind(%s, %q, %q, %v) = %s; want %s", test.txType, test.memoCode, test.memoText, test.amt, got, test.want)
+		}
+	}
+}
+
+func TestRefundTotals(t *testing.T) {
+	cache := earmarkCache()
+	txs := []*donations.Contribution{
+		{CmteID: "C002", TxType: "15", TxPGI: "P2020", TxAmt: 1000, OtherID: "i001"},
+		{CmteID: "C002", TxType: "15", TxPGI: "P2020", TxAmt: 500, OtherID: "i001"},
+		{CmteID: "C002", TxType: "22Y", TxAmt: 500, OtherID: "i001"},
+		{CmteID: "C002", TxType: "17", TxAmt: 100, OtherID: "i001"},
+		{CmteID: "C002", TxType: "17R", TxAmt: 40, OtherID: "i001"},
+	}
+	if err := TransactionUpdate("2020", txs, cache); err != nil {
+		t.Fatal(err)
+	}
+	cmte := cache["cmte_tx_data"]["C002"].(*donations.CmteTxData)
+	if cmte.ContributionsInAmt != 1500 || cmte.AvgContributionIn != 750 {
+		t.Errorf("contributions = %v, avg %v; want 1500, avg 750", cmte.ContributionsInAmt, cmte.AvgContributionIn)
+	}
+	if cmte.OtherReceiptsInAmt != 100 || cmte.TotalIncomingAmt != 1600 {
+		t.Errorf("other receipts, total incoming = %v, %v; want 100, 1600", cmte.OtherReceiptsInAmt, cmte.TotalIncomingAmt)
+	}
+	if cmte.ExpendituresAmt != 0 || cmte.TransfersAmt != 0 || cmte.AvgOutgoing != 0 {
+		t.Errorf("expenditures, transfers, avg outgoing = %v, %v, %v; want 0", cmte.ExpendituresAmt, cmte.TransfersAmt, cmte.AvgOutgoing)
+	}
+	if cmte.TxKindsAmt[KindRefundOut] != 500 || cmte.TxKindsAmt[KindRefundIn] != 40 {
+		t.Errorf("TxKindsAmt = %v; want refund_out: 500, refund_in: 40", cmte.TxKindsAmt)
+	}
+
+	// refunds are left out of the sender/recipient maps
+	indv := cache["individuals"]["i001"].(*donations.Individual)
+	var tests = []struct {
+		field     string
+		got, want float32
+	}{
+		{"TopExpRecipientsAmt[i001]", cmte.TopExpRecipientsAmt["i001"], 0},
+		{"TopIndvContributorsAmt[i001]", cmte.TopIndvContributorsAmt["i001"], 1600},
+		{"Individual.RecipientsAmt[C002]", indv.RecipientsAmt["C002"], 1600},
+		{"Individual.SendersAmt[C002]", indv.SendersAmt["C002"], 0},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("refund maps: %s = %v; want %v", test.field, test.got, test.want)
+		}
+	}
+}
@@ -12,27 +12,29 @@ import (
 // Contribution represents a contribution, expense, or other transaction
 // from a contribution/transactions bulk input file.
 type Contribution struct {
-	CmteID     string // filing committee
-	AmndtInd   string // ammendment indicator
-	ReportType string
-	TxPGI      string // transaction primary-general indicator
-	ImgNum     string // image number
-	TxType     string
-	EntityType string
-	Name       string
-	City       string
-	State      string
-	Zip        string
-	Employer   string
-	Occupation string
-	TxDate     time.Time
-	TxAmt      float32 // transaction amount
-	OtherID    string  // Cmte/Cand/Org/Indv ID for recipient/sender
-	TxID       string
-	FileNum    int
-	MemoCode   string
-	MemoText   string
-	SubID      int // FEC record number, unique row ID
+	CmteID         string // filing committee
+	AmndtInd       string // ammendment indicator
+	ReportType     string
+	TxPGI          string // transaction primary-general indicator
+	ImgNum         string // image number
+	TxType         string
+	EntityType     string
+	Name           string
+	City           string
+	State          string
+	Zip            string
+	Employer       string
+	Occupation     string
+	TxDate         time.Time
+	TxAmt          float32 // transaction amount
+	OtherID        string  // Cmte/Cand/Org/Indv ID for recipient/sender
+	TxID           string
+	FileNum        int
+	MemoCode       string
+	MemoText       string
+	SubID          int    // FEC record number, unique row ID
+	ConduitID      string // earmarked contributions: intermediary committee (conduit)
+	EarmarkRecipID string // earmarked contributions: ultimate recipient committee
 }
 
 // Disbursement represents a disbursement transaction
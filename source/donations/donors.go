@@ -18,6 +18,8 @@ type Individual struct {
 	Zip           string
 	Occupation    string
 	Employer      string
+	Sector        string             // sector code derived from Employer/Occupation
+	Industry      string             // industry code derived from Employer/Occupation
 	Transactions  []string           // List of all incoming/outgoing transactions
 	TotalOutAmt   float32            // Total $ Vale of Outgoing Transactions
 	TotalOutTxs   float32            // Total # of Contributions/Loans To/etc
@@ -30,6 +32,8 @@ type Individual struct {
 	RecipientsTxs map[string]float32 // $ Value contributed to each committee
 	SendersTxs    map[string]float32 // # of Txs from each committee
 	SendersAmt    map[string]float32 // $ Value returned from each committee
+	ElectionsAmt  map[string]float32 // $ Value contributed to each committee for each election (CmteID-Election); Top entries only
+	EarmarkedAmt  map[string]float32 // $ Value of earmarked contributions through each conduit to each recipient (ConduitID-CmteID)
 }
 
 // Committee represents a federal politcal committee
@@ -91,6 +95,23 @@ type CmteTxData struct {
 	TopExpRecipientsAmt            map[string]float32 // Top expenditure recipients by $ value
 	TopExpRecipientsTxs            map[string]float32 // # of transactions for each top recipient by $ value
 	TopExpThreshold                []interface{}      // Minimum values to be in Top x Recipients
+	ElectionsInAmt                 map[string]float32 // $ value of contributions received for each election ("G2020")
+	ElectionsInTxs                 map[string]float32 // # of contributions received for each election
+	ElectionsCmteInAmt             map[string]float32 // $ value of contributions received from each committee for each election (CmteID-Election)
+	ElectionsCmteInTxs             map[string]float32 // # of contributions received from each committee for each election
+	SizeBinsAmt                    map[string]float32 // $ value of individual contributions received in each size bin ("UNDER_200")
+	SizeBinsTxs                    map[string]float32 // # of individual contributions received in each size bin
+	SizeCounts                     map[string]float32 // # of individual contributions received of each whole-dollar amount ("250")
+	ConduitsInAmt                  map[string]float32 // $ value of earmarked contributions received through each conduit committee
+	ConduitsInTxs                  map[string]float32 // # of earmarked contributions received through each conduit committee
+	EarmarkedInAmt                 float32            // $ value of earmarked contributions received as conduit
+	EarmarkedInTxs                 float32            // # of earmarked contributions received as conduit
+	EarmarkedOutAmt                float32            // $ value of earmarked contributions forwarded as conduit
+	EarmarkedOutTxs                float32            // # of earmarked contributions forwarded as conduit
+	EarmarkRecipientsAmt           map[string]float32 // $ value of earmarked contributions forwarded to each recipient committee
+	EarmarkRecipientsTxs           map[string]float32 // # of earmarked contributions forwarded to each recipient committee
+	TxKindsAmt                     map[string]float32 // $ value of refunds, adjustments & memo entries by transaction kind ("refund_out")
+	TxKindsTxs                     map[string]float32 // # of refunds, adjustments & memo entries by transaction kind
 }
 
 // CmteFinancials represents the financial data of a political action committee.
@@ -19,6 +19,8 @@ type TopOverallData struct {
 	Bucket    string // "cmte_tx_data"
 	Category  string // "rec"
 	Party     string // "ALL"
+	State     string // "TX"; "" for all states
+	Metric    string // "ContributionsInAmt"; "" for category default
 	Amts      map[string]float32
 	Threshold []*Entry
 	SizeLimit int
@@ -34,176 +36,259 @@ type YearlyTotal struct {
 	Total    float32 // total sum
 }
 
+// CandRollup aggregates the CmteTxData of every committee linked to a candidate
+// (principal campaign committee and other authorized/affiliated committees)
+// for a given year. Transfers between the linked committees are recorded
+// in InternalTransfersAmt/Txs and excluded from the incoming and outgoing
+// totals so that funds moved between a candidate's committees are counted once.
+type CandRollup struct {
+	CandID                    string             // ID of candidate
+	Party                     string             // Candidate's political party
+	Committees                []string           // ID's of committees included in rollup
+	ContributionsInAmt        float32            // $ value of incoming contributions
+	ContributionsInTxs        float32            // # of incoming contributions
+	AvgContributionIn         float32            // Average $ value of incoming contributions
+	OtherReceiptsInAmt        float32            // $ value of loans from/refunds from/other incoming transactions
+	OtherReceiptsInTxs        float32            // # of loans from/refunds from/other incoming transactions
+	AvgOtherIn                float32            // Average $ value of other incoming receipts
+	InternalTransfersAmt      float32            // $ value of transfers between linked committees
+	InternalTransfersTxs      float32            // # of transfers between linked committees
+	TotalIncomingAmt          float32            // Total $ value of incoming transactions (less internal transfers)
+	TotalIncomingTxs          float32            // Total # of incoming transactions (less internal transfers)
+	AvgIncoming               float32            // Average $ value of incoming transactions
+	TransfersAmt              float32            // $ value of transfers to committees not included in rollup
+	TransfersTxs              float32            // # of transfers to committees not included in rollup
+	AvgTransfer               float32            // Average value of transfers
+	ExpendituresAmt           float32            // $ value of expenditure transactions
+	ExpendituresTxs           float32            // # of expenditure transactions
+	AvgExpenditure            float32            // Average value of expenditures
+	TotalOutgoingAmt          float32            // Total outgoing $ Value (TransfersAmt + ExpendituresAmt)
+	TotalOutgoingTxs          float32            // Total # of outgoing transactions (TransfersTxs + ExpendituresTxs)
+	AvgOutgoing               float32            // Average outgoing transaction
+	NetBalance                float32            // NetBalance = TotalIncomingAmt - TotalOutgoingAmt
+	TopIndvContributorsAmt    map[string]float32 // Top Individuals by $ value contributed
+	TopIndvContributorsTxs    map[string]float32 // # of transactions for each top contributor by $ value
+	TopCmteOrgContributorsAmt map[string]float32 // Top Committee and Organization contributors by $ value contributed
+	TopCmteOrgContributorsTxs map[string]float32 // # of transactions for each top contributor by $ value
+	TransferRecsAmt           map[string]float32 // total $ value of transactions to each recipient committee
+	TransferRecsTxs           map[string]float32 // # of transactions for each recipient committee
+	TopExpRecipientsAmt       map[string]float32 // Top expenditure recipients by $ value
+	TopExpRecipientsTxs       map[string]float32 // # of transactions for each top recipient by $ value
+	ElectionsInAmt            map[string]float32 // $ value of contributions received for each election ("G2020")
+	ElectionsInTxs            map[string]float32 // # of contributions received for each election
+	SizeBinsAmt               map[string]float32 // $ value of individual contributions received in each size bin ("UNDER_200")
+	SizeBinsTxs               map[string]float32 // # of individual contributions received in each size bin
+	SizeCounts                map[string]float32 // # of individual contributions received of each whole-dollar amount ("250")
+	ConduitsInAmt             map[string]float32 // $ value of earmarked contributions received through each conduit committee
+	ConduitsInTxs             map[string]float32 // # of earmarked contributions received through each conduit committee
+	TxKindsAmt                map[string]float32 // $ value of refunds, adjustments & memo entries by transaction kind ("refund_out")
+	TxKindsTxs                map[string]float32 // # of refunds, adjustments & memo entries by transaction kind
+	CandContsAmt              float32            // $ value of contributions from the candidate
+	CandLoansAmt              float32            // $ value of loans from the candidate
+	CandLoanRepayAmt          float32            // $ value of loan repayments to the candidate
+	SelfFundedAmt             float32            // $ value of candidate contributions and loans
+	SelfFundedShare           float32            // share of total receipts funded by the candidate (SelfFundedAmt / CmpnFinancials.TotalReceipts)
+	CandLoansOutstanding      float32            // $ value of candidate loans outstanding across each cycle up to the year
+	CandLoansByYear           map[string]float32 // $ value of loans from the candidate in each cycle
+	CandRepaysByYear          map[string]float32 // $ value of loan repayments to the candidate in each cycle
+}
+
+// GeoData contains the breakdown of funds received from individual donors
+// by the donor's state, 3-digit zip code prefix, and 5-digit zip code
+// for a committee or candidate for a given year.
+type GeoData struct {
+	ID       string             // committee/candidate ID
+	Bucket   string             // "cmte_tx_data" / "candidates"
+	StateAmt map[string]float32 // $ value received from each state
+	StateTxs map[string]float32 // # of transactions from each state
+	Zip3Amt  map[string]float32 // $ value received from each 3-digit zip code prefix
+	Zip3Txs  map[string]float32 // # of transactions from each 3-digit zip code prefix
+	Zip5Amt  map[string]float32 // $ value received from each 5-digit zip code (Top 1000)
+	Zip5Txs  map[string]float32 // # of transactions from each 5-digit zip code (Top 1000)
+}
+
+// GeoTotal contains the total sum of funds contributed by
+// individual donors from a given state for a given year.
+type GeoTotal struct {
+	ID    string  // year-state
+	Year  string  // "2018"
+	State string  // "CA"
+	Total float32 // total sum
+	Txs   float32 // total # of transactions
+}
+
+// SectorData contains the breakdown of funds received from individual donors
+// by the donor's sector and industry classification for a committee or
+// candidate for a given year.
+type SectorData struct {
+	ID          string             // committee/candidate ID
+	Bucket      string             // "cmte_tx_data" / "candidates"
+	SectorAmt   map[string]float32 // $ value received from each sector
+	SectorTxs   map[string]float32 // # of transactions from each sector
+	IndustryAmt map[string]float32 // $ value received from each industry
+	IndustryTxs map[string]float32 // # of transactions from each industry
+}
+
+// SectorTotal contains the total sum of funds contributed by individual
+// donors classified in a given sector for a given year and the top
+// committees, candidates, and donors in the sector by $ value.
+type SectorTotal struct {
+	ID          string             // year-sector
+	Year        string             // "2018"
+	Sector      string             // "FIN"
+	Total       float32            // total sum
+	Txs         float32            // total # of transactions
+	IndustryAmt map[string]float32 // $ value contributed by each industry in the sector
+	TopCmtes    map[string]float32 // Top 100 recipient committees by $ value
+	TopCands    map[string]float32 // Top 100 recipient candidates by $ value
+	TopDonors   map[string]float32 // Top 100 individual donors by $ value
+}
+
+// Adjacency contains the incoming and outgoing money-flow edges of an individual,
+// committee, or candidate for a given year. Graph queries read Adjacency objects
+// in place of the full Individual/CmteTxData/Candidate objects.
+type Adjacency struct {
+	ID          string    // individual/committee/candidate ID
+	Name        string    // object name
+	Type        string    // "indv" / "pac" / "pcc" / "cand"
+	Party       string    // committee/candidate party
+	State       string    // object state
+	TotalInAmt  float32   // total $ value received
+	TotalOutAmt float32   // total $ value sent
+	Out         []AdjEdge // outgoing edges
+	In          []AdjEdge // incoming edges
+}
+
+// AdjEdge represents a weighted edge in an Adjacency list.
+type AdjEdge struct {
+	ID   string  // adjacent object ID
+	Type string  // edge type ("indvOUT" / "cmteOUT" / "cmteIN" / ...)
+	Amt  float32 // $ value
+	Txs  float32 // # of transactions
+}
+
 // Entry represents a key/value pair from a Top X map and is used to sort and update the map.
 type Entry struct {
 	ID    string
 	Total float32
 }
 
-// InitSecondaryDataObjs initializes a set of TopOverall and YearlyTotal objects for the given year.
-func InitSecondaryDataObjs(year string) ([]interface{}, []interface{}) {
-	ods := initTopOverallDataObjs(year)
-	yts := initYearlyTotalObjs(year)
-	return ods, yts
+// RankingDef defines a TopOverallData ranking created by the secondary dataset builder.
+// Party and State filter the objects included in the ranking; Metric names the
+// object's $ value field ranked by and defaults to the Category's total if empty.
+type RankingDef struct {
+	Bucket    string // "individuals" / "cmte_tx_data" / "candidates"
+	Category  string // "rec" / "donor" / "exp" / "self" (candidates only) / centrality ("pagerank", etc.)
+	Party     string // "ALL" / "REP" / "DEM" / "IND" / "OTH" / "UNK"
+	State     string // 2 letter state code; "" for all states
+	Metric    string // ex: "ContributionsInAmt"; "" for category default
+	SizeLimit int    // max # of entries
 }
 
-// initTopOverallDataObjs creates a TopOverallData object for each
-// category and returns the objects in a list.
-// Formats object ID's as year-bucket-category-party.
-func initTopOverallDataObjs(year string) []interface{} {
-	limit := 500
-	od := []interface{}{}
-	// indv & disb_rec
-	indv := initTopOverallObj(year, "individuals", "donor", "ALL", 100000)
-	od = append(od, indv)
-	indvRec := initTopOverallObj(year, "individuals", "rec", "ALL", 100000)
-	od = append(od, indvRec)
-
-	// cmte_recs
-	cmteRecAll := initTopOverallObj(year, "cmte_tx_data", "rec", "ALL", limit)
-	od = append(od, cmteRecAll)
-	cmteRecR := initTopOverallObj(year, "cmte_tx_data", "rec", "REP", limit)
-	od = append(od, cmteRecR)
-	cmteRecD := initTopOverallObj(year, "cmte_tx_data", "rec", "DEM", limit)
-	od = append(od, cmteRecD)
-	cmteRecNa := initTopOverallObj(year, "cmte_tx_data", "rec", "IND", limit)
-	od = append(od, cmteRecNa)
-	cmteRecOth := initTopOverallObj(year, "cmte_tx_data", "rec", "OTH", limit)
-	od = append(od, cmteRecOth)
-	cmteRecUnk := initTopOverallObj(year, "cmte_tx_data", "rec", "UNK", limit)
-	od = append(od, cmteRecUnk)
-
-	// cmte_donors
-	cmteAll := initTopOverallObj(year, "cmte_tx_data", "donor", "ALL", limit)
-	od = append(od, cmteAll)
-	cmteR := initTopOverallObj(year, "cmte_tx_data", "donor", "REP", limit)
-	od = append(od, cmteR)
-	cmteD := initTopOverallObj(year, "cmte_tx_data", "donor", "DEM", limit)
-	od = append(od, cmteD)
-	cmteNa := initTopOverallObj(year, "cmte_tx_data", "donor", "IND", limit)
-	od = append(od, cmteNa)
-	cmteOth := initTopOverallObj(year, "cmte_tx_data", "donor", "OTH", limit)
-	od = append(od, cmteOth)
-	cmteUnk := initTopOverallObj(year, "cmte_tx_data", "donor", "UNK", limit)
-	od = append(od, cmteUnk)
-
-	// cmte_exp
-	cmteExpAll := initTopOverallObj(year, "cmte_tx_data", "exp", "ALL", limit)
-	od = append(od, cmteExpAll)
-	cmteExpR := initTopOverallObj(year, "cmte_tx_data", "exp", "REP", limit)
-	od = append(od, cmteExpR)
-	cmteExpD := initTopOverallObj(year, "cmte_tx_data", "exp", "DEM", limit)
-	od = append(od, cmteExpD)
-	cmteExpNa := initTopOverallObj(year, "cmte_tx_data", "exp", "IND", limit)
-	od = append(od, cmteExpNa)
-	cmteExpOth := initTopOverallObj(year, "cmte_tx_data", "exp", "OTH", limit)
-	od = append(od, cmteExpOth)
-	cmteExpUnk := initTopOverallObj(year, "cmte_tx_data", "exp", "UNK", limit)
-	od = append(od, cmteExpUnk)
-
-	// cand
-	candAll := initTopOverallObj(year, "candidates", "rec", "ALL", limit)
-	od = append(od, candAll)
-	candR := initTopOverallObj(year, "candidates", "rec", "REP", limit)
-	od = append(od, candR)
-	candD := initTopOverallObj(year, "candidates", "rec", "DEM", limit)
-	od = append(od, candD)
-	candNa := initTopOverallObj(year, "candidates", "rec", "IND", limit)
-	od = append(od, candNa)
-	candOth := initTopOverallObj(year, "candidates", "rec", "OTH", limit)
-	od = append(od, candOth)
-	candUnk := initTopOverallObj(year, "candidates", "rec", "UNK", limit)
-	od = append(od, candUnk)
-
-	// cand_donor
-	candDonorAll := initTopOverallObj(year, "candidates", "donor", "ALL", limit)
-	od = append(od, candDonorAll)
-	candDonorR := initTopOverallObj(year, "candidates", "donor", "REP", limit)
-	od = append(od, candDonorR)
-	candDonorD := initTopOverallObj(year, "candidates", "donor", "DEM", limit)
-	od = append(od, candDonorD)
-	candDonorNa := initTopOverallObj(year, "candidates", "donor", "IND", limit)
-	od = append(od, candDonorNa)
-	candDonorOth := initTopOverallObj(year, "candidates", "donor", "OTH", limit)
-	od = append(od, candDonorOth)
-	candDonorUnk := initTopOverallObj(year, "candidates", "donor", "UNK", limit)
-	od = append(od, candDonorUnk)
-
-	// cand_exp
-	candExpAll := initTopOverallObj(year, "candidates", "exp", "ALL", limit)
-	od = append(od, candExpAll)
-	candExpR := initTopOverallObj(year, "candidates", "exp", "REP", limit)
-	od = append(od, candExpR)
-	candExpD := initTopOverallObj(year, "candidates", "exp", "DEM", limit)
-	od = append(od, candExpD)
-	candExpNa := initTopOverallObj(year, "candidates", "exp", "IND", limit)
-	od = append(od, candExpNa)
-	candExpOth := initTopOverallObj(year, "candidates", "exp", "OTH", limit)
-	od = append(od, candExpOth)
-	candExpUnk := initTopOverallObj(year, "candidates", "exp", "UNK", limit)
-	od = append(od, candExpUnk)
+// TotalDef defines a YearlyTotal created by the secondary dataset builder.
+type TotalDef struct {
+	Category string // "rec" / "donor" / "exp"
+	Party    string // "ALL" / "REP" / "DEM" / "IND" / "OTH" / "UNK"
+}
 
-	return od
+// RankingDefs contains the definitions of every TopOverallData
+// ranking and YearlyTotal created for each year.
+type RankingDefs struct {
+	Rankings     []RankingDef
+	YearlyTotals []TotalDef
+}
+
+// ID returns the ID of the ranking for the given year.
+// Formats ID as year-bucket-category-party; the state and metric
+// are appended if defined (year-bucket-category-party-state-metric).
+func (d RankingDef) ID(year string) string {
+	id := year + "-" + d.Bucket + "-" + d.Category + "-" + d.Party
+	if d.State != "" {
+		id += "-" + d.State
+	}
+	if d.Metric != "" {
+		id += "-" + d.Metric
+	}
+	return id
+}
+
+// IDs returns the IDs of every ranking defined for the given year.
+func (defs *RankingDefs) IDs(year string) []string {
+	ids := []string{}
+	for _, d := range defs.Rankings {
+		ids = append(ids, d.ID(year))
+	}
+	return ids
 }
 
-// initYearlyTotalObjs initializes the YearlyTotal objects for the given year.
-func initYearlyTotalObjs(year string) []interface{} {
+// DefaultRankingDefs returns the default ranking definitions: the Top 100000 individuals
+// by funds sent/received, the Top 500 committees and candidates for each
+// category and party, the Top 500 self-funded candidates for each party,
+// the Top 500 committees by each network centrality measure, the Top 500
+// individuals by PageRank and betweenness, and the yearly totals for each
+// category and party.
+func DefaultRankingDefs() *RankingDefs {
+	limit := 500
+	cats := []string{"rec", "donor", "exp"}
+	ptys := []string{"ALL", "REP", "DEM", "IND", "OTH", "UNK"}
+	defs := &RankingDefs{
+		Rankings: []RankingDef{
+			{Bucket: "individuals", Category: "donor", Party: "ALL", SizeLimit: 100000},
+			{Bucket: "individuals", Category: "rec", Party: "ALL", SizeLimit: 100000},
+		},
+	}
+	for _, bucket := range []string{"cmte_tx_data", "candidates"} {
+		for _, cat := range cats {
+			for _, pty := range ptys {
+				defs.Rankings = append(defs.Rankings, RankingDef{Bucket: bucket, Category: cat, Party: pty, SizeLimit: limit})
+			}
+		}
+	}
+	for _, pty := range ptys {
+		defs.Rankings = append(defs.Rankings, RankingDef{Bucket: "candidates", Category: "self", Party: pty, SizeLimit: limit})
+	}
+	for _, cat := range []string{"pagerank", "betweenness", "instrength", "outstrength"} {
+		defs.Rankings = append(defs.Rankings, RankingDef{Bucket: "cmte_tx_data", Category: cat, Party: "ALL", SizeLimit: limit})
+	}
+	for _, cat := range []string{"pagerank", "betweenness"} {
+		defs.Rankings = append(defs.Rankings, RankingDef{Bucket: "individuals", Category: cat, Party: "ALL", SizeLimit: limit})
+	}
+	for _, cat := range cats {
+		for _, pty := range ptys {
+			defs.YearlyTotals = append(defs.YearlyTotals, TotalDef{Category: cat, Party: pty})
+		}
+	}
+	return defs
+}
+
+// InitSecondaryDataObjs initializes the TopOverall and YearlyTotal objects
+// defined by the given RankingDefs for the given year.
+func InitSecondaryDataObjs(year string, defs *RankingDefs) ([]interface{}, []interface{}) {
+	ods := []interface{}{}
+	for _, d := range defs.Rankings {
+		ods = append(ods, initTopOverallObj(year, d))
+	}
 	yts := []interface{}{}
+	for _, d := range defs.YearlyTotals {
+		yts = append(yts, initYrTotalObj(year, d.Category, d.Party))
+	}
+	return ods, yts
+}
 
-	recAll := initYrTotalObj(year, "rec", "ALL")
-	yts = append(yts, recAll)
-	recR := initYrTotalObj(year, "rec", "REP")
-	yts = append(yts, recR)
-	recD := initYrTotalObj(year, "rec", "DEM")
-	yts = append(yts, recD)
-	recNa := initYrTotalObj(year, "rec", "IND")
-	yts = append(yts, recNa)
-	recOth := initYrTotalObj(year, "rec", "OTH")
-	yts = append(yts, recOth)
-	recUnk := initYrTotalObj(year, "rec", "UNK")
-	yts = append(yts, recUnk)
-
-	donorAll := initYrTotalObj(year, "donor", "ALL")
-	yts = append(yts, donorAll)
-	donorR := initYrTotalObj(year, "donor", "REP")
-	yts = append(yts, donorR)
-	donorD := initYrTotalObj(year, "donor", "DEM")
-	yts = append(yts, donorD)
-	donorNa := initYrTotalObj(year, "donor", "IND")
-	yts = append(yts, donorNa)
-	donorOth := initYrTotalObj(year, "donor", "OTH")
-	yts = append(yts, donorOth)
-	donorUnk := initYrTotalObj(year, "donor", "UNK")
-	yts = append(yts, donorUnk)
-
-	expAll := initYrTotalObj(year, "exp", "ALL")
-	yts = append(yts, expAll)
-	expR := initYrTotalObj(year, "exp", "REP")
-	yts = append(yts, expR)
-	expD := initYrTotalObj(year, "exp", "DEM")
-	yts = append(yts, expD)
-	expNa := initYrTotalObj(year, "exp", "IND")
-	yts = append(yts, expNa)
-	expOth := initYrTotalObj(year, "exp", "OTH")
-	yts = append(yts, expOth)
-	expUnk := initYrTotalObj(year, "exp", "UNK")
-	yts = append(yts, expUnk)
-
-	return yts
-}
-
-func initTopOverallObj(year, bucket, cat, pty string, limit int) *TopOverallData {
-	id := year + "-" + bucket + "-" + cat + "-" + pty
+func initTopOverallObj(year string, def RankingDef) *TopOverallData {
+	id := def.ID(year)
 	fmt.Println("created Top Overall: ", id)
 	od := &TopOverallData{
 		ID:        id,
 		Year:      year,
-		Bucket:    bucket,
-		Category:  cat,
-		Party:     pty,
+		Bucket:    def.Bucket,
+		Category:  def.Category,
+		Party:     def.Party,
+		State:     def.State,
+		Metric:    def.Metric,
 		Amts:      make(map[string]float32),
 		Threshold: nil,
-		SizeLimit: limit,
+		SizeLimit: def.SizeLimit,
 	}
 	return od
 }
@@ -387,25 +387,27 @@ func createSearchData(year string, objs []interface{}) lookupPairs {
 	return lookup
 }
 
+// full state names by postal code
+var stateNames = map[string]string{
+	"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas",
+	"CA": "California", "CO": "Colorado", "CT": "Connecticut", "DE": "Delaware",
+	"FL": "Florida", "GA": "Georgia", "HI": "Hawaii", "ID": "Idaho",
+	"IL": "Illinois", "IN": "Indiana", "IA": "Iowa", "KS": "Kansas", "KY": "Kentucky",
+	"LA": "Louisiana", "ME": "Maine", "MD": "Maryland", "MA": "Massachusetts",
+	"MI": "Michigan", "MN": "Minnesota", "MS": "Mississippi", "MO": "Missouri",
+	"MT": "Montana", "NE": "Nebraska", "NV": "Nevada", "NH": "New Hampshire",
+	"NJ": "New Jersey", "NM": "New Mexico", "NY": "New York", "NC": "North Carolina",
+	"ND": "North Dakota", "OH": "Ohio", "OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania",
+	"RI": "Rhode Island", "SC": "South Carolina", "SD": "South Dakota", "TN": "Tennessee",
+	"TX": "Texas", "UT": "Utah", "VT": "Vermont", "VA": "Virginia", "WA": "Washington",
+	"WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+}
+
 // derive search terms from SearchData
 func getTerms(sd *SearchData) []string {
 	name := sd.Name
 	city := sd.City
-	states := map[string]string{
-		"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas",
-		"CA": "California", "CO": "Colorado", "CT": "Connecticut", "DE": "Delaware",
-		"FL": "Florida", "GA": "Georgia", "HI": "Hawaii", "ID": "Idaho",
-		"IL": "Illinois", "IN": "Indiana", "IA": "Iowa", "KS": "Kansas", "KY": "Kentucky",
-		"LA": "Louisiana", "ME": "Maine", "MD": "Maryland", "MA": "Massachusetts",
-		"MI": "Michigan", "MN": "Minnesota", "MS": "Mississippi", "MO": "Missouri",
-		"MT": "Montana", "NE": "Nebraska", "NV": "Nevada", "NH": "New Hampshire",
-		"NJ": "New Jersey", "NM": "New Mexico", "NY": "New York", "NC": "North Carolina",
-		"ND": "North Dakota", "OH": "Ohio", "OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania",
-		"RI": "Rhode Island", "SC": "South Carolina", "SD": "South Dakota", "TN": "Tennessee",
-		"TX": "Texas", "UT": "Utah", "VT": "Vermont", "VA": "Virginia", "WA": "Washington",
-		"WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
-	}
-	state := states[sd.State]
+	state := stateNames[sd.State]
 	var parties = map[string]string{
 		"ACE": "Ace Party",
 		"AKI": "Alaskan Independence Party",
@@ -0,0 +1,420 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains operations for verifying the consistency of the search
+// index with the index metadata and lookup data and rewriting the shards
+// of each term compactly.
+package indexing
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/boltdb/bolt"
+)
+
+/*
+	CONSISTENCY CHECKS
+	Repeated UpdateIndex runs can leave the shard ranges recorded in IndexData.Shards
+	out of sync with the shards stored in search_index.db. CheckIndex reads every
+	shard of every term in order and reports:
+		- unsorted: shard IDs not in ascending order
+		- duplicate: ID found more than once in a term's shards
+		- oversized: shard with more than maxShardSize IDs
+		- range_mismatch: shard min/max doesn't match the metadata range
+		- range_overlap: shard IDs overlap the previous shard's IDs
+		- missing_shard: shard in the metadata not found in the index
+		- untracked_shard: shard in the index not found in the metadata
+		- missing_lookup: ID in the index with no lookup entry
+		- orphaned_id: lookup entry not referenced by any term
+	Shard keys for a term (term, term.1, term.2...) are adjacent in each partition
+	bucket since '.' sorts before every alphanumeric character, so each term's
+	shards are checked as the bucket is scanned. Every ID referenced by the index
+	is held in memory to find orphaned lookup entries.
+
+	CompactIndex rewrites the IDs of each term as sorted, de-duplicated shards of
+	maxShardSize IDs, optionally removing the IDs with no lookup entry, and saves
+	the new shard ranges to the index metadata.
+*/
+
+// index check issues
+const (
+	IssueUnsorted       = "unsorted"
+	IssueDuplicate      = "duplicate"
+	IssueOversized      = "oversized"
+	IssueRange          = "range_mismatch"
+	IssueOverlap        = "range_overlap"
+	IssueMissingShard   = "missing_shard"
+	IssueUntrackedShard = "untracked_shard"
+	IssueMissingLookup  = "missing_lookup"
+	IssueOrphanedID     = "orphaned_id"
+)
+
+// max # of shard keys/IDs recorded for each issue
+const maxSamples = 20
+
+// IndexReport contains the results of an index consistency check.
+type IndexReport struct {
+	Terms         int
+	Shards        int
+	Refs          int // total # of ID references in shards
+	LookupEntries int
+	Issues        map[string]int      // issue: count
+	Samples       map[string][]string // issue: first shard keys/IDs found
+}
+
+// newIndexReport returns an empty IndexReport.
+func newIndexReport() *IndexReport {
+	return &IndexReport{
+		Issues:  make(map[string]int),
+		Samples: make(map[string][]string),
+	}
+}
+
+// add records an occurence of the issue for the shard key or ID.
+func (r *IndexReport) add(issue, key string) {
+	r.Issues[issue]++
+	if len(r.Samples[issue]) < maxSamples {
+		r.Samples[issue] = append(r.Samples[issue], key)
+	}
+}
+
+// OK returns true if no issues were found.
+func (r *IndexReport) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// termShards contains the IDs in each shard of a term read from the index.
+type termShards struct {
+	term   string
+	shards map[int][]string // shard index: IDs
+}
+
+// CheckIndex verifies the shards of every term in the index against the index
+// metadata and lookup data and returns a report of the issues found.
+func CheckIndex() (*IndexReport, error) {
+	id, err := getIndexData()
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CheckIndex failed: %v", err)
+	}
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/search_index.db", 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CheckIndex failed: %v", err)
+	}
+	defer db.Close()
+
+	r := newIndexReport()
+	if err := db.View(func(tx *bolt.Tx) error {
+		lu := tx.Bucket([]byte("lookup"))
+		seen := make(map[string]bool)  // referenced IDs
+		found := make(map[string]bool) // terms found in index
+
+		if err := forEachTerm(tx, func(ts *termShards) {
+			found[ts.term] = true
+			checkTerm(r, id, ts)
+			for _, ids := range ts.shards {
+				for _, ID := range ids {
+					if seen[ID] {
+						continue
+					}
+					seen[ID] = true
+					if lu == nil || lu.Get([]byte(ID)) == nil {
+						r.add(IssueMissingLookup, ID)
+					}
+				}
+			}
+		}); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("tx failed: %v", err)
+		}
+
+		// sharded terms in metadata missing from index
+		for term, sr := range id.Shards {
+			if sr != nil && sr.Shards > 0 && !found[term] {
+				r.add(IssueMissingShard, term)
+			}
+		}
+
+		// lookup entries not referenced by any term
+		if lu == nil {
+			return nil
+		}
+		c := lu.Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			r.LookupEntries++
+			if !seen[string(k)] {
+				r.add(IssueOrphanedID, string(k))
+			}
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CheckIndex failed: %v", err)
+	}
+	return r, nil
+}
+
+// checkTerm checks the term's shards against the index metadata.
+func checkTerm(r *IndexReport, id *IndexData, ts *termShards) {
+	r.Terms++
+	sr := id.Shards[ts.term]
+	n := 0 // # of shards after first in metadata
+	if sr != nil {
+		n = int(sr.Shards)
+	}
+
+	idxs := []int{}
+	for i := range ts.shards {
+		idxs = append(idxs, i)
+	}
+	sort.Ints(idxs)
+	for i := 0; i <= n; i++ {
+		if _, ok := ts.shards[i]; !ok {
+			r.add(IssueMissingShard, shardKey(ts.term, i))
+		}
+	}
+
+	set := make(map[string]bool)
+	prev := "" // last ID in previous shard
+	for _, i := range idxs {
+		key, ids := shardKey(ts.term, i), ts.shards[i]
+		r.Shards++
+		r.Refs += len(ids)
+		if i > n {
+			r.add(IssueUntrackedShard, key)
+		}
+		if len(ids) > maxShardSize {
+			r.add(IssueOversized, key)
+		}
+		if !sort.StringsAreSorted(ids) {
+			r.add(IssueUnsorted, key)
+		}
+		dup := false
+		for _, ID := range ids {
+			if set[ID] {
+				dup = true
+			}
+			set[ID] = true
+		}
+		if dup {
+			r.add(IssueDuplicate, key)
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		min, max := ids[0], ids[len(ids)-1]
+		if prev != "" && min <= prev {
+			r.add(IssueOverlap, key)
+		}
+		prev = max
+		if sr == nil || n == 0 || i > n {
+			continue
+		}
+		rt := sr.Ranges[key]
+		if len(rt.Range) != 2 || rt.Range[0] != min || (rt.Range[1] != "!" && rt.Range[1] != max) {
+			r.add(IssueRange, key)
+		}
+	}
+}
+
+// forEachTerm reads the shards of every term in the index partitions and calls fn for each term.
+func forEachTerm(tx *bolt.Tx, fn func(ts *termShards)) error {
+	return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		if !isPartition(string(name)) {
+			return nil
+		}
+		var ts *termShards
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			term, i := splitShardKey(string(k))
+			if ts == nil || ts.term != term {
+				if ts != nil {
+					fn(ts)
+				}
+				ts = &termShards{term: term, shards: make(map[int][]string)}
+			}
+			ids, err := decodeResultsList(v)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("forEachTerm failed: %v", err)
+			}
+			ts.shards[i] = ids
+		}
+		if ts != nil {
+			fn(ts)
+		}
+		return nil
+	})
+}
+
+// CompactIndex rewrites the shards of every term as sorted, de-duplicated shards of
+// maxShardSize IDs and saves the new shard ranges to the index metadata. IDs with no
+// lookup entry are removed if pruneMissing is true. Returns the report of the
+// compacted index.
+func CompactIndex(pruneMissing bool) (*IndexReport, error) {
+	id, err := getIndexData()
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CompactIndex failed: %v", err)
+	}
+	id.Shards = make(ShardMap) // ranges recreated for every term in index
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/search_index.db", 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CompactIndex failed: %v", err)
+	}
+
+	// get partitions
+	prts := []string{}
+	if err := db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			if isPartition(string(name)) {
+				prts = append(prts, string(name))
+			}
+			return nil
+		})
+	}); err != nil {
+		db.Close()
+		fmt.Println(err)
+		return nil, fmt.Errorf("CompactIndex failed: %v", err)
+	}
+
+	// rewrite each partition in a single tx
+	for _, prt := range prts {
+		fmt.Printf("compacting partition '%s'...\n", prt)
+		if err := db.Update(func(tx *bolt.Tx) error {
+			b := tx.Bucket([]byte(prt))
+			lu := tx.Bucket([]byte("lookup"))
+			terms := make(map[string][]string) // term: shard keys
+			order := []string{}
+			c := b.Cursor()
+			for k, _ := c.First(); k != nil; k, _ = c.Next() {
+				term, _ := splitShardKey(string(k))
+				if terms[term] == nil {
+					order = append(order, term)
+				}
+				terms[term] = append(terms[term], string(k))
+			}
+
+			for _, term := range order {
+				all := []string{}
+				for _, key := range terms[term] {
+					ids, err := decodeResultsList(b.Get([]byte(key)))
+					if err != nil {
+						fmt.Println(err)
+						return fmt.Errorf("tx failed: %v", err)
+					}
+					all = append(all, ids...)
+					if err := b.Delete([]byte(key)); err != nil {
+						fmt.Println(err)
+						return fmt.Errorf("tx failed: %v", err)
+					}
+				}
+				ids := mergeIDs(all, nil)
+				if pruneMissing {
+					kept := []string{}
+					for _, ID := range ids {
+						if lu != nil && lu.Get([]byte(ID)) != nil {
+							kept = append(kept, ID)
+						}
+					}
+					ids = kept
+				}
+				if err := putShards(b, id, term, ids); err != nil {
+					fmt.Println(err)
+					return fmt.Errorf("tx failed: %v", err)
+				}
+			}
+			return nil
+		}); err != nil {
+			db.Close()
+			fmt.Println(err)
+			return nil, fmt.Errorf("CompactIndex failed: %v", err)
+		}
+	}
+	db.Close()
+
+	if err := saveIndexData(id); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CompactIndex failed: %v", err)
+	}
+	r, err := CheckIndex()
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CompactIndex failed: %v", err)
+	}
+	return r, nil
+}
+
+// putShards writes the sorted IDs for the term as shards of maxShardSize IDs
+// and records the term's shard ranges. Terms with no IDs are removed.
+func putShards(b *bolt.Bucket, id *IndexData, term string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var sr *shardRanges
+	if len(ids) > maxShardSize {
+		sr = &shardRanges{Term: term, Ranges: make(map[string]rangeTuple)}
+		id.Shards[term] = sr
+	}
+
+	for i := 0; i*maxShardSize < len(ids); i++ {
+		end := (i + 1) * maxShardSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		shard := ids[i*maxShardSize : end]
+		key := shardKey(term, i)
+		data, err := encodeResultsList(resultList{Results: shard})
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("putShards failed: %v", err)
+		}
+		if err := b.Put([]byte(key), data); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("putShards failed: %v", err)
+		}
+		if sr == nil {
+			continue
+		}
+		max := "!" // last shard incomplete
+		if end < len(ids) {
+			max = shard[len(shard)-1]
+		}
+		sr.Ranges[key] = rangeTuple{[]string{shard[0], max}}
+		sr.Shards = float32(i)
+	}
+	return nil
+}
+
+// isPartition returns true if the bucket is a search index partition.
+func isPartition(name string) bool {
+	return name != "lookup" && name != "index_data" && !strings.HasPrefix(name, "prefix_") && !strings.HasPrefix(name, "fuzzy_")
+}
+
+// shardKey returns the key of the term's shard at the index.
+func shardKey(term string, i int) string {
+	if i == 0 {
+		return term
+	}
+	return term + "." + strconv.Itoa(i)
+}
+
+// splitShardKey returns the term and shard index of the shard key.
+func splitShardKey(key string) (string, int) {
+	ss := strings.SplitN(key, ".", 2)
+	if len(ss) != 2 {
+		return key, 0
+	}
+	i, err := strconv.Atoi(ss[1])
+	if err != nil {
+		return key, 0
+	}
+	return ss[0], i
+}
@@ -0,0 +1,130 @@
+package indexing
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/boltdb/bolt"
+)
+
+// initShardedTestIndex adds n individuals named "DOE, JANE" to the test index
+// so the terms "doe" and "jane" are sharded and saves the index metadata.
+func initShardedTestIndex(t *testing.T, n int) string {
+	dir := initTestIndex(t)
+	id := &IndexData{Shards: make(ShardMap)}
+	index := make(indexMap)
+	lookup := make(lookupPairs)
+	for i := 0; i < n; i++ {
+		sd := &SearchData{ID: fmt.Sprintf("%032x", i), Name: "DOE, JANE", City: "NOWHERE", State: "ZZ", Bucket: "individuals", Years: []string{"2020"}}
+		lookup[sd.ID] = sd
+		for _, term := range formatTerms(getTerms(sd)) {
+			prt := getPartition(term)
+			if index[prt] == nil {
+				index[prt] = make(map[string][]string)
+			}
+			index[prt][term] = append(index[prt][term], sd.ID)
+		}
+	}
+	if _, _, err := saveIndex(id, index, lookup); err != nil {
+		t.Fatal(err)
+	}
+	if err := saveIndexData(id); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCheckIndex(t *testing.T) {
+	n := 2*maxShardSize + 100
+	dir := initShardedTestIndex(t, n)
+	defer os.RemoveAll(dir)
+
+	r, err := CheckIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.OK() {
+		t.Fatalf("CheckIndex() on new index = %v; want no issues", r.Samples)
+	}
+	if r.LookupEntries != n+len(testLookup) {
+		t.Errorf("CheckIndex() LookupEntries = %d; want %d", r.LookupEntries, n+len(testLookup))
+	}
+
+	// simulate drift
+	db, err := bolt.Open(dir+"/db/search_index.db", 0644, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		j := tx.Bucket([]byte("j"))
+		ids, err := decodeResultsList(j.Get([]byte("jane.1")))
+		if err != nil {
+			return err
+		}
+		for a, b := 0, len(ids)-1; a < b; a, b = a+1, b-1 {
+			ids[a], ids[b] = ids[b], ids[a]
+		}
+		data, _ := encodeResultsList(resultList{Results: ids})
+		if err := j.Put([]byte("jane.1"), data); err != nil {
+			return err
+		}
+		data, _ = encodeResultsList(resultList{Results: []string{"indv00000000000000000000000000a1", "indv00000000000000000000000000ff"}})
+		if err := tx.Bucket([]byte("p")).Put([]byte("pelosi.1"), data); err != nil {
+			return err
+		}
+		lu := tx.Bucket([]byte("lookup"))
+		if err := lu.Delete([]byte("indv00000000000000000000000000a3")); err != nil {
+			return err
+		}
+		return lu.Put([]byte("indv00000000000000000000000000zz"), []byte{})
+	}); err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err = CheckIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int{
+		IssueUnsorted:       1, // jane.1
+		IssueRange:          1, // jane.1
+		IssueUntrackedShard: 1, // pelosi.1
+		IssueOverlap:        1, // pelosi.1
+		IssueDuplicate:      1, // pelosi.1
+		IssueMissingLookup:  2, // a3, ff
+		IssueOrphanedID:     1, // zz
+	}
+	for issue, ct := range want {
+		if r.Issues[issue] != ct {
+			t.Errorf("CheckIndex() %s = %d; want %d (%v)", issue, r.Issues[issue], ct, r.Samples[issue])
+		}
+	}
+	if len(r.Issues) != len(want) {
+		t.Errorf("CheckIndex() issues = %v; want %v", r.Issues, want)
+	}
+
+	r, err = CompactIndex(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Issues) != 1 || r.Issues[IssueOrphanedID] != 1 {
+		t.Errorf("CompactIndex() issues = %v; want only orphaned IDs", r.Samples)
+	}
+	hits, err := GetHitsFromShards(&IndexData{Shards: make(ShardMap)}, CreateQuery("john smith", "test"))
+	if err != nil && err.Error() != "NO_RESULTS" {
+		t.Fatal(err)
+	}
+	if len(hits.IDs) != 0 {
+		t.Errorf("search 'john smith' after pruning = %v; want no results", hits.IDs)
+	}
+	id, err := getIndexData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := CreateQuery("jane doe", "test")
+	if hits, err = GetHitsFromShards(id, q); err != nil || hits.Total != n {
+		t.Errorf("search %q after compacting = %d hits, %v; want %d", q.Text, hits.Total, err, n)
+	}
+}
@@ -0,0 +1,285 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains operations for finding the terms in the index within a
+// bounded edit distance of a misspelled query term (fuzzy matching).
+package indexing
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/boltdb/bolt"
+)
+
+/*
+	FUZZY MATCHING
+	Query terms not found in the index are compared to the terms in the index
+	starting with the query term's first or second letter (partitions). The
+	first letter's partition covers misspellings anywhere after the first
+	letter (including a doubled first letter); the second letter's partition
+	covers an extra or transposed first letter ("epelosi", "eplosi" -> "pelosi").
+	Missing or substituted first letters are not matched ("elosi" searches the
+	"e" and "l" partitions only). Each partition's terms are stored in a fuzzy
+	dictionary bucket ("fuzzy_" + partition) keyed by term length + term, so
+	only the terms within the max distance in length are read with a cursor
+	seek for each length. The Damerau-Levenshtein distance (optimal string
+	alignment) of each candidate term stops early once every alignment
+	exceeds the max distance. The fuzzy dictionary is written with the index;
+	indexes saved without it return no fuzzy matches.
+
+	Max edit distance by term length:
+		- < 4 characters: 0 (exact match only)
+		- 4-7 characters: 1
+		- 8+ characters: 2
+
+	Matches are ranked by distance, then by # of references in the index.
+*/
+
+// fuzzy matching parameters
+const (
+	minFuzzyLen     = 4
+	longFuzzyLen    = 8
+	maxFuzzyMatches = 5
+)
+
+// FuzzyMatch is an index term matching a query term within the max edit distance.
+type FuzzyMatch struct {
+	Term string
+	Dist int // edit distance from query term
+	Refs int // # of IDs referenced by term (first shard only)
+}
+
+// FuzzyTerms returns the closest terms in the index to the given term
+// within the max edit distance for the term's length.
+func FuzzyTerms(term string) ([]FuzzyMatch, error) {
+	db, err := bolt.Open(OUTPUT_PATH+"/db/search_index.db", 0644, nil)
+	defer db.Close()
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("FuzzyTerms failed: %v", err)
+	}
+
+	matches := []FuzzyMatch{}
+	if err := db.View(func(tx *bolt.Tx) error {
+		ms, err := fuzzyMatches(tx, term)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		matches = ms
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("FuzzyTerms failed: %v", err)
+	}
+	return matches, nil
+}
+
+// CorrectQuery returns a copy of the query with each term not found in the index
+// replaced by the closest matching term in the index. Returns true if any terms
+// were replaced; the query is returned unchanged if a missing term has no matches.
+func CorrectQuery(q Query) (Query, bool, error) {
+	terms := formatTerms(strings.Split(q.Text, " "))
+	repl := make(map[string]string) // term: corrected term
+	corrected := false
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/search_index.db", 0644, nil)
+	defer db.Close()
+	if err != nil {
+		fmt.Println(err)
+		return q, false, fmt.Errorf("CorrectQuery failed: %v", err)
+	}
+
+	if err := db.View(func(tx *bolt.Tx) error {
+		for _, t := range terms {
+			if filter(t) || checkForID(t) || termExists(tx, t) {
+				continue
+			}
+			ms, err := fuzzyMatches(tx, t)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			if len(ms) == 0 { // no match for term - no results
+				corrected = false
+				return nil
+			}
+			repl[t] = ms[0].Term
+			corrected = true
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return q, false, fmt.Errorf("CorrectQuery failed: %v", err)
+	}
+	if !corrected {
+		return q, false, nil
+	}
+
+	return q.normalize(repl), true, nil
+}
+
+// termExists returns true if the term is in the index.
+func termExists(tx *bolt.Tx, term string) bool {
+	b := tx.Bucket([]byte(getPartition(term)))
+	if b == nil {
+		return false
+	}
+	return b.Get([]byte(term)) != nil
+}
+
+// fuzzyMatches finds the terms in the fuzzy dictionary of the term's
+// partitions within the max edit distance.
+func fuzzyMatches(tx *bolt.Tx, term string) ([]FuzzyMatch, error) {
+	max := maxEdits(term)
+	if max == 0 {
+		return []FuzzyMatch{}, nil
+	}
+
+	q := []rune(term)
+	prts := []string{getPartition(term)}
+	if p := string(q[1]); p != prts[0] {
+		prts = append(prts, p)
+	}
+
+	matches := []FuzzyMatch{}
+	for _, prt := range prts {
+		b := tx.Bucket([]byte(prt))
+		d := tx.Bucket([]byte(fuzzyBucket(prt)))
+		if b == nil || d == nil {
+			continue
+		}
+		c := d.Cursor()
+		for l := len(q) - max; l <= len(q)+max; l++ {
+			p := fuzzyLenPrefix(l)
+			for k, _ := c.Seek(p); k != nil && bytes.HasPrefix(k, p); k, _ = c.Next() {
+				t := string(k[len(p):])
+				dist := editDistance(q, []rune(t), max)
+				if dist > max || dist == 0 {
+					continue
+				}
+				ids, err := decodeResultsList(b.Get([]byte(t)))
+				if err != nil {
+					fmt.Println(err)
+					return nil, fmt.Errorf("fuzzyMatches failed: %v", err)
+				}
+				matches = append(matches, FuzzyMatch{Term: t, Dist: dist, Refs: len(ids)})
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Dist != matches[j].Dist {
+			return matches[i].Dist < matches[j].Dist
+		}
+		if matches[i].Refs != matches[j].Refs {
+			return matches[i].Refs > matches[j].Refs
+		}
+		return matches[i].Term < matches[j].Term
+	})
+	if len(matches) > maxFuzzyMatches {
+		matches = matches[:maxFuzzyMatches]
+	}
+	return matches, nil
+}
+
+// saveFuzzyIndex adds each term in the index to the fuzzy dictionary of its partition.
+func saveFuzzyIndex(db *bolt.DB, index indexMap) error {
+	fmt.Println("writing fuzzy dictionary...")
+	for prt, terms := range index {
+		if err := db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists([]byte(fuzzyBucket(prt)))
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			for term := range terms {
+				if err := b.Put(fuzzyKey(term), []byte{}); err != nil {
+					return fmt.Errorf("tx failed: %v", err)
+				}
+			}
+			return nil
+		}); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("saveFuzzyIndex failed: %v", err)
+		}
+	}
+	fmt.Println("fuzzy dictionary saved!")
+	return nil
+}
+
+// fuzzyBucket returns the name of the partition's fuzzy dictionary bucket.
+func fuzzyBucket(prt string) string {
+	return "fuzzy_" + prt
+}
+
+// fuzzyKey returns the term's fuzzy dictionary key (ex: "006.pelosi").
+func fuzzyKey(term string) []byte {
+	return append(fuzzyLenPrefix(utf8.RuneCountInString(term)), term...)
+}
+
+// fuzzyLenPrefix returns the fuzzy dictionary key prefix of terms with the given length.
+func fuzzyLenPrefix(l int) []byte {
+	return []byte(fmt.Sprintf("%03d.", l))
+}
+
+// maxEdits returns the max edit distance allowed for the term.
+func maxEdits(term string) int {
+	l := utf8.RuneCountInString(term)
+	switch {
+	case l < minFuzzyLen:
+		return 0
+	case l < longFuzzyLen:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// editDistance returns the optimal string alignment distance between a and b
+// (insertions, deletions, substitutions, and adjacent transpositions).
+// Returns max+1 as soon as the distance is known to exceed max.
+func editDistance(a, b []rune, max int) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = minInt(curr[j], prev2[j-2]+1)
+			}
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(b)]
+}
+
+// minInt returns the smallest of the given values.
+func minInt(vs ...int) int {
+	m := vs[0]
+	for _, v := range vs[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
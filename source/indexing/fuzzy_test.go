@@ -0,0 +1,105 @@
+package indexing
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	var tests = []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"pelosi", "pelosi", 2, 0},
+		{"pelosi", "peolsi", 2, 1},  // transposition
+		{"pelosi", "pelossi", 2, 1}, // insertion
+		{"pelosi", "plosi", 2, 1},   // deletion
+		{"pelosi", "pelozi", 2, 1},  // substitution
+		{"pelosi", "palozy", 2, 3},  // exceeds max
+		{"washington", "wahsingtn", 2, 2},
+	}
+	for _, test := range tests {
+		if got := editDistance([]rune(test.a), []rune(test.b), test.max); got != test.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d; want %d", test.a, test.b, test.max, got, test.want)
+		}
+	}
+}
+
+func TestCorrectQuery(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		text      string
+		want      string
+		corrected bool
+	}{
+		{"nancy pelosi", "nancy pelosi", false},
+		{"nancy peolsi", "nancy pelosi", true},
+		{"nanci pelosi", "nancy pelosi", true},
+		{"epolsi", "epolsi", false}, // 2 edits - max 1 for length
+		{"pleosi", "pelosi", true},  // transposed first letter
+		{"nancy xyzzy", "nancy xyzzy", false},
+		{"bob", "bob", false},
+	}
+	for _, test := range tests {
+		q, corrected, err := CorrectQuery(CreateQuery(test.text, "test"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Text != test.want || corrected != test.corrected {
+			t.Errorf("CorrectQuery(%q) = %q, %v; want %q, %v", test.text, q.Text, corrected, test.want, test.corrected)
+		}
+	}
+}
+
+func TestMaxEdits(t *testing.T) {
+	var tests = []struct {
+		term string
+		want int
+	}{
+		{"bob", 0},
+		{"josé", 1},
+		{"pelosi", 1},
+		{"ñañañañ", 1}, // 7 characters, 14 bytes
+		{"washington", 2},
+	}
+	for _, test := range tests {
+		if got := maxEdits(test.term); got != test.want {
+			t.Errorf("maxEdits(%q) = %d; want %d", test.term, got, test.want)
+		}
+	}
+}
+
+func TestFuzzyTerms(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		term string
+		want string // closest match; "" if none
+	}{
+		{"peolsi", "pelosi"},
+		{"pélosi", "pelosi"},  // multi-byte 2nd character
+		{"lpelosi", "pelosi"}, // extra first letter
+		{"eplosi", "pelosi"},  // transposed first letter
+		{"ppelosi", "pelosi"}, // doubled first letter
+		{"elosi", ""},         // missing first letter - "p" partition not searched
+		{"pelosiiii", ""},     // 3 edits - max 2 for length
+		{"xyzzy", ""},
+	}
+	for _, test := range tests {
+		ms, err := FuzzyTerms(test.term)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := ""
+		if len(ms) > 0 {
+			got = ms[0].Term
+		}
+		if got != test.want {
+			t.Errorf("FuzzyTerms(%q) = %v; want %q", test.term, ms, test.want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains the IndexStore interface used by the search operations
+// to read shards and SearchData objects and its BoltDB, DynamoDB and
+// in-memory implementations.
+package indexing
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/boltdb/bolt"
+	"github.com/elections/source/dynamo"
+)
+
+/*
+	INDEX STORES
+	The search index is stored on disk in search_index.db for the admin app and
+	copied to DynamoDB for the service. Queries are run against an IndexStore so
+	the same intersection, union and ranking logic is used for every backend:
+		- BoltStore: search_index.db; opened on first read
+		- DynamoStore: "cf-index" and "cf-lookup" tables
+		- MemoryStore: maps; used for tests and small indexes
+	Shards are read by key (term or term.N) and the shard ranges for each
+	term are read from the IndexData object passed with the query.
+*/
+
+// IndexStore reads shards and SearchData objects from a search index backend.
+type IndexStore interface {
+	// GetShard returns the sorted IDs in the shard; empty if the shard does not exist.
+	GetShard(key string) ([]string, error)
+	// GetSearchData returns the SearchData objects found for the IDs.
+	GetSearchData(ids []string) ([]SearchData, error)
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+// BoltStore reads the search index from search_index.db.
+type BoltStore struct {
+	path string
+	db   *bolt.DB
+}
+
+// NewBoltStore returns a BoltStore for the database at the path.
+// The database is opened on first read.
+func NewBoltStore(path string) *BoltStore {
+	return &BoltStore{path: path}
+}
+
+// open opens the database if not already open.
+func (s *BoltStore) open() error {
+	if s.db != nil {
+		return nil
+	}
+	db, err := bolt.Open(s.path, 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("open failed: %v", err)
+	}
+	s.db = db
+	return nil
+}
+
+// GetShard returns the IDs in the shard.
+func (s *BoltStore) GetShard(key string) ([]string, error) {
+	if err := s.open(); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetShard failed: %v", err)
+	}
+	return getShard(s.db, key)
+}
+
+// GetSearchData returns the SearchData objects for the IDs from the lookup bucket.
+func (s *BoltStore) GetSearchData(ids []string) ([]SearchData, error) {
+	if err := s.open(); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetSearchData failed: %v", err)
+	}
+	return getSearchData(s.db, ids)
+}
+
+// Close closes the database if open.
+func (s *BoltStore) Close() error {
+	if s.db == nil {
+		return nil
+	}
+	err := s.db.Close()
+	s.db = nil
+	return err
+}
+
+// DynamoStore reads the search index from the DynamoDB tables.
+type DynamoStore struct {
+	db *dynamo.DbInfo
+}
+
+// NewDynamoStore returns a DynamoStore using the "cf-index"
+// and "cf-lookup" tables in the DbInfo object.
+func NewDynamoStore(db *dynamo.DbInfo) *DynamoStore {
+	return &DynamoStore{db: db}
+}
+
+// GetShard returns the IDs in the shard from the "cf-index" table.
+func (s *DynamoStore) GetShard(key string) ([]string, error) {
+	q := dynamo.CreateNewQueryObj(getDynamoPrt(key), key)
+	obj, err := dynamo.GetItem(s.db.Svc, q, s.db.Tables["cf-index"], SearchEntry{})
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetShard failed: %v", err)
+	}
+
+	ids := []string{}
+	switch t := obj.(type) {
+	case SearchEntry:
+		ids = t.IDs
+	case *SearchEntry:
+		ids = t.IDs
+	case map[string]interface{}:
+		refs, _ := t["IDs"].([]interface{})
+		for _, ID := range refs {
+			if s, ok := ID.(string); ok {
+				ids = append(ids, s)
+			}
+		}
+	default:
+		fmt.Println("invalid interface found")
+	}
+	return ids, nil
+}
+
+// GetSearchData returns the SearchData objects for the IDs from the "cf-lookup" table.
+func (s *DynamoStore) GetSearchData(ids []string) ([]SearchData, error) {
+	sds, err := LookupSearchDataFromDynamo(s.db, ids)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetSearchData failed: %v", err)
+	}
+	results := []SearchData{}
+	for _, sd := range sds {
+		if sd.ID != "" { // not found
+			results = append(results, sd)
+		}
+	}
+	return results, nil
+}
+
+// Close is a no-op for DynamoDB.
+func (s *DynamoStore) Close() error { return nil }
+
+// MemoryStore holds the search index in memory. Terms added with Add
+// are not sharded and must not have shard ranges in the IndexData.
+type MemoryStore struct {
+	Shards map[string][]string // shard key: sorted IDs
+	Lookup map[string]SearchData
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		Shards: make(map[string][]string),
+		Lookup: make(map[string]SearchData),
+	}
+}
+
+// Add adds the SearchData object to the lookup and its ID to the shard for each of its terms.
+func (s *MemoryStore) Add(sd SearchData) {
+	s.Lookup[sd.ID] = sd
+	for _, t := range formatTerms(getTerms(&sd)) {
+		if filter(t) {
+			continue
+		}
+		s.Shards[t] = mergeIDs([]string{sd.ID}, s.Shards[t])
+	}
+}
+
+// PutShard sets the IDs in the shard.
+func (s *MemoryStore) PutShard(key string, ids []string) {
+	srt := append([]string{}, ids...)
+	sort.Strings(srt)
+	s.Shards[key] = srt
+}
+
+// GetShard returns the IDs in the shard.
+func (s *MemoryStore) GetShard(key string) ([]string, error) {
+	return append([]string{}, s.Shards[key]...), nil
+}
+
+// GetSearchData returns the SearchData objects found for the IDs.
+func (s *MemoryStore) GetSearchData(ids []string) ([]SearchData, error) {
+	results := []SearchData{}
+	for _, ID := range ids {
+		if sd, ok := s.Lookup[ID]; ok {
+			results = append(results, sd)
+		}
+	}
+	return results, nil
+}
+
+// Close is a no-op for the MemoryStore.
+func (s *MemoryStore) Close() error { return nil }
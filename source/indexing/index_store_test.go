@@ -0,0 +1,64 @@
+package indexing
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestMemoryStore(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	mem := NewMemoryStore()
+	for _, sd := range testLookup {
+		mem.Add(*sd)
+	}
+	bs := NewBoltStore(dir + "/db/search_index.db")
+	defer bs.Close()
+
+	queries := []string{
+		"pelosi",
+		"nancy pelosi",
+		"san francisco",
+		"pelosi -congress",
+		"nancy OR paul",
+		`"pelosi for congress" OR peloquin`,
+		"pelosi type:individual",
+		"P00000001",
+	}
+	id := &IndexData{Shards: make(ShardMap)}
+	for _, text := range queries {
+		q := CreateQuery(text, "test")
+		var want, got []string
+		for i, store := range []IndexStore{bs, mem} {
+			hits, err := GetHitsFromStore(store, id, q)
+			if err != nil {
+				t.Fatalf("GetHitsFromStore(%q): %v", text, err)
+			}
+			page, err := PageResultsFromStore(store, q, hits, nil, nil, 0, "")
+			if err != nil {
+				t.Fatalf("PageResultsFromStore(%q): %v", text, err)
+			}
+			ids := []string{}
+			for _, sr := range page.Results {
+				ids = append(ids, sr.ID)
+			}
+			if i == 0 {
+				want = ids
+			} else {
+				got = ids
+			}
+		}
+		if len(want) == 0 {
+			t.Errorf("search %q: no results from BoltStore", text)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("search %q: MemoryStore = %v; BoltStore = %v", text, got, want)
+		}
+	}
+
+	if _, err := GetHitsFromStore(mem, id, CreateQuery("xyzzy", "test")); err == nil || err.Error() != "NO_RESULTS" {
+		t.Errorf("GetHitsFromStore(xyzzy) = %v; want NO_RESULTS", err)
+	}
+}
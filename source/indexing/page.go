@@ -0,0 +1,200 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains operations for paging through the ranked results
+// of a query with cursors.
+package indexing
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+	CURSORS
+	Results are ranked on every request and paged by offset. The cursor returned
+	with each page encodes the offset of the next page and a hash of the normalized
+	query so a cursor can't be used with a different query:
+		base64url("offset:hash")
+	Only the top maxCandidates ranked results can be paged through; TotalHits reports
+	the total # of matching IDs, which is estimated for very broad single term queries
+	and for filtered queries with more matching hits than can be scored.
+
+	Ranking a query retrieves and scores up to maxCandidates SearchData objects
+	(more for filtered queries), so the ranked results of the last rankedCacheSize
+	queries are cached by query hash and # of hits. Requests for the first page
+	always re-rank the hits and replace the cached results; requests with a cursor
+	are served from the cache when available.
+*/
+
+// page size limits
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+// rankedCacheSize is the max # of queries' ranked results kept in the ranked cache.
+const rankedCacheSize = 20
+
+// rankedResults are the ranked results of a query and the # of hits checked.
+type rankedResults struct {
+	ranked  []ScoredResult
+	checked int
+}
+
+// rankedCache stores ranked results by cache key.
+// keys are stored in insertion order and evicted first in, first out.
+var rankedCache = struct {
+	sync.Mutex
+	results map[string]rankedResults
+	keys    []string
+}{results: make(map[string]rankedResults)}
+
+// Hits contains the IDs matching a query and the total # of matching IDs.
+type Hits struct {
+	IDs       []string
+	Total     int  // total # of IDs matching query
+	Estimated bool // Total estimated from # of shards
+}
+
+// ResultsPage contains a page of ranked results for a query.
+type ResultsPage struct {
+	Results    []ScoredResult
+	TotalHits  int
+	Estimated  bool   // TotalHits is an estimate
+	NextCursor string // empty if last page
+}
+
+// PageResults ranks the query hits and returns the page of results starting at the
+// cursor. cursor is the NextCursor returned with the previous page or an empty string
+// for the first page. pageSize defaults to 100 (max 500).
+func PageResults(q Query, hits Hits, cache map[string]SearchData, totals map[string]float32, pageSize int, cursor string) (ResultsPage, error) {
+	store := NewBoltStore(OUTPUT_PATH + "/db/search_index.db")
+	defer store.Close()
+	page, err := PageResultsFromStore(store, q, hits, cache, totals, pageSize, cursor)
+	if err != nil {
+		fmt.Println(err)
+		return page, fmt.Errorf("PageResults failed: %v", err)
+	}
+	return page, nil
+}
+
+// PageResultsFromStore ranks the query hits and returns the page of results starting
+// at the cursor. SearchData objects not found in the cache are retrieved from the
+// index store.
+func PageResultsFromStore(store IndexStore, q Query, hits Hits, cache map[string]SearchData, totals map[string]float32, pageSize int, cursor string) (ResultsPage, error) {
+	page := ResultsPage{TotalHits: hits.Total, Estimated: hits.Estimated}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	offset, err := decodeCursor(q, cursor)
+	if err != nil {
+		fmt.Println(err)
+		return page, fmt.Errorf("PageResultsFromStore failed: %v", err)
+	}
+
+	key := rankedKey(q, hits)
+	rr, ok := getRankedResults(key)
+	if !ok || cursor == "" {
+		sds, checked, err := filterCandidates(store, q, hits.IDs, cache, totals)
+		if err != nil {
+			fmt.Println(err)
+			return page, fmt.Errorf("PageResultsFromStore failed: %v", err)
+		}
+		rr = rankedResults{ranked: ScoreResults(q, sds, totals, 0), checked: checked}
+		putRankedResults(key, rr)
+	}
+	ranked := rr.ranked
+	if q.filtered() {
+		page.TotalHits, page.Estimated = filteredTotal(hits, len(ranked), rr.checked)
+	}
+	if offset > len(ranked) {
+		offset = len(ranked)
+	}
+	end := offset + pageSize
+	if end > len(ranked) {
+		end = len(ranked)
+	}
+	page.Results = append([]ScoredResult{}, ranked[offset:end]...) // cached results are shared
+	if end < len(ranked) {
+		page.NextCursor = encodeCursor(q, end)
+	}
+	return page, nil
+}
+
+// rankedKey returns the ranked cache key for the query's hits.
+func rankedKey(q Query, hits Hits) string {
+	return queryHash(q) + ":" + strconv.Itoa(len(hits.IDs)) + ":" + strconv.Itoa(hits.Total)
+}
+
+func getRankedResults(key string) (rankedResults, bool) {
+	rankedCache.Lock()
+	defer rankedCache.Unlock()
+	rr, ok := rankedCache.results[key]
+	return rr, ok
+}
+
+func putRankedResults(key string, rr rankedResults) {
+	rankedCache.Lock()
+	defer rankedCache.Unlock()
+	if _, ok := rankedCache.results[key]; ok {
+		rankedCache.results[key] = rr
+		return
+	}
+	if len(rankedCache.keys) >= rankedCacheSize {
+		delete(rankedCache.results, rankedCache.keys[0])
+		rankedCache.keys = rankedCache.keys[1:]
+	}
+	rankedCache.results[key] = rr
+	rankedCache.keys = append(rankedCache.keys, key)
+}
+
+// encodeCursor returns the cursor for the result at the offset.
+func encodeCursor(q Query, offset int) string {
+	raw := strconv.Itoa(offset) + ":" + queryHash(q)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeCursor returns the offset encoded in the cursor. Returns
+// an INVALID_CURSOR error if the cursor was not created for the query.
+func decodeCursor(q Query, cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("INVALID_CURSOR")
+	}
+	ss := strings.Split(string(raw), ":")
+	if len(ss) != 2 || ss[1] != queryHash(q) {
+		return 0, fmt.Errorf("INVALID_CURSOR")
+	}
+	offset, err := strconv.Atoi(ss[0])
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("INVALID_CURSOR")
+	}
+	return offset, nil
+}
+
+// filteredTotal returns the total # of hits matching the query's filters and
+// phrases. The total is estimated from the share of checked hits matching
+// if not every hit was checked.
+func filteredTotal(hits Hits, matched, checked int) (int, bool) {
+	if checked == 0 || hits.Total <= checked {
+		return matched, false
+	}
+	return int(float64(matched) / float64(checked) * float64(hits.Total)), true
+}
+
+// queryHash returns the hash of the normalized query.
+func queryHash(q Query) string {
+	h := fnv.New32a()
+	h.Write([]byte(q.normalize(nil).String()))
+	return strconv.FormatUint(uint64(h.Sum32()), 36)
+}
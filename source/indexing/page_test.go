@@ -0,0 +1,102 @@
+package indexing
+
+import (
+	"os"
+	"testing"
+)
+
+func TestPageResults(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	q := CreateQuery("San Francisco", "test")
+	hits, err := GetHitsFromShards(&IndexData{Shards: make(ShardMap)}, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hits.Total != 3 || hits.Estimated {
+		t.Fatalf("GetHitsFromShards(%q) = %+v; want 3 exact hits", q.Text, hits)
+	}
+
+	seen := make(map[string]bool)
+	cursor := ""
+	for i, want := range []int{2, 1} {
+		page, err := PageResults(q, hits, nil, nil, 2, cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Results) != want || page.TotalHits != 3 {
+			t.Fatalf("page %d: %d results, %d total hits; want %d, 3", i, len(page.Results), page.TotalHits, want)
+		}
+		for _, sr := range page.Results {
+			if seen[sr.ID] {
+				t.Errorf("page %d: duplicate result %s", i, sr.ID)
+			}
+			seen[sr.ID] = true
+		}
+		cursor = page.NextCursor
+	}
+	if cursor != "" {
+		t.Errorf("NextCursor = %q on last page; want empty", cursor)
+	}
+
+	// cursors are only valid for the query they were created for
+	page, err := PageResults(q, hits, nil, nil, 2, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := PageResults(CreateQuery("pelosi", "test"), hits, nil, nil, 2, page.NextCursor); err == nil {
+		t.Errorf("PageResults with cursor from other query: want INVALID_CURSOR error")
+	}
+	if _, err := PageResults(CreateQuery("san  francisco", "test"), hits, nil, nil, 2, page.NextCursor); err != nil {
+		t.Errorf("PageResults with cursor from equivalent query: %v", err)
+	}
+}
+
+// countingStore counts the # of SearchData objects retrieved from the store.
+type countingStore struct {
+	*MemoryStore
+	lookups int
+}
+
+func (s *countingStore) GetSearchData(ids []string) ([]SearchData, error) {
+	s.lookups += len(ids)
+	return s.MemoryStore.GetSearchData(ids)
+}
+
+func TestPageResultsCache(t *testing.T) {
+	store := &countingStore{MemoryStore: NewMemoryStore()}
+	for _, sd := range testLookup {
+		store.Add(*sd)
+	}
+	id := &IndexData{Shards: make(ShardMap)}
+	q := CreateQuery("san francisco", "test")
+	hits, err := GetHitsFromStore(store, id, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		first       bool // request first page
+		wantLookups int
+	}{
+		{true, 3},
+		{false, 3}, // next page served from cache
+		{true, 6},  // first page re-ranked
+		{false, 6},
+	}
+	cursor := ""
+	for i, test := range tests {
+		if test.first {
+			cursor = ""
+		}
+		page, err := PageResultsFromStore(store, q, hits, nil, nil, 2, cursor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if store.lookups != test.wantLookups {
+			t.Errorf("request %d: %d SearchData lookups; want %d", i, store.lookups, test.wantLookups)
+		}
+		cursor = page.NextCursor
+	}
+}
@@ -25,6 +25,9 @@ type resultList struct {
 	Results []string // new/updated ID references for given term encoded as Big Endian uint64s
 }
 
+// max # of IDs per shard
+const maxShardSize = 1250
+
 // ShardMap records the number of shards for each term and the min, max values of each shard
 type ShardMap map[string]*shardRanges // schema: term: shards: range (min, max)
 
@@ -205,7 +208,7 @@ func saveIndex(id *IndexData, index indexMap, lookup lookupPairs) (int, int, err
 	// uints := make(map[string]map[string][]string) // store big endian encoded IDs for each shard
 	wrote := make(map[string]bool)
 	shards := make(ShardMap) // ShardMap buffer object
-	maxSize := 1250          // # of IDs (max size @ 4b/ID)
+	maxSize := maxShardSize  // # of IDs (max size @ 4b/ID)
 	ns := "!"                // indicates max value not set for shard (shard incomplete)
 	fmt.Println("save index - writing objects to db/search_index.db")
 
@@ -218,6 +221,12 @@ func saveIndex(id *IndexData, index indexMap, lookup lookupPairs) (int, int, err
 		return 0, 0, fmt.Errorf("saveIndex failed: %v", err)
 	}
 
+	// add terms to fuzzy dictionary before index terms are removed from the map as written
+	if err := saveFuzzyIndex(db, index); err != nil {
+		fmt.Println(err)
+		return 0, 0, fmt.Errorf("saveIndex failed: %v", err)
+	}
+
 	// persist inverted index
 	// add/update each term for each partition
 	for prt, terms := range index {
@@ -425,6 +434,12 @@ func saveIndex(id *IndexData, index indexMap, lookup lookupPairs) (int, int, err
 		return 0, 0, fmt.Errorf("saveIndex failed: %v", err)
 	}
 
+	// add name terms to prefix index (3rd transaction set)
+	if err := savePrefixIndex(db, lookup); err != nil {
+		fmt.Println(err)
+		return 0, 0, fmt.Errorf("saveIndex failed: %v", err)
+	}
+
 	// merge shard counts and ranges with id.Shards
 	for term, sr := range shards {
 		if sr.Shards == 0 {
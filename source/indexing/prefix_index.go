@@ -0,0 +1,300 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains operations for building the prefix index of name terms
+// and finding the best-ranked names matching a partial query (autocomplete).
+package indexing
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/boltdb/bolt"
+)
+
+/*
+	PREFIX INDEX
+	Name terms are stored in one bucket per partition ("prefix_" + first letter)
+	alongside the search index in db/search_index.db. BoltDB keeps each bucket's
+	keys in sorted order, so all terms beginning with a prefix are found with a
+	single cursor seek. Each term maps to the IDs of the highest ranked objects
+	with the term in their name (max 50).
+
+	Suggestions are ranked by:
+		- bucket: candidates, committees, then individuals
+		- exact match: names containing the prefix as a whole term first
+		- # of years the object appears in the index
+		- most recent year
+		- name (alphabetical)
+*/
+
+// prefix index parameters
+const (
+	maxPrefixRefs      = 50  // max # of IDs saved for each name term
+	maxPrefixTerms     = 200 // max # of matching terms scanned for each query
+	defaultSuggestions = 10
+	maxSuggestions     = 50
+)
+
+// suggestion ranks
+var bucketRanks = map[string]int{"candidates": 0, "committees": 1, "individuals": 2}
+
+// suggestion is a prefix query result ranked for autocomplete
+type suggestion struct {
+	sd    *SearchData
+	exact bool // name contains the prefix as a whole term
+}
+
+// Autocomplete returns the best-ranked names beginning with the given prefix.
+// For multi-word queries the last word is used as the prefix and each
+// preceding word must begin a term in the object's name (ex: "nancy pelo").
+// Returns at most limit results (default 10, max 50).
+func Autocomplete(prefix string, limit int) ([]SearchData, error) {
+	if limit <= 0 {
+		limit = defaultSuggestions
+	}
+	if limit > maxSuggestions {
+		limit = maxSuggestions
+	}
+	terms := formatTerms([]string{prefix})
+	if len(terms) == 0 {
+		return []SearchData{}, nil
+	}
+	last := terms[len(terms)-1]
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/search_index.db", 0644, nil)
+	defer db.Close()
+	if err != nil {
+		fmt.Println(err)
+		return []SearchData{}, fmt.Errorf("Autocomplete failed: %v", err)
+	}
+
+	suggestions := []suggestion{}
+	if err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(prefixBucket(last)))
+		lu := tx.Bucket([]byte("lookup"))
+		if b == nil || lu == nil { // prefix index not built
+			return nil
+		}
+		seen := make(map[string]bool)
+		p := []byte(last)
+		c := b.Cursor()
+		n := 0
+		for k, v := c.Seek(p); k != nil && bytes.HasPrefix(k, p) && n < maxPrefixTerms; k, v = c.Next() {
+			n++
+			ids, err := decodeResultsList(v)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			for _, ID := range ids {
+				if seen[ID] {
+					continue
+				}
+				seen[ID] = true
+				sd, err := decodeSearchData(lu.Get([]byte(ID)))
+				if err != nil {
+					fmt.Println(err)
+					return fmt.Errorf("tx failed: %v", err)
+				}
+				if sd.Bucket == "" { // nil item
+					continue
+				}
+				nameTerms := formatTerms([]string{sd.Name})
+				if !matchTerms(terms[:len(terms)-1], nameTerms) {
+					continue
+				}
+				suggestions = append(suggestions, suggestion{sd: sd, exact: hasTerm(nameTerms, last)})
+			}
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return []SearchData{}, fmt.Errorf("Autocomplete failed: %v", err)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if ra, rb := bucketRank(a.sd), bucketRank(b.sd); ra != rb {
+			return ra < rb
+		}
+		if a.exact != b.exact {
+			return a.exact
+		}
+		return rankLess(a.sd, b.sd)
+	})
+
+	results := []SearchData{}
+	for i, s := range suggestions {
+		if i == limit {
+			break
+		}
+		results = append(results, *s.sd)
+	}
+	return results, nil
+}
+
+// savePrefixIndex adds the name terms of each SearchData object to the prefix index.
+// Must be called after the lookup objects are written so that the merged years of
+// existing objects are used to rank each term's IDs.
+func savePrefixIndex(db *bolt.DB, lookup lookupPairs) error {
+	// partition: term: []objID
+	index := make(map[string]map[string][]string)
+	for _, sd := range lookup {
+		set := make(map[string]bool)
+		for _, term := range formatTerms([]string{sd.Name}) {
+			if filter(term) || set[term] {
+				continue
+			}
+			set[term] = true
+			prt := prefixBucket(term)
+			if index[prt] == nil {
+				index[prt] = make(map[string][]string)
+			}
+			index[prt][term] = append(index[prt][term], sd.ID)
+		}
+	}
+
+	fmt.Println("writing prefix index...")
+	for prt, terms := range index {
+		if err := db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists([]byte(prt))
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			lu := tx.Bucket([]byte("lookup"))
+			for term, ids := range terms {
+				prev, err := decodeResultsList(b.Get([]byte(term)))
+				if err != nil {
+					fmt.Println(err)
+					return fmt.Errorf("tx failed: %v", err)
+				}
+				update := mergeIDs(ids, prev)
+				if len(update) > maxPrefixRefs {
+					update, err = rankIDs(lu, update, maxPrefixRefs)
+					if err != nil {
+						fmt.Println(err)
+						return fmt.Errorf("tx failed: %v", err)
+					}
+				}
+				data, err := encodeResultsList(resultList{Results: update})
+				if err != nil {
+					fmt.Println(err)
+					return fmt.Errorf("tx failed: %v", err)
+				}
+				if err := b.Put([]byte(term), data); err != nil {
+					return fmt.Errorf("tx failed: %v", err)
+				}
+			}
+			return nil
+		}); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("savePrefixIndex failed: %v", err)
+		}
+	}
+	fmt.Println("prefix index saved!")
+	return nil
+}
+
+// rankIDs returns the n highest ranked IDs from the list using the
+// SearchData objects stored in the lookup bucket.
+func rankIDs(lu *bolt.Bucket, ids []string, n int) ([]string, error) {
+	sds := []*SearchData{}
+	for _, ID := range ids {
+		sd := &SearchData{ID: ID}
+		if lu != nil {
+			data := lu.Get([]byte(ID))
+			if data != nil {
+				dec, err := decodeSearchData(data)
+				if err != nil {
+					fmt.Println(err)
+					return nil, fmt.Errorf("rankIDs failed: %v", err)
+				}
+				sd = dec
+				sd.ID = ID
+			}
+		}
+		sds = append(sds, sd)
+	}
+	sort.SliceStable(sds, func(i, j int) bool { return rankLess(sds[i], sds[j]) })
+
+	ranked := []string{}
+	for i, sd := range sds {
+		if i == n {
+			break
+		}
+		ranked = append(ranked, sd.ID)
+	}
+	return ranked, nil
+}
+
+// rankLess reports whether a is ranked higher than b.
+func rankLess(a, b *SearchData) bool {
+	if ra, rb := bucketRank(a), bucketRank(b); ra != rb {
+		return ra < rb
+	}
+	if len(a.Years) != len(b.Years) {
+		return len(a.Years) > len(b.Years)
+	}
+	if ya, yb := latestYear(a.Years), latestYear(b.Years); ya != yb {
+		return ya > yb
+	}
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return a.ID < b.ID
+}
+
+// bucketRank returns the rank of the object's bucket; unknown buckets are ranked last.
+func bucketRank(sd *SearchData) int {
+	r, ok := bucketRanks[sd.Bucket]
+	if !ok {
+		return len(bucketRanks)
+	}
+	return r
+}
+
+// latestYear returns the most recent year in the list.
+func latestYear(years []string) string {
+	max := ""
+	for _, y := range years {
+		if y > max {
+			max = y
+		}
+	}
+	return max
+}
+
+// matchTerms returns true if each query term begins one of the name terms.
+func matchTerms(query, name []string) bool {
+	for _, q := range query {
+		found := false
+		for _, t := range name {
+			if strings.HasPrefix(t, q) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// hasTerm returns true if the term is in the list.
+func hasTerm(terms []string, term string) bool {
+	for _, t := range terms {
+		if t == term {
+			return true
+		}
+	}
+	return false
+}
+
+// derive prefix index bucket name from term
+func prefixBucket(term string) string {
+	return "prefix_" + getPartition(term)
+}
@@ -0,0 +1,89 @@
+package indexing
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+var testLookup = lookupPairs{
+	"P00000001":                        &SearchData{ID: "P00000001", Name: "PELOSI, NANCY", City: "SAN FRANCISCO", State: "CA", Employer: "DEM", Bucket: "candidates", Years: []string{"2020", "2018"}},
+	"C00000001":                        &SearchData{ID: "C00000001", Name: "PELOSI FOR CONGRESS", City: "SAN FRANCISCO", State: "CA", Employer: "DEM", Bucket: "committees", Years: []string{"2020"}},
+	"indv00000000000000000000000000a1": &SearchData{ID: "indv00000000000000000000000000a1", Name: "PELOSI, PAUL", City: "SAN FRANCISCO", State: "CA", Employer: "FINANCIAL LEASING SERVICES", Bucket: "individuals", Years: []string{"2020"}},
+	"indv00000000000000000000000000a2": &SearchData{ID: "indv00000000000000000000000000a2", Name: "PELOQUIN, NANCY", City: "BOSTON", State: "MA", Employer: "SELF", Bucket: "individuals", Years: []string{"2020", "2018"}},
+	"indv00000000000000000000000000a3": &SearchData{ID: "indv00000000000000000000000000a3", Name: "SMITH, JOHN", City: "PELOTON", State: "CA", Employer: "NONE", Bucket: "individuals", Years: []string{"2020"}},
+}
+
+// initTestIndex builds the search index, lookup objects and prefix index from the
+// test objects in a temporary search_index.db and returns the directory.
+func initTestIndex(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "indexing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	OUTPUT_PATH = dir
+	if err := os.Mkdir(dir+"/db", 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	index := make(indexMap)
+	lookup := make(lookupPairs)
+	for k, v := range testLookup {
+		sd := *v
+		sd.Years = append([]string{}, v.Years...)
+		lookup[k] = &sd
+		for _, term := range formatTerms(getTerms(&sd)) {
+			if filter(term) {
+				continue
+			}
+			prt := getPartition(term)
+			if index[prt] == nil {
+				index[prt] = make(map[string][]string)
+			}
+			index[prt][term] = append(index[prt][term], k)
+		}
+	}
+	if _, _, err := saveIndex(&IndexData{Shards: make(ShardMap)}, index, lookup); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestAutocomplete(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{"pelo", 10, []string{"P00000001", "C00000001", "indv00000000000000000000000000a2", "indv00000000000000000000000000a1"}},
+		{"Pelo", 2, []string{"P00000001", "C00000001"}},
+		{"pelosi", 10, []string{"P00000001", "C00000001", "indv00000000000000000000000000a1"}},
+		{"nancy pel", 10, []string{"P00000001", "indv00000000000000000000000000a2"}},
+		{"paul pelo", 10, []string{"indv00000000000000000000000000a1"}},
+		{"xyz", 10, []string{}},
+		{"", 10, []string{}},
+	}
+	for _, test := range tests {
+		sds, err := Autocomplete(test.prefix, test.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []string{}
+		for _, sd := range sds {
+			got = append(got, sd.ID)
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("Autocomplete(%q) = %v; want %v", test.prefix, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("Autocomplete(%q) = %v; want %v", test.prefix, got, test.want)
+				break
+			}
+		}
+	}
+}
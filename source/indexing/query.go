@@ -0,0 +1,489 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains operations for parsing the user's query text into
+// search terms, phrases, boolean operators and fielded filters and matching
+// SearchData objects to the parsed query.
+package indexing
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+/*
+	QUERY SYNTAX
+	Filters are written as field:value and may appear anywhere in the query;
+	the remaining words are the query's search terms:
+		pelosi state:CA type:committee
+		smith employer:google year:2018
+		smith employer:"goldman sachs"
+	Supported fields:
+		- state: 2 letter postal code (state:CA)
+		- type: individual, committee or candidate (type:cmte, type:pac, type:donor)
+		- party: party code of committees and candidates (party:DEM)
+		- year: election year the object appears in (year:2018)
+		- employer: employer of individuals (employer:google)
+	Multiple values for the same field match any of the values; different
+	fields must all match. Filters are applied to the SearchData objects of
+	the IDs matching the search terms. If the query has no search terms, the
+	employer and state filter values are used as search terms.
+
+	Search terms are implicitly ANDed. Operators:
+		- "quoted phrase": the words must appear in order in the same field;
+		  names in "LAST, FIRST" format also match "FIRST LAST"
+		- OR: matches the terms before or after the operator (uppercase only)
+		- NOT term, -term, -"phrase": excludes results containing the term or phrase
+	AND binds tighter than OR, and excluded terms apply to every OR group:
+		nancy pelosi OR "paul pelosi" -congress
+	matches (nancy AND pelosi) OR "paul pelosi", excluding results with the
+	term congress. The IDs for each OR group are found by intersecting the
+	shards of the group's terms (phrase words included); the union of the
+	groups is returned minus the IDs in the excluded terms' shards. Phrases
+	are verified against the SearchData objects of the matching IDs.
+*/
+
+// filter fields
+const (
+	FilterState    = "state"
+	FilterType     = "type"
+	FilterParty    = "party"
+	FilterYear     = "year"
+	FilterEmployer = "employer"
+)
+
+// type filter values -> SearchData buckets
+var typeBuckets = map[string]string{
+	"individual": "individuals", "individuals": "individuals", "indv": "individuals", "donor": "individuals",
+	"committee": "committees", "committees": "committees", "cmte": "committees", "pac": "committees",
+	"candidate": "candidates", "candidates": "candidates", "cand": "candidates",
+}
+
+// Filters contains the fielded filters parsed from a query.
+type Filters struct {
+	States    []string
+	Types     []string // SearchData buckets
+	Parties   []string
+	Years     []string
+	Employers []string
+}
+
+// Clause is a group of search terms and phrases that must all match.
+type Clause struct {
+	Terms   []string
+	Phrases []string
+}
+
+// token is a word or quoted phrase in the query text.
+type token struct {
+	text   string
+	phrase bool
+	not    bool // preceded by '-'
+}
+
+// parseQuery splits the query text into OR'ed clauses, excluded
+// terms and phrases, and filters.
+func parseQuery(text string) Query {
+	q := Query{}
+	words := []string{} // positive terms & phrases in order
+	clause := Clause{}
+	not := false
+	for _, tk := range tokenize(text) {
+		if !tk.phrase {
+			switch tk.text {
+			case "OR":
+				q.Clauses = appendClause(q.Clauses, clause)
+				clause = Clause{}
+				not = false
+				continue
+			case "NOT":
+				not = true
+				continue
+			case "AND":
+				continue
+			}
+		}
+		excl := tk.not || not
+		not = false
+
+		if tk.phrase {
+			p := strings.Join(strings.Fields(tk.text), " ")
+			switch {
+			case p == "":
+			case excl:
+				q.Exclude.Phrases = append(q.Exclude.Phrases, p)
+			default:
+				clause.Phrases = append(clause.Phrases, p)
+				words = append(words, p)
+			}
+			continue
+		}
+		if field, v, ok := parseFilter(tk.text); ok {
+			if !excl { // filters can't be negated
+				q.Filters.add(field, v)
+			}
+			continue
+		}
+		if excl {
+			q.Exclude.Terms = append(q.Exclude.Terms, tk.text)
+			continue
+		}
+		clause.Terms = append(clause.Terms, tk.text)
+		words = append(words, tk.text)
+	}
+	q.Clauses = appendClause(q.Clauses, clause)
+	q.Text = strings.Join(words, " ")
+	return q
+}
+
+// tokenize splits the query text into words and quoted phrases.
+func tokenize(text string) []token {
+	tokens := []token{}
+	rs := []rune(text)
+	not := false
+	for i := 0; i < len(rs); {
+		switch {
+		case unicode.IsSpace(rs[i]):
+			i++
+		case rs[i] == '-' && i+1 < len(rs) && !unicode.IsSpace(rs[i+1]):
+			not = true
+			i++
+		case rs[i] == '"':
+			j := i + 1
+			for j < len(rs) && rs[j] != '"' {
+				j++
+			}
+			tokens = append(tokens, token{text: string(rs[i+1 : j]), phrase: true, not: not})
+			not = false
+			i = j + 1
+		default:
+			j, quoted := i, false // quoted filter values may contain spaces
+			for j < len(rs) && (quoted || !unicode.IsSpace(rs[j])) {
+				if rs[j] == '"' {
+					quoted = !quoted
+				}
+				j++
+			}
+			tokens = append(tokens, token{text: string(rs[i:j]), not: not})
+			not = false
+			i = j
+		}
+	}
+	return tokens
+}
+
+// appendClause appends the clause to the list if not empty.
+func appendClause(cs []Clause, c Clause) []Clause {
+	if len(c.Terms) == 0 && len(c.Phrases) == 0 {
+		return cs
+	}
+	return append(cs, c)
+}
+
+// parseFilter returns the field and value of a field:value filter.
+func parseFilter(word string) (string, string, bool) {
+	ss := strings.SplitN(word, ":", 2)
+	if len(ss) != 2 {
+		return "", "", false
+	}
+	field, v := strings.ToLower(ss[0]), strings.TrimSpace(strings.Trim(ss[1], `"`))
+	switch field {
+	case FilterState, FilterType, FilterParty, FilterYear, FilterEmployer:
+		return field, v, v != ""
+	}
+	return "", "", false
+}
+
+// add adds the filter value to the field.
+func (f *Filters) add(field, v string) {
+	switch field {
+	case FilterState:
+		f.States = append(f.States, strings.ToUpper(v))
+	case FilterType:
+		b := typeBuckets[strings.ToLower(v)]
+		if b == "" {
+			b = strings.ToLower(v) // invalid type; matches no results
+		}
+		f.Types = append(f.Types, b)
+	case FilterParty:
+		f.Parties = append(f.Parties, strings.ToUpper(v))
+	case FilterYear:
+		f.Years = append(f.Years, v)
+	case FilterEmployer:
+		f.Employers = append(f.Employers, strings.ToLower(v))
+	}
+}
+
+// Empty returns true if no filters are set.
+func (f Filters) Empty() bool {
+	return len(f.States)+len(f.Types)+len(f.Parties)+len(f.Years)+len(f.Employers) == 0
+}
+
+// Match returns true if the SearchData object matches every filter.
+func (f Filters) Match(sd *SearchData) bool {
+	if len(f.States) > 0 && !hasTerm(f.States, sd.State) {
+		return false
+	}
+	if len(f.Types) > 0 && !hasTerm(f.Types, sd.Bucket) {
+		return false
+	}
+	if len(f.Parties) > 0 && (sd.Bucket == "individuals" || !hasTerm(f.Parties, strings.ToUpper(sd.Employer))) {
+		return false
+	}
+	if len(f.Years) > 0 {
+		found := false
+		for _, y := range sd.Years {
+			if hasTerm(f.Years, y) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Employers) > 0 {
+		if sd.Bucket != "individuals" {
+			return false
+		}
+		emp := termSet(sd.Employer)
+		found := false
+		for _, e := range f.Employers {
+			all := true
+			for _, t := range formatTerms([]string{e}) {
+				if !emp[t] {
+					all = false
+					break
+				}
+			}
+			if all {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the filters in query syntax ordered by field.
+func (f Filters) String() string {
+	ss := []string{}
+	for _, fv := range []struct {
+		field  string
+		values []string
+	}{
+		{FilterState, f.States},
+		{FilterType, f.Types},
+		{FilterParty, f.Parties},
+		{FilterYear, f.Years},
+		{FilterEmployer, f.Employers},
+	} {
+		vs := append([]string{}, fv.values...)
+		sort.Strings(vs)
+		for _, v := range vs {
+			if strings.Contains(v, " ") {
+				v = `"` + v + `"`
+			}
+			ss = append(ss, fv.field+":"+v)
+		}
+	}
+	return strings.Join(ss, " ")
+}
+
+// Text returns the clause's terms and the words of its phrases.
+func (c Clause) Text() string {
+	return strings.Join(append(append([]string{}, c.Terms...), c.Phrases...), " ")
+}
+
+// String returns the clause in query syntax.
+func (c Clause) String() string {
+	ss := append([]string{}, c.Terms...)
+	for _, p := range c.Phrases {
+		ss = append(ss, `"`+p+`"`)
+	}
+	return strings.Join(ss, " ")
+}
+
+// match returns true if the object's fields contain every term and phrase in the clause.
+func (c Clause) match(fields [][]string, terms map[string]bool) bool {
+	for _, t := range formatTerms(strings.Split(strings.Join(c.Terms, " "), " ")) {
+		if !terms[t] {
+			return false
+		}
+	}
+	for _, p := range c.Phrases {
+		if !containsPhrase(fields, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsPhrase returns true if the words of the phrase appear in order in one of the fields.
+func containsPhrase(fields [][]string, phrase string) bool {
+	pw := formatTerms([]string{phrase})
+	if len(pw) == 0 {
+		return true
+	}
+	for _, f := range fields {
+		for i := 0; i+len(pw) <= len(f); i++ {
+			found := true
+			for j := range pw {
+				if f[i+j] != pw[j] {
+					found = false
+					break
+				}
+			}
+			if found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SearchText returns the query's search terms. If the query has no search
+// terms, the employer and state filter values are returned as search terms.
+func (q Query) SearchText() string {
+	if strings.TrimSpace(q.Text) != "" {
+		return q.Text
+	}
+	ss := append([]string{}, q.Filters.Employers...)
+	for _, s := range q.Filters.States {
+		if stateNames[s] != "" {
+			ss = append(ss, stateNames[s])
+		}
+	}
+	return strings.Join(ss, " ")
+}
+
+// Terms returns the formatted index terms searched for the query,
+// including excluded terms.
+func (q Query) Terms() []string {
+	return formatTerms(strings.Split(q.SearchText()+" "+strings.Join(q.Exclude.Terms, " "), " "))
+}
+
+// Match returns true if the SearchData object matches the query's filters
+// and phrases. The object's ID is assumed to match the query's terms.
+func (q Query) Match(sd *SearchData) bool {
+	if !q.Filters.Match(sd) {
+		return false
+	}
+	if !q.hasPhrases() {
+		return true
+	}
+	fields := [][]string{}
+	terms := map[string]bool{strings.ToLower(sd.ID): true} // lookup by ID
+	for _, f := range append(getTerms(sd), reorderName(sd.Name)) {
+		ft := formatTerms([]string{f})
+		fields = append(fields, ft)
+		for _, t := range ft {
+			terms[t] = true
+		}
+	}
+	for _, p := range q.Exclude.Phrases {
+		if containsPhrase(fields, p) {
+			return false
+		}
+	}
+	if len(q.Clauses) == 0 {
+		return true
+	}
+	for _, c := range q.Clauses {
+		if c.match(fields, terms) {
+			return true
+		}
+	}
+	return false
+}
+
+// reorderName returns a name in "LAST, FIRST MIDDLE" format as "FIRST MIDDLE LAST".
+func reorderName(name string) string {
+	ss := strings.SplitN(name, ",", 2)
+	if len(ss) != 2 {
+		return ""
+	}
+	return strings.TrimSpace(ss[1]) + " " + strings.TrimSpace(ss[0])
+}
+
+// filtered returns true if the results must be matched to the
+// query's filters or phrases after the search.
+func (q Query) filtered() bool {
+	return !q.Filters.Empty() || q.hasPhrases()
+}
+
+// hasPhrases returns true if the query contains phrases.
+func (q Query) hasPhrases() bool {
+	if len(q.Exclude.Phrases) > 0 {
+		return true
+	}
+	for _, c := range q.Clauses {
+		if len(c.Phrases) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize returns a copy of the query with its terms and phrases
+// formatted as index terms. Terms in repl are replaced with their values.
+func (q Query) normalize(repl map[string]string) Query {
+	nq := q
+	nq.Clauses = []Clause{}
+	words := []string{}
+	for _, c := range q.Clauses {
+		nc := Clause{
+			Terms:   replaceTerms(c.Terms, repl),
+			Phrases: replaceTerms(c.Phrases, repl),
+		}
+		nq.Clauses = append(nq.Clauses, nc)
+		words = append(words, nc.Text())
+	}
+	nq.Exclude = Clause{
+		Terms:   replaceTerms(q.Exclude.Terms, nil),
+		Phrases: replaceTerms(q.Exclude.Phrases, nil),
+	}
+	nq.Text = strings.Join(words, " ")
+	return nq
+}
+
+// replaceTerms formats each term or phrase and replaces the words found in repl.
+func replaceTerms(ss []string, repl map[string]string) []string {
+	out := []string{}
+	for _, s := range ss {
+		ws := formatTerms([]string{s})
+		for i, w := range ws {
+			if repl[w] != "" {
+				ws[i] = repl[w]
+			}
+		}
+		if len(ws) > 0 {
+			out = append(out, strings.Join(ws, " "))
+		}
+	}
+	return out
+}
+
+// String returns the query in query syntax.
+func (q Query) String() string {
+	ss := []string{}
+	for i, c := range q.Clauses {
+		if i > 0 {
+			ss = append(ss, "OR")
+		}
+		ss = append(ss, c.String())
+	}
+	for _, t := range q.Exclude.Terms {
+		ss = append(ss, "-"+t)
+	}
+	for _, p := range q.Exclude.Phrases {
+		ss = append(ss, `-"`+p+`"`)
+	}
+	if f := q.Filters.String(); f != "" {
+		ss = append(ss, f)
+	}
+	return strings.Join(ss, " ")
+}
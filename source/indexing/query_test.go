@@ -0,0 +1,135 @@
+package indexing
+
+import (
+	"os"
+	"testing"
+)
+
+func TestCreateQuery(t *testing.T) {
+	var tests = []struct {
+		text  string
+		terms string
+		str   string
+	}{
+		{"pelosi", "pelosi", "pelosi"},
+		{"pelosi state:ca TYPE:cmte", "pelosi", "pelosi state:CA type:committees"},
+		{"year:2018 nancy party:dem year:2020", "nancy", "nancy party:DEM year:2018 year:2020"},
+		{"employer:google", "", "employer:google"},
+		{"re: smith foo:bar", "re: smith foo:bar", "re: smith foo:bar"},
+		{`employer:"Goldman Sachs" smith`, "smith", `smith employer:"goldman sachs"`},
+		{`"nancy  pelosi" OR paul -congress`, "nancy pelosi paul", `"nancy pelosi" OR paul -congress`},
+		{`pelosi NOT "for congress" OR OR smith-jones`, "pelosi smith-jones", `pelosi OR smith-jones -"for congress"`},
+		{`-state:CA pelosi`, "pelosi", "pelosi"},
+	}
+	for _, test := range tests {
+		q := CreateQuery(test.text, "test")
+		if q.Text != test.terms || q.String() != test.str {
+			t.Errorf("CreateQuery(%q) = %q, %q; want %q, %q", test.text, q.Text, q.String(), test.terms, test.str)
+		}
+	}
+}
+
+func TestFilteredSearch(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		text string
+		want []string
+	}{
+		{"pelosi", []string{"P00000001", "C00000001", "indv00000000000000000000000000a1"}},
+		{"pelosi type:committee", []string{"C00000001"}},
+		{"nancy state:MA", []string{"indv00000000000000000000000000a2"}},
+		{"nancy year:2018 party:DEM", []string{"P00000001"}},
+		{"pelosi employer:financial", []string{"indv00000000000000000000000000a1"}},
+		{"pelosi employer:google", []string{}},
+		{"state:CA type:individual", []string{"indv00000000000000000000000000a1", "indv00000000000000000000000000a3"}},
+	}
+	for _, test := range tests {
+		checkSearch(t, test.text, test.want)
+	}
+}
+
+func TestBooleanSearch(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		text string
+		want []string
+	}{
+		{"nancy OR paul", []string{"P00000001", "indv00000000000000000000000000a1", "indv00000000000000000000000000a2"}},
+		{"paul OR xyzzy", []string{"indv00000000000000000000000000a1"}},
+		{"pelosi -congress", []string{"P00000001", "indv00000000000000000000000000a1"}},
+		{"pelosi NOT nancy NOT paul", []string{"C00000001"}},
+		{`"nancy pelosi"`, []string{"P00000001"}},
+		{`"pelosi nancy"`, []string{"P00000001"}},
+		{`"francisco san"`, []string{}},
+		{`"pelosi for congress" OR "john smith"`, []string{"C00000001", "indv00000000000000000000000000a3"}},
+		{`"smith john" OR peloquin`, []string{"indv00000000000000000000000000a2", "indv00000000000000000000000000a3"}},
+		{`san francisco -"nancy pelosi"`, []string{"C00000001", "indv00000000000000000000000000a1"}},
+		{"nancy OR paul type:individual", []string{"indv00000000000000000000000000a1", "indv00000000000000000000000000a2"}},
+	}
+	for _, test := range tests {
+		checkSearch(t, test.text, test.want)
+	}
+}
+
+// checkSearch checks that the first page of results for the query contains the wanted IDs.
+func checkSearch(t *testing.T, text string, want []string) {
+	q := CreateQuery(text, "test")
+	hits, err := GetHitsFromShards(&IndexData{Shards: make(ShardMap)}, q)
+	if err != nil {
+		t.Fatalf("search %q: %v", text, err)
+	}
+	page, err := PageResults(q, hits, nil, nil, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]bool)
+	for _, sr := range page.Results {
+		got[sr.ID] = true
+	}
+	if len(got) != len(want) || page.TotalHits != len(want) {
+		t.Errorf("search %q = %v (total %d); want %v", text, got, page.TotalHits, want)
+		return
+	}
+	for _, ID := range want {
+		if !got[ID] {
+			t.Errorf("search %q = %v; want %v", text, got, want)
+			return
+		}
+	}
+}
+
+func TestShardedSearch(t *testing.T) {
+	n := 2*maxShardSize + 100
+	dir := initShardedTestIndex(t, n)
+	defer os.RemoveAll(dir)
+
+	id, err := getIndexData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Shards["jane"] == nil || id.Shards["jane"].Shards != 2 {
+		t.Fatalf("shards for 'jane' = %v; want 2", id.Shards["jane"])
+	}
+	for _, text := range []string{"jane doe", "doe jane nowhere", "nowhere jane doe"} {
+		q := CreateQuery(text, "test")
+		hits, err := GetHitsFromShards(id, q)
+		if err != nil {
+			t.Fatalf("search %q: %v", text, err)
+		}
+		seen := make(map[string]bool)
+		for _, ID := range hits.IDs {
+			if seen[ID] {
+				t.Errorf("search %q: duplicate hit %s", text, ID)
+				break
+			}
+			seen[ID] = true
+		}
+		if hits.Total != n || len(seen) != n {
+			t.Errorf("search %q = %d hits (%d unique); want %d", text, hits.Total, len(seen), n)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Package indexing contains operations for building, searching, and viewing
+// an index created from the complete data.
+// This file contains operations for scoring search results by relevance
+// to the query and returning the best matches.
+package indexing
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+/*
+	RELEVANCE SCORING
+	Each result is scored by how well the query terms match the object's fields
+	and by the object's importance (total $ value from the rankings data):
+		- match: average field weight of each query term, taking the best field
+		  the term is found in (name 1.0, employer/party 0.5, city 0.4, state 0.25),
+		  plus up to 0.25 for the share of the object's name covered by the query
+		- importance: log10(1 + total $) / 9, max 1.0 (1.0 = $1 billion)
+		- score: match + 0.5 * importance
+
+	Ties are broken by # of years, then ID. If more IDs match the query than can be
+	scored, IDs with a rankings total are scored first, followed by the remaining
+	IDs in index order. Queries with filters or phrases check every matching ID
+	in that order, maxCandidates IDs at a time, until maxCandidates IDs passing
+	the filters are found.
+*/
+
+// scoring parameters
+const (
+	maxMatches       = 250000 // max # of IDs returned for query; further hits are only counted in Hits.Total
+	maxCandidates    = 25000  // max # of IDs scored for query
+	importanceWeight = 0.5
+	coverageWeight   = 0.25
+)
+
+// field weights
+const (
+	nameWeight     = 1.0
+	employerWeight = 0.5
+	cityWeight     = 0.4
+	stateWeight    = 0.25
+)
+
+// ScoredResult is a SearchData object scored by relevance to the query.
+type ScoredResult struct {
+	SearchData
+	Match      float32 // term match quality
+	Importance float32 // normalized rankings total
+	Score      float32
+}
+
+// RankResults returns the n most relevant SearchData objects for the query from
+// the list of matching IDs. SearchData objects are retrieved from the cache or from
+// disk and objects not matching the query's filters or phrases are removed. totals maps object
+// IDs to their total $ value from the rankings data and may be nil.
+func RankResults(q Query, ids []string, cache map[string]SearchData, totals map[string]float32, n int) ([]ScoredResult, error) {
+	store := NewBoltStore(OUTPUT_PATH + "/db/search_index.db")
+	defer store.Close()
+	srs, err := RankResultsFromStore(store, q, ids, cache, totals, n)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("RankResults failed: %v", err)
+	}
+	return srs, nil
+}
+
+// RankResultsFromStore returns the n most relevant SearchData objects for the query
+// from the list of matching IDs. SearchData objects not found in the cache are
+// retrieved from the index store.
+func RankResultsFromStore(store IndexStore, q Query, ids []string, cache map[string]SearchData, totals map[string]float32, n int) ([]ScoredResult, error) {
+	sds, _, err := filterCandidates(store, q, ids, cache, totals)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("RankResultsFromStore failed: %v", err)
+	}
+	return ScoreResults(q, sds, totals, n), nil
+}
+
+// filterCandidates returns at most maxCandidates SearchData objects to score and
+// the # of IDs checked. Unfiltered queries return the first maxCandidates IDs selected;
+// filtered queries check the IDs maxCandidates at a time until maxCandidates
+// objects matching the query's filters and phrases are found or every ID is checked.
+func filterCandidates(store IndexStore, q Query, ids []string, cache map[string]SearchData, totals map[string]float32) ([]SearchData, int, error) {
+	if !q.filtered() {
+		ids = selectCandidates(ids, totals, maxCandidates)
+	} else if len(ids) > maxCandidates {
+		ids = orderCandidates(ids, totals)
+	}
+
+	matched := []SearchData{}
+	checked := 0
+	for checked < len(ids) && len(matched) < maxCandidates {
+		end := checked + maxCandidates
+		if end > len(ids) {
+			end = len(ids)
+		}
+		sds, err := lookupCandidates(store, ids[checked:end], cache)
+		if err != nil {
+			fmt.Println(err)
+			return nil, 0, fmt.Errorf("filterCandidates failed: %v", err)
+		}
+		matched = append(matched, FilterResults(q, sds)...)
+		checked = end
+	}
+	if len(matched) > maxCandidates {
+		matched = matched[:maxCandidates]
+	}
+	return matched, checked, nil
+}
+
+// lookupCandidates returns the SearchData objects for the IDs from
+// the cache or from the index store if not found in the cache.
+func lookupCandidates(store IndexStore, ids []string, cache map[string]SearchData) ([]SearchData, error) {
+	nilIDs, frmCache := LookupSearchDataFromCache(ids, cache)
+	if len(nilIDs) == 0 {
+		return frmCache, nil
+	}
+	frmDisk, err := store.GetSearchData(nilIDs)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("lookupCandidates failed: %v", err)
+	}
+	return append(frmCache, frmDisk...), nil
+}
+
+// FilterResults returns the SearchData objects matching the query's filters and phrases.
+func FilterResults(q Query, sds []SearchData) []SearchData {
+	if !q.filtered() {
+		return sds
+	}
+	filtered := []SearchData{}
+	for i := range sds {
+		if q.Match(&sds[i]) {
+			filtered = append(filtered, sds[i])
+		}
+	}
+	return filtered
+}
+
+// ScoreResults scores each SearchData object by relevance to the query
+// and returns the n highest scoring objects in order. Objects are scored
+// by the best matching OR group of terms in the query.
+func ScoreResults(q Query, sds []SearchData, totals map[string]float32, n int) []ScoredResult {
+	groups := [][]string{scoreTerms(q.SearchText())}
+	if len(q.Clauses) > 1 {
+		groups = [][]string{}
+		for _, c := range q.Clauses {
+			groups = append(groups, scoreTerms(c.Text()))
+		}
+	}
+
+	results := []ScoredResult{}
+	for _, sd := range sds {
+		sr := ScoredResult{SearchData: sd}
+		for _, terms := range groups {
+			if m := matchScore(terms, &sd); m > sr.Match {
+				sr.Match = m
+			}
+		}
+		sr.Importance = importance(totals[sd.ID])
+		sr.Score = sr.Match + importanceWeight*sr.Importance
+		results = append(results, sr)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if len(results[i].Years) != len(results[j].Years) {
+			return len(results[i].Years) > len(results[j].Years)
+		}
+		return results[i].ID < results[j].ID
+	})
+	if n > 0 && len(results) > n {
+		results = results[:n]
+	}
+	return results
+}
+
+// scoreTerms returns the formatted search terms in the text.
+func scoreTerms(text string) []string {
+	terms := []string{}
+	for _, t := range formatTerms(strings.Split(text, " ")) {
+		if !filter(t) {
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// matchScore returns the term match quality of the query terms for the object.
+func matchScore(terms []string, sd *SearchData) float32 {
+	if len(terms) == 0 {
+		return 0
+	}
+	fields := getTerms(sd) // name, city, state, employer/party
+	name := termSet(fields[0])
+	weights := []struct {
+		terms  map[string]bool
+		weight float32
+	}{
+		{name, nameWeight},
+		{termSet(fields[3]), employerWeight},
+		{termSet(fields[1]), cityWeight},
+		{termSet(fields[2]), stateWeight},
+	}
+
+	total, inName := float32(0), 0
+	for _, t := range terms {
+		for _, w := range weights {
+			if w.terms[t] {
+				total += w.weight
+				break
+			}
+		}
+		if name[t] {
+			inName++
+		}
+	}
+	score := total / float32(len(terms))
+	if len(name) > 0 {
+		score += coverageWeight * float32(inName) / float32(len(name))
+	}
+	return score
+}
+
+// termSet returns the set of formatted terms in the field.
+func termSet(field string) map[string]bool {
+	set := make(map[string]bool)
+	for _, t := range formatTerms([]string{field}) {
+		set[t] = true
+	}
+	return set
+}
+
+// importance returns the normalized importance of the rankings total.
+func importance(total float32) float32 {
+	if total <= 0 {
+		return 0
+	}
+	return float32(math.Min(1, math.Log10(1+float64(total))/9))
+}
+
+// selectCandidates returns at most max IDs to score; IDs with a rankings
+// total are selected first in order of total.
+func selectCandidates(ids []string, totals map[string]float32, max int) []string {
+	if len(ids) <= max {
+		return ids
+	}
+	return orderCandidates(ids, totals)[:max]
+}
+
+// orderCandidates returns the IDs with a rankings total in order of total
+// followed by the remaining IDs in index order.
+func orderCandidates(ids []string, totals map[string]float32) []string {
+	ranked, rest := []string{}, []string{}
+	for _, ID := range ids {
+		if totals[ID] > 0 {
+			ranked = append(ranked, ID)
+		} else {
+			rest = append(rest, ID)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return totals[ranked[i]] > totals[ranked[j]] })
+	return append(ranked, rest...)
+}
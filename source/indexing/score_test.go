@@ -0,0 +1,112 @@
+package indexing
+
+import (
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestScoreResults(t *testing.T) {
+	totals := map[string]float32{"P00000001": 3000000, "C00000001": 5000000, "indv00000000000000000000000000a1": 2500}
+
+	var tests = []struct {
+		text string
+		ids  []string // IDs matching query
+		n    int
+		want []string
+	}{
+		// full name match ranks above partial name match
+		{"nancy", []string{"indv00000000000000000000000000a2", "P00000001"}, 2, []string{"P00000001", "indv00000000000000000000000000a2"}},
+		// shorter names covered by the query rank higher
+		{"pelosi", []string{"indv00000000000000000000000000a1", "P00000001", "C00000001"}, 3, []string{"P00000001", "C00000001", "indv00000000000000000000000000a1"}},
+		// equal match quality ranked by rankings totals
+		{"san francisco", []string{"indv00000000000000000000000000a1", "P00000001", "C00000001"}, 1, []string{"C00000001"}},
+	}
+	for _, test := range tests {
+		sds := []SearchData{}
+		for _, id := range test.ids {
+			sds = append(sds, *testLookup[id])
+		}
+		srs := ScoreResults(CreateQuery(test.text, "test"), sds, totals, test.n)
+		got := []string{}
+		for _, sr := range srs {
+			got = append(got, sr.ID)
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("ScoreResults(%q) = %v; want %v", test.text, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("ScoreResults(%q) = %v; want %v", test.text, got, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestRankResults(t *testing.T) {
+	dir := initTestIndex(t)
+	defer os.RemoveAll(dir)
+
+	q := CreateQuery("san francisco", "test")
+	ids, err := GetResultsFromShards(&IndexData{Shards: make(ShardMap)}, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 {
+		t.Fatalf("GetResultsFromShards(%q) = %v; want 3 IDs", q.Text, ids)
+	}
+	totals := map[string]float32{"indv00000000000000000000000000a1": 1000000}
+	srs, err := RankResults(q, ids, nil, totals, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srs) != 2 || srs[0].ID != "indv00000000000000000000000000a1" {
+		t.Errorf("RankResults(%q) = %v; want indv00000000000000000000000000a1 first", q.Text, srs)
+	}
+}
+
+func TestRankResultsFiltered(t *testing.T) {
+	// only the last 10 IDs in index order match the state filter
+	n := maxCandidates + 10
+	mem := NewMemoryStore()
+	ids := []string{}
+	for i := 0; i < n; i++ {
+		sd := SearchData{ID: fmt.Sprintf("%032x", i), Name: "DOE, JANE", City: "NOWHERE", State: "NY", Bucket: "individuals", Years: []string{"2020"}}
+		if i >= maxCandidates {
+			sd.State = "CA"
+		}
+		mem.Lookup[sd.ID] = sd
+		ids = append(ids, sd.ID)
+	}
+	totals := map[string]float32{ids[n-1]: 1000} // checked first
+
+	var tests = []struct {
+		text      string
+		want      int
+		wantTotal int
+	}{
+		{"jane doe state:CA", 10, 10},
+		{"jane doe state:NY", maxCandidates, maxCandidates},
+		{"jane doe state:TX", 0, 0},
+		{"jane doe", maxCandidates, n},
+	}
+	for _, test := range tests {
+		q := CreateQuery(test.text, "test")
+		srs, err := RankResultsFromStore(mem, q, ids, nil, totals, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(srs) != test.want {
+			t.Errorf("RankResultsFromStore(%q): %d results; want %d", test.text, len(srs), test.want)
+		}
+		page, err := PageResultsFromStore(mem, q, Hits{IDs: ids, Total: n}, nil, totals, 0, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.TotalHits != test.wantTotal || page.Estimated {
+			t.Errorf("PageResultsFromStore(%q): %d total hits (estimated: %v); want %d", test.text, page.TotalHits, page.Estimated, test.wantTotal)
+		}
+	}
+}
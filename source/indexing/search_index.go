@@ -20,7 +20,10 @@ import (
 
 // Query uses text from user input to look up corresponding results.
 type Query struct {
-	Text      string
+	Text      string   // search terms
+	Clauses   []Clause // OR'ed groups of terms & phrases
+	Exclude   Clause   // NOT terms & phrases
+	Filters   Filters  // fielded filters
 	UserID    string
 	TimeStamp time.Time
 }
@@ -104,13 +107,12 @@ type missingLog struct {
 
 /* DYNAMO OPERATIONS */
 
-// CreateQuery returns a Query object with the given data
+// CreateQuery returns a Query object with the given data.
+// Operators, phrases and fielded filters are parsed from the text.
 func CreateQuery(text, UID string) Query {
-	q := Query{
-		Text:      text,
-		UserID:    UID,
-		TimeStamp: time.Now(),
-	}
+	q := parseQuery(text)
+	q.UserID = UID
+	q.TimeStamp = time.Now()
 	return q
 }
 
@@ -368,57 +370,148 @@ func logMissingItem(db *dynamo.DbInfo, ID string, lookup bool) error {
 
 // GetResultsFromShards returns search results from the sharded index stored on disk
 func GetResultsFromShards(id *IndexData, q Query) ([]string, error) {
-	st := time.Now()
-	if q.Text == "" {
-		return []string{}, nil
+	hits, err := GetHitsFromShards(id, q)
+	if err != nil {
+		return []string{}, err
 	}
+	if hits.Total > len(hits.IDs) {
+		fmt.Println("GetResults failed: MAX_LENGTH exceeded")
+		return []string{}, fmt.Errorf("MAX_LENGTH")
+	}
+	return hits.IDs, nil
+}
 
-	terms := formatTerms(strings.Split(q.Text, " ")) // normalize search terms input
-	common := []string{}                             // aggegate total of intersections for every shard
-	maxResultsSize := 500                            // max number of SearchResults returned before throwing MAX_LENGTH error
+// GetHitsFromShards returns the IDs matching the query from the sharded index stored
+// on disk with the total # of hits.
+func GetHitsFromShards(id *IndexData, q Query) (Hits, error) {
+	store := NewBoltStore(OUTPUT_PATH + "/db/search_index.db")
+	defer store.Close()
+	hits, err := GetHitsFromStore(store, id, q)
+	if err != nil && err.Error() != "NO_RESULTS" {
+		fmt.Println(err)
+		return Hits{}, fmt.Errorf("GetHitsFromShards failed: %v", err)
+	}
+	return hits, err
+}
 
-	// open db
-	db, err := bolt.Open(OUTPUT_PATH+"/db/search_index.db", 0644, nil)
-	if err != nil {
+// GetHitsFromDynamo returns the IDs matching the query from the sharded
+// index stored in DynamoDB with the total # of hits.
+func GetHitsFromDynamo(db *dynamo.DbInfo, id *IndexData, q Query) (Hits, error) {
+	hits, err := GetHitsFromStore(NewDynamoStore(db), id, q)
+	if err != nil && err.Error() != "NO_RESULTS" {
 		fmt.Println(err)
-		return nil, fmt.Errorf("getShard failed: %v", err)
+		return Hits{}, fmt.Errorf("GetHitsFromDynamo failed: %v", err)
+	}
+	return hits, err
+}
+
+// GetHitsFromStore returns the IDs matching the query from the index store with the
+// total # of hits. At most maxMatches IDs are returned; the total for single term
+// queries exceeding maxMatches is estimated from the term's # of shards. Returns the
+// union of the IDs matching each OR group of terms in the query minus the IDs
+// matching the excluded terms.
+func GetHitsFromStore(store IndexStore, id *IndexData, q Query) (Hits, error) {
+	st := time.Now()
+	text := q.SearchText()
+	if text == "" {
+		return Hits{IDs: []string{}}, nil
+	}
+
+	groups := [][]string{formatTerms(strings.Split(text, " "))} // normalize search terms input
+	if len(q.Clauses) > 1 {
+		groups = [][]string{}
+		for _, c := range q.Clauses {
+			groups = append(groups, formatTerms(strings.Split(c.Text(), " ")))
+		}
+	}
+
+	// find union of IDs matching each group
+	hits := Hits{IDs: []string{}}
+	found := false
+	for _, terms := range groups {
+		h, err := intersectShards(store, id, terms)
+		if err != nil {
+			if err.Error() == "NO_RESULTS" {
+				continue
+			}
+			fmt.Println(err)
+			return Hits{}, fmt.Errorf("GetHitsFromStore failed: %v", err)
+		}
+		hits = unionHits(hits, h)
+		found = true
+	}
+	if !found {
+		return Hits{}, fmt.Errorf("NO_RESULTS")
+	}
+
+	// remove IDs matching excluded terms
+	for _, t := range formatTerms(q.Exclude.Terms) {
+		var err error
+		hits, err = excludeShards(store, id, hits, t)
+		if err != nil {
+			fmt.Println(err)
+			return Hits{}, fmt.Errorf("GetHitsFromStore failed: %v", err)
+		}
+	}
+	if len(hits.IDs) > maxMatches {
+		hits.IDs = hits.IDs[:maxMatches]
+	}
+
+	fmt.Println("finish - ", time.Since(st))
+	return hits, nil
+}
+
+// intersectShards returns the IDs common to every term from the index store
+// with the total # of hits. At most maxMatches IDs are returned.
+func intersectShards(store IndexStore, id *IndexData, terms []string) (Hits, error) {
+	var err error
+	common := []string{}         // aggegate total of intersections for every shard
+	maxResultsSize := maxMatches // max number of IDs returned
+	if len(terms) == 0 {
+		return Hits{IDs: common}, nil
 	}
-	defer db.Close()
 
 	// get IDs for single term
 	if len(terms) == 1 {
-		t := terms[0]
-		if id.Shards[t] != nil && id.Shards[t].Shards > 0 {
-			fmt.Println("GetResults failed: MAX_LENGTH exceeded")
-			return []string{}, fmt.Errorf("MAX_LENGTH")
-		}
-		t = strings.TrimSpace(t)
+		t := strings.TrimSpace(terms[0])
 
 		// check if lookup by ID
 		lookup := checkForID(t)
 		if lookup {
-			return []string{strings.ToUpper(t)}, nil
+			return Hits{IDs: []string{strings.ToUpper(t)}, Total: 1}, nil
 		}
 
-		ids, err := getShard(db, t)
-		if err != nil {
-			fmt.Println(err)
-			return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
+		// get IDs from each shard; estimate total if max # of IDs exceeded
+		ct := 0
+		if id.Shards[t] != nil {
+			ct = int(id.Shards[t].Shards)
+		}
+		hits := Hits{IDs: []string{}}
+		for x := 0; x < ct+1; x++ {
+			if len(hits.IDs)+maxShardSize > maxResultsSize {
+				hits.Total = (ct + 1) * maxShardSize
+				hits.Estimated = true
+				break
+			}
+			k := t
+			if x > 0 {
+				k = t + "." + strconv.Itoa(x)
+			}
+			s, err := store.GetShard(k)
+			if err != nil {
+				fmt.Println(err)
+				return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
+			}
+			hits.IDs = append(hits.IDs, s...)
 		}
-		if len(ids) == 0 { // no IDs found for term - no results
+		if len(hits.IDs) == 0 { // no IDs found for term - no results
 			fmt.Println("GetResults failed: NO_RESULTS")
-			return []string{}, fmt.Errorf("NO_RESULTS")
+			return Hits{}, fmt.Errorf("NO_RESULTS")
 		}
-		// add results to common aggregate total; throw error if maxResultsSize reached
-		for i, ID := range ids {
-			if i >= maxResultsSize {
-				fmt.Println("GetResults failed: MAX_LENGTH exceeded")
-				return []string{}, fmt.Errorf("MAX_LENGTH")
-			}
-			common = append(common, ID)
+		if !hits.Estimated {
+			hits.Total = len(hits.IDs)
 		}
-		fmt.Println("finish - ", time.Since(st))
-		return common, nil
+		return hits, nil
 	}
 
 	// sort terms by # of shards, least to greatest
@@ -446,47 +539,47 @@ func GetResultsFromShards(id *IndexData, q Query) ([]string, error) {
 	// compare each shard (s0) in t0 to each shard in t1 (s1) within min/max range
 	for x := 0; x < int((ct0 + 1)); x++ {
 		s0 := []string{} // shard IDs - used to store IDs after first disk read call in inner loop
-		if x > 0 {
-			k0 = i0 + "." + strconv.Itoa(x)
-		}
+		k0 = shardKey(i0, x)
 
 		min0, max0 := "", ""
 		if ct0 == 0 {
 			st1 := time.Now()
-			s0, err = getShard(db, k0)
+			s0, err = store.GetShard(k0)
 			if err != nil {
 				fmt.Println(err)
-				return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
+				return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
 			}
 			if len(s0) == 0 { // no IDs found for term - no results
 				fmt.Println("GetResults failed: NO_RESULTS")
-				return []string{}, fmt.Errorf("NO_RESULTS")
+				return Hits{}, fmt.Errorf("NO_RESULTS")
 			}
 			fmt.Println("s0 read time: ", time.Since(st1))
 			min0, max0 = s0[0], s0[len(s0)-1]
 		} else {
 			r0 := id.Shards[i0].Ranges[k0]
-			min0, max0 = r0.Range[0], r0.Range[1] // min, max value of each shard in t1 compared to these values
+			min0, max0 = r0.Range[0], rangeMax(r0.Range[1]) // min, max value of each shard in t1 compared to these values
 		}
 
 		// get each shard in t1 (s1), compare to s0 and find intersection
 		for y := 0; y < int((ct1 + 1)); y++ {
 			s1 := []string{}
-			if y > 0 {
-				k1 = i1 + "." + strconv.Itoa(y)
-			}
+			k1 = shardKey(i1, y)
 
 			min1, max1 := "", ""
 			if ct1 == 0 {
-				s1, err := getShard(db, k1)
+				s1, err := store.GetShard(k1)
 				if err != nil {
 					fmt.Println(err)
-					return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
+					return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
+				}
+				if len(s1) == 0 { // no IDs found for term - no results
+					fmt.Println("GetResults failed: NO_RESULTS")
+					return Hits{}, fmt.Errorf("NO_RESULTS")
 				}
 				min1, max1 = s1[0], s1[len(s1)-1]
 			} else {
 				r1 := id.Shards[i1].Ranges[k1]
-				min1, max1 = r1.Range[0], r1.Range[1]
+				min1, max1 = r1.Range[0], rangeMax(r1.Range[1])
 			}
 
 			if min0 > max1 {
@@ -497,18 +590,18 @@ func GetResultsFromShards(id *IndexData, q Query) ([]string, error) {
 			}
 
 			if len(s1) == 0 {
-				s1, err = getShard(db, k1)
+				s1, err = store.GetShard(k1)
 				if err != nil {
 					fmt.Println(err)
-					return []string{}, fmt.Errorf("getRefs failed: %v", err)
+					return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
 				}
 			}
 
 			if len(s0) == 0 {
-				s0, err = getShard(db, k0)
+				s0, err = store.GetShard(k0)
 				if err != nil {
 					fmt.Println(err)
-					return []string{}, fmt.Errorf("getRefs failed: %v", err)
+					return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
 				}
 			}
 
@@ -521,7 +614,7 @@ func GetResultsFromShards(id *IndexData, q Query) ([]string, error) {
 	// find intersections of each remaining term
 	for t := 2; t < len(termsSrt); t++ {
 		if len(common) == 0 {
-			return common, nil
+			return Hits{IDs: common}, nil
 		}
 		i2 := termsSrt[t].ID // next term in list
 		k2 := i2
@@ -529,45 +622,50 @@ func GetResultsFromShards(id *IndexData, q Query) ([]string, error) {
 		common = []string{} // reset for each term
 
 		// get each shard in t1 (s1), compare to sc and find intersection
-		sc := []string{} // shard created from common IDs within range of s2
 		ct2 := float32(0.0)
 		if id.Shards[i2] != nil {
 			ct2 = id.Shards[i2].Shards
 		}
 		for z := 0; z < int((ct2 + 1)); z++ {
 			s2 := []string{}
-			if z > 0 {
-				k2 = i2 + "." + strconv.Itoa(z)
-			}
+			k2 = shardKey(i2, z)
 
 			min2, max2 := "", ""
 			if ct2 == 0 {
-				s2, err = getShard(db, k2)
+				s2, err = store.GetShard(k2)
 				if err != nil {
 					fmt.Println(err)
-					return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
+					return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
+				}
+				if len(s2) == 0 { // no IDs found for term - no results
+					fmt.Println("GetResults failed: NO_RESULTS")
+					return Hits{}, fmt.Errorf("NO_RESULTS")
 				}
 				min2, max2 = s2[0], s2[len(s2)-1]
 			} else {
 				r2 := id.Shards[i2].Ranges[k2]
-				min2, max2 = r2.Range[0], r2.Range[1]
+				min2, max2 = r2.Range[0], rangeMax(r2.Range[1])
 			}
 
 			// create comparision shard from buffer IDs within range
+			sc := []string{} // shard created from common IDs within range of s2
 			for _, v := range buffer {
-				if v >= max2 { // no more common values exist
+				if v > max2 { // no more common values exist
 					break // stop
 				}
 				if v >= min2 {
 					sc = append(sc, v)
 				}
 			}
+			if len(sc) == 0 {
+				continue
+			}
 
 			if len(s2) == 0 {
-				s2, err = getShard(db, k2)
+				s2, err = store.GetShard(k2)
 				if err != nil {
 					fmt.Println(err)
-					return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
+					return Hits{}, fmt.Errorf("intersectShards failed: %v", err)
 				}
 			}
 
@@ -579,13 +677,12 @@ func GetResultsFromShards(id *IndexData, q Query) ([]string, error) {
 	}
 
 	fmt.Println("len(common): ", len(common))
+	hits := Hits{IDs: common, Total: len(common)}
 	if len(common) > maxResultsSize {
-		fmt.Println("GetResults failed: MAX_LENGTH exceeded")
-		return []string{}, fmt.Errorf("MAX_LENGTH")
+		hits.IDs = common[:maxResultsSize]
 	}
 
-	fmt.Println("finish - ", time.Since(st))
-	return common, nil
+	return hits, nil
 }
 
 // get ids in single shard
@@ -595,6 +692,9 @@ func getShard(db *bolt.DB, id string) ([]string, error) {
 
 	err := db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket([]byte(prt))
+		if b == nil { // partition does not exist
+			return nil
+		}
 		id = strings.TrimSpace(id)
 		data := b.Get([]byte(id))
 		ids, err := decodeResultsList(data)
@@ -698,6 +798,100 @@ func intersection(s1, s2 []string) []string {
 	return common
 }
 
+// rangeMax returns the max value of a shard range. The max value of
+// the last shard of a term is not set ("!") and is unbounded.
+func rangeMax(max string) string {
+	if max == "!" {
+		return "~" // sorts after all alphanumeric IDs
+	}
+	return max
+}
+
+// union returns the sorted union of two string slices
+func union(s1, s2 []string) []string {
+	checkMap := make(map[string]bool)
+	all := []string{}
+	for _, s := range [][]string{s1, s2} {
+		for _, v := range s {
+			if !checkMap[v] {
+				checkMap[v] = true
+				all = append(all, v)
+			}
+		}
+	}
+	sort.Strings(all)
+	return all
+}
+
+// difference returns the values in s1 not found in s2
+func difference(s1, s2 []string) []string {
+	checkMap := make(map[string]bool)
+	for _, v := range s2 {
+		checkMap[v] = true
+	}
+	diff := []string{}
+	for _, v := range s1 {
+		if !checkMap[v] {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
+
+// unionHits returns the union of the IDs in both hits. IDs not
+// returned in either hits are added to the total.
+func unionHits(h1, h2 Hits) Hits {
+	if len(h1.IDs) == 0 && h1.Total == 0 {
+		return h2
+	}
+	ids := union(h1.IDs, h2.IDs)
+	return Hits{
+		IDs:       ids,
+		Total:     len(ids) + (h1.Total - len(h1.IDs)) + (h2.Total - len(h2.IDs)),
+		Estimated: h1.Estimated || h2.Estimated,
+	}
+}
+
+// excludeShards removes the IDs found in the term's shards from the hits.
+// Only shards within the range of the hits' IDs are read.
+func excludeShards(store IndexStore, id *IndexData, hits Hits, term string) (Hits, error) {
+	if len(hits.IDs) == 0 {
+		return hits, nil
+	}
+	ids := append([]string{}, hits.IDs...)
+	sort.Strings(ids)
+	min, max := ids[0], ids[len(ids)-1]
+
+	ct := 0
+	if id.Shards[term] != nil {
+		ct = int(id.Shards[term].Shards)
+	}
+	excl := []string{}
+	for x := 0; x < ct+1; x++ {
+		k := term
+		if x > 0 {
+			k = term + "." + strconv.Itoa(x)
+		}
+		if ct > 0 {
+			r := id.Shards[term].Ranges[k]
+			if len(r.Range) == 2 && (r.Range[0] > max || r.Range[1] < min) {
+				continue // skip
+			}
+		}
+		s, err := store.GetShard(k)
+		if err != nil {
+			fmt.Println(err)
+			return hits, fmt.Errorf("excludeShards failed: %v", err)
+		}
+		excl = append(excl, s...)
+	}
+
+	rest := difference(hits.IDs, excl)
+	hits.Total -= len(hits.IDs) - len(rest)
+	hits.IDs = rest
+	return hits, nil
+}
+
 // GetIndexData retrieves the index metadata from disk
 func GetIndexData() (*IndexData, error) {
 	id, err := getIndexData()
@@ -968,283 +1162,6 @@ func ConsolidateSearchData(origIDs []string, frmCache, frmDb []SearchData) []Sea
 
 /* DEPRECATED */
 
-// DEPRECATED
-/* // GetResultsFromDynamo returns search results from the sharded index stored in DynamoDB
-func GetResultsFromDynamo(db *dynamo.DbInfo, id *IndexData, q Query) ([]string, error) {
-	st := time.Now()
-	if q.Text == "" {
-		return []string{}, nil
-	}
-
-	terms := formatTerms(strings.Split(q.Text, " ")) // normalize search terms input
-	common := []string{}                             // aggegate total of intersections for every shard
-	maxResultsSize := 500                            // max number of SearchResults returned before throwing MAX_LENGTH error
-	var err error
-
-	// get IDs for single term
-	if len(terms) == 1 {
-		t := terms[0]
-		if id.Shards[t] != nil && id.Shards[t].Shards > 0 {
-			fmt.Println("GetResults failed: MAX_LENGTH exceeded")
-			return []string{}, fmt.Errorf("MAX_LENGTH")
-		}
-		t = strings.TrimSpace(t)
-
-		// check if lookup by ID
-		lookup := checkForID(t)
-		if lookup {
-			return []string{strings.ToUpper(t)}, nil
-		}
-
-		st1 := time.Now()
-		ids, err := getShardFromDynamo(db, t)
-		if err != nil {
-			fmt.Println(err)
-			return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
-		}
-		fmt.Println("get shard time: ", time.Since(st1))
-		// add results to common aggregate total; throw error if maxResultsSize reached
-		for i, ID := range ids {
-			if i >= maxResultsSize {
-				fmt.Println("GetResults failed: MAX_LENGTH exceeded")
-				return []string{}, fmt.Errorf("MAX_LENGTH")
-			}
-			common = append(common, ID)
-		}
-		fmt.Println("finish - ", time.Since(st))
-		return common, nil
-	}
-
-	// sort terms by # of shards, least to greatest
-	termsSrt := shardEntries{}
-	for _, t := range terms {
-		sh := float32(0)
-		if id.Shards[t] != nil {
-			sh = id.Shards[t].Shards
-		}
-		se := shardEntry{ID: t, Shards: sh}
-		termsSrt = append(termsSrt, se)
-	}
-	sort.Sort(termsSrt)
-
-	// find intersections of two smallest records - terms[0] & terms[1] (t0, t1)
-	i0, i1 := termsSrt[0].ID, termsSrt[1].ID // terms
-	k0, k1 := i0, i1                         // shard keys
-	ct0, ct1 := float32(0.0), float32(0.0)   // number of shards created for each term
-	if id.Shards[i0] != nil {
-		ct0 = id.Shards[i0].Shards
-	}
-	if id.Shards[i1] != nil {
-		ct1 = id.Shards[i1].Shards
-	}
-	// compare each shard (s0) in t0 to each shard in t1 (s1) within min/max range
-	for x := 0; x < int((ct0 + 1)); x++ {
-		s0 := []string{} // shard IDs - used to store IDs after first disk read call in inner loop
-		if x > 0 {
-			k0 = i0 + "." + strconv.Itoa(x)
-		}
-
-		min0, max0 := "", ""
-		if ct0 == 0 {
-			st1 := time.Now()
-			s0, err = getShardFromDynamo(db, k0)
-			if err != nil {
-				fmt.Println(err)
-				return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
-			}
-			fmt.Println("s0 read time: ", time.Since(st1))
-			min0, max0 = s0[0], s0[len(s0)-1]
-		} else {
-			r0 := id.Shards[i0].Ranges[k0]
-			min0, max0 = r0.Range[0], r0.Range[1] // min, max value of each shard in t1 compared to these values
-		}
-
-		// get each shard in t1 (s1), compare to s0 and find intersection
-		for y := 0; y < int((ct1 + 1)); y++ {
-			s1 := []string{}
-			if y > 0 {
-				k1 = i1 + "." + strconv.Itoa(y)
-			}
-
-			min1, max1 := "", ""
-			if ct1 == 0 {
-				s1, err := getShardFromDynamo(db, k1)
-				if err != nil {
-					fmt.Println(err)
-					return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
-				}
-				min1, max1 = s1[0], s1[len(s1)-1]
-			} else {
-				r1 := id.Shards[i1].Ranges[k1]
-				min1, max1 = r1.Range[0], r1.Range[1]
-			}
-
-			if min0 > max1 {
-				fmt.Println("skipping shard ", y)
-				continue // skip
-			}
-			if max0 < min1 {
-				fmt.Println("breaking at shard ", y)
-				break // stop
-			}
-
-			if len(s1) == 0 {
-				s1, err = getShardFromDynamo(db, k1)
-				if err != nil {
-					fmt.Println(err)
-					return []string{}, fmt.Errorf("getRefs failed: %v", err)
-				}
-			}
-
-			if len(s0) == 0 {
-				s0, err = getShardFromDynamo(db, k0)
-				if err != nil {
-					fmt.Println(err)
-					return []string{}, fmt.Errorf("getRefs failed: %v", err)
-				}
-			}
-
-			// find intersection of s0, s1, add to common aggregate total
-			intsec := intersection(s0, s1)
-			common = append(common, intsec...)
-		}
-	}
-
-	fmt.Println("common - s0, s1: ", len(common))
-
-	// find intersections of each remaining term
-	for t := 2; t < len(termsSrt); t++ {
-		if len(common) == 0 {
-			return common, nil
-		}
-		i2 := termsSrt[t].ID // next term in list
-		k2 := i2
-		buffer := common    // use to find values in common within range of current s1 shard
-		common = []string{} // reset for each term
-
-		// get each shard in t1 (s1), compare to sc and find intersection
-		sc := []string{} // shard created from common IDs within range of s2
-		ct2 := float32(0.0)
-		if id.Shards[i2] != nil {
-			ct2 = id.Shards[i2].Shards
-		}
-		for z := 0; z < int((ct2 + 1)); z++ {
-			s2 := []string{}
-			if z > 0 {
-				k2 = i2 + "." + strconv.Itoa(z)
-			}
-
-			min2, max2 := "", ""
-			if ct2 == 0 {
-				s2, err = getShardFromDynamo(db, k2)
-				if err != nil {
-					fmt.Println(err)
-					return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
-				}
-				min2, max2 = s2[0], s2[len(s2)-1]
-			} else {
-				r2 := id.Shards[i2].Ranges[k2]
-				min2, max2 = r2.Range[0], r2.Range[1]
-			}
-
-			// create comparision shard from buffer IDs within range
-			for _, v := range buffer {
-				if v >= max2 { // no more common values exist
-					break // stop
-				}
-				if v >= min2 {
-					sc = append(sc, v)
-				}
-			}
-
-			if len(s2) == 0 {
-				s2, err = getShardFromDynamo(db, k2)
-				if err != nil {
-					fmt.Println(err)
-					return []string{}, fmt.Errorf("GetResultsFromShards failed: %v", err)
-				}
-			}
-
-			// find intersection of s0, s2, add to common aggregate total
-			intsec := intersection(sc, s2)
-			common = append(common, intsec...)
-		}
-		sort.Slice(common, func(i, j int) bool { return common[i] < common[j] })
-	}
-
-	fmt.Println("len(common): ", len(common))
-	if len(common) > maxResultsSize {
-		fmt.Println("GetResults failed: MAX_LENGTH exceeded")
-		return []string{}, fmt.Errorf("MAX_LENGTH")
-	}
-
-	fmt.Println("finish - ", time.Since(st))
-	return common, nil
-} */
-
-/* // get ids in single shard from DynamoDB
-func getShardFromDynamo(db *dynamo.DbInfo, id string) ([]string, error) {
-	dPrt := getDynamoPrt(id)
-	dq := dynamo.CreateNewQueryObj(dPrt, id)
-	fmt.Println("dq: ", dq)
-	fmt.Println("tables: ", db.Tables)
-
-	shard, err := dynamo.GetItem(db.Svc, dq, db.Tables["cf-index"], &SearchEntry{})
-	if err != nil {
-		fmt.Println(err)
-		return []string{}, fmt.Errorf("getShardFromDynamo failed: ", err)
-	}
-
-	ids := shard.(*SearchEntry).IDs
-
-	return ids, nil
-}
-
-// getRefs finds the references for each term in query
-func getRefsFromDynamo(db *dynamo.DbInfo, t string, id *IndexData) ([]string, int, error) {
-	var result []string
-	x := 0 // value > 0 indicates 1+ terms in query returned no matching IDs; no results for query
-
-	// Get index list for each term
-	if filter(t) { // term is filtered out; no results returned for term
-		return result, x, nil
-	}
-
-	prt := getPartition(t)
-	key := ""
-	// get aggregate IDs from all shards
-	for i := 0; i < int(id.Shards[t].Shards+1.0); i++ {
-		t = strings.TrimSpace(t)
-		key = t
-		if i > 0 {
-			key = t + "." + strconv.Itoa(int(id.Shards[t].Shards))
-		}
-		query := dynamo.CreateNewQueryObj(prt, key)
-
-		// retreive item from DynamoDB
-		tName := "cf-index"
-		refObj := IndexData{}
-		obj, err := dynamo.GetItem(db.Svc, query, db.Tables[tName], refObj)
-		if err != nil {
-			fmt.Println(err)
-			return result, x, fmt.Errorf("Query failed: %v", err)
-		}
-		ids := obj.(SearchEntry).IDs // check
-		if len(ids) == 0 {
-			fmt.Println("no IDs found for term: ", t)
-			x++
-			return result, x, nil
-		}
-		fmt.Printf("ids found for '%s': %d\n", t, len(ids))
-		result = append(result, ids...)
-		if err != nil {
-			return nil, 0, fmt.Errorf("getRefs failed: %s", err)
-		}
-	}
-	return result, x, nil
-}
-*/
-
 /*
 // getRefs finds the references for each term in query
 func getRefs(q []string, id *IndexData) (map[string][]string, int, error) {
@@ -0,0 +1,166 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for building the year's adjacency index from
+// the Individual, CmteTxData, and Candidate objects and converting between
+// Nodes and the Adjacency objects stored in the index.
+package network
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+)
+
+// BuildAdjacency creates and saves the Adjacency object of every individual,
+// committee, and candidate for the given year. Candidate rollups must be
+// created before the index is built.
+func BuildAdjacency(year string) error {
+	total := 0
+
+	// individuals
+	curr := ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "individuals", curr, 100000)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		adjs := []interface{}{}
+		for _, obj := range objs {
+			adjs = append(adjs, NodeAdjacency(CreateDonorNode(obj.(*donations.Individual))))
+		}
+		if err := persist.SaveAdjacency(year, adjs); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		total += len(adjs)
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+
+	// committees
+	info, err := getCmteInfo(year)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("BuildAdjacency failed: %v", err)
+	}
+	curr = ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "cmte_tx_data", curr, 10000)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		adjs := []interface{}{}
+		for _, obj := range objs {
+			cmte := obj.(*donations.CmteTxData)
+			adjs = append(adjs, NodeAdjacency(CreateCmteNode(cmte, info[cmte.CmteID])))
+		}
+		if err := persist.SaveAdjacency(year, adjs); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		total += len(adjs)
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+
+	// candidates
+	curr = ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "candidates", curr, 10000)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		ids := []string{}
+		for _, obj := range objs {
+			ids = append(ids, obj.(*donations.Candidate).ID)
+		}
+		rollups, err := getObjs(year, "cand_rollup", ids)
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		rMap := make(map[string]*donations.CandRollup)
+		for _, obj := range rollups {
+			rMap[obj.(*donations.CandRollup).CandID] = obj.(*donations.CandRollup)
+		}
+		adjs := []interface{}{}
+		for _, obj := range objs {
+			cand := obj.(*donations.Candidate)
+			adjs = append(adjs, NodeAdjacency(CreateCandNode(cand, rMap[cand.ID])))
+		}
+		if err := persist.SaveAdjacency(year, adjs); err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("BuildAdjacency failed: %v", err)
+		}
+		total += len(adjs)
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+	fmt.Println("adjacency lists created: ", total)
+
+	return nil
+}
+
+// NodeAdjacency returns the node's edges as an Adjacency object.
+// Edges are ordered by $ value.
+func NodeAdjacency(n *Node) *donations.Adjacency {
+	adj := &donations.Adjacency{
+		ID:          n.ID,
+		Name:        n.Name,
+		Type:        n.Type,
+		Party:       n.Party,
+		State:       n.State,
+		TotalInAmt:  n.TotalInAmt,
+		TotalOutAmt: n.TotalOutAmt,
+		Out:         []donations.AdjEdge{},
+		In:          []donations.AdjEdge{},
+	}
+	for t, weights := range n.WeightedEdges {
+		for id, amt := range weights {
+			e := donations.AdjEdge{ID: id, Type: t, Amt: amt, Txs: n.EdgeTxs[t][id]}
+			if outEdges[t] {
+				adj.Out = append(adj.Out, e)
+			} else {
+				adj.In = append(adj.In, e)
+			}
+		}
+	}
+	for _, edges := range [][]donations.AdjEdge{adj.Out, adj.In} {
+		sort.Slice(edges, func(i, j int) bool {
+			if edges[i].Amt == edges[j].Amt {
+				return edges[i].ID < edges[j].ID
+			}
+			return edges[i].Amt > edges[j].Amt
+		})
+	}
+	return adj
+}
+
+// adjacencyNode creates a node from the Adjacency object.
+func adjacencyNode(adj *donations.Adjacency) *Node {
+	node := newNode(adj.ID, adj.Name, adj.Type)
+	node.Party = adj.Party
+	node.State = adj.State
+	node.TotalInAmt = adj.TotalInAmt
+	node.TotalOutAmt = adj.TotalOutAmt
+	for _, edges := range [][]donations.AdjEdge{adj.Out, adj.In} {
+		for _, e := range edges {
+			node.addEdges(e.Type, map[string]float32{e.ID: e.Amt}, map[string]float32{e.ID: e.Txs})
+		}
+	}
+	return node
+}
@@ -0,0 +1,294 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for building the money-flow graph of every
+// committee for a given year and computing the centrality of each node.
+package network
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+)
+
+/*
+	CENTRALITY MEASURES
+	Computed over the year's money-flow graph (contributions, transfers & disbursements):
+		- pagerank: weighted PageRank (damping 0.85) with edges weighted by $ value;
+		  scores are scaled by the # of nodes so the average node scores 1.0
+		- betweenness: # of shortest directed paths (by # of hops) through the node,
+		  estimated from a deterministic sample of source nodes
+		- instrength: total $ value of incoming edges
+		- outstrength: total $ value of outgoing edges
+*/
+
+// centrality measures
+const (
+	CentralityPageRank    = "pagerank"
+	CentralityBetweenness = "betweenness"
+	CentralityInStrength  = "instrength"
+	CentralityOutStrength = "outstrength"
+)
+
+// CentralityMeasures lists the supported centrality measures.
+var CentralityMeasures = []string{CentralityPageRank, CentralityBetweenness, CentralityInStrength, CentralityOutStrength}
+
+// PageRank/betweenness parameters
+const (
+	damping            = 0.85
+	maxIterations      = 100
+	tolerance          = 1e-6
+	betweennessSamples = 256
+)
+
+// Centrality maps each centrality measure to the score of each node ID.
+type Centrality map[string]map[string]float32
+
+// FlowGraphID returns the ID of the year's money-flow graph.
+// Formats ID as year-flows-0-minAmt.
+func FlowGraphID(year string, minAmt float32) string {
+	return GraphID(year, "flows", 0, minAmt)
+}
+
+// CreateFlowGraph builds the money-flow graph of every committee for the given year.
+// Each committee is added with its contribution, transfer and disbursement edges >= minAmt.
+// Committees only list their Top 100 individual contributors, so each individual with
+// edges >= minAmt is added with the edges from its own recipients & senders.
+// The committees and candidates on the other end of each edge are added
+// as nodes without edges if not already on the graph.
+func CreateFlowGraph(year string, minAmt float32) (*NetworkGraph, error) {
+	graph := &NetworkGraph{
+		ID:     FlowGraphID(year, minAmt),
+		Year:   year,
+		Root:   "flows",
+		MinAmt: minAmt,
+		Nodes:  make(map[string]*Node),
+	}
+
+	// get committee names, parties & states
+	info, err := getCmteInfo(year)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CreateFlowGraph failed: %v", err)
+	}
+
+	curr := ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "cmte_tx_data", curr, 10000)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("CreateFlowGraph failed: %v", err)
+		}
+		for _, obj := range objs {
+			cmte := obj.(*donations.CmteTxData)
+			node := CreateCmteNode(cmte, info[cmte.CmteID])
+			delete(node.WeightedEdges, EdgeCandLnk)
+			delete(node.EdgeTxs, EdgeCandLnk)
+			minEdges(node, minAmt)
+			graph.Nodes[node.ID] = node
+		}
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+
+	// individuals
+	curr = ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "individuals", curr, 100000)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("CreateFlowGraph failed: %v", err)
+		}
+		for _, obj := range objs {
+			node := CreateDonorNode(obj.(*donations.Individual))
+			if minEdges(node, minAmt) == 0 {
+				continue
+			}
+			graph.Nodes[node.ID] = node
+		}
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+
+	// add edge endpoints
+	for _, e := range graph.Edges() {
+		for _, id := range []string{e.From, e.To} {
+			if graph.Nodes[id] != nil {
+				continue
+			}
+			switch nodeBucket(id) {
+			case "individuals":
+				graph.Nodes[id] = newNode(id, "", NodeIndv)
+			case "candidates":
+				graph.Nodes[id] = newNode(id, "", NodeCand)
+			default:
+				node := newNode(id, "", NodePAC)
+				if c := info[id]; c != nil {
+					node.Name, node.Party, node.State = c.Name, c.Party, c.State
+				}
+				graph.Nodes[id] = node
+			}
+		}
+	}
+
+	return graph, nil
+}
+
+// minEdges removes the node's edges < minAmt and returns the # of edges remaining.
+func minEdges(node *Node, minAmt float32) int {
+	n := 0
+	for _, weights := range node.WeightedEdges {
+		for id, amt := range weights {
+			if amt < minAmt {
+				delete(weights, id)
+				continue
+			}
+			n++
+		}
+	}
+	return n
+}
+
+// ComputeCentrality returns the centrality measures of each node on the graph.
+func ComputeCentrality(graph *NetworkGraph) Centrality {
+	ids := []string{}
+	for id := range graph.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	idx := make(map[string]int)
+	for i, id := range ids {
+		idx[id] = i
+	}
+
+	// directed weighted adjacency list
+	n := len(ids)
+	out := make([][]int, n)
+	wts := make([][]float64, n)
+	in := make([]float64, n)
+	str := make([]float64, n)
+	for _, e := range graph.Edges() {
+		i, iok := idx[e.From]
+		j, jok := idx[e.To]
+		if !iok || !jok {
+			continue
+		}
+		out[i] = append(out[i], j)
+		wts[i] = append(wts[i], float64(e.Amt))
+		str[i] += float64(e.Amt)
+		in[j] += float64(e.Amt)
+	}
+
+	pr := pageRank(out, wts, str)
+	bc := betweenness(out, betweennessSamples)
+	c := Centrality{
+		CentralityPageRank:    make(map[string]float32),
+		CentralityBetweenness: make(map[string]float32),
+		CentralityInStrength:  make(map[string]float32),
+		CentralityOutStrength: make(map[string]float32),
+	}
+	for i, id := range ids {
+		c[CentralityPageRank][id] = float32(pr[i] * float64(n))
+		c[CentralityBetweenness][id] = float32(bc[i])
+		c[CentralityInStrength][id] = float32(in[i])
+		c[CentralityOutStrength][id] = float32(str[i])
+	}
+	return c
+}
+
+// pageRank returns the weighted PageRank of each node. Each node's rank is
+// divided among its outgoing edges in proportion to the edges' weights;
+// the rank of nodes without outgoing edges is divided among all nodes.
+func pageRank(out [][]int, wts [][]float64, str []float64) []float64 {
+	n := len(out)
+	pr := make([]float64, n)
+	if n == 0 {
+		return pr
+	}
+	for i := range pr {
+		pr[i] = 1.0 / float64(n)
+	}
+	for iter := 0; iter < maxIterations; iter++ {
+		next := make([]float64, n)
+		dangling := 0.0
+		for i := range out {
+			if str[i] == 0 {
+				dangling += pr[i]
+				continue
+			}
+			for k, j := range out[i] {
+				next[j] += damping * pr[i] * wts[i][k] / str[i]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		diff := 0.0
+		for i := range next {
+			next[i] += base
+			diff += math.Abs(next[i] - pr[i])
+		}
+		pr = next
+		if diff < tolerance {
+			break
+		}
+	}
+	return pr
+}
+
+// betweenness returns the betweenness centrality of each node using Brandes'
+// algorithm over unweighted shortest paths. If the graph has more nodes than
+// samples, paths are counted from evenly spaced source nodes and the scores are
+// scaled by the # of nodes / # of samples.
+func betweenness(out [][]int, samples int) []float64 {
+	n := len(out)
+	bc := make([]float64, n)
+	step := 1
+	if samples > 0 && n > samples {
+		step = n / samples
+	}
+	scale := float64(step)
+
+	dist := make([]int, n)
+	sigma := make([]float64, n)
+	delta := make([]float64, n)
+	preds := make([][]int, n)
+	for s := 0; s < n; s += step {
+		for i := range dist {
+			dist[i], sigma[i], delta[i], preds[i] = -1, 0, 0, preds[i][:0]
+		}
+		dist[s], sigma[s] = 0, 1
+		order := []int{}
+		queue := []int{s}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			order = append(order, v)
+			for _, w := range out[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+		for k := len(order) - 1; k > 0; k-- {
+			w := order[k]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			bc[w] += delta[w] * scale
+		}
+	}
+	return bc
+}
@@ -0,0 +1,101 @@
+package network
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+)
+
+func TestComputeCentrality(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	// a1 -> PCC -> PAC -> vendor; a2 -> PAC
+	graph, err := CreateFlowGraph("2020", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.Nodes) != 5 || len(graph.Edges()) != 4 {
+		t.Fatalf("CreateFlowGraph: got %d nodes, %d edges; want 5, 4: %v", len(graph.Nodes), len(graph.Edges()), graph.Edges())
+	}
+	if graph.Nodes["indv00000000000000000000000000v1"].Type != NodeIndv {
+		t.Errorf("CreateFlowGraph: vendor node type = %s; want %s", graph.Nodes["indv00000000000000000000000000v1"].Type, NodeIndv)
+	}
+
+	c := ComputeCentrality(graph)
+	var tests = []struct {
+		measure string
+		id      string
+		want    float32
+	}{
+		{CentralityInStrength, "C00000002", 1050},
+		{CentralityOutStrength, "C00000002", 10000},
+		{CentralityOutStrength, "indv00000000000000000000000000a1", 2800},
+		{CentralityBetweenness, "C00000001", 2}, // a1 -> PAC, a1 -> vendor
+		{CentralityBetweenness, "C00000002", 3}, // a1, a2, PCC -> vendor
+		{CentralityBetweenness, "indv00000000000000000000000000a1", 0},
+	}
+	for _, test := range tests {
+		if got := c[test.measure][test.id]; got != test.want {
+			t.Errorf("ComputeCentrality: %s(%s) = %f; want %f", test.measure, test.id, got, test.want)
+		}
+	}
+
+	// scaled PageRank averages 1.0; vendor receives all flows
+	sum := float32(0)
+	for _, pr := range c[CentralityPageRank] {
+		sum += pr
+	}
+	if math.Abs(float64(sum-5)) > 1e-3 {
+		t.Errorf("ComputeCentrality: PageRank sum = %f; want 5", sum)
+	}
+	for id, pr := range c[CentralityPageRank] {
+		if id != "indv00000000000000000000000000v1" && pr >= c[CentralityPageRank]["indv00000000000000000000000000v1"] {
+			t.Errorf("ComputeCentrality: PageRank(%s) = %f >= vendor", id, pr)
+		}
+	}
+}
+
+func TestCreateFlowGraphIndividuals(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	// a3 is not one of C00000002's listed Top individual contributors
+	a3 := &donations.Individual{
+		ID:            "indv00000000000000000000000000a3",
+		RecipientsAmt: map[string]float32{"C00000002": 75},
+		RecipientsTxs: map[string]float32{"C00000002": 1},
+	}
+	if err := persist.StoreObjects("2020", []interface{}{a3}); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		minAmt float32
+		nodes  int
+		edges  int
+		a3     bool
+	}{
+		{0, 6, 5, true},
+		{75, 5, 4, true}, // a2 -> PAC below threshold
+		{100, 4, 3, false},
+	}
+	for _, test := range tests {
+		graph, err := CreateFlowGraph("2020", test.minAmt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(graph.Nodes) != test.nodes || len(graph.Edges()) != test.edges {
+			t.Errorf("CreateFlowGraph(%.0f): got %d nodes, %d edges; want %d, %d: %v", test.minAmt, len(graph.Nodes), len(graph.Edges()), test.nodes, test.edges, graph.Edges())
+		}
+		if got := graph.Nodes[a3.ID] != nil; got != test.a3 {
+			t.Errorf("CreateFlowGraph(%.0f): a3 on graph = %v; want %v", test.minAmt, got, test.a3)
+		}
+		if c := ComputeCentrality(graph); test.a3 && c[CentralityOutStrength][a3.ID] != 75 {
+			t.Errorf("CreateFlowGraph(%.0f): outstrength(a3) = %f; want 75", test.minAmt, c[CentralityOutStrength][a3.ID])
+		}
+	}
+}
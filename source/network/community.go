@@ -0,0 +1,259 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for detecting communities of committees on
+// the committee transfer graph with the Louvain method and summarizing the
+// transfers within and between each community.
+package network
+
+import (
+	"fmt"
+	"sort"
+)
+
+/*
+	COMMUNITY DETECTION
+	Communities are detected on the undirected transfer graph; the weight between two
+	committees is the total $ value transferred in either direction. The Louvain method
+	moves each node to the neighboring community with the largest modularity gain until
+	no moves remain, then aggregates each community into a single node and repeats until
+	the communities no longer change. Nodes are visited in ID order so results are
+	deterministic for the same graph.
+*/
+
+// Community represents a cluster of committees on the transfer graph.
+type Community struct {
+	ID          int
+	Members     []string       // member IDs ordered by transfer volume
+	InternalAmt float32        // $ value transferred between members
+	InternalTxs float32        // # of transfers between members
+	InAmt       float32        // $ value received from non-members
+	OutAmt      float32        // $ value sent to non-members
+	Parties     map[string]int // # of members by party
+}
+
+// Communities contains the community assignments and summaries for a graph.
+type Communities struct {
+	GraphID     string
+	Modularity  float32
+	Assignments map[string]int // node ID -> community ID
+	Communities []*Community   // ordered by size
+}
+
+// CreateCommunities builds the committee transfer graph for the year and
+// detects the communities of committees on the graph.
+func CreateCommunities(year string, minAmt float32) (*NetworkGraph, *Communities, error) {
+	graph, err := CreateTransferGraph(year, minAmt)
+	if err != nil {
+		fmt.Println(err)
+		return nil, nil, fmt.Errorf("CreateCommunities failed: %v", err)
+	}
+	return graph, DetectCommunities(graph), nil
+}
+
+// DetectCommunities finds the communities on the graph with the Louvain method
+// and returns the assignments with the summary of each community. Community IDs
+// are assigned in order of size starting from 0.
+func DetectCommunities(graph *NetworkGraph) *Communities {
+	nodes := graph.sortedNodes()
+	idx := make(map[string]int)
+	for i, n := range nodes {
+		idx[n.ID] = i
+	}
+	edges := graph.Edges()
+	adj := make([]map[int]float64, len(nodes))
+	for i := range adj {
+		adj[i] = make(map[int]float64)
+	}
+	for _, e := range edges {
+		i, j := idx[e.From], idx[e.To]
+		adj[i][j] += float64(e.Amt)
+		adj[j][i] += float64(e.Amt)
+	}
+
+	// node -> community
+	member := louvain(adj)
+
+	// renumber communities by size, then lowest member ID
+	groups := make(map[int][]int)
+	for i, c := range member {
+		groups[c] = append(groups[c], i)
+	}
+	keys := []int{}
+	for c := range groups {
+		keys = append(keys, c)
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		ga, gb := groups[keys[a]], groups[keys[b]]
+		if len(ga) != len(gb) {
+			return len(ga) > len(gb)
+		}
+		return ga[0] < gb[0]
+	})
+
+	result := &Communities{
+		GraphID:     graph.ID,
+		Modularity:  float32(modularity(adj, member)),
+		Assignments: make(map[string]int),
+	}
+	for id, c := range keys {
+		comm := &Community{ID: id, Parties: make(map[string]int)}
+		for _, i := range groups[c] {
+			result.Assignments[nodes[i].ID] = id
+			comm.Members = append(comm.Members, nodes[i].ID)
+			if nodes[i].Party != "" {
+				comm.Parties[nodes[i].Party]++
+			}
+		}
+		result.Communities = append(result.Communities, comm)
+	}
+	result.summarize(edges)
+
+	return result
+}
+
+// summarize totals the transfers within and between communities and
+// orders each community's members by the $ value of their transfers.
+func (c *Communities) summarize(edges []Edge) {
+	volume := make(map[string]float32)
+	for _, e := range edges {
+		volume[e.From] += e.Amt
+		volume[e.To] += e.Amt
+		from, fok := c.Assignments[e.From]
+		to, tok := c.Assignments[e.To]
+		switch {
+		case fok && tok && from == to:
+			c.Communities[from].InternalAmt += e.Amt
+			c.Communities[from].InternalTxs += e.Txs
+		default:
+			if fok {
+				c.Communities[from].OutAmt += e.Amt
+			}
+			if tok {
+				c.Communities[to].InAmt += e.Amt
+			}
+		}
+	}
+	for _, comm := range c.Communities {
+		m := comm.Members
+		sort.SliceStable(m, func(i, j int) bool { return volume[m[i]] > volume[m[j]] })
+	}
+}
+
+// louvain returns the community index of each node on the undirected
+// weighted graph represented by the symmetric adjacency list.
+func louvain(adj []map[int]float64) []int {
+	member := make([]int, len(adj))
+	for i := range member {
+		member[i] = i
+	}
+	for {
+		comm, moved := localMoves(adj)
+		if !moved {
+			break
+		}
+		// relabel communities 0..n-1 and aggregate
+		label := make(map[int]int)
+		for _, c := range comm {
+			if _, ok := label[c]; !ok {
+				label[c] = len(label)
+			}
+		}
+		agg := make([]map[int]float64, len(label))
+		for i := range agg {
+			agg[i] = make(map[int]float64)
+		}
+		for i, nbrs := range adj {
+			for j, w := range nbrs {
+				agg[label[comm[i]]][label[comm[j]]] += w
+			}
+		}
+		for i, c := range member {
+			member[i] = label[comm[c]]
+		}
+		adj = agg
+	}
+	return member
+}
+
+// localMoves moves each node to the neighboring community with the largest
+// modularity gain until no moves remain. Returns the community of each node
+// and true if any node was moved.
+func localMoves(adj []map[int]float64) ([]int, bool) {
+	n := len(adj)
+	comm := make([]int, n)
+	k := make([]float64, n)   // node degree
+	tot := make([]float64, n) // community degree
+	m2 := 0.0
+	for i, nbrs := range adj {
+		comm[i] = i
+		for _, w := range nbrs {
+			k[i] += w
+		}
+		tot[i] = k[i]
+		m2 += k[i]
+	}
+	if m2 == 0 {
+		return comm, false
+	}
+
+	moved := false
+	for changed := true; changed; {
+		changed = false
+		for i := 0; i < n; i++ {
+			// weights to neighboring communities
+			kin := make(map[int]float64)
+			for j, w := range adj[i] {
+				if j != i {
+					kin[comm[j]] += w
+				}
+			}
+			curr := comm[i]
+			tot[curr] -= k[i]
+			nbrs := []int{}
+			for c := range kin {
+				nbrs = append(nbrs, c)
+			}
+			sort.Ints(nbrs)
+
+			best, bestGain := curr, kin[curr]-tot[curr]*k[i]/m2
+			for _, c := range nbrs {
+				if gain := kin[c] - tot[c]*k[i]/m2; gain > bestGain+1e-9 {
+					best, bestGain = c, gain
+				}
+			}
+			tot[best] += k[i]
+			comm[i] = best
+			if best != curr {
+				changed, moved = true, true
+			}
+		}
+	}
+	return comm, moved
+}
+
+// modularity returns the modularity of the community assignments.
+func modularity(adj []map[int]float64, comm []int) float64 {
+	m2 := 0.0
+	tot := make(map[int]float64)
+	in := make(map[int]float64)
+	for i, nbrs := range adj {
+		for j, w := range nbrs {
+			m2 += w
+			tot[comm[i]] += w
+			if comm[i] == comm[j] {
+				in[comm[i]] += w
+			}
+		}
+	}
+	if m2 == 0 {
+		return 0
+	}
+	q := 0.0
+	for c, t := range tot {
+		q += in[c]/m2 - (t/m2)*(t/m2)
+	}
+	return q
+}
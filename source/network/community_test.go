@@ -0,0 +1,81 @@
+package network
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/elections/source/persist"
+)
+
+// two rings of committees joined by one small transfer
+func ringGraph() *NetworkGraph {
+	transfers := map[string]map[string]float32{
+		"C1": {"C2": 1000, "C3": 800},
+		"C2": {"C3": 900},
+		"C3": {"C4": 10},
+		"C4": {"C5": 1000, "C6": 700},
+		"C5": {"C6": 1200},
+	}
+	graph := &NetworkGraph{ID: "2020-transfers-0-0", Year: "2020", Root: "transfers", Nodes: make(map[string]*Node)}
+	for _, id := range []string{"C1", "C2", "C3", "C4", "C5", "C6"} {
+		node := newNode(id, "Committee "+id, NodePAC)
+		node.Party = "DEM"
+		if id > "C3" {
+			node.Party = "REP"
+		}
+		node.addEdges(EdgeCmteOut, transfers[id], nil)
+		graph.Nodes[id] = node
+	}
+	return graph
+}
+
+func TestDetectCommunities(t *testing.T) {
+	graph := ringGraph()
+	c := DetectCommunities(graph)
+	if len(c.Communities) != 2 {
+		t.Fatalf("DetectCommunities: got %d communities; want 2: %v", len(c.Communities), c.Assignments)
+	}
+	if c.Assignments["C1"] != c.Assignments["C3"] || c.Assignments["C4"] != c.Assignments["C6"] || c.Assignments["C1"] == c.Assignments["C4"] {
+		t.Errorf("DetectCommunities: invalid assignments: %v", c.Assignments)
+	}
+	if c.Modularity < 0.4 {
+		t.Errorf("DetectCommunities: modularity = %f; want >= 0.4", c.Modularity)
+	}
+	dem := c.Communities[c.Assignments["C1"]]
+	if dem.InternalAmt != 2700 || dem.OutAmt != 10 || dem.InAmt != 0 || dem.Parties["DEM"] != 3 {
+		t.Errorf("DetectCommunities: invalid summary: %+v", dem)
+	}
+	if dem.Members[0] != "C2" { // C2: $1900 transferred
+		t.Errorf("DetectCommunities: members = %v; want C2 first", dem.Members)
+	}
+
+	// persist/get round trip
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	persist.OUTPUT_PATH = dir
+	if got, err := GetCommunities(graph.ID); err != nil || got != nil {
+		t.Fatalf("GetCommunities: got %v, %v; want nil", got, err)
+	}
+	if err := PersistCommunities(c); err != nil {
+		t.Fatal(err)
+	}
+	got, err := GetCommunities(graph.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Modularity != c.Modularity || len(got.Communities) != 2 || len(got.Assignments) != 6 {
+		t.Errorf("GetCommunities: got %+v; want %+v", got, c)
+	}
+	if got.Communities[1].InternalAmt != c.Communities[1].InternalAmt {
+		t.Errorf("GetCommunities: got %+v; want %+v", got.Communities[1], c.Communities[1])
+	}
+
+	paths, err := ExportCommunities(graph, c, dir+"/network")
+	if err != nil || len(paths) != 2 {
+		t.Fatalf("ExportCommunities: got %v, %v", paths, err)
+	}
+}
@@ -0,0 +1,331 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for exporting NetworkGraph objects to the
+// GraphML, GEXF, Graphviz DOT, and node-link JSON file formats read by
+// NetworkX, Gephi, and Graphviz, and exporting the communities detected on
+// a graph as CSV files.
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// export formats
+const (
+	FormatGraphML = "graphml"
+	FormatGEXF    = "gexf"
+	FormatDOT     = "dot"
+	FormatJSON    = "json" // NetworkX node-link format
+)
+
+// ExportFormats lists the supported export formats.
+var ExportFormats = []string{FormatGraphML, FormatGEXF, FormatDOT, FormatJSON}
+
+// ExportGraph writes the graph to dir/graphID.format and returns the file path.
+func ExportGraph(graph *NetworkGraph, format, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Println(err)
+		return "", fmt.Errorf("ExportGraph failed: %v", err)
+	}
+	path := filepath.Join(dir, graph.ID+"."+format)
+	f, err := os.Create(path)
+	if err != nil {
+		fmt.Println(err)
+		return "", fmt.Errorf("ExportGraph failed: %v", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	if err := WriteGraph(w, graph, format); err != nil {
+		fmt.Println(err)
+		return "", fmt.Errorf("ExportGraph failed: %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Println(err)
+		return "", fmt.Errorf("ExportGraph failed: %v", err)
+	}
+	return path, nil
+}
+
+// WriteGraph writes the graph to w in the given format.
+func WriteGraph(w io.Writer, graph *NetworkGraph, format string) error {
+	var err error
+	switch format {
+	case FormatGraphML:
+		err = writeGraphML(w, graph)
+	case FormatGEXF:
+		err = writeGEXF(w, graph)
+	case FormatDOT:
+		err = writeDOT(w, graph)
+	case FormatJSON:
+		err = writeJSON(w, graph)
+	default:
+		return fmt.Errorf("WriteGraph failed: %s: invalid format", format)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteGraph failed: %v", err)
+	}
+	return nil
+}
+
+// sortedNodes returns the graph's nodes sorted by ID.
+func (g *NetworkGraph) sortedNodes() []*Node {
+	nodes := []*Node{}
+	for _, node := range g.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes
+}
+
+// esc escapes the string for use in XML attributes and text.
+func esc(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// ftoa formats the float without exponents or trailing zeros.
+func ftoa(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
+
+// node and edge attribute keys
+var nodeAttrs = []string{"name", "type", "party", "state", "total_in", "total_out"}
+var edgeAttrs = []string{"type", "amount", "txs"}
+
+// attr types
+var attrTypes = map[string]string{
+	"name": "string", "type": "string", "party": "string", "state": "string",
+	"total_in": "double", "total_out": "double", "amount": "double", "txs": "double",
+}
+
+func nodeValues(n *Node) map[string]string {
+	return map[string]string{
+		"name": n.Name, "type": n.Type, "party": n.Party, "state": n.State,
+		"total_in": ftoa(n.TotalInAmt), "total_out": ftoa(n.TotalOutAmt),
+	}
+}
+
+func edgeValues(e Edge) map[string]string {
+	return map[string]string{"type": e.Type, "amount": ftoa(e.Amt), "txs": ftoa(e.Txs)}
+}
+
+// writeGraphML writes the graph in the GraphML format.
+func writeGraphML(w io.Writer, g *NetworkGraph) error {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	b.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	for _, k := range nodeAttrs {
+		fmt.Fprintf(&b, "  <key id=\"n_%s\" for=\"node\" attr.name=\"%s\" attr.type=\"%s\"/>\n", k, k, attrTypes[k])
+	}
+	for _, k := range edgeAttrs {
+		fmt.Fprintf(&b, "  <key id=\"e_%s\" for=\"edge\" attr.name=\"%s\" attr.type=\"%s\"/>\n", k, k, attrTypes[k])
+	}
+	fmt.Fprintf(&b, "  <graph id=\"%s\" edgedefault=\"directed\">\n", esc(g.ID))
+	for _, n := range g.sortedNodes() {
+		fmt.Fprintf(&b, "    <node id=\"%s\">\n", esc(n.ID))
+		vals := nodeValues(n)
+		for _, k := range nodeAttrs {
+			fmt.Fprintf(&b, "      <data key=\"n_%s\">%s</data>\n", k, esc(vals[k]))
+		}
+		b.WriteString("    </node>\n")
+	}
+	for i, e := range g.Edges() {
+		fmt.Fprintf(&b, "    <edge id=\"e%d\" source=\"%s\" target=\"%s\">\n", i, esc(e.From), esc(e.To))
+		vals := edgeValues(e)
+		for _, k := range edgeAttrs {
+			fmt.Fprintf(&b, "      <data key=\"e_%s\">%s</data>\n", k, esc(vals[k]))
+		}
+		b.WriteString("    </edge>\n")
+	}
+	b.WriteString("  </graph>\n</graphml>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeGEXF writes the graph in the GEXF 1.2 format.
+func writeGEXF(w io.Writer, g *NetworkGraph) error {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	b.WriteString(`<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">` + "\n")
+	b.WriteString(`  <graph mode="static" defaultedgetype="directed">` + "\n")
+	b.WriteString(`    <attributes class="node">` + "\n")
+	for i, k := range nodeAttrs[1:] { // name is node label
+		fmt.Fprintf(&b, "      <attribute id=\"%d\" title=\"%s\" type=\"%s\"/>\n", i, k, attrTypes[k])
+	}
+	b.WriteString("    </attributes>\n")
+	b.WriteString(`    <attributes class="edge">` + "\n")
+	for i, k := range []string{"type", "txs"} { // amount is edge weight
+		fmt.Fprintf(&b, "      <attribute id=\"%d\" title=\"%s\" type=\"%s\"/>\n", i, k, attrTypes[k])
+	}
+	b.WriteString("    </attributes>\n")
+
+	b.WriteString("    <nodes>\n")
+	for _, n := range g.sortedNodes() {
+		fmt.Fprintf(&b, "      <node id=\"%s\" label=\"%s\">\n        <attvalues>\n", esc(n.ID), esc(n.Name))
+		vals := nodeValues(n)
+		for i, k := range nodeAttrs[1:] {
+			fmt.Fprintf(&b, "          <attvalue for=\"%d\" value=\"%s\"/>\n", i, esc(vals[k]))
+		}
+		b.WriteString("        </attvalues>\n      </node>\n")
+	}
+	b.WriteString("    </nodes>\n")
+
+	b.WriteString("    <edges>\n")
+	for i, e := range g.Edges() {
+		fmt.Fprintf(&b, "      <edge id=\"%d\" source=\"%s\" target=\"%s\" weight=\"%s\">\n", i, esc(e.From), esc(e.To), ftoa(e.Amt))
+		fmt.Fprintf(&b, "        <attvalues>\n          <attvalue for=\"0\" value=\"%s\"/>\n", esc(e.Type))
+		fmt.Fprintf(&b, "          <attvalue for=\"1\" value=\"%s\"/>\n        </attvalues>\n      </edge>\n", ftoa(e.Txs))
+	}
+	b.WriteString("    </edges>\n  </graph>\n</gexf>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeDOT writes the graph in the Graphviz DOT format.
+func writeDOT(w io.Writer, g *NetworkGraph) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(g.ID))
+	for _, n := range g.sortedNodes() {
+		vals := nodeValues(n)
+		attrs := []string{"label=" + strconv.Quote(n.Name)}
+		for _, k := range nodeAttrs[1:] {
+			attrs = append(attrs, k+"="+strconv.Quote(vals[k]))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", strconv.Quote(n.ID), strings.Join(attrs, ", "))
+	}
+	for _, e := range g.Edges() {
+		fmt.Fprintf(&b, "  %s -> %s [type=%s, weight=%s, amount=%s, txs=%s];\n",
+			strconv.Quote(e.From), strconv.Quote(e.To), strconv.Quote(e.Type),
+			strconv.Quote(ftoa(e.Amt)), strconv.Quote(ftoa(e.Amt)), strconv.Quote(ftoa(e.Txs)))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// node-link JSON objects - networkx.node_link_graph
+type nodeLink struct {
+	Directed   bool                   `json:"directed"`
+	Multigraph bool                   `json:"multigraph"`
+	Graph      map[string]interface{} `json:"graph"`
+	Nodes      []nlNode               `json:"nodes"`
+	Links      []nlLink               `json:"links"`
+}
+
+type nlNode struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	Party    string  `json:"party"`
+	State    string  `json:"state"`
+	TotalIn  float32 `json:"total_in"`
+	TotalOut float32 `json:"total_out"`
+}
+
+type nlLink struct {
+	Source string  `json:"source"`
+	Target string  `json:"target"`
+	Type   string  `json:"type"`
+	Amount float32 `json:"amount"`
+	Weight float32 `json:"weight"`
+	Txs    float32 `json:"txs"`
+}
+
+// writeJSON writes the graph in the NetworkX node-link JSON format.
+func writeJSON(w io.Writer, g *NetworkGraph) error {
+	nl := nodeLink{
+		Directed: true,
+		Graph: map[string]interface{}{
+			"id": g.ID, "year": g.Year, "root": g.Root, "depth": g.Depth, "min_amt": g.MinAmt,
+		},
+		Nodes: []nlNode{},
+		Links: []nlLink{},
+	}
+	for _, n := range g.sortedNodes() {
+		nl.Nodes = append(nl.Nodes, nlNode{n.ID, n.Name, n.Type, n.Party, n.State, n.TotalInAmt, n.TotalOutAmt})
+	}
+	for _, e := range g.Edges() {
+		nl.Links = append(nl.Links, nlLink{e.From, e.To, e.Type, e.Amt, e.Amt, e.Txs})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(nl)
+}
+
+// ExportCommunities writes the graph's community summaries to dir/graphID-communities.csv
+// and the community assigned to each node to dir/graphID-assignments.csv.
+// Returns the file paths.
+func ExportCommunities(graph *NetworkGraph, c *Communities, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("ExportCommunities failed: %v", err)
+	}
+
+	// community summaries
+	rows := [][]string{{"community", "size", "internal_amt", "internal_txs", "in_amt", "out_amt", "parties", "members"}}
+	for _, comm := range c.Communities {
+		parties := []string{}
+		for p, n := range comm.Parties {
+			parties = append(parties, fmt.Sprintf("%s:%d", p, n))
+		}
+		sort.Strings(parties)
+		rows = append(rows, []string{
+			strconv.Itoa(comm.ID), strconv.Itoa(len(comm.Members)),
+			ftoa(comm.InternalAmt), ftoa(comm.InternalTxs), ftoa(comm.InAmt), ftoa(comm.OutAmt),
+			strings.Join(parties, ";"), strings.Join(comm.Members, ";"),
+		})
+	}
+	summary := filepath.Join(dir, c.GraphID+"-communities.csv")
+	if err := writeCSV(summary, rows); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("ExportCommunities failed: %v", err)
+	}
+
+	// node assignments
+	rows = [][]string{{"id", "name", "type", "party", "state", "community"}}
+	for _, n := range graph.sortedNodes() {
+		comm, ok := c.Assignments[n.ID]
+		if !ok {
+			continue
+		}
+		rows = append(rows, []string{n.ID, n.Name, n.Type, n.Party, n.State, strconv.Itoa(comm)})
+	}
+	assignments := filepath.Join(dir, c.GraphID+"-assignments.csv")
+	if err := writeCSV(assignments, rows); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("ExportCommunities failed: %v", err)
+	}
+
+	return []string{summary, assignments}, nil
+}
+
+// writeCSV writes the rows to the file at path.
+func writeCSV(path string, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWriteGraph(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	graph, err := CreateTransferGraph("2020", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graph.Nodes) != 2 {
+		t.Fatalf("CreateTransferGraph: %d nodes; want 2", len(graph.Nodes))
+	}
+	edges := graph.Edges()
+	if len(edges) != 1 || edges[0].From != "C00000001" || edges[0].Amt != 1000 || edges[0].Txs != 2 {
+		t.Fatalf("CreateTransferGraph: invalid edges: %v", edges)
+	}
+
+	for _, format := range ExportFormats {
+		var buf bytes.Buffer
+		if err := WriteGraph(&buf, graph, format); err != nil {
+			t.Fatalf("WriteGraph(%s) failed: %v", format, err)
+		}
+		out := buf.String()
+		switch format {
+		case FormatGraphML, FormatGEXF:
+			dec := xml.NewDecoder(strings.NewReader(out))
+			for {
+				_, err := dec.Token()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("WriteGraph(%s): invalid XML: %v", format, err)
+				}
+			}
+		case FormatJSON:
+			nl := nodeLink{}
+			if err := json.Unmarshal(buf.Bytes(), &nl); err != nil {
+				t.Fatalf("WriteGraph(%s): invalid JSON: %v", format, err)
+			}
+			if len(nl.Nodes) != 2 || len(nl.Links) != 1 || nl.Links[0].Txs != 2 {
+				t.Errorf("WriteGraph(%s): got %+v", format, nl)
+			}
+		}
+		for _, want := range []string{"C00000001", "C00000002", "1000", "VA"} {
+			if !strings.Contains(out, want) {
+				t.Errorf("WriteGraph(%s): missing %q", format, want)
+			}
+		}
+	}
+
+	if _, err := ExportGraph(graph, FormatDOT, dir+"/network"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir + "/network/" + graph.ID + ".dot"); err != nil {
+		t.Errorf("ExportGraph: %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for building a graph across multiple years
+// and ranking/clipping the graph's nodes to a maximum size.
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// NetworkID returns the ID of the multi-year graph for the given parameters.
+// Formats ID as year1_year2-root-depth-minAmt with the years in ascending order.
+func NetworkID(years []string, root string, depth int, minAmt float32) string {
+	yrs := append([]string{}, years...)
+	sort.Strings(yrs)
+	return GraphID(strings.Join(yrs, "_"), root, depth, minAmt)
+}
+
+// CreateNetwork builds the money-flow graph from the root ID for each of the given
+// years and merges the graphs into one graph; the $ values and # of transactions for
+// nodes and edges present in multiple years are summed. The minAmt threshold is
+// applied to each year's edges. Years the root does not have data for are skipped.
+func CreateNetwork(years []string, rootID string, depth int, minAmt float32) (*NetworkGraph, error) {
+	id := NetworkID(years, rootID, depth, minAmt)
+	merged := &NetworkGraph{
+		ID:     id,
+		Year:   strings.Split(id, "-")[0],
+		Root:   rootID,
+		Depth:  depth,
+		MinAmt: minAmt,
+		Nodes:  make(map[string]*Node),
+	}
+
+	for _, year := range years {
+		graph := &NetworkGraph{
+			Year:   year,
+			Root:   rootID,
+			Depth:  depth,
+			MinAmt: minAmt,
+			Nodes:  make(map[string]*Node),
+		}
+		if err := populateGraph(graph); err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("CreateNetwork failed: %v", err)
+		}
+		if graph.Nodes[rootID] == nil {
+			continue // no data for year
+		}
+		for _, node := range graph.Nodes {
+			mergeNode(merged.Nodes, node)
+		}
+	}
+	if merged.Nodes[rootID] == nil {
+		return nil, fmt.Errorf("CreateNetwork failed: %s: object not found", rootID)
+	}
+	return merged, nil
+}
+
+// mergeNode adds the node to the map or sums the node's totals and edges
+// with the existing node with the same ID.
+func mergeNode(nodes map[string]*Node, node *Node) {
+	m := nodes[node.ID]
+	if m == nil {
+		nodes[node.ID] = node
+		return
+	}
+	if m.Name == "" {
+		m.Name = node.Name
+	}
+	if m.Party == "" {
+		m.Party = node.Party
+	}
+	if m.State == "" {
+		m.State = node.State
+	}
+	m.TotalInAmt += node.TotalInAmt
+	m.TotalOutAmt += node.TotalOutAmt
+	for t, weights := range node.WeightedEdges {
+		m.addEdges(t, weights, node.EdgeTxs[t])
+	}
+}
+
+// Distances returns the # of hops from the root to each node on the
+// graph following edges in either direction.
+func (g *NetworkGraph) Distances() map[string]int {
+	adj := make(map[string][]string)
+	for _, e := range g.Edges() {
+		adj[e.From] = append(adj[e.From], e.To)
+		adj[e.To] = append(adj[e.To], e.From)
+	}
+	dist := map[string]int{g.Root: 0}
+	level := []string{g.Root}
+	for d := 1; len(level) > 0; d++ {
+		next := []string{}
+		for _, id := range level {
+			for _, adjID := range adj[id] {
+				if _, ok := dist[adjID]; ok {
+					continue
+				}
+				dist[adjID] = d
+				next = append(next, adjID)
+			}
+		}
+		level = next
+	}
+	return dist
+}
+
+// RankedNodes returns the graph's nodes ordered by distance from the root,
+// followed by the total $ value of each node's edges on the graph.
+// Nodes not connected to the root are listed last.
+func (g *NetworkGraph) RankedNodes() []*Node {
+	dist := g.Distances()
+	strength := make(map[string]float32)
+	for _, e := range g.Edges() {
+		strength[e.From] += e.Amt
+		strength[e.To] += e.Amt
+	}
+	nodes := g.sortedNodes()
+	sort.SliceStable(nodes, func(i, j int) bool {
+		di, iok := dist[nodes[i].ID]
+		dj, jok := dist[nodes[j].ID]
+		switch {
+		case iok != jok:
+			return iok
+		case di != dj:
+			return di < dj
+		default:
+			return strength[nodes[i].ID] > strength[nodes[j].ID]
+		}
+	})
+	return nodes
+}
+
+// Clip removes the lowest ranked nodes from the graph so that the graph
+// contains at most maxNodes nodes and returns true if any nodes were removed.
+func (g *NetworkGraph) Clip(maxNodes int) bool {
+	if maxNodes < 1 || len(g.Nodes) <= maxNodes {
+		return false
+	}
+	for _, node := range g.RankedNodes()[maxNodes:] {
+		delete(g.Nodes, node.ID)
+	}
+	for _, node := range g.Nodes {
+		node.trimEdges(g.Nodes, 0)
+	}
+	return true
+}
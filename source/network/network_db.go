@@ -1,163 +1,480 @@
 // Package network contains operations for building social network graphs from
-// object data. This package is still in progress and is not implemented in the
-// current version. Generating the social network graph from the data points
-// derived from this package will be done by Python's NetworkX package.
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for reading and writing graph nodes to the
+// network_db.db database as NodeProto objects. Each graph is stored in its own
+// bucket keyed by the graph ID. The communities detected on a graph are stored
+// as CommunityProto objects in a separate bucket keyed by the graph ID.
 package network
 
 import (
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 
-	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
 
 	"github.com/boltdb/bolt"
 	"github.com/golang/protobuf/proto"
 )
 
-// PersistNodes saves a list of Nodes to the "network_db.db" database
-func PersistNodes(nodes []*Node) error {
-	err := createBucket("nodes")
+// networkDB returns the path of the network_db.db database.
+func networkDB() string {
+	return persist.OUTPUT_PATH + "/db/network_db.db"
+}
+
+// PersistGraph saves each Node on the graph to the "network_db.db" database.
+// Existing nodes for the graph are overwritten.
+func PersistGraph(graph *NetworkGraph) error {
+	nodes := []*Node{}
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, node)
+	}
+	err := PersistNodes(graph.ID, nodes)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("PersistGraph failed: %v", err)
+	}
+	return nil
+}
+
+// PersistNodes saves a list of Nodes to the graph's bucket in the "network_db.db" database
+func PersistNodes(graphID string, nodes []*Node) error {
+	if err := os.MkdirAll(persist.OUTPUT_PATH+"/db", 0755); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("PersistNodes failed: %v", err)
+	}
+	db, err := bolt.Open(networkDB(), 0644, nil)
 	if err != nil {
-		fmt.Println("PersistNodes failed: ", err)
+		fmt.Println(err)
 		return fmt.Errorf("PersistNodes failed: %v", err)
 	}
+	defer db.Close()
 
-	// for each obj
-	for _, obj := range nodes {
-		err := PutNode(obj)
+	// tx
+	if err := db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(graphID))
 		if err != nil {
-			fmt.Println("PersistNodes failed: ", err)
-			return fmt.Errorf("PersistNodes failed: %v", err)
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		for _, node := range nodes {
+			data, err := convNodeToProto(node)
+			if err != nil {
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			if err := b.Put([]byte(node.ID), data); err != nil { // serialize k,v
+				return fmt.Errorf("tx failed: %s: %v", node.ID, err)
+			}
 		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("PersistNodes failed: %v", err)
 	}
 	return nil
 }
 
-// PutNode saves a Node object to the "network_db.db" database as a protobuf object
-func PutNode(node *Node) error {
-	// convert obj to protobuf
-	data, err := convNodeToProto(*node) // FIX THIS
+// GetGraph retrieves the graph with the given ID from the "network_db.db" database.
+func GetGraph(graphID string) (*NetworkGraph, error) {
+	graph, err := parseGraphID(graphID)
 	if err != nil {
-		fmt.Println("PutNode failed: ", err)
-		return fmt.Errorf("PutNodes failed: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetGraph failed: %v", err)
 	}
-	// open/create bucket in db/network_db.db
-	// put protobuf item and use donor.ID as key
-	db, err := bolt.Open("db/network_db.db", 0644, nil)
-	defer db.Close()
+
+	db, err := bolt.Open(networkDB(), 0644, nil)
 	if err != nil {
-		fmt.Println("FATAL: PutNode failed: 'network_db.db' failed to open")
-		return fmt.Errorf("PutNode failed: 'network_db.db' failed to open: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetGraph failed: %v", err)
 	}
+	defer db.Close()
 
 	// tx
-	if err := db.Update(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte("nodes"))
-		if err := b.Put([]byte(node.ID), data); err != nil { // serialize k,v
-			fmt.Printf("PutNode failed: network_db.db': failed to store donor: %s\n", node.ID)
-			return fmt.Errorf("PutNode failed: could not update:\n%v", err)
+	if err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(graphID))
+		if b == nil {
+			return fmt.Errorf("tx failed: %s: graph not found", graphID)
 		}
-		return nil
+		return b.ForEach(func(k, v []byte) error {
+			node, err := convProtoToNode(v)
+			if err != nil {
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			graph.Nodes[node.ID] = node
+			return nil
+		})
 	}); err != nil {
-		fmt.Println("FATAL: PutNode failed: 'network_db.db': 'nodes' bucket failed to open")
-		return fmt.Errorf("PutNode failed: 'network_db.db': 'nodes' bucket failed to open: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetGraph failed: %v", err)
 	}
 
-	return nil
+	return graph, nil
+}
+
+// GraphExists returns true if the graph with the given ID is stored in the "network_db.db" database.
+func GraphExists(graphID string) (bool, error) {
+	if _, err := os.Stat(networkDB()); os.IsNotExist(err) {
+		return false, nil
+	}
+	db, err := bolt.Open(networkDB(), 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return false, fmt.Errorf("GraphExists failed: %v", err)
+	}
+	defer db.Close()
+
+	exists := false
+	if err := db.View(func(tx *bolt.Tx) error {
+		exists = tx.Bucket([]byte(graphID)) != nil
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return false, fmt.Errorf("GraphExists failed: %v", err)
+	}
+	return exists, nil
 }
 
-func GetNode(id string) (*Node, error) {
-	db, err := bolt.Open("db/network_db.db", 0644, nil)
+// DeleteGraphs deletes every graph built from the given year's data and the graph's
+// communities from the "network_db.db" database. Returns the # of graphs deleted.
+// Called when the year's datasets are rebuilt so graphs are not served from stale data.
+func DeleteGraphs(year string) (int, error) {
+	if _, err := os.Stat(networkDB()); os.IsNotExist(err) {
+		return 0, nil
+	}
+	db, err := bolt.Open(networkDB(), 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return 0, fmt.Errorf("DeleteGraphs failed: %v", err)
+	}
 	defer db.Close()
+
+	n := 0
+	if err := db.Update(func(tx *bolt.Tx) error {
+		names := []string{}
+		if err := tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+			id := strings.TrimPrefix(string(name), communitiesBucket(""))
+			for _, yr := range strings.Split(strings.Split(id, "-")[0], "_") {
+				if yr == year {
+					names = append(names, string(name))
+					break
+				}
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		for _, name := range names {
+			if err := tx.DeleteBucket([]byte(name)); err != nil {
+				return fmt.Errorf("tx failed: %s: %v", name, err)
+			}
+			if !strings.HasPrefix(name, communitiesBucket("")) {
+				n++
+			}
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return 0, fmt.Errorf("DeleteGraphs failed: %v", err)
+	}
+	return n, nil
+}
+
+// GetNode retrieves the Node with the given ID from the graph's bucket.
+func GetNode(graphID, id string) (*Node, error) {
+	db, err := bolt.Open(networkDB(), 0644, nil)
 	if err != nil {
-		fmt.Println("FATAL: GetCandidate failed: 'network_db.db' failed to open")
-		return nil, fmt.Errorf("GetCandidate failed: 'network_db.db' failed to open: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetNode failed: %v", err)
 	}
+	defer db.Close()
 
 	var data []byte
 
 	// tx
 	if err := db.View(func(tx *bolt.Tx) error {
-		data = tx.Bucket([]byte("nodes")).Get([]byte(id))
+		b := tx.Bucket([]byte(graphID))
+		if b == nil {
+			return fmt.Errorf("tx failed: %s: graph not found", graphID)
+		}
+		data = b.Get([]byte(id))
 		return nil
 	}); err != nil {
-		fmt.Println("FATAL: GetCandidate failed: 'network_db.db': 'nodes' bucket failed to open")
-		return nil, fmt.Errorf("GetCandidate failed: 'network_db.db': 'nodes' bucket failed to open: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetNode failed: %v", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("GetNode failed: %s: node not found", id)
 	}
 
 	node, err := convProtoToNode(data)
 	if err != nil {
-		fmt.Println("GetCandidate failed: decodeCand failed: ", err)
-		return nil, fmt.Errorf("GetCandidate failed: decodeCand failed: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetNode failed: %v", err)
 	}
 
-	return &node, nil
+	return node, nil
 }
 
-func createBucket(name string) error {
-	db, err := bolt.Open("db/network_db.db", 0644, nil)
-	defer db.Close()
+// communitiesBucket returns the name of the bucket storing the graph's communities.
+func communitiesBucket(graphID string) string {
+	return "communities-" + graphID
+}
+
+// PersistCommunities saves the community summaries and node assignments to the
+// graph's communities bucket in the "network_db.db" database. Existing communities
+// for the graph are overwritten.
+func PersistCommunities(c *Communities) error {
+	if err := os.MkdirAll(persist.OUTPUT_PATH+"/db", 0755); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("PersistCommunities failed: %v", err)
+	}
+	db, err := bolt.Open(networkDB(), 0644, nil)
 	if err != nil {
-		fmt.Printf("FATAL: createBucket failed: 'network_db.db' failed to open\n")
-		return fmt.Errorf("createBucket failed: 'network_db.db' failed to open: %v", err)
+		fmt.Println(err)
+		return fmt.Errorf("PersistCommunities failed: %v", err)
 	}
+	defer db.Close()
 
 	// tx
 	if err := db.Update(func(tx *bolt.Tx) error {
-		_, err := tx.CreateBucketIfNotExists([]byte(name))
+		name := []byte(communitiesBucket(c.GraphID))
+		if tx.Bucket(name) != nil {
+			if err := tx.DeleteBucket(name); err != nil {
+				return fmt.Errorf("tx failed: %v", err)
+			}
+		}
+		b, err := tx.CreateBucket(name)
 		if err != nil {
-			fmt.Printf("FATAL: createBucket failed: 'network_db.db': '%s' bucket failed to open\n", name)
-			return fmt.Errorf("'main': FATAL: 'network_db.db': '%s' bucket failed to open: %v", name, err)
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		mod := strconv.FormatFloat(float64(c.Modularity), 'f', -1, 32)
+		if err := b.Put([]byte("modularity"), []byte(mod)); err != nil {
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		cb, err := b.CreateBucket([]byte("communities"))
+		if err != nil {
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		for _, comm := range c.Communities {
+			data, err := convCommunityToProto(comm)
+			if err != nil {
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			if err := cb.Put([]byte(fmt.Sprintf("%06d", comm.ID)), data); err != nil {
+				return fmt.Errorf("tx failed: %d: %v", comm.ID, err)
+			}
+		}
+		ab, err := b.CreateBucket([]byte("assignments"))
+		if err != nil {
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		for id, comm := range c.Assignments {
+			if err := ab.Put([]byte(id), []byte(strconv.Itoa(comm))); err != nil {
+				return fmt.Errorf("tx failed: %s: %v", id, err)
+			}
 		}
 		return nil
 	}); err != nil {
-		fmt.Printf("FATAL: createBucket failed: 'network_db.db': '%s' bucket failed to open\n", name)
-		return fmt.Errorf("createBucket failed: 'network_db.db': '%s' bucket failed to open: %v", name, err)
+		fmt.Println(err)
+		return fmt.Errorf("PersistCommunities failed: %v", err)
 	}
-
 	return nil
 }
 
-// convNodeToProto encodes LogData structs as protocol buffers
-func convNodeToProto(node Node) ([]byte, error) { // move conversions to protobuf package?
+// GetCommunities retrieves the communities detected on the graph with the
+// given ID from the "network_db.db" database. Returns nil if the graph's
+// communities have not been saved.
+func GetCommunities(graphID string) (*Communities, error) {
+	if _, err := os.Stat(networkDB()); os.IsNotExist(err) {
+		return nil, nil
+	}
+	db, err := bolt.Open(networkDB(), 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetCommunities failed: %v", err)
+	}
+	defer db.Close()
+
+	var c *Communities
+
+	// tx
+	if err := db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(communitiesBucket(graphID)))
+		if b == nil {
+			return nil
+		}
+		c = &Communities{GraphID: graphID, Assignments: make(map[string]int)}
+		mod, err := strconv.ParseFloat(string(b.Get([]byte("modularity"))), 32)
+		if err != nil {
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		c.Modularity = float32(mod)
+		if err := b.Bucket([]byte("communities")).ForEach(func(k, v []byte) error {
+			comm, err := convProtoToCommunity(v)
+			if err != nil {
+				return err
+			}
+			c.Communities = append(c.Communities, comm)
+			return nil
+		}); err != nil {
+			return fmt.Errorf("tx failed: %v", err)
+		}
+		return b.Bucket([]byte("assignments")).ForEach(func(k, v []byte) error {
+			comm, err := strconv.Atoi(string(v))
+			if err != nil {
+				return fmt.Errorf("tx failed: %s: %v", string(k), err)
+			}
+			c.Assignments[string(k)] = comm
+			return nil
+		})
+	}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetCommunities failed: %v", err)
+	}
+
+	return c, nil
+}
+
+// parseGraphID returns an empty graph initialized from the
+// year-root-depth-minAmt values encoded in the graph ID.
+func parseGraphID(graphID string) (*NetworkGraph, error) {
+	ss := strings.Split(graphID, "-")
+	if len(ss) != 4 {
+		return nil, fmt.Errorf("parseGraphID failed: %s: invalid graph ID", graphID)
+	}
+	depth, err := strconv.Atoi(ss[2])
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("parseGraphID failed: %v", err)
+	}
+	minAmt, err := strconv.ParseFloat(ss[3], 32)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("parseGraphID failed: %v", err)
+	}
+	graph := &NetworkGraph{
+		ID:     graphID,
+		Year:   ss[0],
+		Root:   ss[1],
+		Depth:  depth,
+		MinAmt: float32(minAmt),
+		Nodes:  make(map[string]*Node),
+	}
+	return graph, nil
+}
+
+// convNodeToProto encodes Node structs as protocol buffers
+func convNodeToProto(node *Node) ([]byte, error) {
 	entry := &NodeProto{
-		ID:      node.ID,
-		Name:    node.Name,
-		Type:    node.Type,
-		Edges:   node.Edges,
-		Weights: node.Weights,
+		ID:            node.ID,
+		Name:          node.Name,
+		Type:          node.Type,
+		WeightedEdges: encodeInnerMap(node.WeightedEdges),
+		Party:         node.Party,
+		State:         node.State,
+		TotalInAmt:    node.TotalInAmt,
+		TotalOutAmt:   node.TotalOutAmt,
+		EdgeTxs:       encodeInnerMap(node.EdgeTxs),
 	}
 
 	data, err := proto.Marshal(entry)
 	if err != nil {
-		fmt.Println("convNodeToProto failed: ", err)
+		fmt.Println(err)
 		return nil, fmt.Errorf("convNodeToProto failed: %v", err)
 	}
 	return data, nil
 }
 
-func convProtoToNode(data []byte) (Node, error) {
+// convProtoToNode decodes protocol buffers as Node structs
+func convProtoToNode(data []byte) (*Node, error) {
 	node := &NodeProto{}
 	err := proto.Unmarshal(data, node)
 	if err != nil {
-		fmt.Println("convProtoToNode failed: ", err)
-		return donations.Candidate{}, fmt.Errorf("convProtoToNode failed: %v", err)
+		fmt.Println(err)
+		return nil, fmt.Errorf("convProtoToNode failed: %v", err)
 	}
 
-	entry := Node{
+	entry := &Node{
 		ID:            node.GetID(),
 		Name:          node.GetName(),
 		Type:          node.GetType(),
 		WeightedEdges: decodeInnerMap(node.GetWeightedEdges()),
+		Party:         node.GetParty(),
+		State:         node.GetState(),
+		TotalInAmt:    node.GetTotalInAmt(),
+		TotalOutAmt:   node.GetTotalOutAmt(),
+		EdgeTxs:       decodeInnerMap(node.GetEdgeTxs()),
 	}
 
 	return entry, nil
 }
 
+func encodeInnerMap(m map[string]map[string]float32) map[string]*NodeProto_InnerMap {
+	im := make(map[string]*NodeProto_InnerMap)
+	for k, v := range m {
+		im[k] = &NodeProto_InnerMap{Weights: v}
+	}
+	return im
+}
+
 func decodeInnerMap(m map[string]*NodeProto_InnerMap) map[string]map[string]float32 {
 	we := make(map[string]map[string]float32)
 	for k, v := range m {
 		we[k] = make(map[string]float32)
-		we[k] = v.GetWeights()
+		for id, w := range v.GetWeights() {
+			we[k][id] = w
+		}
 	}
 	return we
 }
+
+// convCommunityToProto encodes Community structs as protocol buffers
+func convCommunityToProto(comm *Community) ([]byte, error) {
+	entry := &CommunityProto{
+		ID:          int32(comm.ID),
+		Members:     comm.Members,
+		InternalAmt: comm.InternalAmt,
+		InternalTxs: comm.InternalTxs,
+		InAmt:       comm.InAmt,
+		OutAmt:      comm.OutAmt,
+		Parties:     make(map[string]int32),
+	}
+	for k, v := range comm.Parties {
+		entry.Parties[k] = int32(v)
+	}
+
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("convCommunityToProto failed: %v", err)
+	}
+	return data, nil
+}
+
+// convProtoToCommunity decodes protocol buffers as Community structs
+func convProtoToCommunity(data []byte) (*Community, error) {
+	comm := &CommunityProto{}
+	err := proto.Unmarshal(data, comm)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("convProtoToCommunity failed: %v", err)
+	}
+
+	entry := &Community{
+		ID:          int(comm.GetID()),
+		Members:     comm.GetMembers(),
+		InternalAmt: comm.GetInternalAmt(),
+		InternalTxs: comm.GetInternalTxs(),
+		InAmt:       comm.GetInAmt(),
+		OutAmt:      comm.GetOutAmt(),
+		Parties:     make(map[string]int),
+	}
+	for k, v := range comm.GetParties() {
+		entry.Parties[k] = int(v)
+	}
+
+	return entry, nil
+}
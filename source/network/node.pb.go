@@ -25,6 +25,11 @@ type NodeProto struct {
 	Name                 string                         `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	Type                 string                         `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
 	WeightedEdges        map[string]*NodeProto_InnerMap `protobuf:"bytes,5,rep,name=WeightedEdges,proto3" json:"WeightedEdges,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Party                string                         `protobuf:"bytes,6,opt,name=Party,proto3" json:"Party,omitempty"`
+	State                string                         `protobuf:"bytes,7,opt,name=State,proto3" json:"State,omitempty"`
+	TotalInAmt           float32                        `protobuf:"fixed32,8,opt,name=TotalInAmt,proto3" json:"TotalInAmt,omitempty"`
+	TotalOutAmt          float32                        `protobuf:"fixed32,9,opt,name=TotalOutAmt,proto3" json:"TotalOutAmt,omitempty"`
+	EdgeTxs              map[string]*NodeProto_InnerMap `protobuf:"bytes,10,rep,name=EdgeTxs,proto3" json:"EdgeTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
 	XXX_unrecognized     []byte                         `json:"-"`
 	XXX_sizecache        int32                          `json:"-"`
@@ -83,6 +88,41 @@ func (m *NodeProto) GetWeightedEdges() map[string]*NodeProto_InnerMap {
 	return nil
 }
 
+func (m *NodeProto) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *NodeProto) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *NodeProto) GetTotalInAmt() float32 {
+	if m != nil {
+		return m.TotalInAmt
+	}
+	return 0
+}
+
+func (m *NodeProto) GetTotalOutAmt() float32 {
+	if m != nil {
+		return m.TotalOutAmt
+	}
+	return 0
+}
+
+func (m *NodeProto) GetEdgeTxs() map[string]*NodeProto_InnerMap {
+	if m != nil {
+		return m.EdgeTxs
+	}
+	return nil
+}
+
 type NodeProto_InnerMap struct {
 	Weights              map[string]float32 `protobuf:"bytes,1,rep,name=Weights,proto3" json:"Weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -122,30 +162,132 @@ func (m *NodeProto_InnerMap) GetWeights() map[string]float32 {
 	return nil
 }
 
+type CommunityProto struct {
+	ID                   int32            `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Members              []string         `protobuf:"bytes,2,rep,name=Members,proto3" json:"Members,omitempty"`
+	InternalAmt          float32          `protobuf:"fixed32,3,opt,name=InternalAmt,proto3" json:"InternalAmt,omitempty"`
+	InternalTxs          float32          `protobuf:"fixed32,4,opt,name=InternalTxs,proto3" json:"InternalTxs,omitempty"`
+	InAmt                float32          `protobuf:"fixed32,5,opt,name=InAmt,proto3" json:"InAmt,omitempty"`
+	OutAmt               float32          `protobuf:"fixed32,6,opt,name=OutAmt,proto3" json:"OutAmt,omitempty"`
+	Parties              map[string]int32 `protobuf:"bytes,7,rep,name=Parties,proto3" json:"Parties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CommunityProto) Reset()         { *m = CommunityProto{} }
+func (m *CommunityProto) String() string { return proto.CompactTextString(m) }
+func (*CommunityProto) ProtoMessage()    {}
+func (*CommunityProto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c843d59d2d938e7, []int{1}
+}
+
+func (m *CommunityProto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommunityProto.Unmarshal(m, b)
+}
+func (m *CommunityProto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommunityProto.Marshal(b, m, deterministic)
+}
+func (m *CommunityProto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityProto.Merge(m, src)
+}
+func (m *CommunityProto) XXX_Size() int {
+	return xxx_messageInfo_CommunityProto.Size(m)
+}
+func (m *CommunityProto) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityProto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityProto proto.InternalMessageInfo
+
+func (m *CommunityProto) GetID() int32 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *CommunityProto) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *CommunityProto) GetInternalAmt() float32 {
+	if m != nil {
+		return m.InternalAmt
+	}
+	return 0
+}
+
+func (m *CommunityProto) GetInternalTxs() float32 {
+	if m != nil {
+		return m.InternalTxs
+	}
+	return 0
+}
+
+func (m *CommunityProto) GetInAmt() float32 {
+	if m != nil {
+		return m.InAmt
+	}
+	return 0
+}
+
+func (m *CommunityProto) GetOutAmt() float32 {
+	if m != nil {
+		return m.OutAmt
+	}
+	return 0
+}
+
+func (m *CommunityProto) GetParties() map[string]int32 {
+	if m != nil {
+		return m.Parties
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NodeProto)(nil), "network.NodeProto")
+	proto.RegisterMapType((map[string]*NodeProto_InnerMap)(nil), "network.NodeProto.EdgeTxsEntry")
 	proto.RegisterMapType((map[string]*NodeProto_InnerMap)(nil), "network.NodeProto.WeightedEdgesEntry")
 	proto.RegisterType((*NodeProto_InnerMap)(nil), "network.NodeProto.InnerMap")
 	proto.RegisterMapType((map[string]float32)(nil), "network.NodeProto.InnerMap.WeightsEntry")
+	proto.RegisterType((*CommunityProto)(nil), "network.CommunityProto")
+	proto.RegisterMapType((map[string]int32)(nil), "network.CommunityProto.PartiesEntry")
 }
 
 func init() { proto.RegisterFile("node.proto", fileDescriptor_0c843d59d2d938e7) }
 
 var fileDescriptor_0c843d59d2d938e7 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xca, 0xcb, 0x4f, 0x49,
-	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcf, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0x56,
-	0xfa, 0xc3, 0xc4, 0xc5, 0xe9, 0x97, 0x9f, 0x92, 0x1a, 0x00, 0x16, 0xe6, 0xe3, 0x62, 0xf2, 0x74,
-	0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x62, 0xf2, 0x74, 0x11, 0x12, 0xe2, 0x62, 0xf1, 0x4b,
-	0xcc, 0x4d, 0x95, 0x60, 0x02, 0x8b, 0x80, 0xd9, 0x20, 0xb1, 0x90, 0xca, 0x82, 0x54, 0x09, 0x66,
-	0x88, 0x18, 0x88, 0x2d, 0xe4, 0xcd, 0xc5, 0x1b, 0x9e, 0x9a, 0x99, 0x9e, 0x51, 0x92, 0x9a, 0xe2,
-	0x9a, 0x92, 0x9e, 0x5a, 0x2c, 0xc1, 0xaa, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xaa, 0x07, 0xb5, 0x46,
-	0x0f, 0x6e, 0x85, 0x1e, 0x8a, 0x3a, 0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20, 0x54, 0xbd, 0x52, 0x5d,
-	0x8c, 0x5c, 0x1c, 0x9e, 0x79, 0x79, 0xa9, 0x45, 0xbe, 0x89, 0x05, 0x42, 0x4e, 0x5c, 0xec, 0x10,
-	0xd9, 0x62, 0x09, 0x46, 0xb0, 0x99, 0x1a, 0x58, 0xcc, 0x84, 0xa9, 0x86, 0x1a, 0x0e, 0x35, 0x16,
-	0xa6, 0x51, 0xca, 0x8a, 0x8b, 0x07, 0x59, 0x42, 0x48, 0x80, 0x8b, 0x39, 0x3b, 0xb5, 0x12, 0xea,
-	0x4d, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x14, 0xe2, 0x51, 0xa6, 0x20, 0x08,
-	0xc7, 0x8a, 0xc9, 0x82, 0x51, 0x2a, 0x96, 0x4b, 0x08, 0xd3, 0xc5, 0x58, 0x4c, 0x30, 0x44, 0x36,
-	0x81, 0xdb, 0x48, 0x1a, 0x8f, 0x2b, 0x91, 0x8c, 0x4f, 0x62, 0x03, 0x47, 0x87, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x0d, 0xda, 0x42, 0x9f, 0x9c, 0x01, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x25, 0x93, 0x26, 0x69, 0x6e, 0x6b, 0x91, 0x41, 0x64, 0xa8, 0xa0, 0xa1, 0x28, 0x64, 0x15,
+	0xb0, 0x6e, 0xb4, 0x0b, 0x41, 0x6d, 0x17, 0x41, 0x5a, 0x4b, 0x0c, 0x74, 0xe5, 0x22, 0x25, 0x43,
+	0x2d, 0x6d, 0x26, 0x25, 0x99, 0x6a, 0xf3, 0x0a, 0x3e, 0x89, 0x4f, 0x29, 0x32, 0x3f, 0x29, 0x13,
+	0x2d, 0xdf, 0xb7, 0xf9, 0x76, 0x73, 0xce, 0x9c, 0x9c, 0x7b, 0xe7, 0x9e, 0x1b, 0x00, 0x56, 0xe6,
+	0x34, 0x3a, 0x55, 0x25, 0x2f, 0xb1, 0xc7, 0x28, 0xff, 0x59, 0x56, 0x87, 0xc9, 0x9f, 0x1e, 0xf8,
+	0xab, 0x32, 0xa7, 0x6b, 0x49, 0x8f, 0x00, 0xc5, 0x73, 0x62, 0x05, 0x56, 0xe8, 0x27, 0x28, 0x9e,
+	0x63, 0x0c, 0xbd, 0x55, 0x56, 0x50, 0x82, 0x24, 0x23, 0xcf, 0x82, 0x4b, 0x9b, 0x13, 0x25, 0xb6,
+	0xe2, 0xc4, 0x19, 0x7f, 0x86, 0x47, 0x1b, 0xba, 0xdf, 0x7d, 0xe7, 0x34, 0x5f, 0xe4, 0x3b, 0x5a,
+	0x13, 0x27, 0xb0, 0xc3, 0xc1, 0xf4, 0x55, 0xa4, 0xcb, 0x44, 0xd7, 0x12, 0x51, 0x47, 0xb7, 0x60,
+	0xbc, 0x6a, 0x92, 0xee, 0xb7, 0xf8, 0x09, 0x38, 0xeb, 0xac, 0xe2, 0x0d, 0x71, 0x65, 0x05, 0x05,
+	0x04, 0xfb, 0x95, 0x67, 0x9c, 0x12, 0x4f, 0xb1, 0x12, 0xe0, 0xe7, 0x00, 0x69, 0xc9, 0xb3, 0x63,
+	0xcc, 0x3e, 0x14, 0x9c, 0xf4, 0x03, 0x2b, 0x44, 0x89, 0xc1, 0xe0, 0x00, 0x06, 0x12, 0x7d, 0x39,
+	0x73, 0x21, 0xf0, 0xa5, 0xc0, 0xa4, 0xf0, 0x3b, 0xf0, 0x44, 0xd9, 0xf4, 0x52, 0x13, 0x90, 0x4d,
+	0xbf, 0xb8, 0xd1, 0xb4, 0x56, 0xa8, 0x76, 0x5b, 0xfd, 0xf8, 0x97, 0x05, 0xfd, 0x98, 0x31, 0x5a,
+	0x2d, 0xb3, 0x13, 0xfe, 0x08, 0x9e, 0x7a, 0x46, 0x4d, 0x2c, 0xe9, 0x13, 0xde, 0xf0, 0x69, 0xd5,
+	0x7a, 0x0a, 0xad, 0xa1, 0x46, 0xe3, 0x19, 0x0c, 0xcd, 0x0b, 0xfc, 0x18, 0xec, 0x03, 0x6d, 0x74,
+	0x1e, 0xe2, 0x28, 0xa6, 0xf0, 0x23, 0x3b, 0x9e, 0x55, 0x22, 0x28, 0x51, 0x60, 0x86, 0xde, 0x5a,
+	0xe3, 0x6f, 0x80, 0xff, 0x1f, 0xed, 0x0d, 0x87, 0xd7, 0xa6, 0xc3, 0x60, 0xfa, 0xec, 0x8e, 0x2e,
+	0x4d, 0xfb, 0x0d, 0x0c, 0xcd, 0x21, 0x3c, 0x98, 0xf1, 0xe4, 0x37, 0x82, 0xd1, 0xa7, 0xb2, 0x28,
+	0xce, 0x6c, 0xcf, 0x9b, 0x7f, 0xb7, 0xd0, 0x91, 0x5b, 0x48, 0xc0, 0x5b, 0xd2, 0x62, 0x4b, 0xab,
+	0x9a, 0xa0, 0xc0, 0x0e, 0xfd, 0xa4, 0x85, 0x22, 0xde, 0x98, 0x71, 0x5a, 0xb1, 0xec, 0x28, 0xe2,
+	0xb5, 0x55, 0xbc, 0x06, 0x65, 0x2a, 0x44, 0xc4, 0xbd, 0xae, 0x22, 0xbd, 0xc8, 0x75, 0x53, 0xdb,
+	0xe3, 0xa8, 0x91, 0x4a, 0x80, 0x9f, 0x82, 0xab, 0x77, 0xc6, 0x95, 0xb4, 0x46, 0xf8, 0x3d, 0x78,
+	0x62, 0x1f, 0xf7, 0xb4, 0x26, 0x9e, 0x8c, 0xf9, 0xe5, 0xf5, 0x9d, 0xdd, 0x57, 0x44, 0x5a, 0xa6,
+	0x23, 0xd6, 0x48, 0x44, 0x6c, 0x5e, 0xdc, 0x17, 0xb1, 0x63, 0x8c, 0x6a, 0xeb, 0xca, 0x7f, 0xf7,
+	0xcd, 0xdf, 0x01, 0x00, 0x50, 0x44, 0xe3, 0xef, 0xc9, 0x03, 0x00, 0x00,
 }
@@ -1,303 +1,410 @@
 // Package network contains operations for building social network graphs from
-// object data. This package is still in progress and is not implemented in the
-// current version. Generating the social network graph from the data points
-// derived from this package will be done by Python's NetworkX package.
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for creating Node objects and building
+// NetworkGraph objects from a root node using breadth-first search.
 package network
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+
 	"github.com/elections/source/donations"
 	"github.com/elections/source/persist"
 )
 
+// node types
+const (
+	NodeIndv = "indv" // individual donor/organization/disbursement recipient
+	NodePAC  = "pac"  // committee not linked to a candidate
+	NodePCC  = "pcc"  // committee linked to a candidate
+	NodeCand = "cand" // candidate (all linked committees)
+)
+
+// edge types - keys of Node.WeightedEdges
+const (
+	EdgeIndvOut = "indvOUT"  // indv/cand -> cmte contributions
+	EdgeIndvIn  = "indvIN"   // cmte/cand <- indv/cand contributions
+	EdgeCmteOut = "cmteOUT"  // cmte/cand -> cmte transfers
+	EdgeCmteIn  = "cmteIN"   // cmte/cand/indv <- cmte transfers/payments
+	EdgeDisbOut = "disbOUT"  // cmte/cand -> indv/org expenditures
+	EdgeCandLnk = "candLINK" // pcc -> cand funds raised on behalf of linked candidate
+)
+
+// outgoing edge types; all other edge types are incoming
+var outEdges = map[string]bool{EdgeIndvOut: true, EdgeCmteOut: true, EdgeDisbOut: true, EdgeCandLnk: true}
+
 // Node represents a node on a social network graph
 type Node struct {
-	ID      string
-	Name    string
-	Type    string
-	WeightedEdges map[string]map[string]float32 // type: edge ID: direct %
+	ID            string
+	Name          string
+	Type          string
+	Party         string
+	State         string
+	TotalInAmt    float32                       // total $ value of incoming transactions
+	TotalOutAmt   float32                       // total $ value of outgoing transactions
+	WeightedEdges map[string]map[string]float32 // edge type: adjacent node ID: $ value
+	EdgeTxs       map[string]map[string]float32 // edge type: adjacent node ID: # of transactions
 }
 
 // NetworkGraph is a social network graph comprised of Node objects
 type NetworkGraph struct {
-	ID string
-	Nodes map[string]*Node
+	ID     string
+	Year   string
+	Root   string
+	Depth  int
+	MinAmt float32
+	Nodes  map[string]*Node
 }
 
-func CreateGraph(source interface{}, graphID string) (*NetworkGraph, error) {
-		// initialize graph
-		graph := &NetworkGraph{
-			ID: graphID,
-			Nodes: make(map[string]*Node)
-		}
-		graph, err = populateGraph(source, graph)
-		if err != nil {
-			fmt.Println("CreateGraph failed: ", err)
-			return nil, fmt.Errorf("CreateGraph failed: %v", err)
-		}
+// Edge represents a directed, weighted edge between two nodes on the graph.
+type Edge struct {
+	From string
+	To   string
+	Type string  // edge type of outgoing node
+	Amt  float32 // $ value
+	Txs  float32 // # of transactions
 }
 
+// GraphID returns the ID of the graph for the given parameters.
+// Formats ID as year-root-depth-minAmt; minAmt is formatted without rounding
+// so graphs built with different thresholds do not share an ID.
+func GraphID(year, root string, depth int, minAmt float32) string {
+	return fmt.Sprintf("%s-%s-%d-%s", year, root, depth, strconv.FormatFloat(float64(minAmt), 'f', -1, 32))
+}
 
-func populateGraph(sourceNodes []interface{}, graph *NetworkGraph) (*NetworkGraph, error) {
-	// create root nodes & add to graph
-	for _, source := range sourceNodes {
-		switch r := source.(type) {
-		case *donations.Individual:
-			root, err := CreateDonorNode(source)
-			if err != nil {
-				fmt.Println("CreateGraph failed: ", err)
-				return nil, fmt.Errorf("CreateGraph failed: %v", err)
-			}
-		case *donations.Committee:
-			root, err := CreateCmteNode(source)
-			if err != nil {
-				fmt.Println("CreateGraph failed: ", err)
-				return nil, fmt.Errorf("CreateGraph failed: %v", err)
-			}
-		// case Candidate
-		// case DisbRecipient
-		}
-		graph.Nodes[root.ID] = root
+// CreateGraph builds the money-flow graph for the given year from the root Individual,
+// Committee, or Candidate ID. Adjacent nodes are found by breadth-first search up to
+// depth hops from the root following edges in both directions. Edges with a $ value
+// less than minAmt are not followed and edges to nodes outside the graph are removed.
+func CreateGraph(year, rootID string, depth int, minAmt float32) (*NetworkGraph, error) {
+	// initialize graph
+	graph := &NetworkGraph{
+		ID:     GraphID(year, rootID, depth, minAmt),
+		Year:   year,
+		Root:   rootID,
+		Depth:  depth,
+		MinAmt: minAmt,
+		Nodes:  make(map[string]*Node),
+	}
+	err := populateGraph(graph)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CreateGraph failed: %v", err)
+	}
+	if graph.Nodes[rootID] == nil {
+		return nil, fmt.Errorf("CreateGraph failed: %s: object not found", rootID)
+	}
+	return graph, nil
+}
 
-		// find adjacent nodes
-		adj, err := findAdjacent(source)
+// populateGraph adds the nodes within graph.Depth hops of the root node to the graph.
+func populateGraph(graph *NetworkGraph) error {
+	level := []string{graph.Root}
+	for d := 0; d <= graph.Depth && len(level) > 0; d++ {
+		nodes, err := createNodes(graph.Year, level)
 		if err != nil {
-			fmt.Println("CreateGraph failed: ", err)
-			return nil, fmt.Errorf("CreateGraph failed: %v", err)
+			fmt.Println(err)
+			return fmt.Errorf("populateGraph failed: %v", err)
 		}
 
-		// add adjacent nodes to graph or update if already exist in graph
-		for id, node := range adj {
-			if graph.Nodes[id] != nil {
-
-			}
-		} 
-
-	}	
-
-	
-	
-	// repeat with each node as new root (BFS)
-} 
-
-func findAdjacent(root *Node, adj map[string]*Node) (map[string]*Node, error) {
-	// find all nodes adjacent to root node
-	for nodeType, weights := range root.WeightedEdges {
-		// create nodes for adjacent nodes
-		if nodeType == "indvOUT" || "cmteOUT" {  // indv -> cmte  || cmte -> cmte
-			for id, amt := range weights {
-				// skip if node already exists
-				if adj[id] != nil {
+		// add nodes to graph and find adjacent nodes for next level (BFS)
+		next := []string{}
+		queued := make(map[string]bool)
+		for _, node := range nodes {
+			graph.Nodes[node.ID] = node
+		}
+		for _, node := range nodes {
+			for _, id := range node.adjacent(graph.MinAmt) {
+				if graph.Nodes[id] != nil || queued[id] {
 					continue
 				}
-
-				cmte, err := persist.GetCommittee(id)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				node, err := CreateCmteNode(cmte)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				adj[node.ID] = node
-				continue
+				queued[id] = true
+				next = append(next, id)
 			}
 		}
+		sort.Strings(next)
+		level = next
+	}
 
-		if nodeType == "disbOUT" {  // cmte -> disb. rec.
-			for id, amt := range weights {
-				// skip if node already exists
-				if adj[id] != nil {
-					continue
-				}
+	// remove edges below threshold or to nodes outside graph
+	for _, node := range graph.Nodes {
+		node.trimEdges(graph.Nodes, graph.MinAmt)
+	}
+	return nil
+}
 
-				cmte, err := persist.GetDisbRecipient(id)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				node, err := Create  // create DisbRec Node
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				adj[node.ID] = node
-				continue
+// trimEdges removes the node's edges to nodes not in the given
+// node map or with a $ value less than minAmt.
+func (n *Node) trimEdges(nodes map[string]*Node, minAmt float32) {
+	for t, weights := range n.WeightedEdges {
+		for id, amt := range weights {
+			if nodes[id] == nil || amt < minAmt {
+				delete(weights, id)
+				delete(n.EdgeTxs[t], id)
 			}
 		}
+		if len(weights) == 0 {
+			delete(n.WeightedEdges, t)
+			delete(n.EdgeTxs, t)
+		}
+	}
+}
 
-		if nodeType == "indvIN" {  // cmte <- indv
-			for id, amt := range weights {
-				// skip if node already exists
-				if adj[id] != nil {
-					continue
-				}
-				indv, err := persist.GetIndvDonor(id)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				node, err := CreateDonorNode(indv)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				adj[node.ID] = node
-				continue
+// adjacent returns the IDs of the nodes connected to the node by edges
+// in either direction with a $ value >= minAmt.
+func (n *Node) adjacent(minAmt float32) []string {
+	ids := []string{}
+	for _, weights := range n.WeightedEdges {
+		for id, amt := range weights {
+			if amt >= minAmt && id != n.ID {
+				ids = append(ids, id)
 			}
 		}
+	}
+	return ids
+}
 
-		if nodeType == "cmteIN" {  // cmte <- cmte
+// Edges returns the list of directed edges on the graph sorted by $ value.
+// Edges recorded by both nodes (ie. indvOUT/indvIN) are returned once using
+// the greater of the recorded $ values, or # of transactions if equal.
+func (g *NetworkGraph) Edges() []Edge {
+	edges := make(map[string]*Edge)
+	for _, node := range g.Nodes {
+		for t, weights := range node.WeightedEdges {
 			for id, amt := range weights {
-				// skip if node already exists
-				if adj[id] != nil {
-					continue
+				e := Edge{From: node.ID, To: id, Type: t, Amt: amt, Txs: node.EdgeTxs[t][id]}
+				if !outEdges[t] {
+					e.From, e.To, e.Type = id, node.ID, outType(t, node)
 				}
-				cmte, err := persist.GetCommittee(id)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
+				key := e.From + "|" + e.To
+				if prev := edges[key]; prev == nil || prev.Amt < e.Amt || (prev.Amt == e.Amt && prev.Txs < e.Txs) {
+					edges[key] = &e
 				}
-				node, err := CreateCmteNode(cmte)
-				if err != nil {
-					fmt.Println("CreateGraph failed: ", err)
-					return nil, fmt.Errorf("CreateGraph failed: %v", err)
-				}
-				adj[node.ID] = node
-				continue
 			}
 		}
-
-	// find adjacent nodes recursively 
-	for id, node := adj {
-		adj, err = findAdjacent(node, adj)
-		if err != nil {
-			fmt.Println("CreateGraph failed: ", err)
-			return nil, fmt.Errorf("CreateGraph failed: %v", err)
-		}
 	}
-
-		return adj, nil
+	list := []Edge{}
+	for _, e := range edges {
+		list = append(list, *e)
 	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Amt == list[j].Amt {
+			return list[i].From+list[i].To < list[j].From+list[j].To
+		}
+		return list[i].Amt > list[j].Amt
+	})
+	return list
 }
 
-/* Directed Graph - OUT -> (Donor -> Cmte -> Cand/DisbRec) */
-func CreateDonorNode(donor *donations.Individual) (*Node, error) {
-	weights := make(map[string]map[string]float32)
-
-	// find edges and weights
-	weights["indvOUT"] = make(map[string]float32)
-	for id, amt := range donor.RecipientsAmt {
-		edges = append(edges, id)
-		cmte, err := persist.GetCommittee(id)
-		if err != nil {
-			fmt.Println("CreateDonorNode failed: ", err)
-			return nil, fmt.Errorf("CreateDonorNode failed: %v", err)
-		}
-		weight := amt / cmte.TotalReceived
-		weights["indvOUT"][id] = weight
+// outType returns the outgoing edge type corresponding to the
+// incoming edge type recorded by the receiving node.
+func outType(in string, to *Node) string {
+	switch {
+	case in == EdgeIndvIn:
+		return EdgeIndvOut
+	case to.Type == NodeIndv:
+		return EdgeDisbOut
+	default:
+		return EdgeCmteOut
 	}
+}
 
-	// initialize node object
-	node := &Node{
-		ID:      donor.ID,
-		Name:    donor.Name,
-		Type:    "indv",
-		WeightedEdges: weights,
+// nodeBucket derives the object type from the ID and returns the corresponding bucket.
+// Individual IDs are 32 character hashes; committee and candidate IDs are 9 characters.
+func nodeBucket(id string) string {
+	if len(id) >= 16 || id == "" {
+		return "individuals"
+	}
+	switch id[:1] {
+	case "C":
+		return "cmte_tx_data"
+	case "H", "S", "P":
+		return "candidates"
+	default:
+		return "individuals"
 	}
-
-	return node, nil
 }
 
-// CreateCmteNode creates a node with edges in both directions
-func CreateCmteNode(cmte *donations.Committee) (*Node, error) 
-	weights := make(map[string]map[string]float32)
+// createNodes retrieves the objects for the given IDs and returns the corresponding nodes.
+// Nodes are read from the year's adjacency index; objects not in the index are
+// read from the full datasets. IDs not found in the year's data are skipped.
+func createNodes(year string, IDs []string) ([]*Node, error) {
+	adjs, err := persist.GetAdjacency(year, IDs)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("createNodes failed: %v", err)
+	}
 
-	// find edges and weights of Affiliate Committees (tx out ->)
-	weights["cmteOUT"] =  make(map[string]float32)
-	for id, amt := range cmte.AffiliatesAmt {
-		aff, err := persist.GetCommittee(id)
-		if err != nil {
-			fmt.Println("CreateDonorNode failed: ", err)
-			return nil, fmt.Errorf("CreateDonorNode failed: %v", err)
+	nodes := []*Node{}
+	byBucket := make(map[string][]string)
+	for _, id := range IDs {
+		if adjs[id] != nil {
+			nodes = append(nodes, adjacencyNode(adjs[id]))
+			continue
 		}
-		weight := amt / aff.TotalReceived
-		weights["cmteOUT"][id] = weight
+		b := nodeBucket(id)
+		byBucket[b] = append(byBucket[b], id)
 	}
 
-	// find edges and weights of Disbursement Recipients (tx out ->)
-	weights["disbOUT"] =  make(map[string]float32)
-	for id, amt := range cmte.TopDisbRecipientsAmt {
-		rec, err := persist.GetDisbRecipient(id)
+	if ids := byBucket["individuals"]; len(ids) > 0 {
+		objs, err := getObjs(year, "individuals", ids)
 		if err != nil {
-			fmt.Println("CreateCmteNode failed: ", err)
-			return nil, fmt.Errorf("CreateCmteNode failed: %v", err)
+			fmt.Println(err)
+			return nil, fmt.Errorf("createNodes failed: %v", err)
+		}
+		for _, obj := range objs {
+			nodes = append(nodes, CreateDonorNode(obj.(*donations.Individual)))
 		}
-		weight := amt / rec.TotalReceived
-		weights["disbOUT"][id] = weight
 	}
 
-	// find edges and weights of Indvidual Donors (tx in <-)
-	weights["indvIN"] =  make(map[string]float32)
-	for id, amt := range cmte.TopIndvDonorsAmt {
+	if ids := byBucket["cmte_tx_data"]; len(ids) > 0 {
+		objs, err := getObjs(year, "cmte_tx_data", ids)
 		if err != nil {
-			fmt.Println("CreateCmteNode failed: ", err)
-			return nil, fmt.Errorf("CreateCmteNode failed: %v", err)
+			fmt.Println(err)
+			return nil, fmt.Errorf("createNodes failed: %v", err)
+		}
+		cmtes, err := getObjs(year, "committees", ids)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("createNodes failed: %v", err)
+		}
+		info := make(map[string]*donations.Committee)
+		for _, obj := range cmtes {
+			info[obj.(*donations.Committee).ID] = obj.(*donations.Committee)
+		}
+		for _, obj := range objs {
+			cmte := obj.(*donations.CmteTxData)
+			nodes = append(nodes, CreateCmteNode(cmte, info[cmte.CmteID]))
 		}
-		weight := amt / cmte.TotalReceived
-		weights["indvIN"][id] = weight
 	}
 
-	// find edges and weights of Committee Donors (tx in <-)
-	weights["cmteIN"] =  make(map[string]float32)
-	for id, amt := range cmte.TopCmteDonorsAmt {
+	if ids := byBucket["candidates"]; len(ids) > 0 {
+		objs, err := getObjs(year, "candidates", ids)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("createNodes failed: %v", err)
+		}
+		rollups, err := getObjs(year, "cand_rollup", ids)
 		if err != nil {
-			fmt.Println("CreateCmteNode failed: ", err)
-			return nil, fmt.Errorf("CreateCmteNode failed: %v", err)
+			fmt.Println(err)
+			return nil, fmt.Errorf("createNodes failed: %v", err)
+		}
+		rMap := make(map[string]*donations.CandRollup)
+		for _, obj := range rollups {
+			rMap[obj.(*donations.CandRollup).CandID] = obj.(*donations.CandRollup)
+		}
+		for _, obj := range objs {
+			cand := obj.(*donations.Candidate)
+			nodes = append(nodes, CreateCandNode(cand, rMap[cand.ID]))
 		}
-		weight := amt / cmte.TotalReceived
-		weights["cmteIN"][id] = weight
 	}
 
-	// initialize node object
-	cmteType := "pac"
-	if cmte.Candidate != nil {  // determine committee type
-		cmteType = "pcc"
+	return nodes, nil
+}
+
+// getObjs returns the objects for the given IDs in the year's bucket.
+// An empty list is returned if the bucket does not exist for the year.
+func getObjs(year, bucket string, IDs []string) ([]interface{}, error) {
+	objs, err := persist.BatchGetByYear([]string{year}, bucket, IDs)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getObjs failed: %v", err)
 	}
-	node := &Node{
-		ID:      cmte.ID,
-		Name:    cmte.Name,
-		Type:    cmteType,
-		WeightedEdges: weights,
+	return objs[year], nil
+}
+
+// newNode initializes a Node with empty edge maps.
+func newNode(id, name, nodeType string) *Node {
+	return &Node{
+		ID:            id,
+		Name:          name,
+		Type:          nodeType,
+		WeightedEdges: make(map[string]map[string]float32),
+		EdgeTxs:       make(map[string]map[string]float32),
 	}
+}
 
-	return node, nil
+// addEdges adds the non-zero entries of the given $ value and # of transactions
+// maps to the node's edges of the given type.
+func (n *Node) addEdges(t string, amts, txs map[string]float32) {
+	for id, amt := range amts {
+		if amt <= 0 {
+			continue
+		}
+		if n.WeightedEdges[t] == nil {
+			n.WeightedEdges[t] = make(map[string]float32)
+			n.EdgeTxs[t] = make(map[string]float32)
+		}
+		n.WeightedEdges[t][id] += amt
+		n.EdgeTxs[t][id] += txs[id]
+	}
 }
 
-func CreateCandNode(cand *donations.Candidate) (*Node, error) {
-	weights := make(map[string]float32)
+/* Directed Graph - OUT -> (Donor -> Cmte -> Cand/DisbRec) */
 
-	// find edges and weights of Individual donors (tx in <-)
-	for id, amt := range cand.TopIndvDonorsAmt {
-		edges = append(edges, id)
-		cmte, err := persist.GetCommittee(id)
-		if err != nil {
-			fmt.Println("CreateDonorNode failed: ", err)
-			return nil, fmt.Errorf("CreateDonorNode failed: %v", err)
+// CreateDonorNode creates a node for an individual with edges to the committees
+// contributed to (tx out ->) and from the committees funds were received from (tx in <-).
+func CreateDonorNode(donor *donations.Individual) *Node {
+	node := newNode(donor.ID, donor.Name, NodeIndv)
+	node.State = donor.State
+	node.TotalInAmt = donor.TotalInAmt
+	node.TotalOutAmt = donor.TotalOutAmt
+	node.addEdges(EdgeIndvOut, donor.RecipientsAmt, donor.RecipientsTxs)
+	node.addEdges(EdgeCmteIn, donor.SendersAmt, donor.SendersTxs)
+	return node
+}
+
+// CreateCmteNode creates a node with edges in both directions.
+// info provides the committee's name, party and state and may be nil.
+func CreateCmteNode(cmte *donations.CmteTxData, info *donations.Committee) *Node {
+	cmteType := NodePAC
+	if cmte.CandID != "" { // determine committee type
+		cmteType = NodePCC
+	}
+	node := newNode(cmte.CmteID, "", cmteType)
+	node.Party = cmte.Party
+	if info != nil {
+		node.Name = info.Name
+		node.State = info.State
+		if node.Party == "" {
+			node.Party = info.Party
 		}
-		weight := amt / cmte.TotalReceived
-		weights[id] = weight
 	}
-
-	// initialize node object
-	node := &Node{
-		ID:      cand.ID,
-		Name:    cand.Name,
-		Type:    "cand",
-		WeightedEdges: weights,
+	node.TotalInAmt = cmte.TotalIncomingAmt
+	node.TotalOutAmt = cmte.TotalOutgoingAmt
+	node.addEdges(EdgeIndvIn, cmte.TopIndvContributorsAmt, cmte.TopIndvContributorsTxs)
+	node.addEdges(EdgeCmteIn, cmte.TopCmteOrgContributorsAmt, cmte.TopCmteOrgContributorsTxs)
+	node.addEdges(EdgeCmteOut, cmte.TransferRecsAmt, cmte.TransferRecsTxs)
+	node.addEdges(EdgeDisbOut, cmte.TopExpRecipientsAmt, cmte.TopExpRecipientsTxs)
+	if cmte.CandID != "" {
+		node.addEdges(EdgeCandLnk,
+			map[string]float32{cmte.CandID: cmte.TotalIncomingAmt},
+			map[string]float32{cmte.CandID: cmte.TotalIncomingTxs},
+		)
 	}
+	return node
+}
 
-	return node, nil
+// CreateCandNode creates a node with edges in both directions from the funds
+// received/sent by all of the candidate's linked committees (rollup) and the
+// candidate's direct contributions to committees. rollup may be nil.
+func CreateCandNode(cand *donations.Candidate, rollup *donations.CandRollup) *Node {
+	node := newNode(cand.ID, cand.Name, NodeCand)
+	node.Party = cand.Party
+	node.State = cand.OfficeState
+	node.TotalOutAmt = cand.TotalDirectOutAmt
+	node.addEdges(EdgeIndvOut, cand.DirectRecipientsAmts, cand.DirectRecipientsTxs)
+	if rollup != nil {
+		node.TotalInAmt = rollup.TotalIncomingAmt
+		node.TotalOutAmt += rollup.TotalOutgoingAmt
+		node.addEdges(EdgeIndvIn, rollup.TopIndvContributorsAmt, rollup.TopIndvContributorsTxs)
+		node.addEdges(EdgeCmteIn, rollup.TopCmteOrgContributorsAmt, rollup.TopCmteOrgContributorsTxs)
+		node.addEdges(EdgeCmteOut, rollup.TransferRecsAmt, rollup.TransferRecsTxs)
+		node.addEdges(EdgeDisbOut, rollup.TopExpRecipientsAmt, rollup.TopExpRecipientsTxs)
+	}
+	return node
 }
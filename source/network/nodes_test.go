@@ -0,0 +1,281 @@
+package network
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+)
+
+// indv -> PCC -> PAC -> vendor; indv2 -> PAC (below threshold)
+var graphObjs = []interface{}{
+	&donations.Individual{
+		ID:            "indv00000000000000000000000000a1",
+		Name:          "James Bond",
+		TotalOutAmt:   5600,
+		RecipientsAmt: map[string]float32{"C00000001": 2800},
+	},
+	&donations.Individual{
+		ID:            "indv00000000000000000000000000a2",
+		Name:          "Eric Cartman",
+		RecipientsAmt: map[string]float32{"C00000002": 50},
+	},
+	&donations.Individual{
+		ID:         "indv00000000000000000000000000v1",
+		Name:       "Acme Consulting",
+		SendersAmt: map[string]float32{"C00000002": 10000},
+	},
+	&donations.Committee{ID: "C00000001", Name: "Bond for Senate", CandID: "S00000001"},
+	&donations.Committee{ID: "C00000002", Name: "MI6 PAC & Friends", State: "VA"},
+	&donations.CmteTxData{
+		CmteID:                 "C00000001",
+		CandID:                 "S00000001",
+		TotalIncomingAmt:       2800,
+		TopIndvContributorsAmt: map[string]float32{"indv00000000000000000000000000a1": 2800},
+		TransferRecsAmt:        map[string]float32{"C00000002": 1000},
+		TransferRecsTxs:        map[string]float32{"C00000002": 2},
+	},
+	&donations.CmteTxData{
+		CmteID:                    "C00000002",
+		TopIndvContributorsAmt:    map[string]float32{"indv00000000000000000000000000a2": 50},
+		TopCmteOrgContributorsAmt: map[string]float32{"C00000001": 1000},
+		TopExpRecipientsAmt:       map[string]float32{"indv00000000000000000000000000v1": 10000},
+	},
+	&donations.Candidate{ID: "S00000001", Name: "James Bond", PCC: "C00000001"},
+}
+
+// initTestDB stores the test objects in a temporary database and returns the directory.
+func initTestDB(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	persist.OUTPUT_PATH = dir
+	if err := os.Mkdir(dir+"/db", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := persist.Init("2020"); err != nil {
+		t.Fatal(err)
+	}
+	if err := persist.StoreObjects("2020", graphObjs); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestCreateGraph(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		root   string
+		depth  int
+		minAmt float32
+		nodes  int
+		edges  int
+	}{
+		{"indv00000000000000000000000000a1", 0, 0, 1, 0},
+		{"indv00000000000000000000000000a1", 1, 0, 2, 1},
+		{"indv00000000000000000000000000a1", 2, 0, 4, 3}, // + cand, PAC
+		{"indv00000000000000000000000000a1", 3, 0, 6, 5}, // + indv2, vendor
+		{"indv00000000000000000000000000a1", 3, 100, 5, 4},
+		{"C00000002", 1, 0, 4, 3},
+	}
+	for _, test := range tests {
+		graph, err := CreateGraph("2020", test.root, test.depth, test.minAmt)
+		if err != nil {
+			t.Fatalf("CreateGraph(%s, %d, %.0f) failed: %v", test.root, test.depth, test.minAmt, err)
+		}
+		if len(graph.Nodes) != test.nodes {
+			t.Errorf("CreateGraph(%s, %d, %.0f): %d nodes; want %d", test.root, test.depth, test.minAmt, len(graph.Nodes), test.nodes)
+		}
+		if edges := graph.Edges(); len(edges) != test.edges {
+			t.Errorf("CreateGraph(%s, %d, %.0f): %d edges; want %d: %v", test.root, test.depth, test.minAmt, len(edges), test.edges, edges)
+		}
+	}
+
+	// directed edges and persist/get round trip
+	graph, err := CreateGraph("2020", "C00000002", 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"C00000001|C00000002":                        EdgeCmteOut,
+		"indv00000000000000000000000000a2|C00000002": EdgeIndvOut,
+		"C00000002|indv00000000000000000000000000v1": EdgeDisbOut,
+	}
+	for _, e := range graph.Edges() {
+		if want[e.From+"|"+e.To] != e.Type {
+			t.Errorf("Edges: unexpected edge %v", e)
+		}
+	}
+	if err := PersistGraph(graph); err != nil {
+		t.Fatal(err)
+	}
+	got, err := GetGraph(graph.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Root != "C00000002" || got.Depth != 1 || len(got.Nodes) != len(graph.Nodes) {
+		t.Errorf("GetGraph: got %+v; want %+v", got, graph)
+	}
+	if got.Nodes["C00000002"].WeightedEdges[EdgeDisbOut]["indv00000000000000000000000000v1"] != 10000 {
+		t.Errorf("GetGraph: invalid edge weights: %v", got.Nodes["C00000002"].WeightedEdges)
+	}
+}
+
+func TestFindPaths(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	var tests = []struct {
+		to         string
+		maxHops    int
+		rankBy     string
+		hops       int
+		bottleneck float32
+		attributed float32
+	}{
+		{"C00000002", 2, RankBottleneck, 2, 1000, 1000},
+		{"C00000002", 1, RankBottleneck, 0, 0, 0}, // no path
+		{"S00000001", 3, RankShare, 2, 2800, 2800},
+	}
+	for _, test := range tests {
+		paths, err := FindPaths("2020", "indv00000000000000000000000000a1", test.to, 3, test.maxHops, test.rankBy, 0)
+		if err != nil {
+			t.Fatalf("FindPaths(%s) failed: %v", test.to, err)
+		}
+		if test.hops == 0 {
+			if len(paths) != 0 {
+				t.Errorf("FindPaths(%s, %d): got %v; want no paths", test.to, test.maxHops, paths)
+			}
+			continue
+		}
+		if len(paths) != 1 {
+			t.Fatalf("FindPaths(%s, %d): got %d paths; want 1", test.to, test.maxHops, len(paths))
+		}
+		p := paths[0]
+		if len(p.Hops) != test.hops || p.Bottleneck != test.bottleneck || p.Attributed != test.attributed {
+			t.Errorf("FindPaths(%s, %d): got %+v", test.to, test.maxHops, p)
+		}
+		if p.Share != test.attributed/5600 {
+			t.Errorf("FindPaths(%s, %d): share = %f; want %f", test.to, test.maxHops, p.Share, test.attributed/5600)
+		}
+	}
+}
+
+func TestCreateNetwork(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	// 2018 has no data for root
+	graph, err := CreateNetwork([]string{"2020", "2018"}, "indv00000000000000000000000000a1", 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if graph.ID != "2018_2020-indv00000000000000000000000000a1-3-0" || len(graph.Nodes) != 6 {
+		t.Errorf("CreateNetwork: got %s with %d nodes; want 6 nodes", graph.ID, len(graph.Nodes))
+	}
+	dist := graph.Distances()
+	if dist["C00000002"] != 2 || dist["indv00000000000000000000000000v1"] != 3 {
+		t.Errorf("Distances: got %v", dist)
+	}
+
+	// clip to root, PCC and PAC/cand
+	if !graph.Clip(3) || len(graph.Nodes) != 3 {
+		t.Fatalf("Clip(3): got %d nodes; want 3", len(graph.Nodes))
+	}
+	for _, e := range graph.Edges() {
+		if graph.Nodes[e.From] == nil || graph.Nodes[e.To] == nil {
+			t.Errorf("Clip(3): edge %v to removed node", e)
+		}
+	}
+	if graph.Clip(3) {
+		t.Errorf("Clip(3): clipped graph already within limit")
+	}
+
+	// graphs are deleted when any of their years are rebuilt
+	single, err := CreateGraph("2020", "C00000002", 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range []*NetworkGraph{graph, single} {
+		if err := PersistGraph(g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n, err := DeleteGraphs("2018"); err != nil || n != 1 {
+		t.Errorf("DeleteGraphs(2018) = %d, %v; want 1", n, err)
+	}
+	if exists, _ := GraphExists(single.ID); !exists {
+		t.Errorf("DeleteGraphs(2018): deleted %s", single.ID)
+	}
+	if n, err := DeleteGraphs("2020"); err != nil || n != 1 {
+		t.Errorf("DeleteGraphs(2020) = %d, %v; want 1", n, err)
+	}
+	if exists, _ := GraphExists(single.ID); exists {
+		t.Errorf("DeleteGraphs(2020): %s not deleted", single.ID)
+	}
+}
+
+func TestBuildAdjacency(t *testing.T) {
+	dir := initTestDB(t)
+	defer os.RemoveAll(dir)
+
+	want, err := CreateGraph("2020", "indv00000000000000000000000000a1", 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := BuildAdjacency("2020"); err != nil {
+		t.Fatal(err)
+	}
+	adjs, err := persist.GetAdjacency("2020", []string{"C00000001", "S00000001", "C99999999"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(adjs) != 2 || adjs["C00000001"].Type != NodePCC || len(adjs["C00000001"].Out) != 2 || len(adjs["C00000001"].In) != 1 {
+		t.Fatalf("GetAdjacency: got %+v", adjs)
+	}
+
+	// graph built from adjacency index matches graph built from objects
+	got, err := CreateGraph("2020", "indv00000000000000000000000000a1", 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Nodes) != len(want.Nodes) || len(got.Edges()) != len(want.Edges()) {
+		t.Errorf("CreateGraph: got %d nodes, %d edges; want %d, %d", len(got.Nodes), len(got.Edges()), len(want.Nodes), len(want.Edges()))
+	}
+	for i, e := range got.Edges() {
+		if e != want.Edges()[i] {
+			t.Errorf("CreateGraph: edge %d = %+v; want %+v", i, e, want.Edges()[i])
+		}
+	}
+}
+
+func TestGraphID(t *testing.T) {
+	var tests = []struct {
+		minAmt float32
+		want   string
+	}{
+		{0, "2020-C001-2-0"},
+		{0.4, "2020-C001-2-0.4"},
+		{100, "2020-C001-2-100"},
+		{250.5, "2020-C001-2-250.5"},
+	}
+	for _, test := range tests {
+		id := GraphID("2020", "C001", 2, test.minAmt)
+		if id != test.want {
+			t.Errorf("GraphID(%v) = %q; want %q", test.minAmt, id, test.want)
+		}
+		graph, err := parseGraphID(id)
+		if err != nil {
+			t.Errorf("parseGraphID(%q) failed: %v", id, err)
+			continue
+		}
+		if graph.MinAmt != test.minAmt {
+			t.Errorf("parseGraphID(%q) MinAmt = %v; want %v", id, graph.MinAmt, test.minAmt)
+		}
+	}
+}
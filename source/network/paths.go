@@ -0,0 +1,224 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for finding the top money-flow paths between
+// two entities over the transfer graph.
+package network
+
+import (
+	"container/heap"
+	"fmt"
+)
+
+/*
+	PATH CRITERIA
+	Paths follow the directed transfer graph:
+		- indv/cand -> cmte: contributions (Individual.RecipientsAmt, Candidate.DirectRecipientsAmts)
+		- cmte -> cmte: transfers (CmteTxData.TransferRecsAmt)
+		- pcc -> cand: committee linked to candidate
+	Paths are ranked by either:
+		- bottleneck: the smallest hop amount on the path
+		- share: the $ value attributed to the source, assuming each intermediary's
+		  outgoing transfers are funded pro rata by its total receipts
+	Both scores are non-increasing as a path is extended, so paths are found with a
+	best-first search and the first k paths reaching the target are the top k paths.
+*/
+
+// path ranking methods
+const (
+	RankBottleneck = "bottleneck"
+	RankShare      = "share"
+)
+
+// maxExpansions limits the # of partial paths expanded per search.
+const maxExpansions = 50000
+
+// Hop represents a single edge on a money-flow path.
+type Hop struct {
+	From     string
+	FromName string
+	To       string
+	ToName   string
+	Type     string  // edge type
+	Amt      float32 // $ value sent
+	Txs      float32 // # of transactions
+}
+
+// Path represents a money-flow path from the source to the target.
+type Path struct {
+	Hops       []Hop
+	Bottleneck float32 // smallest hop $ value
+	Attributed float32 // $ value attributed to the source
+	Share      float32 // share of the source's total outgoing $ value attributed to the target
+}
+
+// partial path on the search frontier
+type pathItem struct {
+	path  Path
+	seen  map[string]bool
+	score float32
+}
+
+// pathQueue is a max-heap of partial paths by score.
+type pathQueue []*pathItem
+
+func (q pathQueue) Len() int            { return len(q) }
+func (q pathQueue) Less(i, j int) bool  { return q[i].score > q[j].score }
+func (q pathQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *pathQueue) Push(x interface{}) { *q = append(*q, x.(*pathItem)) }
+func (q *pathQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// flowNodes lazily loads and caches the nodes on the transfer graph.
+type flowNodes struct {
+	year  string
+	nodes map[string]*Node
+}
+
+// load retrieves and caches the nodes for the IDs not yet loaded.
+// IDs for objects that do not exist are cached as nil nodes.
+func (f *flowNodes) load(IDs []string) error {
+	ids := []string{}
+	for _, id := range IDs {
+		if _, ok := f.nodes[id]; !ok {
+			ids = append(ids, id)
+			f.nodes[id] = nil
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	nodes, err := createNodes(f.year, ids)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("load failed: %v", err)
+	}
+	for _, node := range nodes {
+		f.nodes[node.ID] = node
+	}
+	return nil
+}
+
+// flowEdges returns the node's outgoing edge types on the transfer graph.
+// Candidate transfers are recorded by the linked committees and excluded.
+func flowEdges(n *Node) []string {
+	if n.Type == NodeCand {
+		return []string{EdgeIndvOut}
+	}
+	return []string{EdgeIndvOut, EdgeCmteOut, EdgeCandLnk}
+}
+
+// FindPaths returns the top k money-flow paths of at most maxHops hops from the source
+// to the target for the given year, ranked by the given method (bottleneck/share).
+// Hops with a $ value less than minAmt are not followed.
+func FindPaths(year, from, to string, k, maxHops int, rankBy string, minAmt float32) ([]Path, error) {
+	if rankBy != RankBottleneck && rankBy != RankShare {
+		return nil, fmt.Errorf("FindPaths failed: %s: invalid ranking method", rankBy)
+	}
+	if k < 1 || maxHops < 1 {
+		return nil, fmt.Errorf("FindPaths failed: k and maxHops must be > 0")
+	}
+	fn := &flowNodes{year: year, nodes: make(map[string]*Node)}
+	if err := fn.load([]string{from}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("FindPaths failed: %v", err)
+	}
+	src := fn.nodes[from]
+	if src == nil {
+		return nil, fmt.Errorf("FindPaths failed: %s: object not found", from)
+	}
+
+	paths := []Path{}
+	q := &pathQueue{{seen: map[string]bool{from: true}, score: 1e30}}
+	for n := 0; q.Len() > 0 && len(paths) < k && n < maxExpansions; n++ {
+		item := heap.Pop(q).(*pathItem)
+		last := from
+		if len(item.path.Hops) > 0 {
+			last = item.path.Hops[len(item.path.Hops)-1].To
+		}
+		if last == to {
+			paths = append(paths, item.path)
+			continue
+		}
+		if len(item.path.Hops) == maxHops {
+			continue
+		}
+		node := fn.nodes[last]
+		if node == nil {
+			continue
+		}
+
+		// load adjacent nodes
+		adj := []string{}
+		for _, t := range flowEdges(node) {
+			for id, amt := range node.WeightedEdges[t] {
+				if !item.seen[id] && amt >= minAmt {
+					adj = append(adj, id)
+				}
+			}
+		}
+		if err := fn.load(adj); err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("FindPaths failed: %v", err)
+		}
+
+		for _, t := range flowEdges(node) {
+			for id, amt := range node.WeightedEdges[t] {
+				next := fn.nodes[id]
+				if item.seen[id] || amt < minAmt || next == nil {
+					continue
+				}
+				hop := Hop{
+					From: node.ID, FromName: node.Name,
+					To: next.ID, ToName: next.Name,
+					Type: t, Amt: amt, Txs: node.EdgeTxs[t][id],
+				}
+				path := extendPath(item.path, hop, node, src)
+				score := path.Bottleneck
+				if rankBy == RankShare {
+					score = path.Attributed
+				}
+				seen := make(map[string]bool)
+				for s := range item.seen {
+					seen[s] = true
+				}
+				seen[id] = true
+				heap.Push(q, &pathItem{path: path, seen: seen, score: score})
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// extendPath returns a copy of the path extended by the hop from node.
+// The $ value attributed to the source is scaled by the share of the
+// intermediary's receipts sent on the hop.
+func extendPath(p Path, hop Hop, node, src *Node) Path {
+	ext := Path{Hops: append(append([]Hop{}, p.Hops...), hop)}
+	if len(p.Hops) == 0 {
+		ext.Bottleneck = hop.Amt
+		ext.Attributed = hop.Amt
+	} else {
+		ext.Bottleneck = p.Bottleneck
+		if hop.Type != EdgeCandLnk && hop.Amt < ext.Bottleneck {
+			ext.Bottleneck = hop.Amt
+		}
+		frac := float32(1.0)
+		if hop.Type != EdgeCandLnk && node.TotalInAmt > 0 && hop.Amt < node.TotalInAmt {
+			frac = hop.Amt / node.TotalInAmt
+		}
+		ext.Attributed = p.Attributed * frac
+	}
+	if src.TotalOutAmt > 0 {
+		ext.Share = ext.Attributed / src.TotalOutAmt
+	}
+	return ext
+}
@@ -0,0 +1,103 @@
+// Package network contains operations for building social network graphs from
+// object data. Graphs are directed, weighted money-flow graphs built from the
+// persisted Individual, CmteTxData, and CandRollup data for a given year.
+// Analysis of the social network graphs derived from this package will be done
+// by Python's NetworkX package.
+// This file contains operations for building the committee transfer graph
+// comprised of every committee for a given year.
+package network
+
+import (
+	"fmt"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/persist"
+)
+
+// TransferGraphID returns the ID of the year's committee transfer graph.
+// Formats ID as year-transfers-0-minAmt.
+func TransferGraphID(year string, minAmt float32) string {
+	return GraphID(year, "transfers", 0, minAmt)
+}
+
+// CreateTransferGraph builds the graph of transfers between all committees for the given
+// year. Each committee with a transfer >= minAmt to/from another committee is added as a
+// node; only committee -> committee transfer edges are included.
+func CreateTransferGraph(year string, minAmt float32) (*NetworkGraph, error) {
+	graph := &NetworkGraph{
+		ID:     TransferGraphID(year, minAmt),
+		Year:   year,
+		Root:   "transfers",
+		MinAmt: minAmt,
+		Nodes:  make(map[string]*Node),
+	}
+
+	// get committee names, parties & states
+	info, err := getCmteInfo(year)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("CreateTransferGraph failed: %v", err)
+	}
+
+	// create committee nodes with transfer edges only
+	curr := ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "cmte_tx_data", curr, 10000)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("CreateTransferGraph failed: %v", err)
+		}
+		for _, obj := range objs {
+			cmte := obj.(*donations.CmteTxData)
+			node := CreateCmteNode(cmte, info[cmte.CmteID])
+			for t := range node.WeightedEdges {
+				if t != EdgeCmteOut && t != EdgeCmteIn {
+					delete(node.WeightedEdges, t)
+					delete(node.EdgeTxs, t)
+				}
+			}
+			graph.Nodes[node.ID] = node
+		}
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+
+	// remove edges to non-committee nodes/below threshold and committees without transfers
+	for _, node := range graph.Nodes {
+		node.trimEdges(graph.Nodes, minAmt)
+	}
+	linked := make(map[string]bool)
+	for _, e := range graph.Edges() {
+		linked[e.From], linked[e.To] = true, true
+	}
+	for id := range graph.Nodes {
+		if !linked[id] {
+			delete(graph.Nodes, id)
+		}
+	}
+
+	return graph, nil
+}
+
+// getCmteInfo maps each committee ID to the year's Committee object.
+func getCmteInfo(year string) (map[string]*donations.Committee, error) {
+	info := make(map[string]*donations.Committee)
+	curr := ""
+	for {
+		objs, key, err := persist.BatchGetSequential(year, "committees", curr, 10000)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("getCmteInfo failed: %v", err)
+		}
+		for _, obj := range objs {
+			info[obj.(*donations.Committee).ID] = obj.(*donations.Committee)
+		}
+		curr = key
+		if curr == "" {
+			break
+		}
+	}
+	return info, nil
+}
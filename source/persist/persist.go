@@ -187,6 +187,47 @@ func BatchGetSequential(year, bucket, startKey string, n int) ([]interface{}, st
 	return objs, currKey, nil
 }
 
+// BatchGetByYear returns the objects for the given IDs contained within the given bucket
+// for each of the given years, mapped by year. Years without the bucket are skipped.
+func BatchGetByYear(years []string, bucket string, IDs []string) (map[string][]interface{}, error) {
+	objs := make(map[string][]interface{})
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/offline_db.db", 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("BatchGetByYear failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.View(func(tx *bolt.Tx) error {
+		for _, year := range years {
+			yb := tx.Bucket([]byte(year))
+			if yb == nil || yb.Bucket([]byte(bucket)) == nil {
+				continue // no data for year
+			}
+			b := yb.Bucket([]byte(bucket))
+			for _, id := range IDs {
+				data := b.Get([]byte(id))
+				if data == nil {
+					continue
+				}
+				obj, err := decodeFromProto(bucket, data)
+				if err != nil {
+					fmt.Println(err)
+					return fmt.Errorf("tx failed: %v", err)
+				}
+				objs[year] = append(objs[year], obj)
+			}
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("BatchGetByYear failed: %v", err)
+	}
+
+	return objs, nil
+}
+
 // BatchGetByID returns a list of objects for the given IDs contained within the given bucket.
 // Returns a list of non-nil objects of same type and list of IDs that returned nil objects.
 func BatchGetByID(year, bucket string, IDs []string) ([]interface{}, []string, error) {
@@ -296,6 +337,140 @@ func SaveTopOverall(year, bucket string, ods []interface{}) error {
 	return nil
 }
 
+// SaveCandRollups saves a list of CandRollup objects for the given year.
+// The cand_rollup bucket is created if it does not exist.
+func SaveCandRollups(year string, rollups []interface{}) error {
+	err := saveSecondaryObjs(year, rollups)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("SaveCandRollups failed: %v", err)
+	}
+	return nil
+}
+
+// SaveGeoData saves a list of GeoData and/or GeoTotal objects for the given year.
+// The geo_data and geo_totals buckets are created if they do not exist.
+func SaveGeoData(year string, objs []interface{}) error {
+	err := saveSecondaryObjs(year, objs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("SaveGeoData failed: %v", err)
+	}
+	return nil
+}
+
+// GetGeoTotals retreives the GeoTotal objects from disk for the given year.
+func GetGeoTotals(year string) ([]interface{}, error) {
+	objs, err := getSecondaryObjs(year, "geo_totals")
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetGeoTotals failed: %v", err)
+	}
+	return objs, nil
+}
+
+// SaveSectorData saves a list of SectorData and/or SectorTotal objects for the given year.
+// The sector_data and sector_totals buckets are created if they do not exist.
+func SaveSectorData(year string, objs []interface{}) error {
+	err := saveSecondaryObjs(year, objs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("SaveSectorData failed: %v", err)
+	}
+	return nil
+}
+
+// GetSectorTotals retreives the SectorTotal objects from disk for the given year.
+func GetSectorTotals(year string) ([]interface{}, error) {
+	objs, err := getSecondaryObjs(year, "sector_totals")
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetSectorTotals failed: %v", err)
+	}
+	return objs, nil
+}
+
+// getSecondaryObjs retreives every object in the given secondary data bucket.
+// An empty list is returned if the bucket has not yet been created.
+func getSecondaryObjs(year, bucket string) ([]interface{}, error) {
+	objs := []interface{}{}
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/offline_db.db", 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getSecondaryObjs failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.View(func(tx *bolt.Tx) error {
+		yb := tx.Bucket([]byte(year))
+		if yb == nil {
+			return nil // year not yet created
+		}
+		b := yb.Bucket([]byte(bucket))
+		if b == nil {
+			return nil // secondary data not yet created
+		}
+		c := b.Cursor()
+
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			obj, err := decodeFromProto(bucket, v)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			objs = append(objs, obj)
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getSecondaryObjs failed: %v", err)
+	}
+
+	return objs, nil
+}
+
+// saveSecondaryObjs saves a list of objects derived from the primary datasets
+// to their corresponding buckets, creating the buckets if they do not exist.
+func saveSecondaryObjs(year string, objs []interface{}) error {
+	db, err := bolt.Open(OUTPUT_PATH+"/db/offline_db.db", 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("saveSecondaryObjs failed: %v", err)
+	}
+	defer db.Close()
+
+	// tx
+	if err := db.Update(func(tx *bolt.Tx) error {
+		yb, err := tx.CreateBucketIfNotExists([]byte(year))
+		if err != nil {
+			fmt.Println(err)
+			return fmt.Errorf("tx failed %v", err)
+		}
+		for _, obj := range objs {
+			bucket, key, data, err := encodeToProto(obj)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed %v", err)
+			}
+			b, err := yb.CreateBucketIfNotExists([]byte(bucket))
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed %v", err)
+			}
+			if err = b.Put([]byte(key), data); err != nil { // serialize k,v
+				return fmt.Errorf("tx failed %v", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("saveSecondaryObjs failed: %v", err)
+	}
+	return nil
+}
+
 // GetYearlyTotals retreives the Yearly objects from disk for the given year/cateogry.
 func GetYearlyTotals(year, cat string) ([]interface{}, error) {
 	objs := []interface{}{}
@@ -483,6 +658,60 @@ func encodeToProto(obj interface{}) (string, string, []byte, error) {
 			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
 		}
 		return bucket, key, data, nil
+	case *donations.CandRollup:
+		bucket := "cand_rollup"
+		key := obj.(*donations.CandRollup).CandID
+		data, err := encodeCandRollup(*obj.(*donations.CandRollup))
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
+		}
+		return bucket, key, data, nil
+	case *donations.Adjacency:
+		bucket := "adjacency"
+		key := obj.(*donations.Adjacency).ID
+		data, err := encodeAdjacency(*obj.(*donations.Adjacency))
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
+		}
+		return bucket, key, data, nil
+	case *donations.GeoData:
+		bucket := "geo_data"
+		key := obj.(*donations.GeoData).ID
+		data, err := encodeGeoData(*obj.(*donations.GeoData))
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
+		}
+		return bucket, key, data, nil
+	case *donations.GeoTotal:
+		bucket := "geo_totals"
+		key := obj.(*donations.GeoTotal).ID
+		data, err := encodeGeoTotal(*obj.(*donations.GeoTotal))
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
+		}
+		return bucket, key, data, nil
+	case *donations.SectorData:
+		bucket := "sector_data"
+		key := obj.(*donations.SectorData).ID
+		data, err := encodeSectorData(*obj.(*donations.SectorData))
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
+		}
+		return bucket, key, data, nil
+	case *donations.SectorTotal:
+		bucket := "sector_totals"
+		key := obj.(*donations.SectorTotal).ID
+		data, err := encodeSectorTotal(*obj.(*donations.SectorTotal))
+		if err != nil {
+			fmt.Println(err)
+			return "", "", nil, fmt.Errorf("encodeToProto failed: %v", err)
+		}
+		return bucket, key, data, nil
 	case *donations.YearlyTotal:
 		bucket := "yearly_totals"
 		key := obj.(*donations.YearlyTotal).ID
@@ -567,6 +796,57 @@ func decodeFromProto(bucket string, data []byte) (interface{}, error) {
 			data.Year = "0000"
 		}
 		return &data, nil
+	case "cand_rollup":
+		data, err := decodeCandRollup(data)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("decodeFromProto failed: %v", err)
+		}
+		if data.Party == "" {
+			data.Party = "???"
+		}
+		return &data, nil
+	case "adjacency":
+		data, err := decodeAdjacency(data)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("decodeFromProto failed: %v", err)
+		}
+		return &data, nil
+	case "geo_data":
+		data, err := decodeGeoData(data)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("decodeFromProto failed: %v", err)
+		}
+		return &data, nil
+	case "geo_totals":
+		data, err := decodeGeoTotal(data)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("decodeFromProto failed: %v", err)
+		}
+		if data.Year == "" {
+			data.Year = "0000"
+		}
+		return &data, nil
+	case "sector_data":
+		data, err := decodeSectorData(data)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("decodeFromProto failed: %v", err)
+		}
+		return &data, nil
+	case "sector_totals":
+		data, err := decodeSectorTotal(data)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("decodeFromProto failed: %v", err)
+		}
+		if data.Year == "" {
+			data.Year = "0000"
+		}
+		return &data, nil
 	case "yearly_totals":
 		data, err := decodeYrTotal(data)
 		if err != nil {
@@ -595,7 +875,7 @@ func createDB() {
 
 // initializes BoltDB buckets datasets are stored in on disk
 func createObjBuckets(year string) error {
-	buckets := []string{"individuals", "committees", "candidates", "cmte_tx_data", "cmte_fin", "cmpn_fin", "top_overall", "yearly_totals"}
+	buckets := []string{"individuals", "committees", "candidates", "cmte_tx_data", "cmte_fin", "cmpn_fin", "top_overall", "yearly_totals", "cand_rollup", "geo_data", "geo_totals", "sector_data", "sector_totals", "adjacency"}
 	for _, bucket := range buckets {
 		err := createBucket(year, bucket)
 		if err != nil {
@@ -0,0 +1,128 @@
+// Package persist contains operations for reading and writing disk data.
+// Most operations in this package are intended to be performed on the
+// admin local machine and are not intended to be used in the service logic.
+// This file contains operations for encoding/decoding protobufs for the
+// donations.Adjacency object and reading the adjacency index.
+package persist
+
+import (
+	"fmt"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/protobuf"
+
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+)
+
+// SaveAdjacency saves a list of Adjacency objects for the given year.
+// The adjacency bucket is created if it does not exist.
+func SaveAdjacency(year string, objs []interface{}) error {
+	err := saveSecondaryObjs(year, objs)
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("SaveAdjacency failed: %v", err)
+	}
+	return nil
+}
+
+// GetAdjacency returns the Adjacency objects for the given IDs mapped by ID.
+// IDs not found in the year's adjacency index are omitted; an empty map is
+// returned if the index has not been built for the year.
+func GetAdjacency(year string, IDs []string) (map[string]*donations.Adjacency, error) {
+	adjs := make(map[string]*donations.Adjacency)
+
+	db, err := bolt.Open(OUTPUT_PATH+"/db/offline_db.db", 0644, nil)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetAdjacency failed: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.View(func(tx *bolt.Tx) error {
+		yb := tx.Bucket([]byte(year))
+		if yb == nil {
+			return nil
+		}
+		b := yb.Bucket([]byte("adjacency"))
+		if b == nil {
+			return nil
+		}
+		for _, id := range IDs {
+			data := b.Get([]byte(id))
+			if data == nil {
+				continue
+			}
+			adj, err := decodeAdjacency(data)
+			if err != nil {
+				fmt.Println(err)
+				return fmt.Errorf("tx failed: %v", err)
+			}
+			adjs[id] = &adj
+		}
+		return nil
+	}); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetAdjacency failed: %v", err)
+	}
+
+	return adjs, nil
+}
+
+func encodeAdjacency(a donations.Adjacency) ([]byte, error) {
+	entry := &protobuf.Adjacency{
+		ID:          a.ID,
+		Name:        a.Name,
+		Type:        a.Type,
+		Party:       a.Party,
+		State:       a.State,
+		TotalInAmt:  a.TotalInAmt,
+		TotalOutAmt: a.TotalOutAmt,
+		Out:         encodeAdjEdges(a.Out),
+		In:          encodeAdjEdges(a.In),
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("encodeAdjacency failed: %v", err)
+	}
+	return data, nil
+}
+
+func decodeAdjacency(data []byte) (donations.Adjacency, error) {
+	pb := &protobuf.Adjacency{}
+	err := proto.Unmarshal(data, pb)
+	if err != nil {
+		fmt.Println(err)
+		return donations.Adjacency{}, fmt.Errorf("decodeAdjacency failed: %v", err)
+	}
+
+	a := donations.Adjacency{
+		ID:          pb.GetID(),
+		Name:        pb.GetName(),
+		Type:        pb.GetType(),
+		Party:       pb.GetParty(),
+		State:       pb.GetState(),
+		TotalInAmt:  pb.GetTotalInAmt(),
+		TotalOutAmt: pb.GetTotalOutAmt(),
+		Out:         decodeAdjEdges(pb.GetOut()),
+		In:          decodeAdjEdges(pb.GetIn()),
+	}
+	return a, nil
+}
+
+func encodeAdjEdges(edges []donations.AdjEdge) []*protobuf.Adjacency_AdjEdge {
+	pbs := []*protobuf.Adjacency_AdjEdge{}
+	for _, e := range edges {
+		pbs = append(pbs, &protobuf.Adjacency_AdjEdge{ID: e.ID, Type: e.Type, Amt: e.Amt, Txs: e.Txs})
+	}
+	return pbs
+}
+
+func decodeAdjEdges(pbs []*protobuf.Adjacency_AdjEdge) []donations.AdjEdge {
+	edges := []donations.AdjEdge{}
+	for _, e := range pbs {
+		edges = append(edges, donations.AdjEdge{ID: e.GetID(), Type: e.GetType(), Amt: e.GetAmt(), Txs: e.GetTxs()})
+	}
+	return edges
+}
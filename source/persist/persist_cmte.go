@@ -74,6 +74,23 @@ func encodeCmteTxData(data donations.CmteTxData) ([]byte, error) {
 		TransferRecsTxs:                data.TransferRecsTxs,
 		TopExpRecipientsAmt:            data.TopExpRecipientsAmt,
 		TopExpRecipientsTxs:            data.TopExpRecipientsTxs,
+		ElectionsInAmt:                 data.ElectionsInAmt,
+		ElectionsInTxs:                 data.ElectionsInTxs,
+		ElectionsCmteInAmt:             data.ElectionsCmteInAmt,
+		ElectionsCmteInTxs:             data.ElectionsCmteInTxs,
+		SizeBinsAmt:                    data.SizeBinsAmt,
+		SizeBinsTxs:                    data.SizeBinsTxs,
+		SizeCounts:                     data.SizeCounts,
+		ConduitsInAmt:                  data.ConduitsInAmt,
+		ConduitsInTxs:                  data.ConduitsInTxs,
+		EarmarkedInAmt:                 data.EarmarkedInAmt,
+		EarmarkedInTxs:                 data.EarmarkedInTxs,
+		EarmarkedOutAmt:                data.EarmarkedOutAmt,
+		EarmarkedOutTxs:                data.EarmarkedOutTxs,
+		EarmarkRecipientsAmt:           data.EarmarkRecipientsAmt,
+		EarmarkRecipientsTxs:           data.EarmarkRecipientsTxs,
+		TxKindsAmt:                     data.TxKindsAmt,
+		TxKindsTxs:                     data.TxKindsTxs,
 		TopExpThreshold:                encodeCmteThreshold(data.TopExpThreshold),
 	}
 	bytes, err := proto.Marshal(entry)
@@ -166,6 +183,23 @@ func decodeCmteTxData(input []byte) (donations.CmteTxData, error) {
 		TransferRecsTxs:                data.GetTransferRecsTxs(),
 		TopExpRecipientsAmt:            data.GetTopExpRecipientsAmt(),
 		TopExpRecipientsTxs:            data.GetTopExpRecipientsTxs(),
+		ElectionsInAmt:                 data.GetElectionsInAmt(),
+		ElectionsInTxs:                 data.GetElectionsInTxs(),
+		ElectionsCmteInAmt:             data.GetElectionsCmteInAmt(),
+		ElectionsCmteInTxs:             data.GetElectionsCmteInTxs(),
+		SizeBinsAmt:                    data.GetSizeBinsAmt(),
+		SizeBinsTxs:                    data.GetSizeBinsTxs(),
+		SizeCounts:                     data.GetSizeCounts(),
+		ConduitsInAmt:                  data.GetConduitsInAmt(),
+		ConduitsInTxs:                  data.GetConduitsInTxs(),
+		EarmarkedInAmt:                 data.GetEarmarkedInAmt(),
+		EarmarkedInTxs:                 data.GetEarmarkedInTxs(),
+		EarmarkedOutAmt:                data.GetEarmarkedOutAmt(),
+		EarmarkedOutTxs:                data.GetEarmarkedOutTxs(),
+		EarmarkRecipientsAmt:           data.GetEarmarkRecipientsAmt(),
+		EarmarkRecipientsTxs:           data.GetEarmarkRecipientsTxs(),
+		TxKindsAmt:                     data.GetTxKindsAmt(),
+		TxKindsTxs:                     data.GetTxKindsTxs(),
 		TopExpThreshold:                decodeCmteThreshold(data.GetTopExpThreshold()),
 	}
 
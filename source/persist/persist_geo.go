@@ -0,0 +1,88 @@
+// Package persist contains operations for reading and writing disk data.
+// Most operations in this package are intended to be performed on the
+// admin local machine and are not intended to be used in the service logic.
+// This file contains operations for encoding/decoding protobufs for the
+// donations.GeoData and GeoTotal objects.
+package persist
+
+import (
+	"fmt"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/protobuf"
+	"github.com/golang/protobuf/proto"
+)
+
+func encodeGeoData(g donations.GeoData) ([]byte, error) {
+	entry := &protobuf.GeoData{
+		ID:       g.ID,
+		Bucket:   g.Bucket,
+		StateAmt: g.StateAmt,
+		StateTxs: g.StateTxs,
+		Zip3Amt:  g.Zip3Amt,
+		Zip3Txs:  g.Zip3Txs,
+		Zip5Amt:  g.Zip5Amt,
+		Zip5Txs:  g.Zip5Txs,
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("encodeGeoData failed: %v", err)
+	}
+	return data, nil
+}
+
+func decodeGeoData(data []byte) (donations.GeoData, error) {
+	pb := &protobuf.GeoData{}
+	err := proto.Unmarshal(data, pb)
+	if err != nil {
+		fmt.Println(err)
+		return donations.GeoData{}, fmt.Errorf("decodeGeoData failed: %v", err)
+	}
+
+	g := donations.GeoData{
+		ID:       pb.GetID(),
+		Bucket:   pb.GetBucket(),
+		StateAmt: pb.GetStateAmt(),
+		StateTxs: pb.GetStateTxs(),
+		Zip3Amt:  pb.GetZip3Amt(),
+		Zip3Txs:  pb.GetZip3Txs(),
+		Zip5Amt:  pb.GetZip5Amt(),
+		Zip5Txs:  pb.GetZip5Txs(),
+	}
+	return g, nil
+}
+
+func encodeGeoTotal(gt donations.GeoTotal) ([]byte, error) {
+	entry := &protobuf.GeoTotal{
+		ID:    gt.ID,
+		Year:  gt.Year,
+		State: gt.State,
+		Total: gt.Total,
+		Txs:   gt.Txs,
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("encodeGeoTotal failed: %v", err)
+	}
+	return data, nil
+}
+
+func decodeGeoTotal(data []byte) (donations.GeoTotal, error) {
+	pb := &protobuf.GeoTotal{}
+	err := proto.Unmarshal(data, pb)
+	if err != nil {
+		fmt.Println(err)
+		return donations.GeoTotal{}, fmt.Errorf("decodeGeoTotal failed: %v", err)
+	}
+
+	gt := donations.GeoTotal{
+		ID:    pb.GetID(),
+		Year:  pb.GetYear(),
+		State: pb.GetState(),
+		Total: pb.GetTotal(),
+		Txs:   pb.GetTxs(),
+	}
+	return gt, nil
+}
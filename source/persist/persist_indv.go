@@ -23,6 +23,8 @@ func encodeIndv(indv donations.Individual) ([]byte, error) {
 		Zip:           indv.Zip,
 		Occupation:    indv.Occupation,
 		Employer:      indv.Employer,
+		Sector:        indv.Sector,
+		Industry:      indv.Industry,
 		Transactions:  indv.Transactions,
 		TotalOutAmt:   indv.TotalOutAmt,
 		TotalOutTxs:   indv.TotalOutTxs,
@@ -35,6 +37,8 @@ func encodeIndv(indv donations.Individual) ([]byte, error) {
 		RecipientsTxs: indv.RecipientsTxs,
 		SendersAmt:    indv.SendersAmt,
 		SendersTxs:    indv.SendersTxs,
+		ElectionsAmt:  indv.ElectionsAmt,
+		EarmarkedAmt:  indv.EarmarkedAmt,
 	}
 	data, err := proto.Marshal(entry)
 	if err != nil {
@@ -60,6 +64,8 @@ func decodeIndv(data []byte) (donations.Individual, error) {
 		Zip:           indv.GetZip(),
 		Occupation:    indv.GetOccupation(),
 		Employer:      indv.GetEmployer(),
+		Sector:        indv.GetSector(),
+		Industry:      indv.GetIndustry(),
 		Transactions:  indv.GetTransactions(),
 		TotalInAmt:    indv.GetTotalInAmt(),
 		TotalInTxs:    indv.GetTotalInTxs(),
@@ -72,6 +78,8 @@ func decodeIndv(data []byte) (donations.Individual, error) {
 		RecipientsTxs: indv.GetRecipientsTxs(),
 		SendersAmt:    indv.GetSendersAmt(),
 		SendersTxs:    indv.GetSendersTxs(),
+		ElectionsAmt:  indv.GetElectionsAmt(),
+		EarmarkedAmt:  indv.GetEarmarkedAmt(),
 	}
 
 	return entry, nil
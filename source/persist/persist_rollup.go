@@ -0,0 +1,136 @@
+// Package persist contains operations for reading and writing disk data.
+// Most operations in this package are intended to be performed on the
+// admin local machine and are not intended to be used in the service logic.
+// This file contains operations for encoding/decoding protobufs for the
+// donations.CandRollup object.
+package persist
+
+import (
+	"fmt"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/protobuf"
+	"github.com/golang/protobuf/proto"
+)
+
+func encodeCandRollup(r donations.CandRollup) ([]byte, error) {
+	entry := &protobuf.CandRollup{
+		CandID:                    r.CandID,
+		Party:                     r.Party,
+		Committees:                r.Committees,
+		ContributionsInAmt:        r.ContributionsInAmt,
+		ContributionsInTxs:        r.ContributionsInTxs,
+		AvgContributionIn:         r.AvgContributionIn,
+		OtherReceiptsInAmt:        r.OtherReceiptsInAmt,
+		OtherReceiptsInTxs:        r.OtherReceiptsInTxs,
+		AvgOtherIn:                r.AvgOtherIn,
+		InternalTransfersAmt:      r.InternalTransfersAmt,
+		InternalTransfersTxs:      r.InternalTransfersTxs,
+		TotalIncomingAmt:          r.TotalIncomingAmt,
+		TotalIncomingTxs:          r.TotalIncomingTxs,
+		AvgIncoming:               r.AvgIncoming,
+		TransfersAmt:              r.TransfersAmt,
+		TransfersTxs:              r.TransfersTxs,
+		AvgTransfer:               r.AvgTransfer,
+		ExpendituresAmt:           r.ExpendituresAmt,
+		ExpendituresTxs:           r.ExpendituresTxs,
+		AvgExpenditure:            r.AvgExpenditure,
+		TotalOutgoingAmt:          r.TotalOutgoingAmt,
+		TotalOutgoingTxs:          r.TotalOutgoingTxs,
+		AvgOutgoing:               r.AvgOutgoing,
+		NetBalance:                r.NetBalance,
+		TopIndvContributorsAmt:    r.TopIndvContributorsAmt,
+		TopIndvContributorsTxs:    r.TopIndvContributorsTxs,
+		TopCmteOrgContributorsAmt: r.TopCmteOrgContributorsAmt,
+		TopCmteOrgContributorsTxs: r.TopCmteOrgContributorsTxs,
+		TransferRecsAmt:           r.TransferRecsAmt,
+		TransferRecsTxs:           r.TransferRecsTxs,
+		TopExpRecipientsAmt:       r.TopExpRecipientsAmt,
+		TopExpRecipientsTxs:       r.TopExpRecipientsTxs,
+		ElectionsInAmt:            r.ElectionsInAmt,
+		ElectionsInTxs:            r.ElectionsInTxs,
+		SizeBinsAmt:               r.SizeBinsAmt,
+		SizeBinsTxs:               r.SizeBinsTxs,
+		SizeCounts:                r.SizeCounts,
+		ConduitsInAmt:             r.ConduitsInAmt,
+		ConduitsInTxs:             r.ConduitsInTxs,
+		CandContsAmt:              r.CandContsAmt,
+		CandLoansAmt:              r.CandLoansAmt,
+		CandLoanRepayAmt:          r.CandLoanRepayAmt,
+		SelfFundedAmt:             r.SelfFundedAmt,
+		SelfFundedShare:           r.SelfFundedShare,
+		CandLoansOutstanding:      r.CandLoansOutstanding,
+		CandLoansByYear:           r.CandLoansByYear,
+		CandRepaysByYear:          r.CandRepaysByYear,
+		TxKindsAmt:                r.TxKindsAmt,
+		TxKindsTxs:                r.TxKindsTxs,
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("encodeCandRollup failed: %v", err)
+	}
+	return data, nil
+}
+
+func decodeCandRollup(data []byte) (donations.CandRollup, error) {
+	pb := &protobuf.CandRollup{}
+	err := proto.Unmarshal(data, pb)
+	if err != nil {
+		fmt.Println(err)
+		return donations.CandRollup{}, fmt.Errorf("decodeCandRollup failed: %v", err)
+	}
+
+	r := donations.CandRollup{
+		CandID:                    pb.GetCandID(),
+		Party:                     pb.GetParty(),
+		Committees:                pb.GetCommittees(),
+		ContributionsInAmt:        pb.GetContributionsInAmt(),
+		ContributionsInTxs:        pb.GetContributionsInTxs(),
+		AvgContributionIn:         pb.GetAvgContributionIn(),
+		OtherReceiptsInAmt:        pb.GetOtherReceiptsInAmt(),
+		OtherReceiptsInTxs:        pb.GetOtherReceiptsInTxs(),
+		AvgOtherIn:                pb.GetAvgOtherIn(),
+		InternalTransfersAmt:      pb.GetInternalTransfersAmt(),
+		InternalTransfersTxs:      pb.GetInternalTransfersTxs(),
+		TotalIncomingAmt:          pb.GetTotalIncomingAmt(),
+		TotalIncomingTxs:          pb.GetTotalIncomingTxs(),
+		AvgIncoming:               pb.GetAvgIncoming(),
+		TransfersAmt:              pb.GetTransfersAmt(),
+		TransfersTxs:              pb.GetTransfersTxs(),
+		AvgTransfer:               pb.GetAvgTransfer(),
+		ExpendituresAmt:           pb.GetExpendituresAmt(),
+		ExpendituresTxs:           pb.GetExpendituresTxs(),
+		AvgExpenditure:            pb.GetAvgExpenditure(),
+		TotalOutgoingAmt:          pb.GetTotalOutgoingAmt(),
+		TotalOutgoingTxs:          pb.GetTotalOutgoingTxs(),
+		AvgOutgoing:               pb.GetAvgOutgoing(),
+		NetBalance:                pb.GetNetBalance(),
+		TopIndvContributorsAmt:    pb.GetTopIndvContributorsAmt(),
+		TopIndvContributorsTxs:    pb.GetTopIndvContributorsTxs(),
+		TopCmteOrgContributorsAmt: pb.GetTopCmteOrgContributorsAmt(),
+		TopCmteOrgContributorsTxs: pb.GetTopCmteOrgContributorsTxs(),
+		TransferRecsAmt:           pb.GetTransferRecsAmt(),
+		TransferRecsTxs:           pb.GetTransferRecsTxs(),
+		TopExpRecipientsAmt:       pb.GetTopExpRecipientsAmt(),
+		TopExpRecipientsTxs:       pb.GetTopExpRecipientsTxs(),
+		ElectionsInAmt:            pb.GetElectionsInAmt(),
+		ElectionsInTxs:            pb.GetElectionsInTxs(),
+		SizeBinsAmt:               pb.GetSizeBinsAmt(),
+		SizeBinsTxs:               pb.GetSizeBinsTxs(),
+		SizeCounts:                pb.GetSizeCounts(),
+		ConduitsInAmt:             pb.GetConduitsInAmt(),
+		ConduitsInTxs:             pb.GetConduitsInTxs(),
+		CandContsAmt:              pb.GetCandContsAmt(),
+		CandLoansAmt:              pb.GetCandLoansAmt(),
+		CandLoanRepayAmt:          pb.GetCandLoanRepayAmt(),
+		SelfFundedAmt:             pb.GetSelfFundedAmt(),
+		SelfFundedShare:           pb.GetSelfFundedShare(),
+		CandLoansOutstanding:      pb.GetCandLoansOutstanding(),
+		CandLoansByYear:           pb.GetCandLoansByYear(),
+		CandRepaysByYear:          pb.GetCandRepaysByYear(),
+		TxKindsAmt:                pb.GetTxKindsAmt(),
+		TxKindsTxs:                pb.GetTxKindsTxs(),
+	}
+	return r, nil
+}
@@ -0,0 +1,92 @@
+// Package persist contains operations for reading and writing disk data.
+// Most operations in this package are intended to be performed on the
+// admin local machine and are not intended to be used in the service logic.
+// This file contains operations for encoding/decoding protobufs for the
+// donations.SectorData and SectorTotal objects.
+package persist
+
+import (
+	"fmt"
+
+	"github.com/elections/source/donations"
+	"github.com/elections/source/protobuf"
+	"github.com/golang/protobuf/proto"
+)
+
+func encodeSectorData(sd donations.SectorData) ([]byte, error) {
+	entry := &protobuf.SectorData{
+		ID:          sd.ID,
+		Bucket:      sd.Bucket,
+		SectorAmt:   sd.SectorAmt,
+		SectorTxs:   sd.SectorTxs,
+		IndustryAmt: sd.IndustryAmt,
+		IndustryTxs: sd.IndustryTxs,
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("encodeSectorData failed: %v", err)
+	}
+	return data, nil
+}
+
+func decodeSectorData(data []byte) (donations.SectorData, error) {
+	pb := &protobuf.SectorData{}
+	err := proto.Unmarshal(data, pb)
+	if err != nil {
+		fmt.Println(err)
+		return donations.SectorData{}, fmt.Errorf("decodeSectorData failed: %v", err)
+	}
+
+	sd := donations.SectorData{
+		ID:          pb.GetID(),
+		Bucket:      pb.GetBucket(),
+		SectorAmt:   pb.GetSectorAmt(),
+		SectorTxs:   pb.GetSectorTxs(),
+		IndustryAmt: pb.GetIndustryAmt(),
+		IndustryTxs: pb.GetIndustryTxs(),
+	}
+	return sd, nil
+}
+
+func encodeSectorTotal(st donations.SectorTotal) ([]byte, error) {
+	entry := &protobuf.SectorTotal{
+		ID:          st.ID,
+		Year:        st.Year,
+		Sector:      st.Sector,
+		Total:       st.Total,
+		Txs:         st.Txs,
+		IndustryAmt: st.IndustryAmt,
+		TopCmtes:    st.TopCmtes,
+		TopCands:    st.TopCands,
+		TopDonors:   st.TopDonors,
+	}
+	data, err := proto.Marshal(entry)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("encodeSectorTotal failed: %v", err)
+	}
+	return data, nil
+}
+
+func decodeSectorTotal(data []byte) (donations.SectorTotal, error) {
+	pb := &protobuf.SectorTotal{}
+	err := proto.Unmarshal(data, pb)
+	if err != nil {
+		fmt.Println(err)
+		return donations.SectorTotal{}, fmt.Errorf("decodeSectorTotal failed: %v", err)
+	}
+
+	st := donations.SectorTotal{
+		ID:          pb.GetID(),
+		Year:        pb.GetYear(),
+		Sector:      pb.GetSector(),
+		Total:       pb.GetTotal(),
+		Txs:         pb.GetTxs(),
+		IndustryAmt: pb.GetIndustryAmt(),
+		TopCmtes:    pb.GetTopCmtes(),
+		TopCands:    pb.GetTopCands(),
+		TopDonors:   pb.GetTopDonors(),
+	}
+	return st, nil
+}
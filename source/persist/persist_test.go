@@ -314,3 +314,42 @@ func TestPutObject(t *testing.T) {
 		t.Errorf("failed to remove ./db directory")
 	}
 }
+
+// TestSaveGeoData tests the SaveGeoData and GetGeoTotals functions for years with and
+// without existing year buckets. Test passes if the saved GeoTotals are returned and
+// years not yet created return no objects.
+func TestSaveGeoData(t *testing.T) {
+	OUTPUT_PATH = "."
+	Init("2020")
+
+	var geoTests = []struct {
+		year  string
+		input []interface{}
+		want  int
+	}{
+		{"2020", []interface{}{&donations.GeoTotal{ID: "2020-NY", Year: "2020", State: "NY", Total: 100, Txs: 1}}, 1},
+		{"2018", []interface{}{ // year bucket not yet created
+			&donations.GeoData{ID: "cmte00", Bucket: "cmte_tx_data", StateAmt: map[string]float32{"NY": 100}},
+			&donations.GeoTotal{ID: "2018-NY", Year: "2018", State: "NY", Total: 100, Txs: 1},
+			&donations.GeoTotal{ID: "2018-CA", Year: "2018", State: "CA", Total: 50, Txs: 1},
+		}, 2},
+		{"2016", []interface{}{}, 0},
+	}
+	for _, test := range geoTests {
+		if err := SaveGeoData(test.year, test.input); err != nil {
+			t.Errorf("SaveGeoData(%s) failed - err: %v", test.year, err)
+		}
+		objs, err := GetGeoTotals(test.year)
+		if err != nil {
+			t.Errorf("GetGeoTotals(%s) failed - err: %v", test.year, err)
+		}
+		if len(objs) != test.want {
+			t.Errorf("GetGeoTotals(%s) returned %d objects; want %d", test.year, len(objs), test.want)
+		}
+	}
+
+	err := os.RemoveAll("./db")
+	if err != nil {
+		t.Errorf("failed to remove ./db directory")
+	}
+}
@@ -21,6 +21,8 @@ func encodeOverallData(od donations.TopOverallData) ([]byte, error) {
 		Bucket:    od.Bucket,
 		Category:  od.Category,
 		Party:     od.Party,
+		State:     od.State,
+		Metric:    od.Metric,
 		Amts:      od.Amts,
 		Threshold: encodeThreshold(od.Threshold),
 		SizeLimit: int32(od.SizeLimit),
@@ -60,6 +62,8 @@ func decodeOverallData(data []byte) (donations.TopOverallData, error) {
 		Bucket:    od.GetBucket(),
 		Category:  od.GetCategory(),
 		Party:     od.GetParty(),
+		State:     od.GetState(),
+		Metric:    od.GetMetric(),
 		Amts:      od.GetAmts(),
 		Threshold: decodeThreshold(od.GetThreshold()),
 		SizeLimit: int(od.GetSizeLimit()),
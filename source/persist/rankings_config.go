@@ -0,0 +1,64 @@
+// Package persist contains operations for reading and writing disk data.
+// Most operations in this package are intended to be performed on the
+// admin local machine and are not intended to be used in the service logic.
+// This file contains operations for reading and writing the ranking
+// definitions config file.
+package persist
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/elections/source/donations"
+)
+
+// RANKINGS_FILE contains the TopOverall ranking and YearlyTotal definitions.
+// The default definitions are written to this file if it does not exist.
+const RANKINGS_FILE = "rankings.json"
+
+// LoadRankingDefs reads the ranking definitions from the OUTPUT_PATH/config directory.
+// The default definitions are written to the config file if it does not exist.
+func LoadRankingDefs() (*donations.RankingDefs, error) {
+	path := OUTPUT_PATH + "/config/" + RANKINGS_FILE
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		defs := donations.DefaultRankingDefs()
+		err = WriteRankingDefs(defs)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("LoadRankingDefs failed: %v", err)
+		}
+		return defs, nil
+	}
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("LoadRankingDefs failed: %v", err)
+	}
+
+	defs := &donations.RankingDefs{}
+	if err := json.Unmarshal(data, defs); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("LoadRankingDefs failed: %s: %v", path, err)
+	}
+	return defs, nil
+}
+
+// WriteRankingDefs writes the ranking definitions to the config file as indented JSON.
+func WriteRankingDefs(defs *donations.RankingDefs) error {
+	data, err := json.MarshalIndent(defs, "", "  ")
+	if err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteRankingDefs failed: %v", err)
+	}
+	if err := os.MkdirAll(OUTPUT_PATH+"/config", 0755); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteRankingDefs failed: %v", err)
+	}
+	if err := ioutil.WriteFile(OUTPUT_PATH+"/config/"+RANKINGS_FILE, data, 0644); err != nil {
+		fmt.Println(err)
+		return fmt.Errorf("WriteRankingDefs failed: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: adjacency.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Adjacency struct {
+	ID                   string               `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type                 string               `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Party                string               `protobuf:"bytes,4,opt,name=Party,proto3" json:"Party,omitempty"`
+	State                string               `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
+	TotalInAmt           float32              `protobuf:"fixed32,6,opt,name=TotalInAmt,proto3" json:"TotalInAmt,omitempty"`
+	TotalOutAmt          float32              `protobuf:"fixed32,7,opt,name=TotalOutAmt,proto3" json:"TotalOutAmt,omitempty"`
+	Out                  []*Adjacency_AdjEdge `protobuf:"bytes,8,rep,name=Out,proto3" json:"Out,omitempty"`
+	In                   []*Adjacency_AdjEdge `protobuf:"bytes,9,rep,name=In,proto3" json:"In,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Adjacency) Reset()         { *m = Adjacency{} }
+func (m *Adjacency) String() string { return proto.CompactTextString(m) }
+func (*Adjacency) ProtoMessage()    {}
+func (*Adjacency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2f009b605f0ca8, []int{0}
+}
+
+func (m *Adjacency) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Adjacency.Unmarshal(m, b)
+}
+func (m *Adjacency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Adjacency.Marshal(b, m, deterministic)
+}
+func (m *Adjacency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Adjacency.Merge(m, src)
+}
+func (m *Adjacency) XXX_Size() int {
+	return xxx_messageInfo_Adjacency.Size(m)
+}
+func (m *Adjacency) XXX_DiscardUnknown() {
+	xxx_messageInfo_Adjacency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Adjacency proto.InternalMessageInfo
+
+func (m *Adjacency) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Adjacency) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Adjacency) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Adjacency) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *Adjacency) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Adjacency) GetTotalInAmt() float32 {
+	if m != nil {
+		return m.TotalInAmt
+	}
+	return 0
+}
+
+func (m *Adjacency) GetTotalOutAmt() float32 {
+	if m != nil {
+		return m.TotalOutAmt
+	}
+	return 0
+}
+
+func (m *Adjacency) GetOut() []*Adjacency_AdjEdge {
+	if m != nil {
+		return m.Out
+	}
+	return nil
+}
+
+func (m *Adjacency) GetIn() []*Adjacency_AdjEdge {
+	if m != nil {
+		return m.In
+	}
+	return nil
+}
+
+type Adjacency_AdjEdge struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amt                  float32  `protobuf:"fixed32,3,opt,name=Amt,proto3" json:"Amt,omitempty"`
+	Txs                  float32  `protobuf:"fixed32,4,opt,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Adjacency_AdjEdge) Reset()         { *m = Adjacency_AdjEdge{} }
+func (m *Adjacency_AdjEdge) String() string { return proto.CompactTextString(m) }
+func (*Adjacency_AdjEdge) ProtoMessage()    {}
+func (*Adjacency_AdjEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b2f009b605f0ca8, []int{0, 0}
+}
+
+func (m *Adjacency_AdjEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Adjacency_AdjEdge.Unmarshal(m, b)
+}
+func (m *Adjacency_AdjEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Adjacency_AdjEdge.Marshal(b, m, deterministic)
+}
+func (m *Adjacency_AdjEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Adjacency_AdjEdge.Merge(m, src)
+}
+func (m *Adjacency_AdjEdge) XXX_Size() int {
+	return xxx_messageInfo_Adjacency_AdjEdge.Size(m)
+}
+func (m *Adjacency_AdjEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Adjacency_AdjEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Adjacency_AdjEdge proto.InternalMessageInfo
+
+func (m *Adjacency_AdjEdge) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Adjacency_AdjEdge) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Adjacency_AdjEdge) GetAmt() float32 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+func (m *Adjacency_AdjEdge) GetTxs() float32 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Adjacency)(nil), "protobuf.Adjacency")
+	proto.RegisterType((*Adjacency_AdjEdge)(nil), "protobuf.Adjacency.AdjEdge")
+}
+
+func init() { proto.RegisterFile("adjacency.proto", fileDescriptor_7b2f009b605f0ca8) }
+
+var fileDescriptor_7b2f009b605f0ca8 = []byte{
+	// 240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0xc9, 0x6c, 0xff, 0x65, 0x0a, 0x2a, 0x83, 0x87, 0x45, 0x41, 0x82, 0xa7, 0x80, 0x98,
+	0x83, 0x3e, 0x41, 0xa1, 0x1e, 0xf6, 0x62, 0x35, 0xe6, 0x05, 0xb6, 0xed, 0x2a, 0x88, 0xdd, 0x94,
+	0x3a, 0x01, 0xf3, 0xb6, 0x3e, 0x8a, 0xcc, 0xc4, 0x48, 0x0e, 0x42, 0x4f, 0xfb, 0x7d, 0xbf, 0xfd,
+	0x60, 0xe6, 0x1b, 0x3c, 0xf5, 0xdb, 0x77, 0xbf, 0x09, 0x71, 0xd3, 0x16, 0xfb, 0x43, 0xcd, 0x35,
+	0xcd, 0xf4, 0x59, 0x37, 0xaf, 0xd7, 0xdf, 0x80, 0xe9, 0xa2, 0xff, 0xa5, 0x13, 0x04, 0xb7, 0xb4,
+	0x49, 0x96, 0xe4, 0x69, 0x09, 0x6e, 0x49, 0x84, 0xa3, 0x47, 0xbf, 0x0b, 0x16, 0x94, 0xa8, 0x16,
+	0x56, 0xb5, 0xfb, 0x60, 0x4d, 0xc7, 0x44, 0xd3, 0x39, 0x8e, 0x9f, 0xfc, 0x81, 0x5b, 0x3b, 0x52,
+	0xd8, 0x19, 0xa1, 0x2f, 0xec, 0x39, 0xd8, 0x71, 0x47, 0xd5, 0xd0, 0x15, 0x62, 0x55, 0xb3, 0xff,
+	0x70, 0x71, 0xb1, 0x63, 0x3b, 0xc9, 0x92, 0x1c, 0xca, 0x01, 0xa1, 0x0c, 0xe7, 0xea, 0x56, 0x0d,
+	0x4b, 0x60, 0xaa, 0x81, 0x21, 0xa2, 0x5b, 0x34, 0xab, 0x86, 0xed, 0x2c, 0x33, 0xf9, 0xfc, 0xee,
+	0xb2, 0xe8, 0xbb, 0x14, 0x7f, 0x3d, 0x44, 0x3d, 0x6c, 0xdf, 0x42, 0x29, 0x39, 0xba, 0x41, 0x70,
+	0xd1, 0xa6, 0xc7, 0xd3, 0xe0, 0xe2, 0xc5, 0x33, 0x4e, 0x7f, 0xed, 0x7f, 0xc7, 0xd0, 0xe2, 0x30,
+	0x28, 0x7e, 0x86, 0x46, 0x96, 0x34, 0xba, 0xa4, 0x48, 0x21, 0xd5, 0xd7, 0xa7, 0x1e, 0x02, 0x4a,
+	0x91, 0xeb, 0x89, 0x8e, 0xbc, 0xff, 0x19, 0x00, 0x9d, 0x2a, 0xbd, 0x3b, 0x86, 0x01, 0x00, 0x00,
+}
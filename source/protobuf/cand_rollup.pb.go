@@ -0,0 +1,535 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cand_rollup.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CandRollup struct {
+	CandID                    string             `protobuf:"bytes,1,opt,name=CandID,proto3" json:"CandID,omitempty"`
+	Party                     string             `protobuf:"bytes,2,opt,name=Party,proto3" json:"Party,omitempty"`
+	Committees                []string           `protobuf:"bytes,3,rep,name=Committees,proto3" json:"Committees,omitempty"`
+	ContributionsInAmt        float32            `protobuf:"fixed32,4,opt,name=ContributionsInAmt,proto3" json:"ContributionsInAmt,omitempty"`
+	ContributionsInTxs        float32            `protobuf:"fixed32,5,opt,name=ContributionsInTxs,proto3" json:"ContributionsInTxs,omitempty"`
+	AvgContributionIn         float32            `protobuf:"fixed32,6,opt,name=AvgContributionIn,proto3" json:"AvgContributionIn,omitempty"`
+	OtherReceiptsInAmt        float32            `protobuf:"fixed32,7,opt,name=OtherReceiptsInAmt,proto3" json:"OtherReceiptsInAmt,omitempty"`
+	OtherReceiptsInTxs        float32            `protobuf:"fixed32,8,opt,name=OtherReceiptsInTxs,proto3" json:"OtherReceiptsInTxs,omitempty"`
+	AvgOtherIn                float32            `protobuf:"fixed32,9,opt,name=AvgOtherIn,proto3" json:"AvgOtherIn,omitempty"`
+	InternalTransfersAmt      float32            `protobuf:"fixed32,10,opt,name=InternalTransfersAmt,proto3" json:"InternalTransfersAmt,omitempty"`
+	InternalTransfersTxs      float32            `protobuf:"fixed32,11,opt,name=InternalTransfersTxs,proto3" json:"InternalTransfersTxs,omitempty"`
+	TotalIncomingAmt          float32            `protobuf:"fixed32,12,opt,name=TotalIncomingAmt,proto3" json:"TotalIncomingAmt,omitempty"`
+	TotalIncomingTxs          float32            `protobuf:"fixed32,13,opt,name=TotalIncomingTxs,proto3" json:"TotalIncomingTxs,omitempty"`
+	AvgIncoming               float32            `protobuf:"fixed32,14,opt,name=AvgIncoming,proto3" json:"AvgIncoming,omitempty"`
+	TransfersAmt              float32            `protobuf:"fixed32,15,opt,name=TransfersAmt,proto3" json:"TransfersAmt,omitempty"`
+	TransfersTxs              float32            `protobuf:"fixed32,16,opt,name=TransfersTxs,proto3" json:"TransfersTxs,omitempty"`
+	AvgTransfer               float32            `protobuf:"fixed32,17,opt,name=AvgTransfer,proto3" json:"AvgTransfer,omitempty"`
+	ExpendituresAmt           float32            `protobuf:"fixed32,18,opt,name=ExpendituresAmt,proto3" json:"ExpendituresAmt,omitempty"`
+	ExpendituresTxs           float32            `protobuf:"fixed32,19,opt,name=ExpendituresTxs,proto3" json:"ExpendituresTxs,omitempty"`
+	AvgExpenditure            float32            `protobuf:"fixed32,20,opt,name=AvgExpenditure,proto3" json:"AvgExpenditure,omitempty"`
+	TotalOutgoingAmt          float32            `protobuf:"fixed32,21,opt,name=TotalOutgoingAmt,proto3" json:"TotalOutgoingAmt,omitempty"`
+	TotalOutgoingTxs          float32            `protobuf:"fixed32,22,opt,name=TotalOutgoingTxs,proto3" json:"TotalOutgoingTxs,omitempty"`
+	AvgOutgoing               float32            `protobuf:"fixed32,23,opt,name=AvgOutgoing,proto3" json:"AvgOutgoing,omitempty"`
+	NetBalance                float32            `protobuf:"fixed32,24,opt,name=NetBalance,proto3" json:"NetBalance,omitempty"`
+	TopIndvContributorsAmt    map[string]float32 `protobuf:"bytes,25,rep,name=TopIndvContributorsAmt,proto3" json:"TopIndvContributorsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopIndvContributorsTxs    map[string]float32 `protobuf:"bytes,26,rep,name=TopIndvContributorsTxs,proto3" json:"TopIndvContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCmteOrgContributorsAmt map[string]float32 `protobuf:"bytes,27,rep,name=TopCmteOrgContributorsAmt,proto3" json:"TopCmteOrgContributorsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCmteOrgContributorsTxs map[string]float32 `protobuf:"bytes,28,rep,name=TopCmteOrgContributorsTxs,proto3" json:"TopCmteOrgContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TransferRecsAmt           map[string]float32 `protobuf:"bytes,29,rep,name=TransferRecsAmt,proto3" json:"TransferRecsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TransferRecsTxs           map[string]float32 `protobuf:"bytes,30,rep,name=TransferRecsTxs,proto3" json:"TransferRecsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopExpRecipientsAmt       map[string]float32 `protobuf:"bytes,31,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopExpRecipientsTxs       map[string]float32 `protobuf:"bytes,32,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInAmt            map[string]float32 `protobuf:"bytes,33,rep,name=ElectionsInAmt,proto3" json:"ElectionsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInTxs            map[string]float32 `protobuf:"bytes,34,rep,name=ElectionsInTxs,proto3" json:"ElectionsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsAmt               map[string]float32 `protobuf:"bytes,35,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs               map[string]float32 `protobuf:"bytes,36,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeCounts                map[string]float32 `protobuf:"bytes,37,rep,name=SizeCounts,proto3" json:"SizeCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInAmt             map[string]float32 `protobuf:"bytes,38,rep,name=ConduitsInAmt,proto3" json:"ConduitsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInTxs             map[string]float32 `protobuf:"bytes,39,rep,name=ConduitsInTxs,proto3" json:"ConduitsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CandContsAmt              float32            `protobuf:"fixed32,40,opt,name=CandContsAmt,proto3" json:"CandContsAmt,omitempty"`
+	CandLoansAmt              float32            `protobuf:"fixed32,41,opt,name=CandLoansAmt,proto3" json:"CandLoansAmt,omitempty"`
+	CandLoanRepayAmt          float32            `protobuf:"fixed32,42,opt,name=CandLoanRepayAmt,proto3" json:"CandLoanRepayAmt,omitempty"`
+	SelfFundedAmt             float32            `protobuf:"fixed32,43,opt,name=SelfFundedAmt,proto3" json:"SelfFundedAmt,omitempty"`
+	SelfFundedShare           float32            `protobuf:"fixed32,44,opt,name=SelfFundedShare,proto3" json:"SelfFundedShare,omitempty"`
+	CandLoansOutstanding      float32            `protobuf:"fixed32,45,opt,name=CandLoansOutstanding,proto3" json:"CandLoansOutstanding,omitempty"`
+	CandLoansByYear           map[string]float32 `protobuf:"bytes,46,rep,name=CandLoansByYear,proto3" json:"CandLoansByYear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CandRepaysByYear          map[string]float32 `protobuf:"bytes,47,rep,name=CandRepaysByYear,proto3" json:"CandRepaysByYear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsAmt                map[string]float32 `protobuf:"bytes,48,rep,name=TxKindsAmt,proto3" json:"TxKindsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsTxs                map[string]float32 `protobuf:"bytes,49,rep,name=TxKindsTxs,proto3" json:"TxKindsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral      struct{}           `json:"-"`
+	XXX_unrecognized          []byte             `json:"-"`
+	XXX_sizecache             int32              `json:"-"`
+}
+
+func (m *CandRollup) Reset()         { *m = CandRollup{} }
+func (m *CandRollup) String() string { return proto.CompactTextString(m) }
+func (*CandRollup) ProtoMessage()    {}
+func (*CandRollup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e73f1318172909d, []int{0}
+}
+
+func (m *CandRollup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandRollup.Unmarshal(m, b)
+}
+func (m *CandRollup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandRollup.Marshal(b, m, deterministic)
+}
+func (m *CandRollup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandRollup.Merge(m, src)
+}
+func (m *CandRollup) XXX_Size() int {
+	return xxx_messageInfo_CandRollup.Size(m)
+}
+func (m *CandRollup) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandRollup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandRollup proto.InternalMessageInfo
+
+func (m *CandRollup) GetCandID() string {
+	if m != nil {
+		return m.CandID
+	}
+	return ""
+}
+
+func (m *CandRollup) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *CandRollup) GetCommittees() []string {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+func (m *CandRollup) GetContributionsInAmt() float32 {
+	if m != nil {
+		return m.ContributionsInAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetContributionsInTxs() float32 {
+	if m != nil {
+		return m.ContributionsInTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgContributionIn() float32 {
+	if m != nil {
+		return m.AvgContributionIn
+	}
+	return 0
+}
+
+func (m *CandRollup) GetOtherReceiptsInAmt() float32 {
+	if m != nil {
+		return m.OtherReceiptsInAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetOtherReceiptsInTxs() float32 {
+	if m != nil {
+		return m.OtherReceiptsInTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgOtherIn() float32 {
+	if m != nil {
+		return m.AvgOtherIn
+	}
+	return 0
+}
+
+func (m *CandRollup) GetInternalTransfersAmt() float32 {
+	if m != nil {
+		return m.InternalTransfersAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetInternalTransfersTxs() float32 {
+	if m != nil {
+		return m.InternalTransfersTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalIncomingAmt() float32 {
+	if m != nil {
+		return m.TotalIncomingAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalIncomingTxs() float32 {
+	if m != nil {
+		return m.TotalIncomingTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgIncoming() float32 {
+	if m != nil {
+		return m.AvgIncoming
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTransfersAmt() float32 {
+	if m != nil {
+		return m.TransfersAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTransfersTxs() float32 {
+	if m != nil {
+		return m.TransfersTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgTransfer() float32 {
+	if m != nil {
+		return m.AvgTransfer
+	}
+	return 0
+}
+
+func (m *CandRollup) GetExpendituresAmt() float32 {
+	if m != nil {
+		return m.ExpendituresAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetExpendituresTxs() float32 {
+	if m != nil {
+		return m.ExpendituresTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgExpenditure() float32 {
+	if m != nil {
+		return m.AvgExpenditure
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalOutgoingAmt() float32 {
+	if m != nil {
+		return m.TotalOutgoingAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalOutgoingTxs() float32 {
+	if m != nil {
+		return m.TotalOutgoingTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgOutgoing() float32 {
+	if m != nil {
+		return m.AvgOutgoing
+	}
+	return 0
+}
+
+func (m *CandRollup) GetNetBalance() float32 {
+	if m != nil {
+		return m.NetBalance
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTopIndvContributorsAmt() map[string]float32 {
+	if m != nil {
+		return m.TopIndvContributorsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopIndvContributorsTxs() map[string]float32 {
+	if m != nil {
+		return m.TopIndvContributorsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopCmteOrgContributorsAmt() map[string]float32 {
+	if m != nil {
+		return m.TopCmteOrgContributorsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopCmteOrgContributorsTxs() map[string]float32 {
+	if m != nil {
+		return m.TopCmteOrgContributorsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTransferRecsAmt() map[string]float32 {
+	if m != nil {
+		return m.TransferRecsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTransferRecsTxs() map[string]float32 {
+	if m != nil {
+		return m.TransferRecsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopExpRecipientsAmt() map[string]float32 {
+	if m != nil {
+		return m.TopExpRecipientsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopExpRecipientsTxs() map[string]float32 {
+	if m != nil {
+		return m.TopExpRecipientsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetElectionsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetElectionsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetSizeBinsAmt() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetSizeBinsTxs() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetSizeCounts() map[string]float32 {
+	if m != nil {
+		return m.SizeCounts
+	}
+	return nil
+}
+
+func (m *CandRollup) GetConduitsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetConduitsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetCandContsAmt() float32 {
+	if m != nil {
+		return m.CandContsAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansAmt() float32 {
+	if m != nil {
+		return m.CandLoansAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoanRepayAmt() float32 {
+	if m != nil {
+		return m.CandLoanRepayAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetSelfFundedAmt() float32 {
+	if m != nil {
+		return m.SelfFundedAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetSelfFundedShare() float32 {
+	if m != nil {
+		return m.SelfFundedShare
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansOutstanding() float32 {
+	if m != nil {
+		return m.CandLoansOutstanding
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansByYear() map[string]float32 {
+	if m != nil {
+		return m.CandLoansByYear
+	}
+	return nil
+}
+
+func (m *CandRollup) GetCandRepaysByYear() map[string]float32 {
+	if m != nil {
+		return m.CandRepaysByYear
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTxKindsAmt() map[string]float32 {
+	if m != nil {
+		return m.TxKindsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTxKindsTxs() map[string]float32 {
+	if m != nil {
+		return m.TxKindsTxs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CandRollup)(nil), "protobuf.CandRollup")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.CandLoansByYearEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.CandRepaysByYearEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.ConduitsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.ConduitsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.ElectionsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.ElectionsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.SizeCountsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TopCmteOrgContributorsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TopCmteOrgContributorsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TopExpRecipientsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TopExpRecipientsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TopIndvContributorsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TopIndvContributorsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TransferRecsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TransferRecsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TxKindsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CandRollup.TxKindsTxsEntry")
+}
+
+func init() { proto.RegisterFile("cand_rollup.proto", fileDescriptor_2e73f1318172909d) }
+
+var fileDescriptor_2e73f1318172909d = []byte{
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x6f, 0x6f, 0xdb, 0x36,
+	0x10, 0xc6, 0x91, 0x64, 0xcd, 0x9a, 0x4b, 0xf3, 0x8f, 0x49, 0x33, 0xb6, 0xdd, 0x32, 0x2f, 0x4b,
+	0x5b, 0x37, 0x6b, 0xbd, 0xae, 0x7d, 0x33, 0x0c, 0xd8, 0x30, 0xc7, 0x4d, 0x07, 0x63, 0xdd, 0x52,
+	0x28, 0xc2, 0x80, 0xbd, 0x2a, 0x14, 0x8b, 0x71, 0x84, 0xc9, 0x94, 0x26, 0x51, 0x86, 0xbd, 0xef,
+	0xb9, 0xef, 0x33, 0x1c, 0x69, 0x49, 0x14, 0x4d, 0x65, 0xb1, 0xd2, 0x57, 0x89, 0x1e, 0x3e, 0xf7,
+	0xbb, 0x3b, 0x9a, 0xe2, 0x09, 0x76, 0x06, 0x1e, 0xf7, 0x3f, 0x24, 0x51, 0x18, 0x66, 0x71, 0x27,
+	0x4e, 0x22, 0x11, 0x91, 0xbb, 0xf2, 0xcf, 0x45, 0x76, 0x79, 0xf8, 0x6f, 0x0b, 0xa0, 0xe7, 0x71,
+	0xdf, 0x91, 0xcb, 0x64, 0x1f, 0x56, 0xf1, 0xa9, 0xff, 0x86, 0x2e, 0xb5, 0x96, 0xda, 0x6b, 0xce,
+	0xec, 0x89, 0xec, 0xc1, 0x9d, 0xf7, 0x5e, 0x22, 0xa6, 0x74, 0x59, 0xca, 0xea, 0x81, 0x1c, 0x00,
+	0xf4, 0xa2, 0xd1, 0x28, 0x10, 0x82, 0xb1, 0x94, 0xae, 0xb4, 0x56, 0xda, 0x6b, 0x8e, 0xa6, 0x90,
+	0x0e, 0x90, 0x5e, 0xc4, 0x45, 0x12, 0x5c, 0x64, 0x22, 0x88, 0x78, 0xda, 0xe7, 0xdd, 0x91, 0xa0,
+	0x9f, 0xb4, 0x96, 0xda, 0xcb, 0x8e, 0x65, 0xc5, 0xe2, 0x77, 0x27, 0x29, 0xbd, 0x63, 0xf5, 0xbb,
+	0x93, 0x94, 0x3c, 0x87, 0x9d, 0xee, 0x78, 0xa8, 0x2f, 0xf4, 0x39, 0x5d, 0x95, 0xf6, 0xf9, 0x05,
+	0xa4, 0x9f, 0x89, 0x2b, 0x96, 0x38, 0x6c, 0xc0, 0x82, 0x58, 0xcc, 0xaa, 0xf9, 0x54, 0xd1, 0xe7,
+	0x57, 0x2c, 0x7e, 0xac, 0xe6, 0xae, 0xd5, 0x8f, 0xd5, 0x1c, 0x00, 0x74, 0xc7, 0x43, 0xb9, 0xd0,
+	0xe7, 0x74, 0x4d, 0xfa, 0x34, 0x85, 0xbc, 0x82, 0xbd, 0x3e, 0x17, 0x2c, 0xe1, 0x5e, 0xe8, 0x26,
+	0x1e, 0x4f, 0x2f, 0x59, 0x92, 0x62, 0x05, 0x20, 0x9d, 0xd6, 0x35, 0x6b, 0x0c, 0x56, 0xb1, 0x5e,
+	0x13, 0x83, 0x75, 0x1c, 0xc3, 0xb6, 0x1b, 0x09, 0x2f, 0xec, 0xf3, 0x41, 0x34, 0x0a, 0xf8, 0x10,
+	0x73, 0xdc, 0x93, 0xfe, 0x39, 0x7d, 0xce, 0x8b, 0xec, 0x0d, 0x8b, 0x17, 0xb9, 0x2d, 0x58, 0xef,
+	0x8e, 0x87, 0xb9, 0x42, 0x37, 0xa5, 0x4d, 0x97, 0xc8, 0x21, 0xdc, 0xab, 0x74, 0xb6, 0x25, 0x2d,
+	0x15, 0xad, 0xe2, 0xc1, 0x6c, 0xdb, 0x86, 0xa7, 0xcc, 0x94, 0x4b, 0x74, 0xa7, 0xc8, 0x94, 0x4b,
+	0xa4, 0x0d, 0x5b, 0xa7, 0x93, 0x98, 0x71, 0x3f, 0x10, 0x59, 0xc2, 0x64, 0x32, 0x22, 0x5d, 0xa6,
+	0x6c, 0x3a, 0x31, 0xe5, 0xee, 0xbc, 0x13, 0xb3, 0x3e, 0x81, 0xcd, 0xee, 0x78, 0xa8, 0xa9, 0x74,
+	0x4f, 0x1a, 0x0d, 0xb5, 0xd8, 0xb3, 0xb3, 0x4c, 0x0c, 0xa3, 0xd9, 0xfe, 0xde, 0xd7, 0xf6, 0x4c,
+	0xd3, 0xe7, 0xbc, 0x98, 0x7e, 0xdf, 0xe2, 0x2d, 0xbb, 0xce, 0x15, 0xfa, 0x59, 0xd1, 0x75, 0x2e,
+	0xe1, 0x09, 0xfb, 0x9d, 0x89, 0x13, 0x2f, 0xf4, 0xf8, 0x80, 0x51, 0xaa, 0x4e, 0x58, 0xa9, 0x90,
+	0x2b, 0xd8, 0x77, 0xa3, 0xb8, 0xcf, 0xfd, 0x71, 0x71, 0xf4, 0x23, 0xf5, 0x4b, 0x3c, 0x68, 0xad,
+	0xb4, 0xd7, 0x5f, 0xbd, 0xec, 0xe4, 0xef, 0x7d, 0xa7, 0x7c, 0xe7, 0x3b, 0xf6, 0x90, 0x53, 0x2e,
+	0x92, 0xa9, 0x53, 0xc3, 0xab, 0xc9, 0x84, 0xdd, 0x3d, 0x5c, 0x2c, 0x93, 0x3b, 0x49, 0xeb, 0x33,
+	0xe1, 0xae, 0xfc, 0x0d, 0x0f, 0xdc, 0x28, 0xee, 0x8d, 0x04, 0x3b, 0x4b, 0x86, 0x66, 0x5b, 0x8f,
+	0x64, 0xb2, 0xd7, 0x75, 0xc9, 0xec, 0x51, 0x2a, 0x5f, 0x3d, 0xb5, 0x3e, 0x25, 0xf6, 0xf7, 0xf9,
+	0xc2, 0x29, 0x8b, 0x16, 0xeb, 0xa9, 0xe4, 0x1c, 0xb6, 0xf2, 0xb3, 0xed, 0xb0, 0x81, 0xec, 0xed,
+	0x0b, 0x99, 0xe8, 0x99, 0x3d, 0x51, 0xd5, 0xab, 0xf0, 0x26, 0xc1, 0x84, 0x62, 0xf5, 0x07, 0x37,
+	0x84, 0x16, 0x35, 0x9b, 0x04, 0xf2, 0x01, 0x76, 0xdd, 0x28, 0x3e, 0x9d, 0xc4, 0x0e, 0x1b, 0x04,
+	0x71, 0xc0, 0xb8, 0x90, 0xd5, 0x7e, 0x29, 0xc1, 0x2f, 0xea, 0xb6, 0xc5, 0xf4, 0x2b, 0xb8, 0x8d,
+	0x64, 0x4b, 0x80, 0x95, 0xb7, 0x16, 0x48, 0x50, 0x54, 0x6f, 0x23, 0x91, 0xf7, 0xb0, 0x79, 0x1a,
+	0xb2, 0x81, 0x36, 0x91, 0xbe, 0x92, 0xec, 0xb6, 0x95, 0x5d, 0xb5, 0x2a, 0xac, 0x11, 0x6f, 0x10,
+	0xb1, 0xda, 0xc3, 0x9b, 0x11, 0x8b, 0x42, 0x8d, 0x78, 0xf2, 0x0b, 0xac, 0x9f, 0x07, 0xff, 0xb0,
+	0x93, 0x80, 0xcb, 0xdd, 0xfd, 0x5a, 0xe2, 0x1e, 0x5b, 0x71, 0x9a, 0x4f, 0xb1, 0xf4, 0x48, 0x1d,
+	0x84, 0x75, 0x1d, 0xdd, 0x00, 0x54, 0x14, 0xa5, 0x47, 0x92, 0x37, 0x00, 0xf8, 0xd8, 0x8b, 0x32,
+	0x2e, 0x52, 0xfa, 0x58, 0x72, 0x8e, 0x6a, 0x39, 0xca, 0xa6, 0x30, 0x5a, 0x1c, 0xf9, 0x0d, 0x36,
+	0x7a, 0x11, 0xf7, 0xb3, 0x20, 0x1f, 0xbf, 0x4f, 0x24, 0xe8, 0xa9, 0x15, 0x54, 0x71, 0x2a, 0x56,
+	0x35, 0xba, 0x8a, 0xc3, 0xfe, 0x9e, 0xde, 0x08, 0x57, 0x74, 0x58, 0x8d, 0xc6, 0xd9, 0x84, 0x7e,
+	0x7c, 0x39, 0xe5, 0xb6, 0xb7, 0xd5, 0x6c, 0xd2, 0xb5, 0xdc, 0xf3, 0x2e, 0xf2, 0xd4, 0x4f, 0xf3,
+	0xac, 0xf4, 0xe4, 0x1a, 0xde, 0xfa, 0xf9, 0xb3, 0xc3, 0x62, 0x6f, 0x8a, 0xbe, 0x63, 0x75, 0xeb,
+	0x9b, 0x3a, 0x39, 0x82, 0x8d, 0x73, 0x16, 0x5e, 0xbe, 0xcd, 0xb8, 0xcf, 0x7c, 0x34, 0x7e, 0x23,
+	0x8d, 0x55, 0x11, 0xa7, 0x58, 0x29, 0x9c, 0x5f, 0x79, 0x09, 0xa3, 0xcf, 0xd5, 0x14, 0x33, 0x64,
+	0xfc, 0x62, 0x28, 0x6a, 0x39, 0xcb, 0x44, 0x2a, 0x3c, 0xee, 0xe3, 0x38, 0x79, 0xa1, 0xbe, 0x18,
+	0x6c, 0x6b, 0x78, 0x51, 0x14, 0xfa, 0xc9, 0xf4, 0x4f, 0xe6, 0x25, 0xb4, 0x73, 0xcd, 0x45, 0x61,
+	0x78, 0x67, 0x17, 0x85, 0xa1, 0x92, 0x3f, 0xd4, 0x26, 0xc8, 0x46, 0x73, 0xea, 0xb7, 0x92, 0x7a,
+	0x5c, 0x4b, 0xd5, 0xcd, 0x0a, 0x3b, 0xc7, 0xc0, 0x83, 0xe8, 0x4e, 0x7e, 0x0d, 0xb8, 0x2f, 0xb7,
+	0xff, 0xe5, 0x35, 0x07, 0xb1, 0xb4, 0xcd, 0x0e, 0x62, 0x29, 0x68, 0x14, 0x3c, 0x36, 0xdf, 0xfd,
+	0x3f, 0xa5, 0x38, 0x33, 0x5a, 0xdc, 0xc3, 0x3e, 0x3c, 0xba, 0x66, 0x7a, 0x92, 0x6d, 0x58, 0xf9,
+	0x8b, 0x4d, 0x67, 0x9f, 0xd2, 0xf8, 0x2f, 0x7e, 0x47, 0x8f, 0xbd, 0x30, 0x63, 0xf2, 0x3b, 0x7a,
+	0xd9, 0x51, 0x0f, 0x3f, 0x2c, 0x7f, 0xbf, 0x54, 0x83, 0xca, 0xb3, 0x2e, 0x84, 0x7a, 0x07, 0x07,
+	0xd7, 0x0f, 0xbf, 0x8f, 0x43, 0x6b, 0x54, 0xdb, 0x09, 0xec, 0xd9, 0x86, 0xd7, 0x6d, 0x18, 0x8d,
+	0xea, 0x78, 0x0b, 0xb4, 0x6e, 0x2c, 0xdd, 0x96, 0xd3, 0xa8, 0x9e, 0x2e, 0xec, 0x5a, 0x26, 0xcd,
+	0x2d, 0x10, 0x8d, 0xaa, 0xf8, 0x09, 0xb6, 0xcd, 0x71, 0xd2, 0x34, 0xbe, 0x51, 0xfe, 0x1f, 0x61,
+	0xcb, 0x98, 0x1e, 0x0b, 0x85, 0xff, 0x0c, 0xa4, 0xbc, 0xd0, 0x1b, 0x35, 0x50, 0x21, 0x34, 0x3d,
+	0xe0, 0xb6, 0xfb, 0x71, 0x21, 0x46, 0x0f, 0xee, 0x5b, 0x6f, 0xc3, 0x45, 0xf7, 0xd2, 0xb8, 0x00,
+	0x1b, 0x86, 0x37, 0xd9, 0x86, 0x8b, 0x55, 0x79, 0x95, 0xbe, 0xfe, 0x6f, 0x00, 0xc0, 0x40, 0x74,
+	0x14, 0x7d, 0x10, 0x00, 0x00,
+}
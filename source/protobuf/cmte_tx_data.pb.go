@@ -102,6 +102,23 @@ type CmteTxData struct {
 	TopExpRecipientsAmt            map[string]float32 `protobuf:"bytes,32,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	TopExpRecipientsTxs            map[string]float32 `protobuf:"bytes,33,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	TopExpThreshold                []*CmteEntry       `protobuf:"bytes,34,rep,name=TopExpThreshold,proto3" json:"TopExpThreshold,omitempty"`
+	ElectionsInAmt                 map[string]float32 `protobuf:"bytes,35,rep,name=ElectionsInAmt,proto3" json:"ElectionsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInTxs                 map[string]float32 `protobuf:"bytes,36,rep,name=ElectionsInTxs,proto3" json:"ElectionsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsAmt                    map[string]float32 `protobuf:"bytes,37,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs                    map[string]float32 `protobuf:"bytes,38,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeCounts                     map[string]float32 `protobuf:"bytes,39,rep,name=SizeCounts,proto3" json:"SizeCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInAmt                  map[string]float32 `protobuf:"bytes,40,rep,name=ConduitsInAmt,proto3" json:"ConduitsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInTxs                  map[string]float32 `protobuf:"bytes,41,rep,name=ConduitsInTxs,proto3" json:"ConduitsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkedInAmt                 float32            `protobuf:"fixed32,42,opt,name=EarmarkedInAmt,proto3" json:"EarmarkedInAmt,omitempty"`
+	EarmarkedInTxs                 float32            `protobuf:"fixed32,43,opt,name=EarmarkedInTxs,proto3" json:"EarmarkedInTxs,omitempty"`
+	EarmarkedOutAmt                float32            `protobuf:"fixed32,44,opt,name=EarmarkedOutAmt,proto3" json:"EarmarkedOutAmt,omitempty"`
+	EarmarkedOutTxs                float32            `protobuf:"fixed32,45,opt,name=EarmarkedOutTxs,proto3" json:"EarmarkedOutTxs,omitempty"`
+	EarmarkRecipientsAmt           map[string]float32 `protobuf:"bytes,46,rep,name=EarmarkRecipientsAmt,proto3" json:"EarmarkRecipientsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkRecipientsTxs           map[string]float32 `protobuf:"bytes,47,rep,name=EarmarkRecipientsTxs,proto3" json:"EarmarkRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsAmt                     map[string]float32 `protobuf:"bytes,48,rep,name=TxKindsAmt,proto3" json:"TxKindsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsTxs                     map[string]float32 `protobuf:"bytes,49,rep,name=TxKindsTxs,proto3" json:"TxKindsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsCmteInAmt             map[string]float32 `protobuf:"bytes,50,rep,name=ElectionsCmteInAmt,proto3" json:"ElectionsCmteInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsCmteInTxs             map[string]float32 `protobuf:"bytes,51,rep,name=ElectionsCmteInTxs,proto3" json:"ElectionsCmteInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral           struct{}           `json:"-"`
 	XXX_unrecognized               []byte             `json:"-"`
 	XXX_sizecache                  int32              `json:"-"`
@@ -370,9 +387,139 @@ func (m *CmteTxData) GetTopExpThreshold() []*CmteEntry {
 	return nil
 }
 
+func (m *CmteTxData) GetElectionsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetElectionsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetSizeBinsAmt() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetSizeBinsTxs() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetSizeCounts() map[string]float32 {
+	if m != nil {
+		return m.SizeCounts
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetConduitsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetConduitsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetEarmarkedInAmt() float32 {
+	if m != nil {
+		return m.EarmarkedInAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkedInTxs() float32 {
+	if m != nil {
+		return m.EarmarkedInTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkedOutAmt() float32 {
+	if m != nil {
+		return m.EarmarkedOutAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkedOutTxs() float32 {
+	if m != nil {
+		return m.EarmarkedOutTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkRecipientsAmt() map[string]float32 {
+	if m != nil {
+		return m.EarmarkRecipientsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetEarmarkRecipientsTxs() map[string]float32 {
+	if m != nil {
+		return m.EarmarkRecipientsTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetTxKindsAmt() map[string]float32 {
+	if m != nil {
+		return m.TxKindsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetTxKindsTxs() map[string]float32 {
+	if m != nil {
+		return m.TxKindsTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetElectionsCmteInAmt() map[string]float32 {
+	if m != nil {
+		return m.ElectionsCmteInAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetElectionsCmteInTxs() map[string]float32 {
+	if m != nil {
+		return m.ElectionsCmteInTxs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CmteEntry)(nil), "protobuf.CmteEntry")
 	proto.RegisterType((*CmteTxData)(nil), "protobuf.CmteTxData")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.ConduitsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.ConduitsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.EarmarkRecipientsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.EarmarkRecipientsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.ElectionsCmteInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.ElectionsCmteInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.ElectionsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.ElectionsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.SizeCountsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TopCmteOrgContributorsAmtEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TopCmteOrgContributorsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TopExpRecipientsAmtEntry")
@@ -381,54 +528,77 @@ func init() {
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TopIndvContributorsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TransferRecsAmtEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TransferRecsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TxKindsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.CmteTxData.TxKindsTxsEntry")
 }
 
 func init() { proto.RegisterFile("cmte_tx_data.proto", fileDescriptor_e66b7cd10fa5e378) }
 
 var fileDescriptor_e66b7cd10fa5e378 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x55, 0xdb, 0xdf, 0xf6, 0x5b, 0xef, 0xfe, 0x74, 0x73, 0xc7, 0xf0, 0x36, 0x18, 0xa5, 0x42,
-	0xa8, 0x20, 0xa8, 0x80, 0xbd, 0x20, 0x24, 0x1e, 0xba, 0x75, 0x48, 0x91, 0x26, 0x86, 0x42, 0x78,
-	0xe2, 0x61, 0xca, 0x5a, 0x2f, 0x8d, 0x68, 0xed, 0x90, 0xb8, 0x55, 0xf6, 0x79, 0xf9, 0x22, 0xc8,
-	0x37, 0x4d, 0xda, 0x26, 0x36, 0xd0, 0xee, 0xa9, 0xf5, 0xf1, 0xb9, 0xe7, 0x5c, 0x1f, 0x5f, 0x2b,
-	0x40, 0x7a, 0x23, 0xc9, 0xae, 0x65, 0x7c, 0xdd, 0x77, 0xa5, 0xdb, 0x0e, 0x42, 0x21, 0x05, 0xd9,
-	0xc0, 0x9f, 0x9b, 0xf1, 0x6d, 0xf3, 0x2d, 0x54, 0xcf, 0x47, 0x92, 0x5d, 0x70, 0x19, 0xde, 0x91,
-	0x1d, 0x28, 0x5b, 0x5d, 0x5a, 0x6a, 0x94, 0x5a, 0x55, 0xbb, 0x6c, 0x75, 0xc9, 0x3e, 0xac, 0x39,
-	0x42, 0xba, 0x43, 0x5a, 0x6e, 0x94, 0x5a, 0x65, 0x3b, 0x59, 0x34, 0x7f, 0xd5, 0x01, 0x54, 0x8d,
-	0x13, 0x77, 0x5d, 0xe9, 0x92, 0x03, 0x58, 0x57, 0xab, 0xac, 0x70, 0xba, 0x42, 0xdc, 0xe5, 0x7d,
-	0xab, 0x8b, 0xd5, 0x0a, 0xc7, 0x95, 0x12, 0xfd, 0xe2, 0x86, 0xf2, 0x8e, 0x56, 0x10, 0x4e, 0x16,
-	0xa4, 0x0d, 0xe4, 0x5c, 0x70, 0x19, 0xfa, 0x37, 0x63, 0xe9, 0x0b, 0x1e, 0x59, 0xbc, 0x33, 0x92,
-	0xf4, 0x3f, 0xf4, 0xd5, 0xec, 0x68, 0xf8, 0x4e, 0x1c, 0xd1, 0x35, 0x2d, 0xdf, 0x89, 0x23, 0xf2,
-	0x0a, 0xf6, 0x3a, 0x13, 0x6f, 0x7e, 0xc3, 0xe2, 0x74, 0x1d, 0xe9, 0xc5, 0x0d, 0xa5, 0x7e, 0x25,
-	0x07, 0x2c, 0xb4, 0x59, 0x8f, 0xf9, 0x81, 0x9c, 0x76, 0xf3, 0x7f, 0xa2, 0x5e, 0xdc, 0xd1, 0xf0,
-	0x55, 0x37, 0x1b, 0x5a, 0xbe, 0xea, 0xe6, 0x04, 0xa0, 0x33, 0xf1, 0x70, 0xc3, 0xe2, 0xb4, 0x8a,
-	0xbc, 0x39, 0x84, 0xbc, 0x84, 0x5d, 0xcc, 0xda, 0xe2, 0x3d, 0x31, 0xf2, 0xb9, 0xa7, 0xdc, 0x01,
-	0x59, 0x05, 0xbc, 0xc0, 0x55, 0xce, 0x9b, 0x1a, 0xae, 0xf2, 0x6d, 0xc0, 0x66, 0x67, 0xe2, 0xa5,
-	0x08, 0xdd, 0x42, 0xda, 0x3c, 0x44, 0x9a, 0xb0, 0xe5, 0x84, 0x2e, 0x8f, 0x6e, 0x59, 0x18, 0x29,
-	0xd7, 0x6d, 0xa4, 0x2c, 0x60, 0x0b, 0x1c, 0xe5, 0xb6, 0x93, 0xe3, 0xcc, 0x9c, 0x52, 0x88, 0xd6,
-	0x32, 0xa7, 0x14, 0x22, 0xcf, 0x60, 0x3b, 0xab, 0xb8, 0xf4, 0x23, 0x49, 0x77, 0x1b, 0x95, 0x56,
-	0xd5, 0x5e, 0x04, 0x49, 0x0b, 0x6a, 0x17, 0x71, 0xc0, 0x78, 0xdf, 0x97, 0xe3, 0x90, 0x61, 0x4b,
-	0x7b, 0xa8, 0x95, 0x87, 0xf3, 0x4c, 0xd5, 0x18, 0x29, 0x32, 0x55, 0x6f, 0xcf, 0x61, 0xa7, 0x33,
-	0xf1, 0xe6, 0x50, 0x5a, 0x47, 0x62, 0x0e, 0xcd, 0x92, 0xbd, 0x1a, 0x4b, 0x4f, 0x4c, 0x6f, 0x61,
-	0x7f, 0x2e, 0xd9, 0x39, 0xbc, 0xc0, 0x55, 0xf6, 0x0f, 0x34, 0xdc, 0x59, 0x36, 0x29, 0x42, 0x0f,
-	0xb2, 0x6c, 0x52, 0x48, 0xcd, 0xc7, 0x67, 0x26, 0xcf, 0xdc, 0xa1, 0xcb, 0x7b, 0x8c, 0x3e, 0x4c,
-	0xe6, 0x63, 0x86, 0x90, 0x01, 0x1c, 0x38, 0x22, 0xb0, 0x78, 0x7f, 0x92, 0x0d, 0xae, 0x48, 0xee,
-	0x8b, 0x36, 0x2a, 0xad, 0xcd, 0x77, 0x6f, 0xda, 0xe9, 0x03, 0x6f, 0xcf, 0x5e, 0x6a, 0x5b, 0x5f,
-	0x82, 0x4f, 0xdf, 0x36, 0xe8, 0x19, 0x9c, 0xd4, 0xe9, 0x0e, 0x97, 0x73, 0x72, 0xe2, 0xc8, 0xec,
-	0xa4, 0x52, 0xf9, 0x06, 0xc7, 0xc5, 0x1d, 0x67, 0x10, 0xb2, 0x68, 0x20, 0x86, 0x7d, 0x7a, 0x84,
-	0x76, 0xf5, 0x45, 0xbb, 0x44, 0xf1, 0x4f, 0x75, 0xe4, 0x27, 0x1c, 0x3a, 0x22, 0x50, 0xe4, 0xab,
-	0xd0, 0xcb, 0xa7, 0x75, 0x8c, 0xa2, 0xa7, 0xa6, 0x33, 0xe8, 0xab, 0x12, 0x53, 0xb3, 0xaa, 0xd9,
-	0x52, 0xc5, 0xf6, 0x68, 0x69, 0xcb, 0x2c, 0x39, 0xb3, 0x2a, 0xf9, 0x0e, 0x27, 0xda, 0xcd, 0x59,
-	0x7e, 0x8f, 0xcd, 0xf9, 0xfd, 0xa5, 0x94, 0x7c, 0x85, 0x5a, 0xfa, 0x28, 0x6d, 0xd6, 0xc3, 0xe0,
-	0x4e, 0x50, 0xed, 0x85, 0xfe, 0x14, 0x8b, 0xdc, 0xc4, 0x23, 0xaf, 0x90, 0x17, 0x55, 0xd1, 0x3c,
-	0xf9, 0x47, 0xd1, 0x2c, 0x90, 0xbc, 0x02, 0xb9, 0x86, 0xba, 0x23, 0x82, 0x8b, 0x38, 0xb0, 0x59,
-	0xcf, 0x0f, 0x7c, 0xc6, 0x25, 0x76, 0xdb, 0x40, 0xe1, 0xd7, 0xa6, 0xcc, 0xf3, 0xfc, 0x44, 0x5c,
-	0xa7, 0xa4, 0x33, 0x50, 0x9d, 0x3f, 0x5d, 0xc2, 0x20, 0xeb, 0x5e, 0xa7, 0x44, 0x3e, 0x42, 0x2d,
-	0x81, 0x67, 0x37, 0xd7, 0x34, 0xdf, 0x5c, 0x9e, 0x7b, 0x64, 0xe9, 0x1e, 0x51, 0x76, 0x26, 0xb2,
-	0x0b, 0x95, 0x1f, 0xec, 0x6e, 0xfa, 0xa1, 0x56, 0x7f, 0xd5, 0xd7, 0x78, 0xe2, 0x0e, 0xc7, 0x2c,
-	0xfd, 0xc4, 0xe3, 0xe2, 0x43, 0xf9, 0x7d, 0xc9, 0x20, 0x95, 0x76, 0xbf, 0x94, 0xd4, 0xa5, 0x61,
-	0x3a, 0x57, 0x6b, 0xcc, 0xa8, 0xb6, 0x52, 0x6f, 0x67, 0xb0, 0xaf, 0x1b, 0xd8, 0xfb, 0x68, 0xac,
-	0xd4, 0xc7, 0x27, 0xa0, 0xa6, 0x51, 0xbc, 0xaf, 0xce, 0x2a, 0xfd, 0xdc, 0xac, 0xe3, 0xb8, 0x9d,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x38, 0x0b, 0xa2, 0x3f, 0x0a, 0x00, 0x00,
+	// 1028 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xf1, 0x53, 0xdb, 0x36,
+	0x14, 0xc7, 0x8f, 0xb0, 0xb2, 0xf2, 0x28, 0x84, 0x0a, 0x46, 0x45, 0xbb, 0xb1, 0x8c, 0xd1, 0x36,
+	0xed, 0x68, 0xd6, 0x96, 0x5f, 0x76, 0xbb, 0xeb, 0x6e, 0x81, 0x64, 0x3d, 0xdf, 0xba, 0xd1, 0x4b,
+	0xbd, 0x9f, 0xb6, 0x3b, 0xce, 0x24, 0x6a, 0xf0, 0x35, 0x91, 0x33, 0x5b, 0xc9, 0x85, 0xfd, 0x25,
+	0xfb, 0x73, 0x7b, 0x4f, 0x8a, 0x65, 0x5b, 0x96, 0x21, 0x71, 0x7e, 0x02, 0x3d, 0x7d, 0xdf, 0xe7,
+	0xfb, 0xf4, 0x2c, 0x59, 0x31, 0x90, 0xee, 0x50, 0xb0, 0x0b, 0x31, 0xbd, 0xe8, 0x79, 0xc2, 0x6b,
+	0x8c, 0xc2, 0x40, 0x04, 0xe4, 0xae, 0xfc, 0x73, 0x39, 0xfe, 0x78, 0xf8, 0x0a, 0xd6, 0xcf, 0x86,
+	0x82, 0xb5, 0xb9, 0x08, 0xaf, 0xc9, 0x16, 0x54, 0x9c, 0x16, 0x5d, 0xa9, 0xad, 0xd4, 0xd7, 0x3b,
+	0x15, 0xa7, 0x45, 0x76, 0xe1, 0x8e, 0x1b, 0x08, 0x6f, 0x40, 0x2b, 0xb5, 0x95, 0x7a, 0xa5, 0xa3,
+	0x06, 0x87, 0xff, 0x1f, 0x01, 0x60, 0x8e, 0x3b, 0x6d, 0x79, 0xc2, 0x23, 0x7b, 0xb0, 0x86, 0x23,
+	0x9d, 0x38, 0x1b, 0xc9, 0xb8, 0xc7, 0x7b, 0x4e, 0x8b, 0x56, 0x66, 0x71, 0x39, 0x42, 0xe8, 0x7b,
+	0x2f, 0x14, 0xd7, 0x74, 0x55, 0x86, 0xd5, 0x80, 0x34, 0x80, 0x9c, 0x05, 0x5c, 0x84, 0xfe, 0xe5,
+	0x58, 0xf8, 0x01, 0x8f, 0x1c, 0xde, 0x1c, 0x0a, 0xfa, 0x85, 0xf4, 0xb5, 0xcc, 0x58, 0xf4, 0xee,
+	0x34, 0xa2, 0x77, 0xac, 0x7a, 0x77, 0x1a, 0x91, 0x63, 0xb8, 0xdf, 0x9c, 0xf4, 0xd3, 0x13, 0x0e,
+	0xa7, 0x6b, 0x52, 0x9e, 0x9f, 0x40, 0xfa, 0xb9, 0xb8, 0x62, 0x61, 0x87, 0x75, 0x99, 0x3f, 0x12,
+	0xb3, 0x6a, 0xbe, 0x54, 0xf4, 0xfc, 0x8c, 0x45, 0x8f, 0xd5, 0xdc, 0xb5, 0xea, 0xb1, 0x9a, 0x03,
+	0x80, 0xe6, 0xa4, 0x2f, 0x27, 0x1c, 0x4e, 0xd7, 0xa5, 0x2e, 0x15, 0x21, 0xcf, 0x61, 0x5b, 0xf6,
+	0xda, 0xe1, 0xdd, 0x60, 0xe8, 0xf3, 0x3e, 0xba, 0x83, 0x54, 0xe5, 0xe2, 0x39, 0x2d, 0x3a, 0x6f,
+	0x58, 0xb4, 0xe8, 0x5b, 0x83, 0x8d, 0xe6, 0xa4, 0x1f, 0x47, 0xe8, 0x3d, 0x29, 0x4b, 0x87, 0xc8,
+	0x21, 0xdc, 0x73, 0x43, 0x8f, 0x47, 0x1f, 0x59, 0x18, 0xa1, 0xeb, 0xa6, 0x94, 0x64, 0x62, 0x19,
+	0x0d, 0xba, 0x6d, 0x19, 0x9a, 0xc4, 0x29, 0x0e, 0xd1, 0xaa, 0x76, 0x8a, 0x43, 0xe4, 0x08, 0x36,
+	0x75, 0xc6, 0x3b, 0x3f, 0x12, 0x74, 0xbb, 0xb6, 0x5a, 0x5f, 0xef, 0x64, 0x83, 0xa4, 0x0e, 0xd5,
+	0xf6, 0x74, 0xc4, 0x78, 0xcf, 0x17, 0xe3, 0x90, 0xc9, 0x92, 0xee, 0x4b, 0x96, 0x19, 0x36, 0x95,
+	0x58, 0x18, 0xc9, 0x2b, 0xb1, 0xb6, 0x27, 0xb0, 0xd5, 0x9c, 0xf4, 0x53, 0x51, 0xba, 0x23, 0x85,
+	0x46, 0x54, 0x77, 0xf6, 0x7c, 0x2c, 0xfa, 0xc1, 0xec, 0x29, 0xec, 0xa6, 0x3a, 0x9b, 0x8a, 0xe7,
+	0xb4, 0x68, 0xff, 0x95, 0x45, 0x9b, 0xf4, 0x26, 0x8e, 0xd0, 0x3d, 0xdd, 0x9b, 0x38, 0x84, 0xfb,
+	0xe3, 0x4f, 0x26, 0x4e, 0xbd, 0x81, 0xc7, 0xbb, 0x8c, 0x3e, 0x50, 0xfb, 0x23, 0x89, 0x90, 0x2b,
+	0xd8, 0x73, 0x83, 0x91, 0xc3, 0x7b, 0x13, 0xbd, 0x71, 0x03, 0xf5, 0xbc, 0x68, 0x6d, 0xb5, 0xbe,
+	0xf1, 0xfa, 0x65, 0x23, 0x3e, 0xe0, 0x8d, 0xe4, 0xa4, 0x36, 0xec, 0x29, 0xf2, 0xe8, 0x77, 0x0a,
+	0x78, 0x05, 0x4e, 0xb8, 0xba, 0xfd, 0xc5, 0x9c, 0xdc, 0x69, 0x54, 0xec, 0x84, 0x5d, 0xf9, 0x0b,
+	0x1e, 0xe5, 0x67, 0xdc, 0xab, 0x90, 0x45, 0x57, 0xc1, 0xa0, 0x47, 0x1f, 0x4a, 0xbb, 0x9d, 0xac,
+	0x9d, 0x22, 0xde, 0x94, 0x47, 0xfe, 0x85, 0x7d, 0x37, 0x18, 0xa1, 0xf8, 0x3c, 0xec, 0x9b, 0xdd,
+	0x7a, 0x24, 0xa1, 0x27, 0x45, 0x6b, 0xb0, 0x67, 0x29, 0xd3, 0x62, 0x6a, 0xb1, 0x25, 0xb6, 0xed,
+	0xeb, 0x85, 0x2d, 0x75, 0xe7, 0x8a, 0xa9, 0xe4, 0x6f, 0x38, 0xb0, 0x4e, 0x26, 0xfd, 0xfb, 0xa6,
+	0xb8, 0x7f, 0xb7, 0xa4, 0x92, 0x0f, 0x50, 0x8d, 0x0f, 0x65, 0x87, 0x75, 0x65, 0xe3, 0x0e, 0x24,
+	0xed, 0x99, 0x7d, 0x15, 0x59, 0xad, 0xf2, 0x30, 0x09, 0x26, 0x14, 0x5b, 0xf3, 0xed, 0x9c, 0x50,
+	0xdd, 0x10, 0x93, 0x40, 0x2e, 0x60, 0xc7, 0x0d, 0x46, 0xed, 0xe9, 0xa8, 0xc3, 0xba, 0xfe, 0xc8,
+	0x67, 0x5c, 0xc8, 0x6a, 0x6b, 0x12, 0xfc, 0xa2, 0xa8, 0xe7, 0xa6, 0x5e, 0xc1, 0x6d, 0x24, 0x9b,
+	0x01, 0x56, 0xfe, 0xdd, 0x02, 0x06, 0xba, 0x7a, 0x1b, 0x89, 0xbc, 0x81, 0xaa, 0x0a, 0x27, 0x4f,
+	0xee, 0xb0, 0xf8, 0xc9, 0x99, 0x5a, 0xf2, 0x1e, 0xb6, 0xda, 0x03, 0xd6, 0x4d, 0x5d, 0xa1, 0xdf,
+	0xcb, 0xec, 0xba, 0xb5, 0xb4, 0xac, 0x54, 0x21, 0x8d, 0x7c, 0x83, 0x88, 0x8b, 0x3d, 0x9a, 0x8f,
+	0xa8, 0xd7, 0x69, 0xe4, 0x93, 0xb7, 0xb0, 0xf1, 0xc1, 0xff, 0x8f, 0x9d, 0xfa, 0x5c, 0x3e, 0x9c,
+	0xc7, 0x12, 0xf7, 0xd8, 0x8a, 0x4b, 0xe9, 0x14, 0x2b, 0x9d, 0x99, 0x06, 0x61, 0x5d, 0x4f, 0xe6,
+	0x00, 0xe9, 0xa2, 0xd2, 0x99, 0xa4, 0x05, 0x80, 0xc3, 0xb3, 0x60, 0xcc, 0x45, 0x44, 0x9f, 0x4a,
+	0xce, 0x51, 0x21, 0x47, 0xc9, 0x14, 0x26, 0x95, 0x47, 0xfe, 0x80, 0xcd, 0xb3, 0x80, 0xf7, 0xc6,
+	0x7e, 0xfc, 0x7b, 0xa1, 0x2e, 0x41, 0x4f, 0xad, 0xa0, 0x8c, 0x52, 0xb1, 0xb2, 0xd9, 0x59, 0x1c,
+	0xae, 0xef, 0xd9, 0x5c, 0x38, 0xbd, 0xc2, 0x6c, 0x36, 0x5e, 0x7a, 0x6d, 0x2f, 0x1c, 0x7a, 0xe1,
+	0x27, 0xd6, 0x53, 0xe5, 0x3d, 0x57, 0x97, 0x5e, 0x36, 0x6a, 0xe8, 0xd0, 0xf7, 0x87, 0x9c, 0x0e,
+	0x79, 0x78, 0xdd, 0xc6, 0x91, 0xf3, 0xb1, 0x40, 0xe0, 0xf1, 0xec, 0xba, 0xcd, 0x86, 0x4d, 0x25,
+	0x22, 0x5f, 0xe4, 0x95, 0xc8, 0xbc, 0x84, 0xdd, 0x59, 0x28, 0x7b, 0x7e, 0x1b, 0x72, 0xe5, 0x0d,
+	0xfb, 0x8e, 0xb3, 0x24, 0xa8, 0x06, 0x58, 0x59, 0x56, 0x0f, 0x2c, 0xe9, 0xc7, 0x45, 0x3c, 0x74,
+	0x93, 0xad, 0x2c, 0xdc, 0x4f, 0xee, 0xf4, 0x77, 0x9f, 0xf7, 0x64, 0xf5, 0x2f, 0x6f, 0xd8, 0x4f,
+	0x89, 0x6c, 0xb6, 0x9f, 0x92, 0x40, 0x8a, 0x82, 0xf5, 0xbd, 0xba, 0x9d, 0xa2, 0xab, 0x4a, 0xe5,
+	0x91, 0x7f, 0x80, 0xe8, 0xf3, 0x87, 0x29, 0xea, 0xd9, 0xbf, 0x96, 0xb4, 0xe3, 0x9b, 0xcf, 0xb0,
+	0x96, 0x2b, 0xaa, 0x85, 0x63, 0xa1, 0x63, 0xad, 0x27, 0xf3, 0xd3, 0x75, 0xcd, 0x16, 0xce, 0x43,
+	0xc7, 0xf6, 0x93, 0x40, 0x37, 0x8b, 0x6c, 0xc3, 0xea, 0x27, 0x76, 0x3d, 0xfb, 0xec, 0xc0, 0x7f,
+	0xf1, 0xdb, 0x62, 0xe2, 0x0d, 0xc6, 0x2c, 0xfe, 0x60, 0x91, 0x83, 0x9f, 0x2b, 0x3f, 0xad, 0x14,
+	0xa0, 0x62, 0xf7, 0x85, 0x50, 0xef, 0x0a, 0xee, 0xda, 0x72, 0x85, 0x15, 0xd2, 0x4a, 0xd5, 0x76,
+	0x0a, 0xbb, 0xb6, 0xeb, 0x77, 0x19, 0x46, 0xa9, 0x3a, 0x7e, 0x03, 0x5a, 0x74, 0xb1, 0x2e, 0xcb,
+	0x29, 0x55, 0x4f, 0x13, 0x76, 0x2c, 0x97, 0xdd, 0x12, 0x88, 0x52, 0x55, 0xfc, 0x02, 0xdb, 0xe6,
+	0x8d, 0x56, 0x36, 0xbf, 0x94, 0xff, 0x1b, 0xa8, 0x1a, 0x17, 0xd8, 0x42, 0xe9, 0xbf, 0x02, 0x49,
+	0xee, 0x94, 0x52, 0x0b, 0xc8, 0x10, 0x4a, 0x2d, 0xe1, 0x2d, 0xec, 0x17, 0xbe, 0xf1, 0x97, 0x06,
+	0x95, 0x6d, 0xaa, 0xf1, 0x16, 0x2f, 0x99, 0x5e, 0xca, 0xbd, 0x0d, 0x0f, 0x0a, 0xde, 0xd7, 0x4b,
+	0x62, 0xca, 0x54, 0x73, 0xb9, 0x26, 0xdf, 0xf8, 0x27, 0x9f, 0x07, 0x00, 0xe8, 0xbd, 0xab, 0xf1,
+	0x74, 0x12, 0x00, 0x00,
 }
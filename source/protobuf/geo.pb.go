@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: geo.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GeoData struct {
+	ID                   string             `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Bucket               string             `protobuf:"bytes,2,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	StateAmt             map[string]float32 `protobuf:"bytes,3,rep,name=StateAmt,proto3" json:"StateAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	StateTxs             map[string]float32 `protobuf:"bytes,4,rep,name=StateTxs,proto3" json:"StateTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Zip3Amt              map[string]float32 `protobuf:"bytes,5,rep,name=Zip3Amt,proto3" json:"Zip3Amt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Zip3Txs              map[string]float32 `protobuf:"bytes,6,rep,name=Zip3Txs,proto3" json:"Zip3Txs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Zip5Amt              map[string]float32 `protobuf:"bytes,7,rep,name=Zip5Amt,proto3" json:"Zip5Amt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Zip5Txs              map[string]float32 `protobuf:"bytes,8,rep,name=Zip5Txs,proto3" json:"Zip5Txs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GeoData) Reset()         { *m = GeoData{} }
+func (m *GeoData) String() string { return proto.CompactTextString(m) }
+func (*GeoData) ProtoMessage()    {}
+func (*GeoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_363b5540e34010f3, []int{0}
+}
+
+func (m *GeoData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeoData.Unmarshal(m, b)
+}
+func (m *GeoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeoData.Marshal(b, m, deterministic)
+}
+func (m *GeoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoData.Merge(m, src)
+}
+func (m *GeoData) XXX_Size() int {
+	return xxx_messageInfo_GeoData.Size(m)
+}
+func (m *GeoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoData proto.InternalMessageInfo
+
+func (m *GeoData) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GeoData) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *GeoData) GetStateAmt() map[string]float32 {
+	if m != nil {
+		return m.StateAmt
+	}
+	return nil
+}
+
+func (m *GeoData) GetStateTxs() map[string]float32 {
+	if m != nil {
+		return m.StateTxs
+	}
+	return nil
+}
+
+func (m *GeoData) GetZip3Amt() map[string]float32 {
+	if m != nil {
+		return m.Zip3Amt
+	}
+	return nil
+}
+
+func (m *GeoData) GetZip3Txs() map[string]float32 {
+	if m != nil {
+		return m.Zip3Txs
+	}
+	return nil
+}
+
+func (m *GeoData) GetZip5Amt() map[string]float32 {
+	if m != nil {
+		return m.Zip5Amt
+	}
+	return nil
+}
+
+func (m *GeoData) GetZip5Txs() map[string]float32 {
+	if m != nil {
+		return m.Zip5Txs
+	}
+	return nil
+}
+
+type GeoTotal struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Year                 string   `protobuf:"bytes,2,opt,name=Year,proto3" json:"Year,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=State,proto3" json:"State,omitempty"`
+	Total                float32  `protobuf:"fixed32,4,opt,name=Total,proto3" json:"Total,omitempty"`
+	Txs                  float32  `protobuf:"fixed32,5,opt,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeoTotal) Reset()         { *m = GeoTotal{} }
+func (m *GeoTotal) String() string { return proto.CompactTextString(m) }
+func (*GeoTotal) ProtoMessage()    {}
+func (*GeoTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_363b5540e34010f3, []int{1}
+}
+
+func (m *GeoTotal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeoTotal.Unmarshal(m, b)
+}
+func (m *GeoTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeoTotal.Marshal(b, m, deterministic)
+}
+func (m *GeoTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoTotal.Merge(m, src)
+}
+func (m *GeoTotal) XXX_Size() int {
+	return xxx_messageInfo_GeoTotal.Size(m)
+}
+func (m *GeoTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoTotal proto.InternalMessageInfo
+
+func (m *GeoTotal) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GeoTotal) GetYear() string {
+	if m != nil {
+		return m.Year
+	}
+	return ""
+}
+
+func (m *GeoTotal) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *GeoTotal) GetTotal() float32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GeoTotal) GetTxs() float32 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GeoData)(nil), "protobuf.GeoData")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.GeoData.StateAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.GeoData.StateTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.GeoData.Zip3AmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.GeoData.Zip3TxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.GeoData.Zip5AmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.GeoData.Zip5TxsEntry")
+	proto.RegisterType((*GeoTotal)(nil), "protobuf.GeoTotal")
+}
+
+func init() { proto.RegisterFile("geo.proto", fileDescriptor_363b5540e34010f3) }
+
+var fileDescriptor_363b5540e34010f3 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcd, 0x4b, 0xf3, 0x40,
+	0x10, 0x87, 0xc9, 0x77, 0x3a, 0xef, 0xab, 0x94, 0x45, 0x64, 0xf1, 0xa0, 0xa5, 0xa7, 0x9e, 0x72,
+	0xb0, 0x2c, 0x94, 0xf6, 0xa4, 0x44, 0x4a, 0xaf, 0x31, 0x17, 0xbd, 0x6d, 0x65, 0x15, 0x69, 0x74,
+	0x4b, 0xb2, 0x91, 0xf4, 0x8f, 0x17, 0x64, 0xbf, 0x62, 0x43, 0x21, 0x60, 0x7b, 0xca, 0xce, 0x30,
+	0xcf, 0xef, 0x81, 0x99, 0xc0, 0xe0, 0x8d, 0xf1, 0x64, 0x5b, 0x72, 0xc1, 0x51, 0xac, 0x3e, 0xeb,
+	0xfa, 0x75, 0xfc, 0x1d, 0x40, 0xb4, 0x64, 0x3c, 0xa5, 0x82, 0xa2, 0x73, 0x70, 0x57, 0x29, 0x76,
+	0x46, 0xce, 0x64, 0x90, 0xb9, 0xab, 0x14, 0x5d, 0x42, 0x78, 0x5f, 0xbf, 0x6c, 0x98, 0xc0, 0xae,
+	0xea, 0x99, 0x0a, 0x2d, 0x20, 0x7e, 0x14, 0x54, 0xb0, 0xbb, 0x0f, 0x81, 0xbd, 0x91, 0x37, 0xf9,
+	0x77, 0x7b, 0x93, 0xd8, 0xc0, 0xc4, 0x84, 0x25, 0x76, 0xe2, 0xe1, 0x53, 0x94, 0xbb, 0xac, 0x05,
+	0x5a, 0x38, 0x6f, 0x2a, 0xec, 0xf7, 0xc2, 0x79, 0x53, 0xed, 0xc3, 0x79, 0x53, 0xa1, 0x19, 0x44,
+	0xcf, 0xef, 0xdb, 0xa9, 0x14, 0x07, 0x8a, 0xbd, 0x3e, 0x64, 0xcd, 0x80, 0x46, 0xed, 0xb8, 0x25,
+	0xa5, 0x35, 0xec, 0x23, 0x5b, 0xa9, 0x1d, 0x37, 0x24, 0x91, 0xce, 0xa8, 0x87, 0x24, 0x1d, 0x27,
+	0xf9, 0x75, 0x12, 0xe9, 0x8c, 0xfb, 0xc8, 0x8e, 0x53, 0x56, 0x57, 0x0b, 0x38, 0xeb, 0xec, 0x0f,
+	0x0d, 0xc1, 0xdb, 0xb0, 0x9d, 0xb9, 0x8d, 0x7c, 0xa2, 0x0b, 0x08, 0xbe, 0x68, 0x51, 0x33, 0x75,
+	0x1b, 0x37, 0xd3, 0xc5, 0xdc, 0x9d, 0x39, 0x2d, 0x6c, 0x63, 0xff, 0x04, 0xcf, 0xe1, 0xff, 0xfe,
+	0x02, 0x8f, 0x61, 0x4f, 0xf0, 0x92, 0x13, 0xbc, 0xe4, 0x18, 0xef, 0xb8, 0x80, 0x78, 0xc9, 0x78,
+	0xce, 0x05, 0x2d, 0x0e, 0xfe, 0x7f, 0x04, 0xfe, 0x13, 0xa3, 0xa5, 0xf9, 0xfb, 0xd5, 0x5b, 0x26,
+	0xa9, 0xe5, 0x62, 0x4f, 0x35, 0x75, 0x21, 0xbb, 0x2a, 0x02, 0xfb, 0x3a, 0x5f, 0xe7, 0x0d, 0xc1,
+	0x93, 0xb7, 0x0f, 0x54, 0x4f, 0x3e, 0xd7, 0xa1, 0xba, 0xff, 0xf4, 0x67, 0x00, 0x19, 0xc4, 0x8c,
+	0xb9, 0x8b, 0x03, 0x00, 0x00,
+}
@@ -40,6 +40,10 @@ type Individual struct {
 	RecipientsTxs        map[string]float32 `protobuf:"bytes,17,rep,name=RecipientsTxs,proto3" json:"RecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	SendersAmt           map[string]float32 `protobuf:"bytes,18,rep,name=SendersAmt,proto3" json:"SendersAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	SendersTxs           map[string]float32 `protobuf:"bytes,19,rep,name=SendersTxs,proto3" json:"SendersTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Sector               string             `protobuf:"bytes,20,opt,name=Sector,proto3" json:"Sector,omitempty"`
+	Industry             string             `protobuf:"bytes,21,opt,name=Industry,proto3" json:"Industry,omitempty"`
+	ElectionsAmt         map[string]float32 `protobuf:"bytes,22,rep,name=ElectionsAmt,proto3" json:"ElectionsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkedAmt         map[string]float32 `protobuf:"bytes,23,rep,name=EarmarkedAmt,proto3" json:"EarmarkedAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -203,8 +207,38 @@ func (m *Individual) GetSendersTxs() map[string]float32 {
 	return nil
 }
 
+func (m *Individual) GetSector() string {
+	if m != nil {
+		return m.Sector
+	}
+	return ""
+}
+
+func (m *Individual) GetIndustry() string {
+	if m != nil {
+		return m.Industry
+	}
+	return ""
+}
+
+func (m *Individual) GetElectionsAmt() map[string]float32 {
+	if m != nil {
+		return m.ElectionsAmt
+	}
+	return nil
+}
+
+func (m *Individual) GetEarmarkedAmt() map[string]float32 {
+	if m != nil {
+		return m.EarmarkedAmt
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Individual)(nil), "protobuf.Individual")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.Individual.EarmarkedAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.Individual.ElectionsAmtEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.Individual.RecipientsAmtEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.Individual.RecipientsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "protobuf.Individual.SendersAmtEntry")
@@ -214,32 +248,36 @@ func init() {
 func init() { proto.RegisterFile("indv_donor.proto", fileDescriptor_98d40319702848d1) }
 
 var fileDescriptor_98d40319702848d1 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xaf, 0x93, 0x40,
-	0x10, 0xc7, 0x03, 0xbc, 0xf7, 0xda, 0x4e, 0x5f, 0x7f, 0xb8, 0x7a, 0xd8, 0xf4, 0x60, 0x48, 0x63,
-	0x22, 0x27, 0x0e, 0x7a, 0x31, 0x26, 0x26, 0x56, 0xdb, 0x03, 0x07, 0xdb, 0x84, 0x72, 0xf2, 0x62,
-	0xb6, 0xb0, 0x9a, 0x8d, 0x74, 0x21, 0xb0, 0x10, 0xf8, 0x37, 0xfd, 0x8b, 0xcc, 0x2c, 0xb6, 0x5d,
-	0xac, 0x89, 0xda, 0x13, 0x33, 0xdf, 0xef, 0xcc, 0x67, 0x67, 0xd9, 0x81, 0xb9, 0x90, 0x49, 0xfd,
-	0x25, 0xc9, 0x64, 0x56, 0xf8, 0x79, 0x91, 0xa9, 0x8c, 0x0c, 0xf5, 0xe7, 0x50, 0x7d, 0x5d, 0xfe,
-	0x18, 0x00, 0x04, 0x32, 0x11, 0xb5, 0x48, 0x2a, 0x96, 0x92, 0x29, 0xd8, 0xc1, 0x9a, 0x5a, 0xae,
-	0xe5, 0x8d, 0x42, 0x3b, 0x58, 0x13, 0x02, 0x77, 0x5b, 0x76, 0xe4, 0xd4, 0xd6, 0x8a, 0x8e, 0x51,
-	0xfb, 0x28, 0x54, 0x4b, 0x9d, 0x4e, 0xc3, 0x98, 0x3c, 0x83, 0xfb, 0xbd, 0x62, 0x8a, 0xd3, 0x3b,
-	0x2d, 0x76, 0x09, 0x99, 0x83, 0xf3, 0x59, 0xe4, 0xf4, 0x5e, 0x6b, 0x18, 0x92, 0xe7, 0x00, 0xbb,
-	0x38, 0xae, 0x72, 0xa6, 0x44, 0x26, 0xe9, 0x83, 0x36, 0x0c, 0x85, 0x2c, 0x60, 0xb8, 0x39, 0xe6,
-	0x69, 0xd6, 0xf2, 0x82, 0x0e, 0xb4, 0x7b, 0xce, 0xc9, 0x12, 0x1e, 0xa3, 0x82, 0xc9, 0x92, 0xc5,
-	0x58, 0x5a, 0xd2, 0xa1, 0xeb, 0x78, 0xa3, 0xb0, 0xa7, 0x11, 0x17, 0xc6, 0x51, 0xa6, 0x58, 0xba,
-	0xab, 0xd4, 0xea, 0xa8, 0xe8, 0xc8, 0xb5, 0x3c, 0x3b, 0x34, 0x25, 0xb3, 0x22, 0x6a, 0x4a, 0x0a,
-	0xfd, 0x8a, 0xa8, 0x29, 0x71, 0x86, 0x55, 0xfd, 0x2d, 0x6a, 0x76, 0x95, 0xa2, 0x63, 0x6d, 0x9f,
-	0x73, 0x9c, 0x5f, 0x97, 0x06, 0x12, 0xf1, 0x8f, 0xda, 0x35, 0x14, 0xc3, 0x47, 0xf8, 0xa4, 0xe7,
-	0x23, 0x9b, 0xc2, 0x40, 0xb3, 0x02, 0x49, 0xa7, 0xda, 0x3c, 0xa5, 0xd8, 0xb9, 0xe5, 0xea, 0x03,
-	0x4b, 0x99, 0x8c, 0x39, 0x9d, 0x75, 0x9d, 0x17, 0x85, 0x7c, 0x82, 0x49, 0xc8, 0x63, 0x91, 0x0b,
-	0x2e, 0x55, 0x89, 0x87, 0xcf, 0x5d, 0xc7, 0x1b, 0xbf, 0x7a, 0xe9, 0x9f, 0x9e, 0xd2, 0xbf, 0x3c,
-	0xa3, 0xdf, 0xab, 0xdc, 0x48, 0x55, 0xb4, 0x61, 0xbf, 0xbb, 0x8f, 0xc3, 0x59, 0x9f, 0xfc, 0x13,
-	0x2e, 0x6a, 0xca, 0x2b, 0x1c, 0xde, 0x6b, 0x0d, 0xb0, 0xe7, 0x32, 0xe1, 0x85, 0x1e, 0x8d, 0x68,
-	0xd6, 0x8b, 0x3f, 0xb2, 0x2e, 0x65, 0x1d, 0xc8, 0xe8, 0x33, 0x28, 0x38, 0xd1, 0xd3, 0xbf, 0x53,
-	0xce, 0xe3, 0x18, 0x7d, 0x8b, 0xf7, 0x40, 0xae, 0xef, 0x8f, 0xbb, 0xf8, 0x9d, 0xb7, 0xbf, 0x56,
-	0x1b, 0x43, 0xdc, 0xd9, 0x9a, 0xa5, 0x55, 0xb7, 0xdc, 0x76, 0xd8, 0x25, 0x6f, 0xed, 0x37, 0x56,
-	0x9f, 0x70, 0x3a, 0xe3, 0xbf, 0x08, 0xef, 0x60, 0xf6, 0xdb, 0x45, 0x6f, 0x6c, 0xbf, 0xe5, 0xf4,
-	0xc3, 0x83, 0xfe, 0x65, 0xaf, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x42, 0x23, 0x92, 0xf9,
-	0x03, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x15, 0xa7, 0x4d, 0x93, 0x49, 0xda, 0xa6, 0x4b, 0x29, 0xab, 0x1e, 0x90, 0x55, 0x21,
+	0xc8, 0x29, 0x07, 0xb8, 0x20, 0x24, 0x04, 0x85, 0xe4, 0x60, 0x24, 0x1a, 0xc9, 0xf1, 0x89, 0x0b,
+	0xda, 0xda, 0x0b, 0xb2, 0xea, 0xac, 0xad, 0xf5, 0x3a, 0x8a, 0xdf, 0x86, 0x47, 0x45, 0x33, 0xae,
+	0x93, 0x75, 0x53, 0x41, 0x93, 0x93, 0x77, 0xfe, 0x99, 0xf9, 0x66, 0xbc, 0xb3, 0x03, 0xc3, 0x58,
+	0x45, 0xcb, 0x9f, 0x51, 0xaa, 0x52, 0x3d, 0xce, 0x74, 0x6a, 0x52, 0xd6, 0xa5, 0xcf, 0x6d, 0xf1,
+	0xeb, 0xea, 0x0f, 0x00, 0x78, 0x2a, 0x8a, 0x97, 0x71, 0x54, 0x88, 0x84, 0x9d, 0x80, 0xe3, 0x4d,
+	0x78, 0xcb, 0x6d, 0x8d, 0x7a, 0xbe, 0xe3, 0x4d, 0x18, 0x83, 0x83, 0x1b, 0xb1, 0x90, 0xdc, 0x21,
+	0x85, 0xce, 0xa8, 0x7d, 0x8d, 0x4d, 0xc9, 0xdb, 0x95, 0x86, 0x67, 0x76, 0x0e, 0x87, 0x73, 0x23,
+	0x8c, 0xe4, 0x07, 0x24, 0x56, 0x06, 0x1b, 0x42, 0xfb, 0x47, 0x9c, 0xf1, 0x43, 0xd2, 0xf0, 0xc8,
+	0x5e, 0x02, 0xcc, 0xc2, 0xb0, 0xc8, 0x84, 0x89, 0x53, 0xc5, 0x3b, 0xe4, 0xb0, 0x14, 0x76, 0x09,
+	0xdd, 0xe9, 0x22, 0x4b, 0xd2, 0x52, 0x6a, 0x7e, 0x44, 0xde, 0xb5, 0xcd, 0xae, 0x60, 0x10, 0x68,
+	0xa1, 0x72, 0x11, 0x62, 0x68, 0xce, 0xbb, 0x6e, 0x7b, 0xd4, 0xf3, 0x1b, 0x1a, 0x73, 0xa1, 0x1f,
+	0xa4, 0x46, 0x24, 0xb3, 0xc2, 0x5c, 0x2f, 0x0c, 0xef, 0xb9, 0xad, 0x91, 0xe3, 0xdb, 0x92, 0x1d,
+	0x11, 0xac, 0x72, 0x0e, 0xcd, 0x88, 0x60, 0x95, 0x63, 0x0f, 0xd7, 0xcb, 0xdf, 0xc1, 0x6a, 0x56,
+	0x18, 0xde, 0x27, 0xf7, 0xda, 0xc6, 0xfe, 0x29, 0xd4, 0x53, 0x88, 0x1f, 0x90, 0xd7, 0x52, 0x2c,
+	0x3f, 0xc2, 0x8f, 0x1b, 0x7e, 0x64, 0x73, 0x38, 0x22, 0x96, 0xa7, 0xf8, 0x09, 0x39, 0x6b, 0x13,
+	0x33, 0x6f, 0xa4, 0xf9, 0x22, 0x12, 0xa1, 0x42, 0xc9, 0x4f, 0xab, 0xcc, 0x8d, 0xc2, 0xbe, 0xc3,
+	0xb1, 0x2f, 0xc3, 0x38, 0x8b, 0xa5, 0x32, 0x39, 0x16, 0x1f, 0xba, 0xed, 0x51, 0xff, 0xed, 0x9b,
+	0x71, 0x3d, 0xca, 0xf1, 0x66, 0x8c, 0xe3, 0x46, 0xe4, 0x54, 0x19, 0x5d, 0xfa, 0xcd, 0xec, 0x26,
+	0x0e, 0x7b, 0x3d, 0x7b, 0x12, 0x2e, 0x58, 0xe5, 0x5b, 0x38, 0xfc, 0xaf, 0x09, 0xc0, 0x5c, 0xaa,
+	0x48, 0x6a, 0x6a, 0x8d, 0x11, 0xeb, 0xd5, 0xa3, 0xac, 0x4d, 0x58, 0x05, 0xb2, 0xf2, 0x2c, 0x0a,
+	0x76, 0xf4, 0xec, 0xff, 0x94, 0x75, 0x3b, 0x56, 0x1e, 0xbb, 0x80, 0xce, 0x5c, 0x86, 0x26, 0xd5,
+	0xfc, 0x9c, 0x5e, 0xd0, 0xbd, 0x85, 0x73, 0xf5, 0x54, 0x54, 0xe4, 0x46, 0x97, 0xfc, 0x79, 0xf5,
+	0xb6, 0x6a, 0x9b, 0x7d, 0x83, 0xc1, 0x34, 0x91, 0xd5, 0x23, 0xc2, 0x3f, 0xb8, 0xa0, 0xda, 0xaf,
+	0x1f, 0xad, 0x6d, 0x07, 0x56, 0xd5, 0x1b, 0xb9, 0xc4, 0x12, 0x7a, 0x21, 0xf4, 0x9d, 0x8c, 0x90,
+	0xf5, 0xe2, 0x5f, 0x2c, 0x2b, 0xb0, 0x66, 0x59, 0xd2, 0xe5, 0x67, 0x60, 0xdb, 0xb3, 0xc4, 0xbd,
+	0xba, 0x93, 0xe5, 0xfd, 0x9a, 0xe2, 0x11, 0xf7, 0x6f, 0x29, 0x92, 0xa2, 0x5a, 0x54, 0xc7, 0xaf,
+	0x8c, 0x0f, 0xce, 0xfb, 0x56, 0x93, 0x50, 0xdf, 0xd7, 0x4e, 0x84, 0x8f, 0x70, 0xfa, 0x60, 0x68,
+	0x7b, 0xa6, 0xef, 0x55, 0xfd, 0x13, 0x9c, 0x6d, 0x5d, 0xf8, 0xce, 0x80, 0x87, 0xb7, 0xbc, 0x0b,
+	0xe0, 0xb6, 0x43, 0x83, 0x7b, 0xf7, 0x77, 0x00, 0x88, 0xb9, 0xc1, 0x28, 0x47, 0x05, 0x00, 0x00,
 }
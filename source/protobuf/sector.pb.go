@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: sector.proto
+
+package protobuf
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SectorData struct {
+	ID                   string             `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Bucket               string             `protobuf:"bytes,2,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	SectorAmt            map[string]float32 `protobuf:"bytes,3,rep,name=SectorAmt,proto3" json:"SectorAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SectorTxs            map[string]float32 `protobuf:"bytes,4,rep,name=SectorTxs,proto3" json:"SectorTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	IndustryAmt          map[string]float32 `protobuf:"bytes,5,rep,name=IndustryAmt,proto3" json:"IndustryAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	IndustryTxs          map[string]float32 `protobuf:"bytes,6,rep,name=IndustryTxs,proto3" json:"IndustryTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SectorData) Reset()         { *m = SectorData{} }
+func (m *SectorData) String() string { return proto.CompactTextString(m) }
+func (*SectorData) ProtoMessage()    {}
+func (*SectorData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5ea2cc957d4f3d0, []int{0}
+}
+
+func (m *SectorData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SectorData.Unmarshal(m, b)
+}
+func (m *SectorData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SectorData.Marshal(b, m, deterministic)
+}
+func (m *SectorData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SectorData.Merge(m, src)
+}
+func (m *SectorData) XXX_Size() int {
+	return xxx_messageInfo_SectorData.Size(m)
+}
+func (m *SectorData) XXX_DiscardUnknown() {
+	xxx_messageInfo_SectorData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SectorData proto.InternalMessageInfo
+
+func (m *SectorData) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *SectorData) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *SectorData) GetSectorAmt() map[string]float32 {
+	if m != nil {
+		return m.SectorAmt
+	}
+	return nil
+}
+
+func (m *SectorData) GetSectorTxs() map[string]float32 {
+	if m != nil {
+		return m.SectorTxs
+	}
+	return nil
+}
+
+func (m *SectorData) GetIndustryAmt() map[string]float32 {
+	if m != nil {
+		return m.IndustryAmt
+	}
+	return nil
+}
+
+func (m *SectorData) GetIndustryTxs() map[string]float32 {
+	if m != nil {
+		return m.IndustryTxs
+	}
+	return nil
+}
+
+type SectorTotal struct {
+	ID                   string             `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Year                 string             `protobuf:"bytes,2,opt,name=Year,proto3" json:"Year,omitempty"`
+	Sector               string             `protobuf:"bytes,3,opt,name=Sector,proto3" json:"Sector,omitempty"`
+	Total                float32            `protobuf:"fixed32,4,opt,name=Total,proto3" json:"Total,omitempty"`
+	Txs                  float32            `protobuf:"fixed32,5,opt,name=Txs,proto3" json:"Txs,omitempty"`
+	IndustryAmt          map[string]float32 `protobuf:"bytes,6,rep,name=IndustryAmt,proto3" json:"IndustryAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCmtes             map[string]float32 `protobuf:"bytes,7,rep,name=TopCmtes,proto3" json:"TopCmtes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCands             map[string]float32 `protobuf:"bytes,8,rep,name=TopCands,proto3" json:"TopCands,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopDonors            map[string]float32 `protobuf:"bytes,9,rep,name=TopDonors,proto3" json:"TopDonors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SectorTotal) Reset()         { *m = SectorTotal{} }
+func (m *SectorTotal) String() string { return proto.CompactTextString(m) }
+func (*SectorTotal) ProtoMessage()    {}
+func (*SectorTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5ea2cc957d4f3d0, []int{1}
+}
+
+func (m *SectorTotal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SectorTotal.Unmarshal(m, b)
+}
+func (m *SectorTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SectorTotal.Marshal(b, m, deterministic)
+}
+func (m *SectorTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SectorTotal.Merge(m, src)
+}
+func (m *SectorTotal) XXX_Size() int {
+	return xxx_messageInfo_SectorTotal.Size(m)
+}
+func (m *SectorTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SectorTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SectorTotal proto.InternalMessageInfo
+
+func (m *SectorTotal) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *SectorTotal) GetYear() string {
+	if m != nil {
+		return m.Year
+	}
+	return ""
+}
+
+func (m *SectorTotal) GetSector() string {
+	if m != nil {
+		return m.Sector
+	}
+	return ""
+}
+
+func (m *SectorTotal) GetTotal() float32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SectorTotal) GetTxs() float32 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *SectorTotal) GetIndustryAmt() map[string]float32 {
+	if m != nil {
+		return m.IndustryAmt
+	}
+	return nil
+}
+
+func (m *SectorTotal) GetTopCmtes() map[string]float32 {
+	if m != nil {
+		return m.TopCmtes
+	}
+	return nil
+}
+
+func (m *SectorTotal) GetTopCands() map[string]float32 {
+	if m != nil {
+		return m.TopCands
+	}
+	return nil
+}
+
+func (m *SectorTotal) GetTopDonors() map[string]float32 {
+	if m != nil {
+		return m.TopDonors
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SectorData)(nil), "protobuf.SectorData")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorData.IndustryAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorData.IndustryTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorData.SectorAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorData.SectorTxsEntry")
+	proto.RegisterType((*SectorTotal)(nil), "protobuf.SectorTotal")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorTotal.IndustryAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorTotal.TopCandsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorTotal.TopCmtesEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "protobuf.SectorTotal.TopDonorsEntry")
+}
+
+func init() { proto.RegisterFile("sector.proto", fileDescriptor_d5ea2cc957d4f3d0) }
+
+var fileDescriptor_d5ea2cc957d4f3d0 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4b, 0x4f, 0xf2, 0x40,
+	0x14, 0x86, 0x43, 0x5b, 0xf8, 0xe8, 0xe1, 0x93, 0x90, 0x89, 0x31, 0x13, 0x57, 0x04, 0x2f, 0x61,
+	0xd5, 0x85, 0x6e, 0x8c, 0x1a, 0x0d, 0x58, 0xa3, 0x6c, 0xb1, 0x1b, 0x97, 0x05, 0xc6, 0x0d, 0xd0,
+	0x21, 0x33, 0x53, 0x03, 0xff, 0xd0, 0xbf, 0xe4, 0xce, 0xcc, 0x9c, 0x5e, 0x11, 0x83, 0xe0, 0x8a,
+	0x39, 0xc3, 0x79, 0x9f, 0x39, 0x97, 0xb7, 0xf0, 0x5f, 0xb2, 0xb1, 0xe2, 0xc2, 0x5b, 0x08, 0xae,
+	0x38, 0xa9, 0x9b, 0x9f, 0x51, 0xfc, 0xd6, 0xf9, 0x70, 0x00, 0x5e, 0xcc, 0x5f, 0x7e, 0xa8, 0x42,
+	0xd2, 0x04, 0x6b, 0xe0, 0xd3, 0x4a, 0xbb, 0xd2, 0x75, 0x87, 0xd6, 0xc0, 0x27, 0x47, 0x50, 0xeb,
+	0xc7, 0xe3, 0x29, 0x53, 0xd4, 0x32, 0x77, 0x49, 0x44, 0x7a, 0xe0, 0xa2, 0xaa, 0x37, 0x57, 0xd4,
+	0x6e, 0xdb, 0xdd, 0xc6, 0xc5, 0x89, 0x97, 0x42, 0xbd, 0x1c, 0xe8, 0x65, 0x59, 0x8f, 0x91, 0x12,
+	0xab, 0x61, 0xae, 0xca, 0x11, 0xc1, 0x52, 0x52, 0x67, 0x2b, 0x22, 0x58, 0xca, 0x12, 0x22, 0x58,
+	0x4a, 0xf2, 0x04, 0x8d, 0x41, 0x34, 0x89, 0xa5, 0x12, 0x2b, 0x5d, 0x47, 0xd5, 0x40, 0xce, 0x36,
+	0x42, 0x0a, 0x79, 0x88, 0x29, 0x2a, 0x8b, 0x20, 0x5d, 0x4d, 0xed, 0x17, 0xa0, 0xac, 0x9e, 0xa2,
+	0xf2, 0xf8, 0x16, 0x9a, 0xe5, 0x8e, 0x49, 0x0b, 0xec, 0x29, 0x5b, 0x25, 0x23, 0xd5, 0x47, 0x72,
+	0x08, 0xd5, 0xf7, 0x70, 0x16, 0x33, 0x33, 0x52, 0x6b, 0x88, 0xc1, 0xb5, 0x75, 0x55, 0xc9, 0xd5,
+	0x29, 0x7c, 0x27, 0xf5, 0x1d, 0xb4, 0xd6, 0xbb, 0xdc, 0x57, 0xbf, 0xcf, 0xfb, 0x9d, 0x4f, 0x07,
+	0x1a, 0x49, 0xf9, 0x5c, 0x85, 0xb3, 0x6f, 0x5e, 0x22, 0xe0, 0xbc, 0xb2, 0x50, 0x24, 0x4e, 0x32,
+	0x67, 0xed, 0x2f, 0x94, 0x50, 0x1b, 0xfd, 0x85, 0x91, 0x7e, 0xc5, 0x40, 0xa8, 0x83, 0xaf, 0x20,
+	0xb1, 0x05, 0xb6, 0x5e, 0x4f, 0xd5, 0xdc, 0xe9, 0x23, 0x79, 0x2e, 0x3b, 0x00, 0x17, 0x77, 0xbe,
+	0xbe, 0x38, 0xa3, 0xde, 0x62, 0x81, 0x7b, 0xa8, 0x07, 0x7c, 0xf1, 0x30, 0x57, 0x4c, 0xd2, 0x7f,
+	0x9b, 0xdd, 0x88, 0x98, 0x34, 0x0b, 0x19, 0x99, 0x28, 0x05, 0x84, 0xd1, 0x44, 0xd2, 0xfa, 0x36,
+	0x80, 0xce, 0x2a, 0x00, 0x74, 0x48, 0xfa, 0xe0, 0x06, 0x7c, 0xe1, 0xf3, 0x88, 0x0b, 0x49, 0x5d,
+	0x43, 0x38, 0xfd, 0x91, 0x80, 0x69, 0xc9, 0x17, 0x91, 0xc5, 0x7f, 0xf6, 0xc0, 0x0d, 0x1c, 0x94,
+	0xfa, 0xdb, 0x47, 0x9c, 0xf5, 0xb6, 0xab, 0xf7, 0xcb, 0x6d, 0xed, 0xa2, 0x1e, 0xd5, 0xcc, 0x9c,
+	0x2e, 0xbf, 0x06, 0x00, 0x4a, 0x70, 0x95, 0xc4, 0xe7, 0x04, 0x00, 0x00,
+}
@@ -76,6 +76,8 @@ type TopOverallData struct {
 	Amts                 map[string]float32 `protobuf:"bytes,6,rep,name=Amts,proto3" json:"Amts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	Threshold            []*Entry           `protobuf:"bytes,7,rep,name=Threshold,proto3" json:"Threshold,omitempty"`
 	SizeLimit            int32              `protobuf:"varint,8,opt,name=SizeLimit,proto3" json:"SizeLimit,omitempty"`
+	State                string             `protobuf:"bytes,9,opt,name=State,proto3" json:"State,omitempty"`
+	Metric               string             `protobuf:"bytes,10,opt,name=Metric,proto3" json:"Metric,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -162,6 +164,20 @@ func (m *TopOverallData) GetSizeLimit() int32 {
 	return 0
 }
 
+func (m *TopOverallData) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *TopOverallData) GetMetric() string {
+	if m != nil {
+		return m.Metric
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Entry)(nil), "protobuf.Entry")
 	proto.RegisterType((*TopOverallData)(nil), "protobuf.TopOverallData")
@@ -171,23 +187,24 @@ func init() {
 func init() { proto.RegisterFile("top_overall.proto", fileDescriptor_aeeca2cb3885364f) }
 
 var fileDescriptor_aeeca2cb3885364f = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0xc6, 0xc9, 0xe6, 0x8f, 0xc9, 0x08, 0x55, 0x17, 0x91, 0xa5, 0x78, 0x08, 0x39, 0xe5, 0xd2,
-	0x1c, 0x14, 0x54, 0xbc, 0xa9, 0xf1, 0x50, 0x10, 0x94, 0x98, 0x8b, 0x27, 0xd9, 0xea, 0x6a, 0x43,
-	0xb7, 0x6e, 0xd8, 0x4e, 0x0a, 0xf1, 0xd9, 0x7c, 0x38, 0xc9, 0xa4, 0x6d, 0x10, 0x4f, 0x3b, 0xbf,
-	0x99, 0xef, 0x9b, 0x9d, 0x19, 0x38, 0x42, 0x53, 0xbf, 0x9a, 0xb5, 0xb2, 0x52, 0xeb, 0xac, 0xb6,
-	0x06, 0x0d, 0x0f, 0xe9, 0x99, 0x35, 0x1f, 0xc9, 0x04, 0xfc, 0xfb, 0x2f, 0xb4, 0x2d, 0x1f, 0x01,
-	0x9b, 0xe6, 0xc2, 0x89, 0x9d, 0x34, 0x2a, 0xd8, 0x34, 0xe7, 0xc7, 0xe0, 0x97, 0x06, 0xa5, 0x16,
-	0x2c, 0x76, 0x52, 0x56, 0xf4, 0x90, 0xfc, 0x30, 0x18, 0x95, 0xa6, 0x7e, 0xec, 0xbb, 0xe5, 0x12,
-	0xe5, 0x3f, 0x23, 0x07, 0xef, 0x45, 0x49, 0x4b, 0xbe, 0xa8, 0xa0, 0x98, 0x9f, 0x40, 0x70, 0xdb,
-	0xbc, 0x2d, 0x14, 0x0a, 0x97, 0xb2, 0x1b, 0xe2, 0x63, 0x08, 0xef, 0x24, 0xaa, 0x4f, 0x63, 0x5b,
-	0xe1, 0x51, 0x65, 0xc7, 0xdd, 0x00, 0x4f, 0xd2, 0x62, 0x2b, 0x7c, 0x2a, 0xf4, 0xc0, 0x2f, 0xc0,
-	0xbb, 0x59, 0xe2, 0x4a, 0x04, 0xb1, 0x9b, 0xee, 0x9f, 0x25, 0xd9, 0x76, 0x91, 0xec, 0xef, 0x54,
-	0x59, 0x27, 0xa2, 0xc5, 0x0a, 0xd2, 0xf3, 0x09, 0x44, 0xe5, 0xdc, 0xaa, 0xd5, 0xdc, 0xe8, 0x77,
-	0xb1, 0x47, 0xe6, 0x83, 0xc1, 0xdc, 0x2b, 0x07, 0x05, 0x3f, 0x85, 0xe8, 0xb9, 0xfa, 0x56, 0x0f,
-	0xd5, 0xb2, 0x42, 0x11, 0xc6, 0x4e, 0xea, 0x17, 0x43, 0x62, 0x7c, 0x09, 0xd1, 0xae, 0x3f, 0x3f,
-	0x04, 0x77, 0xa1, 0xda, 0xcd, 0x01, 0xba, 0xb0, 0x9b, 0x7c, 0x2d, 0x75, 0xa3, 0xb6, 0xa7, 0x23,
-	0xb8, 0x66, 0x57, 0xce, 0x2c, 0xa0, 0x1f, 0xcf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd4, 0xe7,
-	0xf2, 0x50, 0x93, 0x01, 0x00, 0x00,
+	// 296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x41, 0x4b, 0xfb, 0x30,
+	0x18, 0xc6, 0x69, 0xb6, 0xee, 0xbf, 0xbc, 0x7f, 0x98, 0x1a, 0x44, 0xc2, 0xf0, 0x50, 0x76, 0xea,
+	0x65, 0x3d, 0x28, 0xa8, 0x78, 0x53, 0xeb, 0x61, 0xa0, 0x28, 0x5d, 0x2f, 0x9e, 0x24, 0x9b, 0xd1,
+	0x95, 0x75, 0xa6, 0x64, 0x6f, 0x07, 0xf5, 0x23, 0xfb, 0x29, 0x24, 0x6f, 0xb6, 0x15, 0xf1, 0x94,
+	0xfc, 0x92, 0xe7, 0x79, 0x79, 0x9e, 0x17, 0x8e, 0xd0, 0x54, 0xaf, 0x66, 0xa3, 0xad, 0x2a, 0xcb,
+	0xa4, 0xb2, 0x06, 0x8d, 0xe8, 0xd3, 0x31, 0xab, 0xdf, 0x47, 0x63, 0x08, 0xef, 0x3f, 0xd1, 0x36,
+	0x62, 0x00, 0x6c, 0x92, 0xca, 0x20, 0x0a, 0x62, 0x9e, 0xb1, 0x49, 0x2a, 0x8e, 0x21, 0xcc, 0x0d,
+	0xaa, 0x52, 0xb2, 0x28, 0x88, 0x59, 0xe6, 0x61, 0xf4, 0xcd, 0x60, 0x90, 0x9b, 0xea, 0xc9, 0x4f,
+	0x4b, 0x15, 0xaa, 0x3f, 0x46, 0x01, 0xdd, 0x17, 0xad, 0x2c, 0xf9, 0x78, 0x46, 0x77, 0x71, 0x02,
+	0xbd, 0xdb, 0x7a, 0xbe, 0xd4, 0x28, 0x3b, 0xf4, 0xba, 0x25, 0x31, 0x84, 0xfe, 0x9d, 0x42, 0xfd,
+	0x61, 0x6c, 0x23, 0xbb, 0xf4, 0xb3, 0x67, 0x17, 0xe0, 0x59, 0x59, 0x6c, 0x64, 0x48, 0x1f, 0x1e,
+	0xc4, 0x05, 0x74, 0x6f, 0x56, 0xb8, 0x96, 0xbd, 0xa8, 0x13, 0xff, 0x3f, 0x1b, 0x25, 0xbb, 0x22,
+	0xc9, 0xef, 0x54, 0x89, 0x13, 0x51, 0xb1, 0x8c, 0xf4, 0x62, 0x0c, 0x3c, 0x5f, 0x58, 0xbd, 0x5e,
+	0x98, 0xf2, 0x4d, 0xfe, 0x23, 0xf3, 0x41, 0x6b, 0xf6, 0xca, 0x56, 0x21, 0x4e, 0x81, 0x4f, 0x8b,
+	0x2f, 0xfd, 0x50, 0xac, 0x0a, 0x94, 0xfd, 0x28, 0x88, 0xc3, 0xac, 0x7d, 0x70, 0xd1, 0xa6, 0xa8,
+	0x50, 0x4b, 0xee, 0xa3, 0x11, 0xb8, 0x92, 0x8f, 0x1a, 0x6d, 0x31, 0x97, 0xe0, 0x4b, 0x7a, 0x1a,
+	0x5e, 0x02, 0xdf, 0xa7, 0x11, 0x87, 0xd0, 0x59, 0xea, 0x66, 0xbb, 0x2e, 0x77, 0x75, 0xc3, 0x36,
+	0xaa, 0xac, 0xf5, 0x6e, 0xd1, 0x04, 0xd7, 0xec, 0x2a, 0x98, 0xf5, 0x28, 0xdf, 0xf9, 0xcf, 0x00,
+	0xee, 0x41, 0x98, 0x00, 0xc1, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,180 @@
+// Package server contains operations for initializing and
+// communicating with the HTTP and gRPC servers.
+// This file contains operations for retrieving money-flow
+// network data derived from the datasets stored on disk.
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/elections/source/network"
+)
+
+// network graph size caps
+const (
+	defaultNetworkDepth = 2
+	maxNetworkDepth     = 3
+	defaultNetworkNodes = 500
+	maxNetworkNodes     = 2000
+	defaultNetworkPage  = 100
+	maxNetworkPage      = 500
+)
+
+// FindPaths returns the top k money-flow paths from the source to the target
+// for the given year ranked by bottleneck amount ("bottleneck") or by the
+// $ value attributed to the source ("share").
+func FindPaths(year, from, to string, k, maxHops int, rankBy string, minAmt float32) ([]FlowPath, error) {
+	paths, err := network.FindPaths(year, from, to, k, maxHops, rankBy, minAmt)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("FindPaths failed: %v", err)
+	}
+
+	wraps := []FlowPath{}
+	for _, p := range paths {
+		wrap := FlowPath{
+			Bottleneck: p.Bottleneck,
+			Attributed: p.Attributed,
+			Share:      p.Share,
+		}
+		for _, h := range p.Hops {
+			wrap.Hops = append(wrap.Hops, FlowHop{
+				From:     h.From,
+				FromName: h.FromName,
+				To:       h.To,
+				ToName:   h.ToName,
+				Type:     h.Type,
+				Amt:      h.Amt,
+				Txs:      h.Txs,
+			})
+		}
+		wraps = append(wraps, wrap)
+	}
+	return wraps, nil
+}
+
+// GetNetwork returns a page of the money-flow graph built from the root ID for the
+// given years. Graphs are cached in network_db.db after they are first built and
+// deleted when the year's datasets are rebuilt. The depth defaults to 2 if not set
+// and negative minAmt values are treated as 0.
+// The graph is clipped to maxNodes nodes ranked by distance from the root and
+// edge $ values; nodes are paged in ranked order and each edge is returned with
+// the page containing the lower ranked of its nodes. pageToken is the token
+// returned with the previous page or an empty string for the first page.
+func GetNetwork(years []string, rootID string, depth int, minAmt float32, maxNodes, pageSize int, pageToken string) (NetworkPage, error) {
+	page := NetworkPage{}
+	if len(years) == 0 {
+		return page, fmt.Errorf("GetNetwork failed: NO_YEAR_SET")
+	}
+	if depth <= 0 { // not set
+		depth = defaultNetworkDepth
+	}
+	if minAmt < 0 {
+		minAmt = 0
+	}
+	if depth > maxNetworkDepth {
+		depth = maxNetworkDepth
+	}
+	if maxNodes <= 0 {
+		maxNodes = defaultNetworkNodes
+	}
+	if maxNodes > maxNetworkNodes {
+		maxNodes = maxNetworkNodes
+	}
+	if pageSize <= 0 {
+		pageSize = defaultNetworkPage
+	}
+	if pageSize > maxNetworkPage {
+		pageSize = maxNetworkPage
+	}
+	offset := 0
+	if pageToken != "" {
+		var err error
+		offset, err = strconv.Atoi(pageToken)
+		if err != nil || offset < 0 {
+			return page, fmt.Errorf("GetNetwork failed: INVALID_PAGE_TOKEN")
+		}
+	}
+
+	graph, err := getNetworkGraph(years, rootID, depth, minAmt)
+	if err != nil {
+		fmt.Println(err)
+		return page, fmt.Errorf("GetNetwork failed: %v", err)
+	}
+	page.Truncated = graph.Clip(maxNodes)
+
+	// rank nodes and edges
+	ranked := graph.RankedNodes()
+	dist := graph.Distances()
+	rank := make(map[string]int)
+	for i, node := range ranked {
+		rank[node.ID] = i
+	}
+	edges := graph.Edges()
+	page.TotalNodes = int32(len(ranked))
+	page.TotalEdges = int32(len(edges))
+
+	end := offset + pageSize
+	if end > len(ranked) {
+		end = len(ranked)
+	}
+	for i := offset; i < end; i++ {
+		n := ranked[i]
+		page.Nodes = append(page.Nodes, NetworkNode{
+			ID:          n.ID,
+			Name:        n.Name,
+			Type:        n.Type,
+			Party:       n.Party,
+			State:       n.State,
+			TotalInAmt:  n.TotalInAmt,
+			TotalOutAmt: n.TotalOutAmt,
+			Depth:       int32(dist[n.ID]),
+		})
+	}
+	for _, e := range edges {
+		r := rank[e.From]
+		if rank[e.To] > r {
+			r = rank[e.To]
+		}
+		if r < offset || r >= end {
+			continue
+		}
+		page.Edges = append(page.Edges, NetworkEdge{From: e.From, To: e.To, Type: e.Type, Amt: e.Amt, Txs: e.Txs})
+	}
+	if end < len(ranked) {
+		page.NextPageToken = strconv.Itoa(end)
+	}
+
+	return page, nil
+}
+
+// getNetworkGraph retrieves the graph from network_db.db or builds
+// and saves the graph if it does not exist.
+func getNetworkGraph(years []string, rootID string, depth int, minAmt float32) (*network.NetworkGraph, error) {
+	id := network.NetworkID(years, rootID, depth, minAmt)
+	exists, err := network.GraphExists(id)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getNetworkGraph failed: %v", err)
+	}
+	if exists {
+		graph, err := network.GetGraph(id)
+		if err != nil {
+			fmt.Println(err)
+			return nil, fmt.Errorf("getNetworkGraph failed: %v", err)
+		}
+		return graph, nil
+	}
+
+	graph, err := network.CreateNetwork(years, rootID, depth, minAmt)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getNetworkGraph failed: %v", err)
+	}
+	if err := network.PersistGraph(graph); err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("getNetworkGraph failed: %v", err)
+	}
+	return graph, nil
+}
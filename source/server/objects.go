@@ -99,6 +99,74 @@ type CmteTxData struct {
 	TransferRecsTxs           map[string]float32 // # of transactions for each recipient committee
 	TopExpRecipientsAmt       map[string]float32 // Top expenditure recipients by $ value
 	TopExpRecipientsTxs       map[string]float32 // # of transactions for each top recipient by $ value
+	ElectionsInAmt            map[string]float32 // $ value of contributions received for each election ("G2020")
+	ElectionsInTxs            map[string]float32 // # of contributions received for each election
+	SizeBinsAmt               map[string]float32 // $ value of individual contributions received in each size bin ("UNDER_200")
+	SizeBinsTxs               map[string]float32 // # of individual contributions received in each size bin
+	SizePercentiles           map[string]float32 // percentile individual contribution amounts ("P50" = median)
+	ConduitsInAmt             map[string]float32 // $ value of earmarked contributions received through each conduit committee
+	ConduitsInTxs             map[string]float32 // # of earmarked contributions received through each conduit committee
+	EarmarkedInAmt            float32            // $ value of earmarked contributions received as conduit
+	EarmarkedInTxs            float32            // # of earmarked contributions received as conduit
+	EarmarkedOutAmt           float32            // $ value of earmarked contributions forwarded as conduit
+	EarmarkedOutTxs           float32            // # of earmarked contributions forwarded as conduit
+	EarmarkRecipientsAmt      map[string]float32 // $ value of earmarked contributions forwarded to each recipient committee
+	EarmarkRecipientsTxs      map[string]float32 // # of earmarked contributions forwarded to each recipient committee
+	TxKindsAmt                map[string]float32 // $ value of refunds, adjustments & memo entries by transaction kind ("refund_out")
+	TxKindsTxs                map[string]float32 // # of refunds, adjustments & memo entries by transaction kind
+}
+
+// CandRollup wraps donations.CandRollup
+type CandRollup struct {
+	CandID                    string             // ID of candidate
+	Party                     string             // Candidate's political party
+	Committees                []string           // ID's of committees included in rollup
+	ContributionsInAmt        float32            // $ value of incoming contributions
+	ContributionsInTxs        float32            // # of incoming contributions
+	AvgContributionIn         float32            // Average $ value of incoming contributions
+	OtherReceiptsInAmt        float32            // $ value of loans from/refunds from/other incoming transactions
+	OtherReceiptsInTxs        float32            // # of loans from/refunds from/other incoming transactions
+	AvgOtherIn                float32            // Average $ value of other incoming receipts
+	InternalTransfersAmt      float32            // $ value of transfers between linked committees
+	InternalTransfersTxs      float32            // # of transfers between linked committees
+	TotalIncomingAmt          float32            // Total $ value of incoming transactions (less internal transfers)
+	TotalIncomingTxs          float32            // Total # of incoming transactions (less internal transfers)
+	AvgIncoming               float32            // Average $ value of incoming transactions
+	TransfersAmt              float32            // $ value of transfers to committees not included in rollup
+	TransfersTxs              float32            // # of transfers to committees not included in rollup
+	AvgTransfer               float32            // Average value of transfers
+	ExpendituresAmt           float32            // $ value of expenditure transactions
+	ExpendituresTxs           float32            // # of expenditure transactions
+	AvgExpenditure            float32            // Average value of expenditures
+	TotalOutgoingAmt          float32            // Total outgoing $ Value (TransfersAmt + ExpendituresAmt)
+	TotalOutgoingTxs          float32            // Total # of outgoing transactions (TransfersTxs + ExpendituresTxs)
+	AvgOutgoing               float32            // Average outgoing transaction
+	NetBalance                float32            // NetBalance = TotalIncomingAmt - TotalOutgoingAmt
+	TopIndvContributorsAmt    map[string]float32 // Top Individuals by $ value contributed
+	TopIndvContributorsTxs    map[string]float32 // # of transactions for each top contributor by $ value
+	TopCmteOrgContributorsAmt map[string]float32 // Top Committee and Organization contributors by $ value contributed
+	TopCmteOrgContributorsTxs map[string]float32 // # of transactions for each top contributor by $ value
+	TransferRecsAmt           map[string]float32 // total $ value of transactions to each recipient committee
+	TransferRecsTxs           map[string]float32 // # of transactions for each recipient committee
+	TopExpRecipientsAmt       map[string]float32 // Top expenditure recipients by $ value
+	TopExpRecipientsTxs       map[string]float32 // # of transactions for each top recipient by $ value
+	ElectionsInAmt            map[string]float32 // $ value of contributions received for each election ("G2020")
+	ElectionsInTxs            map[string]float32 // # of contributions received for each election
+	SizeBinsAmt               map[string]float32 // $ value of individual contributions received in each size bin ("UNDER_200")
+	SizeBinsTxs               map[string]float32 // # of individual contributions received in each size bin
+	SizePercentiles           map[string]float32 // percentile individual contribution amounts ("P50" = median)
+	ConduitsInAmt             map[string]float32 // $ value of earmarked contributions received through each conduit committee
+	ConduitsInTxs             map[string]float32 // # of earmarked contributions received through each conduit committee
+	CandContsAmt              float32            // $ value of contributions from the candidate
+	CandLoansAmt              float32            // $ value of loans from the candidate
+	CandLoanRepayAmt          float32            // $ value of loan repayments to the candidate
+	SelfFundedAmt             float32            // $ value of candidate contributions and loans
+	SelfFundedShare           float32            // share of total receipts funded by the candidate
+	CandLoansOutstanding      float32            // $ value of candidate loans outstanding across each cycle up to the year
+	CandLoansByYear           map[string]float32 // $ value of loans from the candidate in each cycle
+	CandRepaysByYear          map[string]float32 // $ value of loan repayments to the candidate in each cycle
+	TxKindsAmt                map[string]float32 // $ value of refunds, adjustments & memo entries by transaction kind ("refund_out")
+	TxKindsTxs                map[string]float32 // # of refunds, adjustments & memo entries by transaction kind
 }
 
 // CmteFinancials wraps donations.CmteTxData (not used in current version)
@@ -188,3 +256,54 @@ type CmpnFinancials struct {
 	IndvRefunds    float32
 	CmteRefunds    float32
 }
+
+// FlowHop wraps network.Hop
+type FlowHop struct {
+	From     string
+	FromName string
+	To       string
+	ToName   string
+	Type     string
+	Amt      float32
+	Txs      float32
+}
+
+// FlowPath wraps network.Path
+type FlowPath struct {
+	Hops       []FlowHop
+	Bottleneck float32 // smallest hop $ value
+	Attributed float32 // $ value attributed to the source
+	Share      float32 // share of the source's total outgoing $ value attributed to the target
+}
+
+// NetworkNode wraps network.Node
+type NetworkNode struct {
+	ID          string
+	Name        string
+	Type        string
+	Party       string
+	State       string
+	TotalInAmt  float32
+	TotalOutAmt float32
+	Depth       int32 // # of hops from root node
+}
+
+// NetworkEdge wraps network.Edge
+type NetworkEdge struct {
+	From string
+	To   string
+	Type string
+	Amt  float32
+	Txs  float32
+}
+
+// NetworkPage contains a page of nodes and the edges between
+// the nodes and the nodes on previous pages of a network graph.
+type NetworkPage struct {
+	Nodes         []NetworkNode
+	Edges         []NetworkEdge
+	TotalNodes    int32
+	TotalEdges    int32
+	Truncated     bool   // graph clipped to max # of nodes
+	NextPageToken string // empty if last page
+}
@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/elections/source/persist"
 
+	"github.com/elections/source/databuilder"
 	"github.com/elections/source/donations"
 	"github.com/elections/source/dynamo"
 	"github.com/elections/source/indexing"
@@ -70,10 +72,11 @@ func GetIndexData() (*IndexData, error) {
 
 // SearchData takes a user query as a string and
 // finds the results matching each word in query.
-func SearchData(id *IndexData, txt string) ([]string, error) {
+func SearchData(id *IndexData, txt string) (indexing.Hits, error) {
 	// get query from user / return & print results
 	// indexing.OUTPUT_PATH = "./Volumes/T7/processed" // set output path in main
-	terms := formatTerms(strings.Split(txt, " "))
+	q := indexing.CreateQuery(txt, "user")
+	terms := q.Terms()
 	wrap := &indexing.IndexData{
 		Shards: make(indexing.ShardMap),
 	}
@@ -81,20 +84,77 @@ func SearchData(id *IndexData, txt string) ([]string, error) {
 		wrap.Shards[t] = id.Shards[t]
 	}
 
-	q := indexing.CreateQuery(txt, "user")
-	common, err := indexing.GetResultsFromShards(wrap, q)
+	hits, err := indexing.GetHitsFromShards(wrap, q)
 	if err != nil {
-		if err.Error() == "MAX_LENGTH" {
-			return []string{}, err
-		}
 		if err.Error() == "NO_RESULTS" {
 			fmt.Println("search data: ", err)
-			return []string{}, err
+			return indexing.Hits{}, err
 		}
 		fmt.Println(err)
-		return []string{}, fmt.Errorf("QueryData failed: %v", err)
+		return indexing.Hits{}, fmt.Errorf("QueryData failed: %v", err)
+	}
+	return hits, nil
+}
+
+// FuzzySearchData replaces each query term not found in the index with the
+// closest matching term and finds the results matching the corrected query.
+// Returns the corrected query text with the results, or a NO_RESULTS error
+// if no terms could be corrected.
+func FuzzySearchData(id *IndexData, txt string) (indexing.Hits, string, error) {
+	q, corrected, err := indexing.CorrectQuery(indexing.CreateQuery(txt, "user"))
+	if err != nil {
+		fmt.Println(err)
+		return indexing.Hits{}, "", fmt.Errorf("FuzzySearchData failed: %v", err)
+	}
+	if !corrected {
+		return indexing.Hits{}, "", fmt.Errorf("NO_RESULTS")
+	}
+	fmt.Printf("fuzzy search: '%s' -> '%s'\n", txt, q.String())
+
+	hits, err := SearchData(id, q.String())
+	if err != nil {
+		return indexing.Hits{}, "", err
+	}
+	return hits, q.String(), nil
+}
+
+// Autocomplete returns the best-ranked names beginning with the
+// user's partial query.
+func Autocomplete(prefix string, limit int) ([]indexing.SearchData, error) {
+	sds, err := indexing.Autocomplete(prefix, limit)
+	if err != nil {
+		fmt.Println(err)
+		return []indexing.SearchData{}, fmt.Errorf("Autocomplete failed: %v", err)
+	}
+	return sds, nil
+}
+
+// GetSearchPage ranks the query hits by term match quality and rankings totals
+// and returns the page of results starting at the cursor.
+func GetSearchPage(txt string, hits indexing.Hits, cache SearchDataMap, totals map[string]float32, pageSize int, cursor string) (indexing.ResultsPage, error) {
+	q := indexing.CreateQuery(txt, "user")
+	page, err := indexing.PageResults(q, hits, cache, totals, pageSize, cursor)
+	if err != nil {
+		fmt.Println(err)
+		return page, fmt.Errorf("GetSearchPage failed: %v", err)
 	}
-	return common, nil
+	return page, nil
+}
+
+// CreateTotalsMap returns the largest total $ value of each object
+// in the rankings lists for all years.
+func CreateTotalsMap(rankings RankingsMap) map[string]float32 {
+	totals := make(map[string]float32)
+	for _, set := range rankings {
+		for _, data := range set {
+			for objID, total := range data.Rankings {
+				if total > totals[objID] {
+					totals[objID] = total
+				}
+			}
+		}
+	}
+	return totals
 }
 
 // GetSearchResults returns the SearchData object for the given IDs.
@@ -127,7 +187,74 @@ func GetObjectFromDisk(year, ID, bucket string) (interface{}, error) {
 		return nil, fmt.Errorf("GetObjFromDisk failed: %v", err)
 	}
 
-	// re-encode object to intermediate type sent thru gRPC
+	return wrapDiskObject(obj, bucket)
+}
+
+// GetMergedObjectFromDisk merges the object's datasets on disk for each of the given
+// years into one object and returns the merged object as interface{}.
+// Merged objects are cached by package databuilder; cfg may be nil to use the default config.
+func GetMergedObjectFromDisk(years []string, ID, bucket string, cfg *databuilder.MergeConfig) (interface{}, error) {
+	obj, err := databuilder.MergeData(years, ID, bucket, cfg)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("GetMergedObjectFromDisk failed: %v", err)
+	}
+
+	return wrapDiskObject(obj, bucket)
+}
+
+// LookupYears returns the years requested for an entity lookup.
+// If a start and end year are given, each election cycle in the range is returned instead.
+func LookupYears(years []string, start, end string) ([]string, error) {
+	if start == "" && end == "" {
+		return years, nil
+	}
+	if start == "" {
+		start = end
+	}
+	if end == "" {
+		end = start
+	}
+	rng, err := databuilder.YearRange(start, end)
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("LookupYears failed: %v", err)
+	}
+	return rng, nil
+}
+
+// GetLookupObject retrieves the entity's dataset for the given years.
+// A single year's dataset is retrieved from DynamoDB; datasets for multiple
+// years are merged from disk. Committee info is not aggregated, so the
+// latest year's dataset is returned for the committees bucket.
+func GetLookupObject(db *dynamo.DbInfo, query *dynamo.Query, ID, bucket string, years []string) (interface{}, error) {
+	if len(years) == 0 {
+		return nil, fmt.Errorf("NO_YEAR_SET")
+	}
+	if len(years) > 1 {
+		sorted := append([]string{}, years...)
+		sort.Strings(sorted)
+		if bucket != "committees" {
+			obj, err := GetMergedObjectFromDisk(sorted, ID, bucket, nil)
+			if err != nil {
+				fmt.Println(err)
+				return nil, fmt.Errorf("GetLookupObject failed: %v", err)
+			}
+			return obj, nil
+		}
+		years = sorted[len(sorted)-1:]
+	}
+
+	objs, err := GetObjectFromDynamo(db, query, bucket, years)
+	if err != nil {
+		// TABLE_NOT_FOUND is returned as is for callers to handle
+		return nil, err
+	}
+	return objs[0], nil
+}
+
+// re-encode object from disk to intermediate type sent thru gRPC
+func wrapDiskObject(obj interface{}, bucket string) (interface{}, error) {
 	var intf interface{}
 	switch bucket {
 	case "individuals":
@@ -203,6 +330,21 @@ func GetObjectFromDisk(year, ID, bucket string) (interface{}, error) {
 			TransferRecsTxs:           cmte.TransferRecsTxs,
 			TopExpRecipientsAmt:       cmte.TopExpRecipientsAmt,
 			TopExpRecipientsTxs:       cmte.TopExpRecipientsTxs,
+			ElectionsInAmt:            cmte.ElectionsInAmt,
+			ElectionsInTxs:            cmte.ElectionsInTxs,
+			SizeBinsAmt:               cmte.SizeBinsAmt,
+			SizeBinsTxs:               cmte.SizeBinsTxs,
+			ConduitsInAmt:             cmte.ConduitsInAmt,
+			ConduitsInTxs:             cmte.ConduitsInTxs,
+			EarmarkedInAmt:            cmte.EarmarkedInAmt,
+			EarmarkedInTxs:            cmte.EarmarkedInTxs,
+			EarmarkedOutAmt:           cmte.EarmarkedOutAmt,
+			EarmarkedOutTxs:           cmte.EarmarkedOutTxs,
+			EarmarkRecipientsAmt:      cmte.EarmarkRecipientsAmt,
+			EarmarkRecipientsTxs:      cmte.EarmarkRecipientsTxs,
+			TxKindsAmt:                cmte.TxKindsAmt,
+			TxKindsTxs:                cmte.TxKindsTxs,
+			SizePercentiles:           databuilder.SizePercentiles(cmte.SizeCounts),
 		}
 		intf = new
 	case "cmte_fin":
@@ -336,6 +478,16 @@ func CreateQueryFromSearchData(sd indexing.SearchData) *dynamo.Query {
 	return &dynamo.Query{PrimaryValue: pk, SortValue: sd.ID}
 }
 
+// CreateRollupQuery returns a Dynamo Query object for the given Candidate's CandRollup.
+func CreateRollupQuery(cand Candidate) *dynamo.Query {
+	pk := cand.Party
+	if pk == "" {
+		pk = "???"
+	}
+
+	return &dynamo.Query{PrimaryValue: pk, SortValue: cand.ID}
+}
+
 // GetRankingsFromDynamo retrieves the TopOvearll datasets
 // for the given year from Dynamo to store in memory.
 func GetRankingsFromDynamo(db *dynamo.DbInfo) (RankingsMap, error) {
@@ -352,7 +504,11 @@ func GetRankingsFromDynamo(db *dynamo.DbInfo) (RankingsMap, error) {
 		fmt.Printf("getting rankings for %s...\n", yr)
 		// get list of object IDs for the year,
 		queries := []*dynamo.Query{}
-		names := createRankingsNames(yr)
+		names, err := createRankingsNames(yr)
+		if err != nil {
+			fmt.Println(err)
+			return rankings, fmt.Errorf("GetRankingsFromDynamo failed: %v", err)
+		}
 		for _, n := range names {
 			ss := strings.Split(n, "-")
 			prt := ss[1]
@@ -586,6 +742,7 @@ func initTableObjs(db *dynamo.DbInfo, year string) {
 	cand := "cf-" + year + "-candidates"       // pk = First Letter of Name
 	cmte := "cf-" + year + "-committees"       // pk = First Letter of Name
 	cmteData := "cf-" + year + "-cmte_tx_data" // pk = First Letter of Name
+	rollup := "cf-" + year + "-cand_rollup"    // pk = Party
 	// cmteFin := "cf-" + year + "-cmte_financials" // pk = First Letter of Name
 	topOverall := "cf-" + year + "-top_overall" // pk = Year
 	yrTotals := "cf-" + year + "-yearly_totals" // pk = Year
@@ -609,6 +766,10 @@ func initTableObjs(db *dynamo.DbInfo, year string) {
 	t = dynamo.CreateNewTableObj(cmteData, "Party", "string", "CmteID", "string")
 	db.AddTable(t)
 
+	// create candidate rollup table
+	t = dynamo.CreateNewTableObj(rollup, "Party", "string", "CandID", "string")
+	db.AddTable(t)
+
 	// create TopOverall table
 	t = dynamo.CreateNewTableObj(topOverall, "Year", "string", "ID", "string")
 	db.AddTable(t)
@@ -643,6 +804,8 @@ func getRefObj(bucket string) interface{} {
 		refObj = Committee{}
 	case bucket == "cmte_tx_data":
 		refObj = CmteTxData{}
+	case bucket == "cand_rollup":
+		refObj = CandRollup{}
 	case bucket == "cmte_financials":
 		refObj = CmteFinancials{}
 	case bucket == "top_overall":
@@ -655,22 +818,23 @@ func getRefObj(bucket string) interface{} {
 	return refObj
 }
 
-func createRankingsNames(year string) []string {
+// createRankingsNames returns the IDs of the rankings defined
+// in the rankings config file for the given year.
+func createRankingsNames(year string) ([]string, error) {
+	defs, err := persist.LoadRankingDefs()
+	if err != nil {
+		fmt.Println(err)
+		return nil, fmt.Errorf("createRankingsNames failed: %v", err)
+	}
+	// centrality rankings are not created for all_time
 	names := []string{}
-	buckets := []string{"candidates", "cmte_tx_data", "individuals"}
-	cats := []string{"rec", "donor", "exp"}
-	ptys := []string{"ALL", "REP", "DEM", "IND", "OTH", ""}
-
-	for _, b := range buckets {
-		for _, c := range cats {
-			for _, p := range ptys {
-				n := year + "-" + b + "-" + c + "-" + p
-				names = append(names, n)
-			}
+	for _, d := range defs.Rankings {
+		if year == "all_time" && databuilder.IsCentralityCategory(d.Bucket, d.Category) {
+			continue
 		}
+		names = append(names, d.ID(year))
 	}
-
-	return names
+	return names, nil
 }
 
 func createRankingsPreview(full RankingsData) RankingsData {
@@ -1046,6 +1210,75 @@ func wrapObjStruct(obj interface{}) interface{} {
 			TransferRecsTxs:           cmte.TransferRecsTxs,
 			TopExpRecipientsAmt:       cmte.TopExpRecipientsAmt,
 			TopExpRecipientsTxs:       cmte.TopExpRecipientsTxs,
+			ElectionsInAmt:            cmte.ElectionsInAmt,
+			ElectionsInTxs:            cmte.ElectionsInTxs,
+			SizeBinsAmt:               cmte.SizeBinsAmt,
+			SizeBinsTxs:               cmte.SizeBinsTxs,
+			ConduitsInAmt:             cmte.ConduitsInAmt,
+			ConduitsInTxs:             cmte.ConduitsInTxs,
+			EarmarkedInAmt:            cmte.EarmarkedInAmt,
+			EarmarkedInTxs:            cmte.EarmarkedInTxs,
+			EarmarkedOutAmt:           cmte.EarmarkedOutAmt,
+			EarmarkedOutTxs:           cmte.EarmarkedOutTxs,
+			EarmarkRecipientsAmt:      cmte.EarmarkRecipientsAmt,
+			EarmarkRecipientsTxs:      cmte.EarmarkRecipientsTxs,
+			TxKindsAmt:                cmte.TxKindsAmt,
+			TxKindsTxs:                cmte.TxKindsTxs,
+			SizePercentiles:           cmte.SizePercentiles,
+		}
+		wrap = w
+	case CandRollup:
+		r := obj.(CandRollup)
+		w := CandRollup{
+			CandID:                    r.CandID,
+			Party:                     r.Party,
+			Committees:                r.Committees,
+			ContributionsInAmt:        r.ContributionsInAmt,
+			ContributionsInTxs:        r.ContributionsInTxs,
+			AvgContributionIn:         r.AvgContributionIn,
+			OtherReceiptsInAmt:        r.OtherReceiptsInAmt,
+			OtherReceiptsInTxs:        r.OtherReceiptsInTxs,
+			AvgOtherIn:                r.AvgOtherIn,
+			InternalTransfersAmt:      r.InternalTransfersAmt,
+			InternalTransfersTxs:      r.InternalTransfersTxs,
+			TotalIncomingAmt:          r.TotalIncomingAmt,
+			TotalIncomingTxs:          r.TotalIncomingTxs,
+			AvgIncoming:               r.AvgIncoming,
+			TransfersAmt:              r.TransfersAmt,
+			TransfersTxs:              r.TransfersTxs,
+			AvgTransfer:               r.AvgTransfer,
+			ExpendituresAmt:           r.ExpendituresAmt,
+			ExpendituresTxs:           r.ExpendituresTxs,
+			AvgExpenditure:            r.AvgExpenditure,
+			TotalOutgoingAmt:          r.TotalOutgoingAmt,
+			TotalOutgoingTxs:          r.TotalOutgoingTxs,
+			AvgOutgoing:               r.AvgOutgoing,
+			NetBalance:                r.NetBalance,
+			TopIndvContributorsAmt:    r.TopIndvContributorsAmt,
+			TopIndvContributorsTxs:    r.TopIndvContributorsTxs,
+			TopCmteOrgContributorsAmt: r.TopCmteOrgContributorsAmt,
+			TopCmteOrgContributorsTxs: r.TopCmteOrgContributorsTxs,
+			TransferRecsAmt:           r.TransferRecsAmt,
+			TransferRecsTxs:           r.TransferRecsTxs,
+			TopExpRecipientsAmt:       r.TopExpRecipientsAmt,
+			TopExpRecipientsTxs:       r.TopExpRecipientsTxs,
+			ElectionsInAmt:            r.ElectionsInAmt,
+			ElectionsInTxs:            r.ElectionsInTxs,
+			SizeBinsAmt:               r.SizeBinsAmt,
+			SizeBinsTxs:               r.SizeBinsTxs,
+			ConduitsInAmt:             r.ConduitsInAmt,
+			ConduitsInTxs:             r.ConduitsInTxs,
+			CandContsAmt:              r.CandContsAmt,
+			CandLoansAmt:              r.CandLoansAmt,
+			CandLoanRepayAmt:          r.CandLoanRepayAmt,
+			SelfFundedAmt:             r.SelfFundedAmt,
+			SelfFundedShare:           r.SelfFundedShare,
+			CandLoansOutstanding:      r.CandLoansOutstanding,
+			CandLoansByYear:           r.CandLoansByYear,
+			CandRepaysByYear:          r.CandRepaysByYear,
+			TxKindsAmt:                r.TxKindsAmt,
+			TxKindsTxs:                r.TxKindsTxs,
+			SizePercentiles:           r.SizePercentiles,
 		}
 		wrap = w
 	case Candidate:
@@ -1153,6 +1386,74 @@ func wrapObjAv(av map[string]interface{}, obj interface{}) interface{} {
 			TransferRecsTxs:           wrapTotals(av["TransferRecsTxs"]),
 			TopExpRecipientsAmt:       wrapTotals(av["TopExpRecipientsAmt"]),
 			TopExpRecipientsTxs:       wrapTotals(av["TopExpRecipientsTxs"]),
+			ElectionsInAmt:            wrapTotals(av["ElectionsInAmt"]),
+			ElectionsInTxs:            wrapTotals(av["ElectionsInTxs"]),
+			SizeBinsAmt:               wrapTotals(av["SizeBinsAmt"]),
+			SizeBinsTxs:               wrapTotals(av["SizeBinsTxs"]),
+			ConduitsInAmt:             wrapTotals(av["ConduitsInAmt"]),
+			ConduitsInTxs:             wrapTotals(av["ConduitsInTxs"]),
+			EarmarkedInAmt:            wrapFloat(av["EarmarkedInAmt"]),
+			EarmarkedInTxs:            wrapFloat(av["EarmarkedInTxs"]),
+			EarmarkedOutAmt:           wrapFloat(av["EarmarkedOutAmt"]),
+			EarmarkedOutTxs:           wrapFloat(av["EarmarkedOutTxs"]),
+			EarmarkRecipientsAmt:      wrapTotals(av["EarmarkRecipientsAmt"]),
+			EarmarkRecipientsTxs:      wrapTotals(av["EarmarkRecipientsTxs"]),
+			TxKindsAmt:                wrapTotals(av["TxKindsAmt"]),
+			TxKindsTxs:                wrapTotals(av["TxKindsTxs"]),
+			SizePercentiles:           databuilder.SizePercentiles(wrapTotals(av["SizeCounts"])),
+		}
+		wrap = w
+	case CandRollup:
+		w := CandRollup{
+			CandID:                    wrapString(av["CandID"]),
+			Party:                     wrapString(av["Party"]),
+			Committees:                wrapStrings(av["Committees"]),
+			ContributionsInAmt:        wrapFloat(av["ContributionsInAmt"]),
+			ContributionsInTxs:        wrapFloat(av["ContributionsInTxs"]),
+			AvgContributionIn:         wrapFloat(av["AvgContributionIn"]),
+			OtherReceiptsInAmt:        wrapFloat(av["OtherReceiptsInAmt"]),
+			OtherReceiptsInTxs:        wrapFloat(av["OtherReceiptsInTxs"]),
+			AvgOtherIn:                wrapFloat(av["AvgOtherIn"]),
+			InternalTransfersAmt:      wrapFloat(av["InternalTransfersAmt"]),
+			InternalTransfersTxs:      wrapFloat(av["InternalTransfersTxs"]),
+			TotalIncomingAmt:          wrapFloat(av["TotalIncomingAmt"]),
+			TotalIncomingTxs:          wrapFloat(av["TotalIncomingTxs"]),
+			AvgIncoming:               wrapFloat(av["AvgIncoming"]),
+			TransfersAmt:              wrapFloat(av["TransfersAmt"]),
+			TransfersTxs:              wrapFloat(av["TransfersTxs"]),
+			AvgTransfer:               wrapFloat(av["AvgTransfer"]),
+			ExpendituresAmt:           wrapFloat(av["ExpendituresAmt"]),
+			ExpendituresTxs:           wrapFloat(av["ExpendituresTxs"]),
+			AvgExpenditure:            wrapFloat(av["AvgExpenditure"]),
+			TotalOutgoingAmt:          wrapFloat(av["TotalOutgoingAmt"]),
+			TotalOutgoingTxs:          wrapFloat(av["TotalOutgoingTxs"]),
+			AvgOutgoing:               wrapFloat(av["AvgOutgoing"]),
+			NetBalance:                wrapFloat(av["NetBalance"]),
+			TopIndvContributorsAmt:    wrapTotals(av["TopIndvContributorsAmt"]),
+			TopIndvContributorsTxs:    wrapTotals(av["TopIndvContributorsTxs"]),
+			TopCmteOrgContributorsAmt: wrapTotals(av["TopCmteOrgContributorsAmt"]),
+			TopCmteOrgContributorsTxs: wrapTotals(av["TopCmteOrgContributorsTxs"]),
+			TransferRecsAmt:           wrapTotals(av["TransferRecsAmt"]),
+			TransferRecsTxs:           wrapTotals(av["TransferRecsTxs"]),
+			TopExpRecipientsAmt:       wrapTotals(av["TopExpRecipientsAmt"]),
+			TopExpRecipientsTxs:       wrapTotals(av["TopExpRecipientsTxs"]),
+			ElectionsInAmt:            wrapTotals(av["ElectionsInAmt"]),
+			ElectionsInTxs:            wrapTotals(av["ElectionsInTxs"]),
+			SizeBinsAmt:               wrapTotals(av["SizeBinsAmt"]),
+			SizeBinsTxs:               wrapTotals(av["SizeBinsTxs"]),
+			ConduitsInAmt:             wrapTotals(av["ConduitsInAmt"]),
+			ConduitsInTxs:             wrapTotals(av["ConduitsInTxs"]),
+			CandContsAmt:              wrapFloat(av["CandContsAmt"]),
+			CandLoansAmt:              wrapFloat(av["CandLoansAmt"]),
+			CandLoanRepayAmt:          wrapFloat(av["CandLoanRepayAmt"]),
+			SelfFundedAmt:             wrapFloat(av["SelfFundedAmt"]),
+			SelfFundedShare:           wrapFloat(av["SelfFundedShare"]),
+			CandLoansOutstanding:      wrapFloat(av["CandLoansOutstanding"]),
+			CandLoansByYear:           wrapTotals(av["CandLoansByYear"]),
+			CandRepaysByYear:          wrapTotals(av["CandRepaysByYear"]),
+			TxKindsAmt:                wrapTotals(av["TxKindsAmt"]),
+			TxKindsTxs:                wrapTotals(av["TxKindsTxs"]),
+			SizePercentiles:           databuilder.SizePercentiles(wrapTotals(av["SizeCounts"])),
 		}
 		wrap = w
 	case Candidate:
@@ -1194,6 +1495,18 @@ func wrapString(intf interface{}) string {
 	return str
 }
 
+func wrapStrings(intf interface{}) []string {
+	wrap := []string{}
+	if intf == nil {
+		return wrap
+	}
+	l := intf.([]interface{})
+	for _, v := range l {
+		wrap = append(wrap, v.(string))
+	}
+	return wrap
+}
+
 func wrapFloat(intf interface{}) float32 {
 	fl := float32(0.0)
 	if intf == nil {
@@ -489,14 +489,18 @@ func (m *Totals) GetTotal() float32 {
 }
 
 type SearchIndexRequest struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
-	Text                 string               `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UID       string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID  string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Text      string               `protobuf:"bytes,3,opt,name=Text,proto3" json:"Text,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg       string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// # of results per page (default 100, max 500)
+	PageSize int32 `protobuf:"varint,6,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// NextCursor from previous response; empty for first page
+	Cursor               string   `protobuf:"bytes,7,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchIndexRequest) Reset()         { *m = SearchIndexRequest{} }
@@ -559,15 +563,39 @@ func (m *SearchIndexRequest) GetMsg() string {
 	return ""
 }
 
+func (m *SearchIndexRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchIndexRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type SearchIndexResponse struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
-	Results              []*SearchResult      `protobuf:"bytes,3,rep,name=Results,proto3" json:"Results,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UID       string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID  string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Results   []*SearchResult      `protobuf:"bytes,3,rep,name=Results,proto3" json:"Results,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg       string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// results found with misspelled terms replaced by closest index terms
+	Approximate bool `protobuf:"varint,6,opt,name=Approximate,proto3" json:"Approximate,omitempty"`
+	// corrected query text for approximate results
+	Corrected string `protobuf:"bytes,7,opt,name=Corrected,proto3" json:"Corrected,omitempty"`
+	// total # of IDs matching query
+	TotalHits int32 `protobuf:"varint,8,opt,name=TotalHits,proto3" json:"TotalHits,omitempty"`
+	// TotalHits estimated for broad queries
+	Estimated bool `protobuf:"varint,9,opt,name=Estimated,proto3" json:"Estimated,omitempty"`
+	// cursor for next page; empty if last page
+	NextCursor           string   `protobuf:"bytes,10,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchIndexResponse) Reset()         { *m = SearchIndexResponse{} }
@@ -630,6 +658,41 @@ func (m *SearchIndexResponse) GetMsg() string {
 	return ""
 }
 
+func (m *SearchIndexResponse) GetApproximate() bool {
+	if m != nil {
+		return m.Approximate
+	}
+	return false
+}
+
+func (m *SearchIndexResponse) GetCorrected() string {
+	if m != nil {
+		return m.Corrected
+	}
+	return ""
+}
+
+func (m *SearchIndexResponse) GetTotalHits() int32 {
+	if m != nil {
+		return m.TotalHits
+	}
+	return 0
+}
+
+func (m *SearchIndexResponse) GetEstimated() bool {
+	if m != nil {
+		return m.Estimated
+	}
+	return false
+}
+
+func (m *SearchIndexResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type SearchResult struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Bucket               string   `protobuf:"bytes,2,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
@@ -717,6 +780,165 @@ func (m *SearchResult) GetYears() []string {
 	return nil
 }
 
+type AutocompleteRequest struct {
+	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID string `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Prefix   string `protobuf:"bytes,3,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	// max # of results (default 10, max 50)
+	Limit                int32                `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,6,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AutocompleteRequest) Reset()         { *m = AutocompleteRequest{} }
+func (m *AutocompleteRequest) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteRequest) ProtoMessage()    {}
+func (*AutocompleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{12}
+}
+
+func (m *AutocompleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutocompleteRequest.Unmarshal(m, b)
+}
+func (m *AutocompleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutocompleteRequest.Marshal(b, m, deterministic)
+}
+func (m *AutocompleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteRequest.Merge(m, src)
+}
+func (m *AutocompleteRequest) XXX_Size() int {
+	return xxx_messageInfo_AutocompleteRequest.Size(m)
+}
+func (m *AutocompleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteRequest proto.InternalMessageInfo
+
+func (m *AutocompleteRequest) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetServerID() string {
+	if m != nil {
+		return m.ServerID
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AutocompleteRequest) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AutocompleteRequest) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type AutocompleteResponse struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Prefix               string               `protobuf:"bytes,3,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Results              []*SearchResult      `protobuf:"bytes,4,rep,name=Results,proto3" json:"Results,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,6,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AutocompleteResponse) Reset()         { *m = AutocompleteResponse{} }
+func (m *AutocompleteResponse) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteResponse) ProtoMessage()    {}
+func (*AutocompleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{13}
+}
+
+func (m *AutocompleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutocompleteResponse.Unmarshal(m, b)
+}
+func (m *AutocompleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutocompleteResponse.Marshal(b, m, deterministic)
+}
+func (m *AutocompleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteResponse.Merge(m, src)
+}
+func (m *AutocompleteResponse) XXX_Size() int {
+	return xxx_messageInfo_AutocompleteResponse.Size(m)
+}
+func (m *AutocompleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteResponse proto.InternalMessageInfo
+
+func (m *AutocompleteResponse) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *AutocompleteResponse) GetServerID() string {
+	if m != nil {
+		return m.ServerID
+	}
+	return ""
+}
+
+func (m *AutocompleteResponse) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AutocompleteResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *AutocompleteResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AutocompleteResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
 type LookupObjRequest struct {
 	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
 	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
@@ -732,7 +954,7 @@ func (m *LookupObjRequest) Reset()         { *m = LookupObjRequest{} }
 func (m *LookupObjRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupObjRequest) ProtoMessage()    {}
 func (*LookupObjRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{12}
+	return fileDescriptor_f750e0f7889345b5, []int{14}
 }
 
 func (m *LookupObjRequest) XXX_Unmarshal(b []byte) error {
@@ -803,7 +1025,7 @@ func (m *LookupObjResponse) Reset()         { *m = LookupObjResponse{} }
 func (m *LookupObjResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupObjResponse) ProtoMessage()    {}
 func (*LookupObjResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{13}
+	return fileDescriptor_f750e0f7889345b5, []int{15}
 }
 
 func (m *LookupObjResponse) XXX_Unmarshal(b []byte) error {
@@ -860,23 +1082,26 @@ func (m *LookupObjResponse) GetMsg() string {
 }
 
 type LookupIndvRequest struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
-	ObjectID             string               `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
-	Bucket               string               `protobuf:"bytes,4,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
-	Years                []string             `protobuf:"bytes,5,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UID       string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID  string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	ObjectID  string               `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Bucket    string               `protobuf:"bytes,4,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	Years     []string             `protobuf:"bytes,5,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg       string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// optional range of election cycles; overrides Years if set
+	StartYear            string   `protobuf:"bytes,8,opt,name=StartYear,proto3" json:"StartYear,omitempty"`
+	EndYear              string   `protobuf:"bytes,9,opt,name=EndYear,proto3" json:"EndYear,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LookupIndvRequest) Reset()         { *m = LookupIndvRequest{} }
 func (m *LookupIndvRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupIndvRequest) ProtoMessage()    {}
 func (*LookupIndvRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{14}
+	return fileDescriptor_f750e0f7889345b5, []int{16}
 }
 
 func (m *LookupIndvRequest) XXX_Unmarshal(b []byte) error {
@@ -946,6 +1171,20 @@ func (m *LookupIndvRequest) GetMsg() string {
 	return ""
 }
 
+func (m *LookupIndvRequest) GetStartYear() string {
+	if m != nil {
+		return m.StartYear
+	}
+	return ""
+}
+
+func (m *LookupIndvRequest) GetEndYear() string {
+	if m != nil {
+		return m.EndYear
+	}
+	return ""
+}
+
 type LookupIndvResponse struct {
 	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
 	ServerID string `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
@@ -967,7 +1206,7 @@ func (m *LookupIndvResponse) Reset()         { *m = LookupIndvResponse{} }
 func (m *LookupIndvResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupIndvResponse) ProtoMessage()    {}
 func (*LookupIndvResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{15}
+	return fileDescriptor_f750e0f7889345b5, []int{17}
 }
 
 func (m *LookupIndvResponse) XXX_Unmarshal(b []byte) error {
@@ -1045,23 +1284,26 @@ func (m *LookupIndvResponse) GetMsg() string {
 }
 
 type LookupCandRequest struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
-	ObjectID             string               `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
-	Bucket               string               `protobuf:"bytes,4,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
-	Years                []string             `protobuf:"bytes,5,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UID       string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID  string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	ObjectID  string               `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Bucket    string               `protobuf:"bytes,4,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	Years     []string             `protobuf:"bytes,5,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg       string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// optional range of election cycles; overrides Years if set
+	StartYear            string   `protobuf:"bytes,8,opt,name=StartYear,proto3" json:"StartYear,omitempty"`
+	EndYear              string   `protobuf:"bytes,9,opt,name=EndYear,proto3" json:"EndYear,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LookupCandRequest) Reset()         { *m = LookupCandRequest{} }
 func (m *LookupCandRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupCandRequest) ProtoMessage()    {}
 func (*LookupCandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{16}
+	return fileDescriptor_f750e0f7889345b5, []int{18}
 }
 
 func (m *LookupCandRequest) XXX_Unmarshal(b []byte) error {
@@ -1131,6 +1373,20 @@ func (m *LookupCandRequest) GetMsg() string {
 	return ""
 }
 
+func (m *LookupCandRequest) GetStartYear() string {
+	if m != nil {
+		return m.StartYear
+	}
+	return ""
+}
+
+func (m *LookupCandRequest) GetEndYear() string {
+	if m != nil {
+		return m.EndYear
+	}
+	return ""
+}
+
 type LookupCandResponse struct {
 	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
 	ServerID string `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
@@ -1139,21 +1395,23 @@ type LookupCandResponse struct {
 	// datasets for each year in request aggregated
 	// into singular object encoded on server prior
 	// to encoding response
-	Candidate            *Candidate           `protobuf:"bytes,5,opt,name=Candidate,proto3" json:"Candidate,omitempty"`
-	Financials           *CmpnFinancials      `protobuf:"bytes,6,opt,name=Financials,proto3" json:"Financials,omitempty"`
-	Years                []string             `protobuf:"bytes,7,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,9,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Candidate  *Candidate           `protobuf:"bytes,5,opt,name=Candidate,proto3" json:"Candidate,omitempty"`
+	Financials *CmpnFinancials      `protobuf:"bytes,6,opt,name=Financials,proto3" json:"Financials,omitempty"`
+	Years      []string             `protobuf:"bytes,7,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp  *timestamp.Timestamp `protobuf:"bytes,8,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg        string               `protobuf:"bytes,9,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// aggregated datasets for all of candidate's linked committees
+	Rollup               *CandRollup `protobuf:"bytes,10,opt,name=Rollup,proto3" json:"Rollup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *LookupCandResponse) Reset()         { *m = LookupCandResponse{} }
 func (m *LookupCandResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupCandResponse) ProtoMessage()    {}
 func (*LookupCandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{17}
+	return fileDescriptor_f750e0f7889345b5, []int{19}
 }
 
 func (m *LookupCandResponse) XXX_Unmarshal(b []byte) error {
@@ -1237,24 +1495,34 @@ func (m *LookupCandResponse) GetMsg() string {
 	return ""
 }
 
+func (m *LookupCandResponse) GetRollup() *CandRollup {
+	if m != nil {
+		return m.Rollup
+	}
+	return nil
+}
+
 type LookupCmteRequest struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
-	ObjectID             string               `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
-	Bucket               string               `protobuf:"bytes,4,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
-	Years                []string             `protobuf:"bytes,5,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UID       string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID  string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	ObjectID  string               `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Bucket    string               `protobuf:"bytes,4,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	Years     []string             `protobuf:"bytes,5,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg       string               `protobuf:"bytes,7,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// optional range of election cycles; overrides Years if set
+	StartYear            string   `protobuf:"bytes,8,opt,name=StartYear,proto3" json:"StartYear,omitempty"`
+	EndYear              string   `protobuf:"bytes,9,opt,name=EndYear,proto3" json:"EndYear,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LookupCmteRequest) Reset()         { *m = LookupCmteRequest{} }
 func (m *LookupCmteRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupCmteRequest) ProtoMessage()    {}
 func (*LookupCmteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{18}
+	return fileDescriptor_f750e0f7889345b5, []int{20}
 }
 
 func (m *LookupCmteRequest) XXX_Unmarshal(b []byte) error {
@@ -1324,6 +1592,20 @@ func (m *LookupCmteRequest) GetMsg() string {
 	return ""
 }
 
+func (m *LookupCmteRequest) GetStartYear() string {
+	if m != nil {
+		return m.StartYear
+	}
+	return ""
+}
+
+func (m *LookupCmteRequest) GetEndYear() string {
+	if m != nil {
+		return m.EndYear
+	}
+	return ""
+}
+
 type LookupCmteResponse struct {
 	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
 	ServerID string `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
@@ -1347,7 +1629,7 @@ func (m *LookupCmteResponse) Reset()         { *m = LookupCmteResponse{} }
 func (m *LookupCmteResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupCmteResponse) ProtoMessage()    {}
 func (*LookupCmteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{19}
+	return fileDescriptor_f750e0f7889345b5, []int{21}
 }
 
 func (m *LookupCmteResponse) XXX_Unmarshal(b []byte) error {
@@ -1450,7 +1732,7 @@ func (m *TotalsMap) Reset()         { *m = TotalsMap{} }
 func (m *TotalsMap) String() string { return proto.CompactTextString(m) }
 func (*TotalsMap) ProtoMessage()    {}
 func (*TotalsMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{20}
+	return fileDescriptor_f750e0f7889345b5, []int{22}
 }
 
 func (m *TotalsMap) XXX_Unmarshal(b []byte) error {
@@ -1514,7 +1796,7 @@ func (m *Individual) Reset()         { *m = Individual{} }
 func (m *Individual) String() string { return proto.CompactTextString(m) }
 func (*Individual) ProtoMessage()    {}
 func (*Individual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{21}
+	return fileDescriptor_f750e0f7889345b5, []int{23}
 }
 
 func (m *Individual) XXX_Unmarshal(b []byte) error {
@@ -1691,7 +1973,7 @@ func (m *Committee) Reset()         { *m = Committee{} }
 func (m *Committee) String() string { return proto.CompactTextString(m) }
 func (*Committee) ProtoMessage()    {}
 func (*Committee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{22}
+	return fileDescriptor_f750e0f7889345b5, []int{24}
 }
 
 func (m *Committee) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +2118,7 @@ func (m *Candidate) Reset()         { *m = Candidate{} }
 func (m *Candidate) String() string { return proto.CompactTextString(m) }
 func (*Candidate) ProtoMessage()    {}
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{23}
+	return fileDescriptor_f750e0f7889345b5, []int{25}
 }
 
 func (m *Candidate) XXX_Unmarshal(b []byte) error {
@@ -2057,7 +2339,7 @@ func (m *CmpnFinancials) Reset()         { *m = CmpnFinancials{} }
 func (m *CmpnFinancials) String() string { return proto.CompactTextString(m) }
 func (*CmpnFinancials) ProtoMessage()    {}
 func (*CmpnFinancials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{24}
+	return fileDescriptor_f750e0f7889345b5, []int{26}
 }
 
 func (m *CmpnFinancials) XXX_Unmarshal(b []byte) error {
@@ -2313,7 +2595,7 @@ func (m *CmteFinancials) Reset()         { *m = CmteFinancials{} }
 func (m *CmteFinancials) String() string { return proto.CompactTextString(m) }
 func (*CmteFinancials) ProtoMessage()    {}
 func (*CmteFinancials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{25}
+	return fileDescriptor_f750e0f7889345b5, []int{27}
 }
 
 func (m *CmteFinancials) XXX_Unmarshal(b []byte) error {
@@ -2520,6 +2802,21 @@ type CmteTxData struct {
 	TransferRecsTxs           map[string]float32 `protobuf:"bytes,29,rep,name=TransferRecsTxs,proto3" json:"TransferRecsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	TopExpRecipientsAmt       []*TotalsMap       `protobuf:"bytes,30,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty"`
 	TopExpRecipientsTxs       map[string]float32 `protobuf:"bytes,31,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInAmt            map[string]float32 `protobuf:"bytes,32,rep,name=ElectionsInAmt,proto3" json:"ElectionsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInTxs            map[string]float32 `protobuf:"bytes,33,rep,name=ElectionsInTxs,proto3" json:"ElectionsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsAmt               map[string]float32 `protobuf:"bytes,34,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs               map[string]float32 `protobuf:"bytes,35,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizePercentiles           map[string]float32 `protobuf:"bytes,36,rep,name=SizePercentiles,proto3" json:"SizePercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInAmt             map[string]float32 `protobuf:"bytes,37,rep,name=ConduitsInAmt,proto3" json:"ConduitsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInTxs             map[string]float32 `protobuf:"bytes,38,rep,name=ConduitsInTxs,proto3" json:"ConduitsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkedInAmt            float32            `protobuf:"fixed32,39,opt,name=EarmarkedInAmt,proto3" json:"EarmarkedInAmt,omitempty"`
+	EarmarkedInTxs            float32            `protobuf:"fixed32,40,opt,name=EarmarkedInTxs,proto3" json:"EarmarkedInTxs,omitempty"`
+	EarmarkedOutAmt           float32            `protobuf:"fixed32,41,opt,name=EarmarkedOutAmt,proto3" json:"EarmarkedOutAmt,omitempty"`
+	EarmarkedOutTxs           float32            `protobuf:"fixed32,42,opt,name=EarmarkedOutTxs,proto3" json:"EarmarkedOutTxs,omitempty"`
+	EarmarkRecipientsAmt      map[string]float32 `protobuf:"bytes,43,rep,name=EarmarkRecipientsAmt,proto3" json:"EarmarkRecipientsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkRecipientsTxs      map[string]float32 `protobuf:"bytes,44,rep,name=EarmarkRecipientsTxs,proto3" json:"EarmarkRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsAmt                map[string]float32 `protobuf:"bytes,45,rep,name=TxKindsAmt,proto3" json:"TxKindsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsTxs                map[string]float32 `protobuf:"bytes,46,rep,name=TxKindsTxs,proto3" json:"TxKindsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral      struct{}           `json:"-"`
 	XXX_unrecognized          []byte             `json:"-"`
 	XXX_sizecache             int32              `json:"-"`
@@ -2529,7 +2826,7 @@ func (m *CmteTxData) Reset()         { *m = CmteTxData{} }
 func (m *CmteTxData) String() string { return proto.CompactTextString(m) }
 func (*CmteTxData) ProtoMessage()    {}
 func (*CmteTxData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f750e0f7889345b5, []int{26}
+	return fileDescriptor_f750e0f7889345b5, []int{28}
 }
 
 func (m *CmteTxData) XXX_Unmarshal(b []byte) error {
@@ -2767,15 +3064,1301 @@ func (m *CmteTxData) GetTopExpRecipientsTxs() map[string]float32 {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Empty)(nil), "index.Empty")
-	proto.RegisterType((*GetCachesRequest)(nil), "index.GetCachesRequest")
-	proto.RegisterType((*GetCachesResponse)(nil), "index.GetCachesResponse")
-	proto.RegisterType((*RankingsCache)(nil), "index.RankingsCache")
-	proto.RegisterMapType((map[string]*RankingsMap)(nil), "index.RankingsCache.CacheEntry")
-	proto.RegisterType((*RankingsMap)(nil), "index.RankingsMap")
-	proto.RegisterMapType((map[string]*Rankings)(nil), "index.RankingsMap.EntryEntry")
-	proto.RegisterType((*TotalsCache)(nil), "index.TotalsCache")
+func (m *CmteTxData) GetElectionsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetElectionsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetSizeBinsAmt() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetSizeBinsTxs() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetSizePercentiles() map[string]float32 {
+	if m != nil {
+		return m.SizePercentiles
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetConduitsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetConduitsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetEarmarkedInAmt() float32 {
+	if m != nil {
+		return m.EarmarkedInAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkedInTxs() float32 {
+	if m != nil {
+		return m.EarmarkedInTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkedOutAmt() float32 {
+	if m != nil {
+		return m.EarmarkedOutAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkedOutTxs() float32 {
+	if m != nil {
+		return m.EarmarkedOutTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetEarmarkRecipientsAmt() map[string]float32 {
+	if m != nil {
+		return m.EarmarkRecipientsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetEarmarkRecipientsTxs() map[string]float32 {
+	if m != nil {
+		return m.EarmarkRecipientsTxs
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetTxKindsAmt() map[string]float32 {
+	if m != nil {
+		return m.TxKindsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetTxKindsTxs() map[string]float32 {
+	if m != nil {
+		return m.TxKindsTxs
+	}
+	return nil
+}
+
+type CandRollup struct {
+	CandID                    string             `protobuf:"bytes,1,opt,name=CandID,proto3" json:"CandID,omitempty"`
+	Party                     string             `protobuf:"bytes,2,opt,name=Party,proto3" json:"Party,omitempty"`
+	Committees                []string           `protobuf:"bytes,3,rep,name=Committees,proto3" json:"Committees,omitempty"`
+	ContributionsInAmt        float32            `protobuf:"fixed32,4,opt,name=ContributionsInAmt,proto3" json:"ContributionsInAmt,omitempty"`
+	ContributionsInTxs        float32            `protobuf:"fixed32,5,opt,name=ContributionsInTxs,proto3" json:"ContributionsInTxs,omitempty"`
+	AvgContributionIn         float32            `protobuf:"fixed32,6,opt,name=AvgContributionIn,proto3" json:"AvgContributionIn,omitempty"`
+	OtherReceiptsInAmt        float32            `protobuf:"fixed32,7,opt,name=OtherReceiptsInAmt,proto3" json:"OtherReceiptsInAmt,omitempty"`
+	OtherReceiptsInTxs        float32            `protobuf:"fixed32,8,opt,name=OtherReceiptsInTxs,proto3" json:"OtherReceiptsInTxs,omitempty"`
+	AvgOtherIn                float32            `protobuf:"fixed32,9,opt,name=AvgOtherIn,proto3" json:"AvgOtherIn,omitempty"`
+	InternalTransfersAmt      float32            `protobuf:"fixed32,10,opt,name=InternalTransfersAmt,proto3" json:"InternalTransfersAmt,omitempty"`
+	InternalTransfersTxs      float32            `protobuf:"fixed32,11,opt,name=InternalTransfersTxs,proto3" json:"InternalTransfersTxs,omitempty"`
+	TotalIncomingAmt          float32            `protobuf:"fixed32,12,opt,name=TotalIncomingAmt,proto3" json:"TotalIncomingAmt,omitempty"`
+	TotalIncomingTxs          float32            `protobuf:"fixed32,13,opt,name=TotalIncomingTxs,proto3" json:"TotalIncomingTxs,omitempty"`
+	AvgIncoming               float32            `protobuf:"fixed32,14,opt,name=AvgIncoming,proto3" json:"AvgIncoming,omitempty"`
+	TransfersAmt              float32            `protobuf:"fixed32,15,opt,name=TransfersAmt,proto3" json:"TransfersAmt,omitempty"`
+	TransfersTxs              float32            `protobuf:"fixed32,16,opt,name=TransfersTxs,proto3" json:"TransfersTxs,omitempty"`
+	AvgTransfer               float32            `protobuf:"fixed32,17,opt,name=AvgTransfer,proto3" json:"AvgTransfer,omitempty"`
+	ExpendituresAmt           float32            `protobuf:"fixed32,18,opt,name=ExpendituresAmt,proto3" json:"ExpendituresAmt,omitempty"`
+	ExpendituresTxs           float32            `protobuf:"fixed32,19,opt,name=ExpendituresTxs,proto3" json:"ExpendituresTxs,omitempty"`
+	AvgExpenditure            float32            `protobuf:"fixed32,20,opt,name=AvgExpenditure,proto3" json:"AvgExpenditure,omitempty"`
+	TotalOutgoingAmt          float32            `protobuf:"fixed32,21,opt,name=TotalOutgoingAmt,proto3" json:"TotalOutgoingAmt,omitempty"`
+	TotalOutgoingTxs          float32            `protobuf:"fixed32,22,opt,name=TotalOutgoingTxs,proto3" json:"TotalOutgoingTxs,omitempty"`
+	AvgOutgoing               float32            `protobuf:"fixed32,23,opt,name=AvgOutgoing,proto3" json:"AvgOutgoing,omitempty"`
+	NetBalance                float32            `protobuf:"fixed32,24,opt,name=NetBalance,proto3" json:"NetBalance,omitempty"`
+	TopIndvContributorsAmt    []*TotalsMap       `protobuf:"bytes,25,rep,name=TopIndvContributorsAmt,proto3" json:"TopIndvContributorsAmt,omitempty"`
+	TopIndvContributorsTxs    map[string]float32 `protobuf:"bytes,26,rep,name=TopIndvContributorsTxs,proto3" json:"TopIndvContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCmteOrgContributorsAmt []*TotalsMap       `protobuf:"bytes,27,rep,name=TopCmteOrgContributorsAmt,proto3" json:"TopCmteOrgContributorsAmt,omitempty"`
+	TopCmteOrgContributorsTxs map[string]float32 `protobuf:"bytes,28,rep,name=TopCmteOrgContributorsTxs,proto3" json:"TopCmteOrgContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TransferRecsAmt           []*TotalsMap       `protobuf:"bytes,29,rep,name=TransferRecsAmt,proto3" json:"TransferRecsAmt,omitempty"`
+	TransferRecsTxs           map[string]float32 `protobuf:"bytes,30,rep,name=TransferRecsTxs,proto3" json:"TransferRecsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopExpRecipientsAmt       []*TotalsMap       `protobuf:"bytes,31,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty"`
+	TopExpRecipientsTxs       map[string]float32 `protobuf:"bytes,32,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInAmt            map[string]float32 `protobuf:"bytes,33,rep,name=ElectionsInAmt,proto3" json:"ElectionsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInTxs            map[string]float32 `protobuf:"bytes,34,rep,name=ElectionsInTxs,proto3" json:"ElectionsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsAmt               map[string]float32 `protobuf:"bytes,35,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs               map[string]float32 `protobuf:"bytes,36,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizePercentiles           map[string]float32 `protobuf:"bytes,37,rep,name=SizePercentiles,proto3" json:"SizePercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInAmt             map[string]float32 `protobuf:"bytes,38,rep,name=ConduitsInAmt,proto3" json:"ConduitsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInTxs             map[string]float32 `protobuf:"bytes,39,rep,name=ConduitsInTxs,proto3" json:"ConduitsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CandContsAmt              float32            `protobuf:"fixed32,40,opt,name=CandContsAmt,proto3" json:"CandContsAmt,omitempty"`
+	CandLoansAmt              float32            `protobuf:"fixed32,41,opt,name=CandLoansAmt,proto3" json:"CandLoansAmt,omitempty"`
+	CandLoanRepayAmt          float32            `protobuf:"fixed32,42,opt,name=CandLoanRepayAmt,proto3" json:"CandLoanRepayAmt,omitempty"`
+	SelfFundedAmt             float32            `protobuf:"fixed32,43,opt,name=SelfFundedAmt,proto3" json:"SelfFundedAmt,omitempty"`
+	SelfFundedShare           float32            `protobuf:"fixed32,44,opt,name=SelfFundedShare,proto3" json:"SelfFundedShare,omitempty"`
+	CandLoansOutstanding      float32            `protobuf:"fixed32,45,opt,name=CandLoansOutstanding,proto3" json:"CandLoansOutstanding,omitempty"`
+	CandLoansByYear           map[string]float32 `protobuf:"bytes,46,rep,name=CandLoansByYear,proto3" json:"CandLoansByYear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CandRepaysByYear          map[string]float32 `protobuf:"bytes,47,rep,name=CandRepaysByYear,proto3" json:"CandRepaysByYear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsAmt                map[string]float32 `protobuf:"bytes,48,rep,name=TxKindsAmt,proto3" json:"TxKindsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsTxs                map[string]float32 `protobuf:"bytes,49,rep,name=TxKindsTxs,proto3" json:"TxKindsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral      struct{}           `json:"-"`
+	XXX_unrecognized          []byte             `json:"-"`
+	XXX_sizecache             int32              `json:"-"`
+}
+
+func (m *CandRollup) Reset()         { *m = CandRollup{} }
+func (m *CandRollup) String() string { return proto.CompactTextString(m) }
+func (*CandRollup) ProtoMessage()    {}
+func (*CandRollup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{29}
+}
+
+func (m *CandRollup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandRollup.Unmarshal(m, b)
+}
+func (m *CandRollup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandRollup.Marshal(b, m, deterministic)
+}
+func (m *CandRollup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandRollup.Merge(m, src)
+}
+func (m *CandRollup) XXX_Size() int {
+	return xxx_messageInfo_CandRollup.Size(m)
+}
+func (m *CandRollup) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandRollup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandRollup proto.InternalMessageInfo
+
+func (m *CandRollup) GetCandID() string {
+	if m != nil {
+		return m.CandID
+	}
+	return ""
+}
+
+func (m *CandRollup) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *CandRollup) GetCommittees() []string {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+func (m *CandRollup) GetContributionsInAmt() float32 {
+	if m != nil {
+		return m.ContributionsInAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetContributionsInTxs() float32 {
+	if m != nil {
+		return m.ContributionsInTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgContributionIn() float32 {
+	if m != nil {
+		return m.AvgContributionIn
+	}
+	return 0
+}
+
+func (m *CandRollup) GetOtherReceiptsInAmt() float32 {
+	if m != nil {
+		return m.OtherReceiptsInAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetOtherReceiptsInTxs() float32 {
+	if m != nil {
+		return m.OtherReceiptsInTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgOtherIn() float32 {
+	if m != nil {
+		return m.AvgOtherIn
+	}
+	return 0
+}
+
+func (m *CandRollup) GetInternalTransfersAmt() float32 {
+	if m != nil {
+		return m.InternalTransfersAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetInternalTransfersTxs() float32 {
+	if m != nil {
+		return m.InternalTransfersTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalIncomingAmt() float32 {
+	if m != nil {
+		return m.TotalIncomingAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalIncomingTxs() float32 {
+	if m != nil {
+		return m.TotalIncomingTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgIncoming() float32 {
+	if m != nil {
+		return m.AvgIncoming
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTransfersAmt() float32 {
+	if m != nil {
+		return m.TransfersAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTransfersTxs() float32 {
+	if m != nil {
+		return m.TransfersTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgTransfer() float32 {
+	if m != nil {
+		return m.AvgTransfer
+	}
+	return 0
+}
+
+func (m *CandRollup) GetExpendituresAmt() float32 {
+	if m != nil {
+		return m.ExpendituresAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetExpendituresTxs() float32 {
+	if m != nil {
+		return m.ExpendituresTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgExpenditure() float32 {
+	if m != nil {
+		return m.AvgExpenditure
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalOutgoingAmt() float32 {
+	if m != nil {
+		return m.TotalOutgoingAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTotalOutgoingTxs() float32 {
+	if m != nil {
+		return m.TotalOutgoingTxs
+	}
+	return 0
+}
+
+func (m *CandRollup) GetAvgOutgoing() float32 {
+	if m != nil {
+		return m.AvgOutgoing
+	}
+	return 0
+}
+
+func (m *CandRollup) GetNetBalance() float32 {
+	if m != nil {
+		return m.NetBalance
+	}
+	return 0
+}
+
+func (m *CandRollup) GetTopIndvContributorsAmt() []*TotalsMap {
+	if m != nil {
+		return m.TopIndvContributorsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopIndvContributorsTxs() map[string]float32 {
+	if m != nil {
+		return m.TopIndvContributorsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopCmteOrgContributorsAmt() []*TotalsMap {
+	if m != nil {
+		return m.TopCmteOrgContributorsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopCmteOrgContributorsTxs() map[string]float32 {
+	if m != nil {
+		return m.TopCmteOrgContributorsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTransferRecsAmt() []*TotalsMap {
+	if m != nil {
+		return m.TransferRecsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTransferRecsTxs() map[string]float32 {
+	if m != nil {
+		return m.TransferRecsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopExpRecipientsAmt() []*TotalsMap {
+	if m != nil {
+		return m.TopExpRecipientsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTopExpRecipientsTxs() map[string]float32 {
+	if m != nil {
+		return m.TopExpRecipientsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetElectionsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetElectionsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ElectionsInTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetSizeBinsAmt() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetSizeBinsTxs() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetSizePercentiles() map[string]float32 {
+	if m != nil {
+		return m.SizePercentiles
+	}
+	return nil
+}
+
+func (m *CandRollup) GetConduitsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetConduitsInTxs() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInTxs
+	}
+	return nil
+}
+
+func (m *CandRollup) GetCandContsAmt() float32 {
+	if m != nil {
+		return m.CandContsAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansAmt() float32 {
+	if m != nil {
+		return m.CandLoansAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoanRepayAmt() float32 {
+	if m != nil {
+		return m.CandLoanRepayAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetSelfFundedAmt() float32 {
+	if m != nil {
+		return m.SelfFundedAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetSelfFundedShare() float32 {
+	if m != nil {
+		return m.SelfFundedShare
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansOutstanding() float32 {
+	if m != nil {
+		return m.CandLoansOutstanding
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansByYear() map[string]float32 {
+	if m != nil {
+		return m.CandLoansByYear
+	}
+	return nil
+}
+
+func (m *CandRollup) GetCandRepaysByYear() map[string]float32 {
+	if m != nil {
+		return m.CandRepaysByYear
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTxKindsAmt() map[string]float32 {
+	if m != nil {
+		return m.TxKindsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTxKindsTxs() map[string]float32 {
+	if m != nil {
+		return m.TxKindsTxs
+	}
+	return nil
+}
+
+type FindPathsRequest struct {
+	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID string `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Year     string `protobuf:"bytes,3,opt,name=Year,proto3" json:"Year,omitempty"`
+	SourceID string `protobuf:"bytes,4,opt,name=SourceID,proto3" json:"SourceID,omitempty"`
+	TargetID string `protobuf:"bytes,5,opt,name=TargetID,proto3" json:"TargetID,omitempty"`
+	K        int32  `protobuf:"varint,6,opt,name=K,proto3" json:"K,omitempty"`
+	MaxHops  int32  `protobuf:"varint,7,opt,name=MaxHops,proto3" json:"MaxHops,omitempty"`
+	// "bottleneck" or "share"
+	RankBy               string               `protobuf:"bytes,8,opt,name=RankBy,proto3" json:"RankBy,omitempty"`
+	MinAmount            float32              `protobuf:"fixed32,9,opt,name=MinAmount,proto3" json:"MinAmount,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,11,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FindPathsRequest) Reset()         { *m = FindPathsRequest{} }
+func (m *FindPathsRequest) String() string { return proto.CompactTextString(m) }
+func (*FindPathsRequest) ProtoMessage()    {}
+func (*FindPathsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{30}
+}
+
+func (m *FindPathsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPathsRequest.Unmarshal(m, b)
+}
+func (m *FindPathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPathsRequest.Marshal(b, m, deterministic)
+}
+func (m *FindPathsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPathsRequest.Merge(m, src)
+}
+func (m *FindPathsRequest) XXX_Size() int {
+	return xxx_messageInfo_FindPathsRequest.Size(m)
+}
+func (m *FindPathsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPathsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindPathsRequest proto.InternalMessageInfo
+
+func (m *FindPathsRequest) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *FindPathsRequest) GetServerID() string {
+	if m != nil {
+		return m.ServerID
+	}
+	return ""
+}
+
+func (m *FindPathsRequest) GetYear() string {
+	if m != nil {
+		return m.Year
+	}
+	return ""
+}
+
+func (m *FindPathsRequest) GetSourceID() string {
+	if m != nil {
+		return m.SourceID
+	}
+	return ""
+}
+
+func (m *FindPathsRequest) GetTargetID() string {
+	if m != nil {
+		return m.TargetID
+	}
+	return ""
+}
+
+func (m *FindPathsRequest) GetK() int32 {
+	if m != nil {
+		return m.K
+	}
+	return 0
+}
+
+func (m *FindPathsRequest) GetMaxHops() int32 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *FindPathsRequest) GetRankBy() string {
+	if m != nil {
+		return m.RankBy
+	}
+	return ""
+}
+
+func (m *FindPathsRequest) GetMinAmount() float32 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *FindPathsRequest) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *FindPathsRequest) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type FindPathsResponse struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID             string               `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	Paths                []*FlowPath          `protobuf:"bytes,3,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FindPathsResponse) Reset()         { *m = FindPathsResponse{} }
+func (m *FindPathsResponse) String() string { return proto.CompactTextString(m) }
+func (*FindPathsResponse) ProtoMessage()    {}
+func (*FindPathsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{31}
+}
+
+func (m *FindPathsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindPathsResponse.Unmarshal(m, b)
+}
+func (m *FindPathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindPathsResponse.Marshal(b, m, deterministic)
+}
+func (m *FindPathsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindPathsResponse.Merge(m, src)
+}
+func (m *FindPathsResponse) XXX_Size() int {
+	return xxx_messageInfo_FindPathsResponse.Size(m)
+}
+func (m *FindPathsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindPathsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindPathsResponse proto.InternalMessageInfo
+
+func (m *FindPathsResponse) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *FindPathsResponse) GetServerID() string {
+	if m != nil {
+		return m.ServerID
+	}
+	return ""
+}
+
+func (m *FindPathsResponse) GetPaths() []*FlowPath {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *FindPathsResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *FindPathsResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type FlowPath struct {
+	Hops                 []*FlowHop `protobuf:"bytes,1,rep,name=Hops,proto3" json:"Hops,omitempty"`
+	Bottleneck           float32    `protobuf:"fixed32,2,opt,name=Bottleneck,proto3" json:"Bottleneck,omitempty"`
+	Attributed           float32    `protobuf:"fixed32,3,opt,name=Attributed,proto3" json:"Attributed,omitempty"`
+	Share                float32    `protobuf:"fixed32,4,opt,name=Share,proto3" json:"Share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *FlowPath) Reset()         { *m = FlowPath{} }
+func (m *FlowPath) String() string { return proto.CompactTextString(m) }
+func (*FlowPath) ProtoMessage()    {}
+func (*FlowPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{32}
+}
+
+func (m *FlowPath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlowPath.Unmarshal(m, b)
+}
+func (m *FlowPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlowPath.Marshal(b, m, deterministic)
+}
+func (m *FlowPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowPath.Merge(m, src)
+}
+func (m *FlowPath) XXX_Size() int {
+	return xxx_messageInfo_FlowPath.Size(m)
+}
+func (m *FlowPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowPath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowPath proto.InternalMessageInfo
+
+func (m *FlowPath) GetHops() []*FlowHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *FlowPath) GetBottleneck() float32 {
+	if m != nil {
+		return m.Bottleneck
+	}
+	return 0
+}
+
+func (m *FlowPath) GetAttributed() float32 {
+	if m != nil {
+		return m.Attributed
+	}
+	return 0
+}
+
+func (m *FlowPath) GetShare() float32 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+type FlowHop struct {
+	From                 string   `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	FromName             string   `protobuf:"bytes,2,opt,name=FromName,proto3" json:"FromName,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	ToName               string   `protobuf:"bytes,4,opt,name=ToName,proto3" json:"ToName,omitempty"`
+	Type                 string   `protobuf:"bytes,5,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount               float32  `protobuf:"fixed32,6,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Txs                  float32  `protobuf:"fixed32,7,opt,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FlowHop) Reset()         { *m = FlowHop{} }
+func (m *FlowHop) String() string { return proto.CompactTextString(m) }
+func (*FlowHop) ProtoMessage()    {}
+func (*FlowHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{33}
+}
+
+func (m *FlowHop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlowHop.Unmarshal(m, b)
+}
+func (m *FlowHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlowHop.Marshal(b, m, deterministic)
+}
+func (m *FlowHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowHop.Merge(m, src)
+}
+func (m *FlowHop) XXX_Size() int {
+	return xxx_messageInfo_FlowHop.Size(m)
+}
+func (m *FlowHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowHop proto.InternalMessageInfo
+
+func (m *FlowHop) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *FlowHop) GetFromName() string {
+	if m != nil {
+		return m.FromName
+	}
+	return ""
+}
+
+func (m *FlowHop) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *FlowHop) GetToName() string {
+	if m != nil {
+		return m.ToName
+	}
+	return ""
+}
+
+func (m *FlowHop) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FlowHop) GetAmount() float32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FlowHop) GetTxs() float32 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+type GetNetworkRequest struct {
+	UID       string   `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID  string   `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	ObjectID  string   `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Years     []string `protobuf:"bytes,4,rep,name=Years,proto3" json:"Years,omitempty"`
+	Depth     int32    `protobuf:"varint,5,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	MinAmount float32  `protobuf:"fixed32,6,opt,name=MinAmount,proto3" json:"MinAmount,omitempty"`
+	// max # of nodes in graph (default 500, max 2000)
+	MaxNodes int32 `protobuf:"varint,7,opt,name=MaxNodes,proto3" json:"MaxNodes,omitempty"`
+	// # of nodes per page (default 100, max 500)
+	PageSize int32 `protobuf:"varint,8,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// NextPageToken from previous response; empty for first page
+	PageToken            string               `protobuf:"bytes,9,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,11,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetNetworkRequest) Reset()         { *m = GetNetworkRequest{} }
+func (m *GetNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkRequest) ProtoMessage()    {}
+func (*GetNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{34}
+}
+
+func (m *GetNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkRequest.Unmarshal(m, b)
+}
+func (m *GetNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkRequest.Merge(m, src)
+}
+func (m *GetNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkRequest.Size(m)
+}
+func (m *GetNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNetworkRequest proto.InternalMessageInfo
+
+func (m *GetNetworkRequest) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *GetNetworkRequest) GetServerID() string {
+	if m != nil {
+		return m.ServerID
+	}
+	return ""
+}
+
+func (m *GetNetworkRequest) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
+	}
+	return ""
+}
+
+func (m *GetNetworkRequest) GetYears() []string {
+	if m != nil {
+		return m.Years
+	}
+	return nil
+}
+
+func (m *GetNetworkRequest) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *GetNetworkRequest) GetMinAmount() float32 {
+	if m != nil {
+		return m.MinAmount
+	}
+	return 0
+}
+
+func (m *GetNetworkRequest) GetMaxNodes() int32 {
+	if m != nil {
+		return m.MaxNodes
+	}
+	return 0
+}
+
+func (m *GetNetworkRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GetNetworkRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *GetNetworkRequest) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *GetNetworkRequest) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type GetNetworkResponse struct {
+	UID        string         `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ServerID   string         `protobuf:"bytes,2,opt,name=ServerID,proto3" json:"ServerID,omitempty"`
+	ObjectID   string         `protobuf:"bytes,3,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Years      []string       `protobuf:"bytes,4,rep,name=Years,proto3" json:"Years,omitempty"`
+	Nodes      []*NetworkNode `protobuf:"bytes,5,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Edges      []*NetworkEdge `protobuf:"bytes,6,rep,name=Edges,proto3" json:"Edges,omitempty"`
+	TotalNodes int32          `protobuf:"varint,7,opt,name=TotalNodes,proto3" json:"TotalNodes,omitempty"`
+	TotalEdges int32          `protobuf:"varint,8,opt,name=TotalEdges,proto3" json:"TotalEdges,omitempty"`
+	// graph clipped to MaxNodes
+	Truncated            bool                 `protobuf:"varint,9,opt,name=Truncated,proto3" json:"Truncated,omitempty"`
+	NextPageToken        string               `protobuf:"bytes,10,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,11,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,12,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetNetworkResponse) Reset()         { *m = GetNetworkResponse{} }
+func (m *GetNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkResponse) ProtoMessage()    {}
+func (*GetNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{35}
+}
+
+func (m *GetNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkResponse.Unmarshal(m, b)
+}
+func (m *GetNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkResponse.Merge(m, src)
+}
+func (m *GetNetworkResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkResponse.Size(m)
+}
+func (m *GetNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNetworkResponse proto.InternalMessageInfo
+
+func (m *GetNetworkResponse) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *GetNetworkResponse) GetServerID() string {
+	if m != nil {
+		return m.ServerID
+	}
+	return ""
+}
+
+func (m *GetNetworkResponse) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
+	}
+	return ""
+}
+
+func (m *GetNetworkResponse) GetYears() []string {
+	if m != nil {
+		return m.Years
+	}
+	return nil
+}
+
+func (m *GetNetworkResponse) GetNodes() []*NetworkNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *GetNetworkResponse) GetEdges() []*NetworkEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+func (m *GetNetworkResponse) GetTotalNodes() int32 {
+	if m != nil {
+		return m.TotalNodes
+	}
+	return 0
+}
+
+func (m *GetNetworkResponse) GetTotalEdges() int32 {
+	if m != nil {
+		return m.TotalEdges
+	}
+	return 0
+}
+
+func (m *GetNetworkResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *GetNetworkResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *GetNetworkResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *GetNetworkResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type NetworkNode struct {
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type        string  `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Party       string  `protobuf:"bytes,4,opt,name=Party,proto3" json:"Party,omitempty"`
+	State       string  `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
+	TotalInAmt  float32 `protobuf:"fixed32,6,opt,name=TotalInAmt,proto3" json:"TotalInAmt,omitempty"`
+	TotalOutAmt float32 `protobuf:"fixed32,7,opt,name=TotalOutAmt,proto3" json:"TotalOutAmt,omitempty"`
+	// # of hops from root node
+	Depth                int32    `protobuf:"varint,8,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkNode) Reset()         { *m = NetworkNode{} }
+func (m *NetworkNode) String() string { return proto.CompactTextString(m) }
+func (*NetworkNode) ProtoMessage()    {}
+func (*NetworkNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{36}
+}
+
+func (m *NetworkNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkNode.Unmarshal(m, b)
+}
+func (m *NetworkNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkNode.Marshal(b, m, deterministic)
+}
+func (m *NetworkNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkNode.Merge(m, src)
+}
+func (m *NetworkNode) XXX_Size() int {
+	return xxx_messageInfo_NetworkNode.Size(m)
+}
+func (m *NetworkNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkNode proto.InternalMessageInfo
+
+func (m *NetworkNode) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *NetworkNode) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NetworkNode) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *NetworkNode) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *NetworkNode) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *NetworkNode) GetTotalInAmt() float32 {
+	if m != nil {
+		return m.TotalInAmt
+	}
+	return 0
+}
+
+func (m *NetworkNode) GetTotalOutAmt() float32 {
+	if m != nil {
+		return m.TotalOutAmt
+	}
+	return 0
+}
+
+func (m *NetworkNode) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+type NetworkEdge struct {
+	From                 string   `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount               float32  `protobuf:"fixed32,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Txs                  float32  `protobuf:"fixed32,5,opt,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkEdge) Reset()         { *m = NetworkEdge{} }
+func (m *NetworkEdge) String() string { return proto.CompactTextString(m) }
+func (*NetworkEdge) ProtoMessage()    {}
+func (*NetworkEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f750e0f7889345b5, []int{37}
+}
+
+func (m *NetworkEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkEdge.Unmarshal(m, b)
+}
+func (m *NetworkEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkEdge.Marshal(b, m, deterministic)
+}
+func (m *NetworkEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkEdge.Merge(m, src)
+}
+func (m *NetworkEdge) XXX_Size() int {
+	return xxx_messageInfo_NetworkEdge.Size(m)
+}
+func (m *NetworkEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkEdge proto.InternalMessageInfo
+
+func (m *NetworkEdge) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *NetworkEdge) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *NetworkEdge) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *NetworkEdge) GetAmount() float32 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *NetworkEdge) GetTxs() float32 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "index.Empty")
+	proto.RegisterType((*GetCachesRequest)(nil), "index.GetCachesRequest")
+	proto.RegisterType((*GetCachesResponse)(nil), "index.GetCachesResponse")
+	proto.RegisterType((*RankingsCache)(nil), "index.RankingsCache")
+	proto.RegisterMapType((map[string]*RankingsMap)(nil), "index.RankingsCache.CacheEntry")
+	proto.RegisterType((*RankingsMap)(nil), "index.RankingsMap")
+	proto.RegisterMapType((map[string]*Rankings)(nil), "index.RankingsMap.EntryEntry")
+	proto.RegisterType((*TotalsCache)(nil), "index.TotalsCache")
 	proto.RegisterMapType((map[string]*YrTotalsMap)(nil), "index.TotalsCache.CacheEntry")
 	proto.RegisterType((*YrTotalsMap)(nil), "index.YrTotalsMap")
 	proto.RegisterMapType((map[string]*Totals)(nil), "index.YrTotalsMap.TotalsEntry")
@@ -2785,6 +4368,8 @@ func init() {
 	proto.RegisterType((*SearchIndexRequest)(nil), "index.SearchIndexRequest")
 	proto.RegisterType((*SearchIndexResponse)(nil), "index.SearchIndexResponse")
 	proto.RegisterType((*SearchResult)(nil), "index.SearchResult")
+	proto.RegisterType((*AutocompleteRequest)(nil), "index.AutocompleteRequest")
+	proto.RegisterType((*AutocompleteResponse)(nil), "index.AutocompleteResponse")
 	proto.RegisterType((*LookupObjRequest)(nil), "index.LookupObjRequest")
 	proto.RegisterType((*LookupObjResponse)(nil), "index.LookupObjResponse")
 	proto.RegisterType((*LookupIndvRequest)(nil), "index.LookupIndvRequest")
@@ -2804,181 +4389,304 @@ func init() {
 	proto.RegisterType((*CmpnFinancials)(nil), "index.CmpnFinancials")
 	proto.RegisterType((*CmteFinancials)(nil), "index.CmteFinancials")
 	proto.RegisterType((*CmteTxData)(nil), "index.CmteTxData")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.ConduitsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.ConduitsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.EarmarkRecipientsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.EarmarkRecipientsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.ElectionsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.ElectionsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.SizePercentilesEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.TopCmteOrgContributorsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.TopExpRecipientsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.TopIndvContributorsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.TransferRecsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.TxKindsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CmteTxData.TxKindsTxsEntry")
+	proto.RegisterType((*CandRollup)(nil), "index.CandRollup")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.CandLoansByYearEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.CandRepaysByYearEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.ConduitsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.ConduitsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.ElectionsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.ElectionsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.SizePercentilesEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.TopCmteOrgContributorsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.TopExpRecipientsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.TopIndvContributorsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.TransferRecsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.TxKindsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "index.CandRollup.TxKindsTxsEntry")
+	proto.RegisterType((*FindPathsRequest)(nil), "index.FindPathsRequest")
+	proto.RegisterType((*FindPathsResponse)(nil), "index.FindPathsResponse")
+	proto.RegisterType((*FlowPath)(nil), "index.FlowPath")
+	proto.RegisterType((*FlowHop)(nil), "index.FlowHop")
+	proto.RegisterType((*GetNetworkRequest)(nil), "index.GetNetworkRequest")
+	proto.RegisterType((*GetNetworkResponse)(nil), "index.GetNetworkResponse")
+	proto.RegisterType((*NetworkNode)(nil), "index.NetworkNode")
+	proto.RegisterType((*NetworkEdge)(nil), "index.NetworkEdge")
 }
 
 func init() { proto.RegisterFile("index.proto", fileDescriptor_f750e0f7889345b5) }
 
 var fileDescriptor_f750e0f7889345b5 = []byte{
-	// 2637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x0f, 0x49, 0x51, 0x24, 0x1f, 0xf5, 0x83, 0x1a, 0xc9, 0xf6, 0x9a, 0xb1, 0x15, 0x7d, 0xf9,
-	0x4d, 0x03, 0x27, 0x48, 0x98, 0xc4, 0x69, 0x82, 0xd4, 0xfd, 0x81, 0x48, 0xa4, 0x64, 0xb3, 0x70,
-	0x4c, 0x61, 0xc5, 0x1e, 0x9c, 0xf6, 0xb2, 0x22, 0x47, 0xd4, 0xc6, 0xe2, 0xee, 0x66, 0x77, 0xc8,
-	0x52, 0xfd, 0x17, 0x8a, 0xa2, 0x3d, 0xf4, 0x0f, 0x28, 0x7a, 0xe8, 0xa1, 0x40, 0xff, 0x83, 0xa2,
-	0xbd, 0x15, 0xe8, 0xb1, 0xa7, 0xde, 0x73, 0x2c, 0x50, 0xa0, 0x7f, 0x42, 0xf1, 0x66, 0x66, 0x77,
-	0x66, 0xf6, 0x87, 0x6d, 0x09, 0x46, 0x80, 0xe6, 0x62, 0x73, 0x3e, 0xf3, 0x99, 0x37, 0xef, 0xbd,
-	0x79, 0xef, 0xed, 0x9b, 0x5d, 0x41, 0xd3, 0xf5, 0x26, 0x74, 0xd9, 0x0d, 0x42, 0x9f, 0xf9, 0xa4,
-	0xca, 0x07, 0xed, 0x37, 0xa6, 0xbe, 0x3f, 0xbd, 0xa0, 0xef, 0x73, 0xf0, 0x74, 0x7e, 0xf6, 0x3e,
-	0x73, 0x67, 0x34, 0x62, 0xce, 0x2c, 0x10, 0xbc, 0x4e, 0x0d, 0xaa, 0x87, 0xb3, 0x80, 0x5d, 0x76,
-	0x7e, 0x01, 0xad, 0x87, 0x94, 0xf5, 0x9c, 0xf1, 0x39, 0x8d, 0x6c, 0xfa, 0xd5, 0x9c, 0x46, 0x8c,
-	0xb4, 0xa1, 0x7e, 0x42, 0xc3, 0x05, 0x0d, 0x07, 0x7d, 0xab, 0xb4, 0x57, 0xba, 0xd7, 0xb0, 0x93,
-	0x31, 0xf9, 0x14, 0x1a, 0xa3, 0x58, 0x96, 0x55, 0xde, 0x2b, 0xdd, 0x6b, 0xde, 0x6f, 0x77, 0xc5,
-	0x6e, 0xdd, 0x78, 0xb7, 0x6e, 0xc2, 0xb0, 0x15, 0x99, 0xb4, 0xa0, 0xf2, 0x79, 0x34, 0xb5, 0x2a,
-	0x5c, 0x20, 0xfe, 0xec, 0xfc, 0xbb, 0x04, 0x5b, 0xda, 0xe6, 0x51, 0xe0, 0x7b, 0x11, 0x7d, 0xee,
-	0xee, 0x0f, 0x60, 0xdd, 0x76, 0xbc, 0x67, 0xae, 0x37, 0x8d, 0xf8, 0x2a, 0xa9, 0xc1, 0x4e, 0x57,
-	0xf8, 0xc0, 0x98, 0xb3, 0x4d, 0x2a, 0xf9, 0x2e, 0x34, 0x47, 0x3e, 0x73, 0x2e, 0xe4, 0xca, 0x0a,
-	0x5f, 0x49, 0xe4, 0x4a, 0x6d, 0xc6, 0xd6, 0x69, 0xa6, 0xbd, 0x2b, 0xd7, 0xb0, 0xb7, 0xaa, 0xec,
-	0xfd, 0x6d, 0x29, 0xa5, 0x3e, 0xf9, 0x18, 0xaa, 0x42, 0x9b, 0xd2, 0x5e, 0xe5, 0x5e, 0xf3, 0xfe,
-	0x1b, 0x79, 0x76, 0x74, 0xf9, 0xbf, 0x87, 0x1e, 0x0b, 0x2f, 0x6d, 0xc1, 0x6e, 0x3f, 0x06, 0x50,
-	0x20, 0x6e, 0xf4, 0x8c, 0x5e, 0x4a, 0x5f, 0xe1, 0x4f, 0x72, 0x0f, 0xaa, 0x0b, 0xe7, 0x62, 0x1e,
-	0xbb, 0x87, 0xa4, 0xc4, 0x7e, 0xee, 0x04, 0xb6, 0x20, 0x3c, 0x28, 0x7f, 0x5a, 0xea, 0xfc, 0xaa,
-	0x04, 0x4d, 0x6d, 0x8a, 0x7c, 0x04, 0x55, 0x2e, 0x58, 0x2a, 0x75, 0x37, 0xbb, 0xba, 0xcb, 0xe7,
-	0xa5, 0x4a, 0xfc, 0xbf, 0xf6, 0x00, 0x40, 0x81, 0x39, 0x2a, 0x7d, 0xc7, 0x54, 0x69, 0x33, 0x25,
-	0x54, 0xd7, 0xe7, 0x37, 0x25, 0xe3, 0xa4, 0x50, 0x1f, 0xdd, 0x49, 0x77, 0xb3, 0x47, 0xf6, 0xea,
-	0x5c, 0xf4, 0x34, 0x14, 0x62, 0x53, 0x2e, 0xfa, 0x75, 0x09, 0x9a, 0xda, 0x14, 0xf9, 0x04, 0x56,
-	0xc5, 0x40, 0xea, 0xb4, 0x9b, 0x5d, 0x2e, 0xf5, 0x13, 0x4a, 0x49, 0x76, 0xfb, 0x51, 0x6c, 0x59,
-	0x91, 0x5a, 0xff, 0x6f, 0xaa, 0xb5, 0x6e, 0xd8, 0xaa, 0x6b, 0xf4, 0x9f, 0x12, 0xd4, 0x63, 0xe7,
-	0x91, 0x0d, 0x28, 0x27, 0xc9, 0x52, 0x1e, 0xf4, 0x09, 0x81, 0x95, 0xa7, 0xd4, 0x09, 0xb9, 0x90,
-	0x86, 0xcd, 0x7f, 0x93, 0x9b, 0xb0, 0x7a, 0x30, 0x1f, 0x3f, 0xa3, 0x4c, 0x66, 0xa0, 0x1c, 0x61,
-	0xba, 0xf5, 0x1c, 0x46, 0xa7, 0x7e, 0x78, 0xc9, 0xe3, 0xbb, 0x61, 0x27, 0x63, 0xb2, 0x03, 0xd5,
-	0x63, 0x27, 0x64, 0x97, 0x32, 0x88, 0xc5, 0x80, 0x7c, 0x4f, 0xed, 0x6c, 0xad, 0xe6, 0x86, 0x48,
-	0xf2, 0x43, 0x58, 0x9f, 0xd0, 0xdb, 0xdf, 0x57, 0x09, 0x50, 0xe4, 0x81, 0x1d, 0xdd, 0x03, 0x65,
-	0xdd, 0x64, 0x16, 0x3b, 0xfd, 0xa5, 0xec, 0xd5, 0xed, 0xaa, 0x14, 0xd9, 0xb5, 0xa2, 0xdb, 0xb5,
-	0x03, 0x55, 0x2e, 0x9f, 0x5b, 0x5b, 0xb6, 0xc5, 0xa0, 0xf3, 0xfb, 0x12, 0x90, 0x13, 0xea, 0x84,
-	0xe3, 0xf3, 0x01, 0xda, 0x18, 0xd7, 0xc8, 0x16, 0x54, 0x7e, 0x92, 0xe8, 0x80, 0x3f, 0x8d, 0xba,
-	0x55, 0x4e, 0xd5, 0x2d, 0x02, 0x2b, 0x23, 0xba, 0x8c, 0x5d, 0xcf, 0x7f, 0xbf, 0xd2, 0xca, 0xf2,
-	0x97, 0x12, 0x6c, 0x1b, 0x4a, 0xca, 0x5a, 0x7a, 0x35, 0x2d, 0xdf, 0x83, 0x9a, 0x4d, 0xa3, 0xf9,
-	0x05, 0x8b, 0xac, 0x0a, 0x3f, 0xd7, 0x6d, 0x79, 0xae, 0x42, 0xb4, 0x98, 0xb3, 0x63, 0xce, 0x2b,
-	0x35, 0xe0, 0x0f, 0x25, 0x58, 0xd3, 0x77, 0xc9, 0x1c, 0xb1, 0x0a, 0xdf, 0xb2, 0x11, 0xbe, 0x04,
-	0x56, 0x9e, 0x38, 0x33, 0x1a, 0x7b, 0x16, 0x7f, 0x23, 0xd6, 0x73, 0x93, 0xd3, 0xe5, 0xbf, 0xf1,
-	0x70, 0x4f, 0x98, 0xc3, 0x68, 0x1c, 0xca, 0x7c, 0x80, 0xde, 0x38, 0x9c, 0x05, 0x17, 0xfe, 0x25,
-	0x0d, 0xad, 0x55, 0xe1, 0x8d, 0x78, 0x8c, 0x2b, 0x30, 0x90, 0x22, 0xab, 0xb6, 0x57, 0xc1, 0x15,
-	0x7c, 0xd0, 0xf9, 0x63, 0x09, 0x5a, 0x8f, 0x7d, 0xff, 0xd9, 0x3c, 0x18, 0x9e, 0x7e, 0x79, 0xbd,
-	0x60, 0xb8, 0x03, 0x8d, 0xe1, 0xe9, 0x97, 0x74, 0xcc, 0x06, 0x13, 0xe1, 0xe8, 0x86, 0xad, 0x80,
-	0x57, 0xea, 0xd5, 0x3f, 0x97, 0x60, 0x4b, 0x53, 0xf6, 0x7f, 0x2d, 0x28, 0xfe, 0x99, 0xa8, 0x3f,
-	0xf0, 0x26, 0x8b, 0xeb, 0x39, 0xbb, 0x0d, 0x75, 0xe9, 0xdb, 0x7e, 0x5c, 0x06, 0xe2, 0xb1, 0x16,
-	0x53, 0x2b, 0x46, 0x4c, 0x25, 0x27, 0x5f, 0xd5, 0x4e, 0xde, 0xb4, 0x6c, 0xf5, 0x1a, 0x96, 0xd5,
-	0x94, 0x65, 0xbf, 0x2c, 0x03, 0xd1, 0x2d, 0xbb, 0xd6, 0xc9, 0x5c, 0xc7, 0xb4, 0x0f, 0x01, 0x06,
-	0xde, 0xc4, 0x5d, 0xb8, 0x93, 0xb9, 0x2c, 0x74, 0xcd, 0xfb, 0x5b, 0xf2, 0x40, 0xd5, 0x84, 0xad,
-	0x91, 0x94, 0x37, 0x56, 0x0b, 0xbd, 0x51, 0xbb, 0x86, 0x37, 0xea, 0x79, 0xe7, 0xdc, 0x73, 0xbc,
-	0xc9, 0xb7, 0xe9, 0x9c, 0xff, 0x9a, 0x9c, 0xb3, 0xb0, 0xec, 0x1b, 0x3b, 0xe7, 0x2e, 0x34, 0x70,
-	0x47, 0x77, 0x12, 0x97, 0xbc, 0xe6, 0xfd, 0x96, 0x3c, 0xe6, 0x04, 0xb7, 0x15, 0x85, 0x7c, 0x0c,
-	0x70, 0xe4, 0x7a, 0x8e, 0x37, 0x76, 0xb1, 0xa9, 0x11, 0x56, 0xdf, 0x88, 0x17, 0xcc, 0x02, 0x4f,
-	0x4d, 0xda, 0x1a, 0x31, 0xbf, 0x46, 0x9a, 0x1e, 0xac, 0x5f, 0xc3, 0x83, 0x8d, 0xdc, 0xd8, 0x98,
-	0x31, 0xfa, 0x6d, 0x8a, 0x8d, 0x7f, 0xa9, 0xd8, 0xe0, 0x96, 0x7d, 0xa3, 0xb1, 0xe1, 0xcf, 0x66,
-	0x2e, 0x63, 0x34, 0x13, 0x1b, 0x31, 0x6e, 0x2b, 0x0a, 0x79, 0x1b, 0x56, 0x47, 0xcb, 0xbe, 0xc3,
-	0x1c, 0x69, 0xf1, 0x56, 0x12, 0x17, 0x8c, 0x8a, 0x09, 0x5b, 0x12, 0x52, 0x61, 0x54, 0x4b, 0x85,
-	0x11, 0xa3, 0x2f, 0x0a, 0xa3, 0x7a, 0xa1, 0xb3, 0x1b, 0xd7, 0x70, 0x36, 0x28, 0x67, 0x7f, 0x08,
-	0x0d, 0xd5, 0xbd, 0xa7, 0x7b, 0x8b, 0xa4, 0xf1, 0x2b, 0xeb, 0x8d, 0xdf, 0xef, 0x56, 0xf5, 0x5a,
-	0x99, 0xd7, 0x73, 0xf2, 0xc6, 0xa3, 0x9c, 0xd3, 0x78, 0x54, 0xf2, 0x1a, 0x8f, 0x15, 0xbd, 0xf1,
-	0x68, 0x41, 0xe5, 0x0b, 0x37, 0x88, 0x1f, 0x76, 0x5f, 0xb8, 0x01, 0xd9, 0x05, 0x18, 0x8e, 0xc7,
-	0xf3, 0xc0, 0x61, 0xae, 0xef, 0xc9, 0x66, 0x44, 0x43, 0x8c, 0x56, 0xa5, 0x96, 0x6a, 0x55, 0x3a,
-	0xb0, 0x36, 0x0a, 0x1d, 0x2f, 0x72, 0xc6, 0x48, 0x8d, 0xdd, 0x68, 0x60, 0x64, 0x4f, 0x5e, 0x3d,
-	0x86, 0x73, 0xb6, 0x3f, 0x63, 0xdc, 0x9f, 0x65, 0x5b, 0x87, 0x74, 0xc6, 0x68, 0x19, 0x71, 0xef,
-	0x69, 0x8c, 0xd1, 0x32, 0x42, 0x1d, 0xf6, 0x17, 0xd3, 0xd1, 0x72, 0x38, 0x67, 0x56, 0x93, 0x4f,
-	0x27, 0x63, 0xd4, 0x9f, 0x53, 0x07, 0x1e, 0x8a, 0x5f, 0xe3, 0xb3, 0x1a, 0xa2, 0xcd, 0xa3, 0xf0,
-	0x75, 0x63, 0x1e, 0x65, 0x5b, 0x50, 0xe3, 0xb2, 0x06, 0x9e, 0xb5, 0xc1, 0x27, 0xe3, 0x21, 0xae,
-	0x7c, 0x42, 0xd9, 0x81, 0x73, 0xe1, 0x78, 0x63, 0x6a, 0x6d, 0x8a, 0x95, 0x0a, 0x21, 0x9f, 0xc0,
-	0xba, 0x4d, 0xc7, 0x6e, 0xe0, 0x52, 0x8f, 0x45, 0xb8, 0x79, 0x8b, 0xf7, 0x29, 0x2d, 0xe3, 0xee,
-	0x84, 0x17, 0x3a, 0x93, 0x46, 0x7e, 0xac, 0xaf, 0x43, 0xa5, 0xb6, 0xf8, 0xba, 0x37, 0x33, 0x8f,
-	0xc3, 0xae, 0x41, 0x13, 0x77, 0x1a, 0x73, 0x29, 0xf9, 0x00, 0xe0, 0x84, 0x7a, 0x13, 0x1a, 0x72,
-	0x05, 0x48, 0x81, 0x02, 0x1a, 0x87, 0xec, 0x27, 0x2b, 0x70, 0xeb, 0x6d, 0xbe, 0xe2, 0xff, 0xb2,
-	0x5b, 0x2b, 0x8e, 0xd8, 0x57, 0x5b, 0xd4, 0xfe, 0x0c, 0x48, 0x56, 0xb3, 0xab, 0x5c, 0xa9, 0xda,
-	0x3f, 0x84, 0xcd, 0xd4, 0x06, 0x57, 0xba, 0x91, 0xfd, 0xbd, 0xac, 0x95, 0x92, 0x97, 0xca, 0x90,
-	0x36, 0xd4, 0x47, 0x21, 0x8d, 0xb4, 0x96, 0x3d, 0x19, 0x5f, 0xa1, 0x6d, 0x97, 0xd9, 0xb3, 0xaa,
-	0xb2, 0x67, 0x0f, 0x9a, 0x7d, 0x1a, 0xb9, 0x53, 0x4f, 0xa4, 0x8f, 0x48, 0x10, 0x1d, 0xe2, 0x57,
-	0xb0, 0xcb, 0x80, 0xca, 0xbe, 0x83, 0xff, 0x56, 0xf7, 0xc0, 0x86, 0x7e, 0x0f, 0xdc, 0xc5, 0x22,
-	0x76, 0xe1, 0x7a, 0xd3, 0xa3, 0x90, 0x7e, 0x25, 0x8b, 0x88, 0x86, 0x60, 0xa4, 0x0e, 0xc3, 0x29,
-	0x17, 0xd6, 0xe4, 0x93, 0xf1, 0x10, 0xf3, 0xb0, 0xe7, 0x7b, 0x1e, 0x1d, 0x33, 0x3a, 0x19, 0x86,
-	0x53, 0x9e, 0x05, 0x0d, 0xdb, 0xc0, 0xb0, 0x2a, 0xe3, 0x63, 0x77, 0xd0, 0xe7, 0x39, 0xd0, 0xb0,
-	0xe5, 0xa8, 0xf3, 0xa7, 0xba, 0xf6, 0xc8, 0x7e, 0x29, 0x5f, 0x26, 0xda, 0x57, 0x74, 0xed, 0xb1,
-	0x4e, 0x5c, 0xd0, 0x31, 0xf3, 0x9e, 0x86, 0xdc, 0x93, 0x55, 0x3b, 0x19, 0xa3, 0x97, 0x86, 0x67,
-	0x67, 0xee, 0x98, 0xea, 0x3e, 0xd5, 0x21, 0xd4, 0x4e, 0x0c, 0xa5, 0x73, 0xe5, 0x08, 0x3d, 0x7e,
-	0xdc, 0xeb, 0xc5, 0x8f, 0xaf, 0xe3, 0x5e, 0x2f, 0x39, 0xad, 0x7a, 0xde, 0x69, 0x35, 0x72, 0x4e,
-	0x0b, 0xd4, 0x69, 0xdd, 0x83, 0xcd, 0x21, 0x3b, 0xa7, 0xe1, 0xfe, 0xd9, 0x99, 0x7b, 0xe1, 0x3a,
-	0x8c, 0x46, 0x56, 0x93, 0x97, 0xac, 0x34, 0x4c, 0xde, 0x81, 0x96, 0x5e, 0xc5, 0x1e, 0xbb, 0x11,
-	0xd6, 0x16, 0xa4, 0x66, 0x70, 0xce, 0xc5, 0x54, 0xeb, 0xbb, 0x21, 0x3e, 0x00, 0x79, 0x1d, 0x12,
-	0x75, 0x26, 0x83, 0x67, 0xb8, 0x98, 0x83, 0x1b, 0x39, 0x5c, 0xcc, 0xed, 0x3d, 0x68, 0xee, 0x2f,
-	0xa6, 0x31, 0x22, 0x0b, 0x90, 0x0e, 0x91, 0x77, 0x61, 0x4b, 0x5b, 0x25, 0x2b, 0x6c, 0x8b, 0xf3,
-	0xb2, 0x13, 0x59, 0xb6, 0xa8, 0x3d, 0x39, 0x6c, 0xdc, 0xbd, 0x03, 0x6b, 0xc9, 0x56, 0x58, 0x77,
-	0x09, 0x27, 0x1a, 0x18, 0xe9, 0x02, 0x51, 0xf5, 0x50, 0xc0, 0xa3, 0xa5, 0xb5, 0xcd, 0x99, 0x39,
-	0x33, 0xa4, 0x0f, 0x3b, 0xe2, 0xb7, 0x51, 0x10, 0x23, 0x6b, 0xa7, 0xa0, 0x6e, 0xe5, 0xb2, 0xc9,
-	0x4f, 0x61, 0x3b, 0x8d, 0xa3, 0x25, 0x37, 0xb8, 0x90, 0xb7, 0xd3, 0xdd, 0x66, 0x37, 0x87, 0x2b,
-	0x4a, 0x5a, 0x9e, 0x14, 0xf2, 0x23, 0xd8, 0x12, 0xb0, 0x2a, 0x99, 0x91, 0x75, 0xb3, 0x40, 0xbf,
-	0x2c, 0x95, 0xd8, 0xd0, 0x32, 0x40, 0xd4, 0xec, 0x16, 0x5f, 0xfe, 0x56, 0x81, 0x66, 0xe9, 0x4a,
-	0x9b, 0x59, 0xdf, 0x3e, 0x02, 0xab, 0xc8, 0x88, 0x2b, 0x55, 0xdd, 0x1e, 0xdc, 0xc8, 0xdd, 0xf2,
-	0x4a, 0xb5, 0xf7, 0xeb, 0x1a, 0x6c, 0x98, 0x9d, 0xb9, 0x56, 0x5a, 0x4a, 0x7a, 0x69, 0xc9, 0x2d,
-	0x1e, 0x16, 0xd4, 0x78, 0xbd, 0xe8, 0x4d, 0x64, 0xf9, 0x88, 0x87, 0x05, 0x2f, 0xc7, 0xde, 0x84,
-	0x75, 0xee, 0x6f, 0x9b, 0x8e, 0xa9, 0x1b, 0xb0, 0x48, 0xbe, 0x24, 0x33, 0x41, 0xde, 0x42, 0x60,
-	0x5a, 0x1e, 0x85, 0xfb, 0x73, 0x76, 0xce, 0x6b, 0x08, 0xb6, 0x10, 0x0a, 0x4a, 0xe4, 0xf4, 0xdd,
-	0xe8, 0x34, 0xc2, 0x33, 0xad, 0x69, 0x72, 0x62, 0x30, 0x91, 0x33, 0xf2, 0xb9, 0x9c, 0xba, 0x26,
-	0x47, 0x40, 0xdc, 0xd6, 0xe1, 0xa3, 0x83, 0xe1, 0xb1, 0xec, 0x64, 0xe4, 0x48, 0xe2, 0xbd, 0xe1,
-	0xb1, 0xec, 0x5f, 0xe4, 0x88, 0xdc, 0x11, 0xd5, 0xb5, 0xe7, 0x7b, 0x2c, 0x92, 0xbd, 0x8b, 0x02,
-	0xe2, 0xd9, 0xc7, 0xbe, 0xe3, 0x45, 0xb2, 0x77, 0x51, 0x00, 0x6f, 0xcd, 0xb0, 0x2e, 0x89, 0x69,
-	0xd9, 0xba, 0x28, 0x04, 0x6d, 0x8a, 0xc9, 0x36, 0x0d, 0x9c, 0x4b, 0x59, 0x49, 0x4c, 0x90, 0xbc,
-	0x05, 0x1b, 0xc9, 0x1a, 0x41, 0x13, 0x95, 0x24, 0x85, 0x8a, 0x47, 0xd9, 0x29, 0x8b, 0x86, 0x3f,
-	0xa7, 0x93, 0x83, 0x4b, 0x59, 0x46, 0x74, 0x08, 0x25, 0xc9, 0xc6, 0x69, 0xb2, 0x10, 0x06, 0x89,
-	0xea, 0x91, 0x42, 0xd3, 0xe5, 0x9e, 0x64, 0xcb, 0x3d, 0xea, 0xc4, 0x87, 0x7d, 0x37, 0x62, 0xa1,
-	0x3b, 0x66, 0xbc, 0x68, 0x34, 0xec, 0x14, 0x8a, 0x45, 0xe8, 0x24, 0xa0, 0x63, 0xfe, 0x20, 0xc1,
-	0xe7, 0xeb, 0x8e, 0x78, 0xb0, 0xe9, 0x18, 0x72, 0x8e, 0x43, 0x77, 0x96, 0x70, 0x6e, 0x08, 0x8e,
-	0x8e, 0xa1, 0x46, 0xf6, 0xdc, 0x4b, 0x28, 0x37, 0x85, 0x46, 0x1a, 0x84, 0x8c, 0x87, 0x54, 0x31,
-	0x6e, 0x09, 0x86, 0x06, 0xa1, 0xce, 0xda, 0xf0, 0x78, 0xcc, 0x2c, 0x4b, 0x58, 0x6f, 0xa2, 0x89,
-	0xbf, 0xf1, 0xde, 0x21, 0xbc, 0x74, 0x5b, 0xf3, 0x77, 0x82, 0xe2, 0x03, 0xf3, 0x98, 0x5d, 0x0a,
-	0x46, 0x5b, 0x34, 0xb5, 0xf1, 0x98, 0x3c, 0x00, 0xe8, 0x2d, 0xa6, 0x87, 0xde, 0xa4, 0x8f, 0x0e,
-	0x7c, 0xfd, 0x85, 0x77, 0x10, 0x8d, 0x8d, 0x96, 0x88, 0x97, 0x3b, 0x67, 0x73, 0x6f, 0x12, 0x59,
-	0x77, 0xc4, 0x39, 0x6a, 0x10, 0x32, 0xc4, 0xd5, 0x4f, 0x30, 0xee, 0x0a, 0x86, 0x06, 0x75, 0xbe,
-	0xae, 0x62, 0x92, 0xeb, 0xf7, 0x26, 0x1e, 0xe0, 0x33, 0x46, 0xb5, 0x24, 0xe7, 0xa3, 0x6c, 0x82,
-	0x96, 0xf3, 0x12, 0x14, 0xbb, 0xf0, 0x65, 0x74, 0x14, 0xfa, 0xb3, 0xfd, 0xb3, 0x33, 0x9e, 0xf9,
-	0xd8, 0x85, 0x27, 0x08, 0x26, 0x82, 0x8a, 0xaa, 0x15, 0x91, 0x08, 0x2a, 0xa0, 0xe2, 0x44, 0x10,
-	0xd3, 0x55, 0x2d, 0x11, 0x12, 0x57, 0xc6, 0x39, 0x25, 0x73, 0x3f, 0x19, 0x9b, 0x29, 0x56, 0xcb,
-	0x49, 0x31, 0xae, 0xa8, 0x98, 0xae, 0x6b, 0xb7, 0x03, 0x31, 0x7f, 0x47, 0xde, 0xdf, 0xb0, 0x42,
-	0xc8, 0x8c, 0x57, 0x00, 0x16, 0xb3, 0xd1, 0x72, 0xe4, 0xa3, 0x49, 0x22, 0xeb, 0xe3, 0x61, 0xfa,
-	0x10, 0x9a, 0xd9, 0x43, 0xe8, 0xc0, 0x1a, 0xb7, 0x20, 0xa6, 0x88, 0xec, 0x37, 0x30, 0xdc, 0x5d,
-	0x65, 0xad, 0xc8, 0x7f, 0x05, 0xe0, 0xee, 0x3d, 0x27, 0x3a, 0xc7, 0x5a, 0x24, 0x6f, 0x2e, 0x72,
-	0x18, 0xcf, 0x60, 0x35, 0xda, 0x54, 0x33, 0xb2, 0x1c, 0x25, 0x19, 0x2d, 0x53, 0x5c, 0x01, 0x18,
-	0xba, 0x4f, 0x7c, 0xef, 0x88, 0x4e, 0x46, 0xcb, 0xc8, 0xa6, 0xe3, 0xc5, 0x24, 0x4e, 0x70, 0x13,
-	0xc5, 0x3e, 0x0a, 0x7d, 0x3b, 0xf2, 0x93, 0x90, 0x96, 0xed, 0x41, 0x1a, 0xc6, 0xa8, 0x19, 0x78,
-	0x93, 0xc3, 0x65, 0x20, 0xbb, 0x02, 0x39, 0xe2, 0xc1, 0x8f, 0xf5, 0x1d, 0x67, 0x76, 0x64, 0xf0,
-	0xcb, 0x31, 0x4a, 0x17, 0xfb, 0x9d, 0x9c, 0x3b, 0x21, 0xe5, 0x8b, 0x6f, 0x08, 0xe9, 0x29, 0x98,
-	0xfc, 0x00, 0x9a, 0x3d, 0x5f, 0xe5, 0xc9, 0xcd, 0x17, 0xe6, 0x89, 0x4e, 0xef, 0xfc, 0x6d, 0x03,
-	0x40, 0xbd, 0x4b, 0x28, 0x0c, 0x70, 0xf5, 0x74, 0x2b, 0x1b, 0x4f, 0xb7, 0xfc, 0x36, 0xb8, 0x0b,
-	0x04, 0x7d, 0x10, 0xba, 0xa7, 0x73, 0xde, 0x21, 0x8a, 0x76, 0x50, 0x44, 0x74, 0xce, 0x4c, 0x0e,
-	0x1f, 0x3b, 0x86, 0x6a, 0x2e, 0x1f, 0xfb, 0x93, 0x77, 0x61, 0x6b, 0x7f, 0x31, 0xd5, 0x27, 0x06,
-	0x9e, 0x8c, 0xf9, 0xec, 0x04, 0x4a, 0x97, 0x01, 0x25, 0xf2, 0x50, 0x68, 0x23, 0xb2, 0x20, 0x67,
-	0x26, 0x87, 0x8f, 0xda, 0xd4, 0x73, 0xf9, 0xa8, 0xcd, 0x2e, 0xc0, 0xfe, 0x62, 0xca, 0x27, 0x06,
-	0x9e, 0xcc, 0x0f, 0x0d, 0x49, 0xda, 0xdd, 0x81, 0x37, 0xf6, 0x67, 0xae, 0x37, 0xc5, 0xdd, 0x41,
-	0x6b, 0x77, 0x35, 0x3c, 0xc3, 0xc5, 0x9d, 0x9b, 0x39, 0x5c, 0xd5, 0x1a, 0xc7, 0x88, 0xcc, 0x1d,
-	0x1d, 0x4a, 0x5e, 0x4d, 0x9c, 0xc9, 0xab, 0xb1, 0xc8, 0x1e, 0x03, 0x33, 0x38, 0xaa, 0x11, 0x37,
-	0x30, 0xb9, 0x53, 0x0c, 0x69, 0x4d, 0x78, 0x0c, 0xf1, 0x02, 0x18, 0xaf, 0xe0, 0xf7, 0x84, 0x16,
-	0xbf, 0x27, 0x98, 0x20, 0x06, 0xf5, 0xe1, 0x32, 0xa0, 0xde, 0xc4, 0x65, 0xf3, 0x90, 0x72, 0x95,
-	0x44, 0x6e, 0xa5, 0xe1, 0x34, 0x13, 0x15, 0x23, 0x59, 0x26, 0xea, 0xf6, 0x16, 0x6c, 0xec, 0x2f,
-	0xa6, 0x1a, 0x2a, 0x93, 0x2c, 0x85, 0x26, 0x9e, 0x1d, 0xce, 0xd9, 0xd4, 0x97, 0xa7, 0xb0, 0xa3,
-	0x79, 0x56, 0xc3, 0x33, 0x5c, 0xd1, 0x59, 0x67, 0xb9, 0xca, 0x37, 0x31, 0xc2, 0xd3, 0x4f, 0xf8,
-	0x26, 0x86, 0x52, 0xaf, 0x50, 0x6e, 0x65, 0x5e, 0xa1, 0x3c, 0x82, 0x9b, 0x23, 0x3f, 0x88, 0x0b,
-	0x3d, 0x0f, 0x5c, 0x5f, 0x9c, 0x97, 0x55, 0xd0, 0x72, 0x17, 0xf0, 0x09, 0xcd, 0x95, 0x84, 0xda,
-	0xdf, 0xe6, 0x92, 0xde, 0xcb, 0xbc, 0x3c, 0xec, 0xe6, 0xf3, 0x45, 0x13, 0x5e, 0x20, 0x8c, 0x3c,
-	0x81, 0xdb, 0x23, 0x9f, 0xbf, 0x38, 0x1d, 0x86, 0xd3, 0xb4, 0xce, 0xed, 0x02, 0x9d, 0x8b, 0x97,
-	0x10, 0xaf, 0x48, 0x1e, 0x6a, 0xfe, 0x3a, 0x97, 0xf7, 0x41, 0xae, 0xe6, 0xf9, 0x4b, 0x84, 0xf2,
-	0xc5, 0x22, 0xc9, 0x03, 0xd8, 0x8c, 0xe3, 0xd2, 0xa6, 0x63, 0xae, 0xf5, 0x9d, 0x02, 0xad, 0xd3,
-	0x44, 0x72, 0x6c, 0xae, 0x45, 0x0d, 0xef, 0x9a, 0x37, 0x1b, 0x4d, 0x43, 0x93, 0x28, 0xf4, 0x4a,
-	0x2f, 0x27, 0x07, 0xb0, 0x3d, 0xf2, 0x83, 0xc3, 0x65, 0x60, 0xbe, 0x47, 0xdb, 0x2d, 0xd0, 0x28,
-	0x8f, 0x4c, 0x7e, 0x96, 0x95, 0x81, 0x9a, 0xbd, 0xc1, 0x65, 0xbc, 0x93, 0xeb, 0xbb, 0x34, 0x59,
-	0x5e, 0x07, 0x73, 0x66, 0xda, 0x03, 0x78, 0xfd, 0x39, 0x61, 0x72, 0xa5, 0xdb, 0xd7, 0x63, 0xd8,
-	0x7d, 0xfe, 0xb9, 0x5d, 0x49, 0xda, 0x01, 0xec, 0xe4, 0xf9, 0xf8, 0x4a, 0x32, 0x8e, 0xc0, 0x2a,
-	0xf2, 0xc6, 0x55, 0xe4, 0xdc, 0xff, 0x47, 0x05, 0xaa, 0xfc, 0xfb, 0x3f, 0xf9, 0x0c, 0x1a, 0xc9,
-	0x1f, 0x56, 0x91, 0x5b, 0xd2, 0xf9, 0xe9, 0xbf, 0xf3, 0x6a, 0x5b, 0xd9, 0x09, 0xf1, 0x11, 0xa2,
-	0xf3, 0x1a, 0x39, 0x82, 0xa6, 0xf6, 0x07, 0x05, 0xe4, 0xb6, 0xf1, 0xd1, 0x57, 0xff, 0x4b, 0x88,
-	0x76, 0x3b, 0x6f, 0x2a, 0x91, 0xd3, 0x87, 0xf5, 0xe4, 0x0b, 0x34, 0x1d, 0x33, 0xa5, 0x4d, 0xfa,
-	0x23, 0x7a, 0xa2, 0x4d, 0xe6, 0x83, 0x35, 0xd7, 0x66, 0xfd, 0x21, 0x65, 0xda, 0xdb, 0x78, 0x93,
-	0xac, 0x7d, 0x1e, 0x6e, 0xdf, 0xce, 0x99, 0x49, 0xe4, 0x1c, 0xc2, 0x1a, 0x1a, 0x9b, 0xbc, 0xb2,
-	0x34, 0xc5, 0x68, 0x5f, 0x98, 0x52, 0x62, 0xf4, 0x2f, 0x34, 0x4a, 0x4c, 0xf2, 0xb6, 0x2e, 0x25,
-	0x46, 0x7d, 0xc4, 0x4c, 0x8b, 0xd1, 0x3e, 0x02, 0x76, 0x5e, 0x23, 0x1d, 0x58, 0x79, 0xe2, 0x0f,
-	0x03, 0xb2, 0x26, 0x49, 0xfc, 0x2f, 0xf2, 0xda, 0xc6, 0xe8, 0x74, 0x95, 0x77, 0x4f, 0x1f, 0xfd,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x4b, 0x5b, 0x12, 0xe6, 0x27, 0x00, 0x00,
+	// 4035 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4f, 0x6f, 0x23, 0x47,
+	0x76, 0x0f, 0x49, 0x91, 0x22, 0x1f, 0x25, 0x8d, 0xa6, 0xa4, 0xd1, 0xb4, 0x38, 0x7f, 0xac, 0xe5,
+	0x7a, 0xec, 0xb1, 0xd7, 0x96, 0xed, 0xd9, 0xec, 0x62, 0xe3, 0x24, 0x0b, 0x4b, 0x94, 0xe4, 0x61,
+	0x3c, 0x33, 0x14, 0x5a, 0xcc, 0xc1, 0x9b, 0x00, 0x41, 0x0f, 0x59, 0xa2, 0x7a, 0x45, 0x76, 0x73,
+	0xbb, 0x9b, 0x32, 0xb5, 0xe7, 0xdc, 0x82, 0x60, 0x73, 0xc8, 0x21, 0xa7, 0x24, 0x40, 0x82, 0x1c,
+	0x02, 0xe4, 0x16, 0xe4, 0x94, 0x7c, 0x80, 0xfd, 0x02, 0x09, 0x90, 0xe3, 0x1e, 0x03, 0x04, 0xc8,
+	0x47, 0x08, 0x5e, 0x55, 0x75, 0xfd, 0xe9, 0x2e, 0x5a, 0x24, 0x47, 0x08, 0xe0, 0x60, 0x2e, 0x33,
+	0xac, 0xdf, 0x7b, 0xf5, 0xfa, 0xd5, 0xab, 0xf7, 0x5e, 0xd7, 0xab, 0xae, 0x12, 0xd4, 0xfd, 0xa0,
+	0x4f, 0xa7, 0xfb, 0xe3, 0x28, 0x4c, 0x42, 0x52, 0x66, 0x8d, 0xc6, 0x3b, 0x83, 0x30, 0x1c, 0x0c,
+	0xe9, 0x27, 0x0c, 0x7c, 0x3d, 0x39, 0xff, 0x24, 0xf1, 0x47, 0x34, 0x4e, 0xbc, 0xd1, 0x98, 0xf3,
+	0x35, 0x57, 0xa1, 0x7c, 0x3c, 0x1a, 0x27, 0xd7, 0xcd, 0x5f, 0xc2, 0xe6, 0x97, 0x34, 0x69, 0x79,
+	0xbd, 0x0b, 0x1a, 0xbb, 0xf4, 0x17, 0x13, 0x1a, 0x27, 0xa4, 0x01, 0xd5, 0x33, 0x1a, 0x5d, 0xd1,
+	0xa8, 0x7d, 0xe4, 0x14, 0xf6, 0x0a, 0x4f, 0x6b, 0xae, 0x6c, 0x93, 0x9f, 0x40, 0xad, 0x9b, 0xca,
+	0x72, 0x8a, 0x7b, 0x85, 0xa7, 0xf5, 0x67, 0x8d, 0x7d, 0xfe, 0xb4, 0xfd, 0xf4, 0x69, 0xfb, 0x92,
+	0xc3, 0x55, 0xcc, 0x64, 0x13, 0x4a, 0x2f, 0xe3, 0x81, 0x53, 0x62, 0x02, 0xf1, 0x67, 0xf3, 0xbf,
+	0x0b, 0x70, 0x57, 0x7b, 0x78, 0x3c, 0x0e, 0x83, 0x98, 0x7e, 0xeb, 0xd3, 0x3f, 0x87, 0x75, 0xd7,
+	0x0b, 0x2e, 0xfd, 0x60, 0x10, 0xb3, 0x5e, 0x42, 0x83, 0xed, 0x7d, 0x6e, 0x03, 0x83, 0xe6, 0x9a,
+	0xac, 0xe4, 0xb7, 0xa1, 0xde, 0x0d, 0x13, 0x6f, 0x28, 0x7a, 0x96, 0x58, 0x4f, 0x22, 0x7a, 0x6a,
+	0x14, 0x57, 0x67, 0x33, 0xc7, 0xbb, 0xb2, 0xc4, 0x78, 0xcb, 0x6a, 0xbc, 0x7f, 0x59, 0xc8, 0xa8,
+	0x4f, 0x7e, 0x04, 0x65, 0xae, 0x4d, 0x61, 0xaf, 0xf4, 0xb4, 0xfe, 0xec, 0x1d, 0xdb, 0x38, 0xf6,
+	0xd9, 0xbf, 0xc7, 0x41, 0x12, 0x5d, 0xbb, 0x9c, 0xbb, 0xf1, 0x02, 0x40, 0x81, 0xf8, 0xa0, 0x4b,
+	0x7a, 0x2d, 0x6c, 0x85, 0x3f, 0xc9, 0x53, 0x28, 0x5f, 0x79, 0xc3, 0x49, 0x6a, 0x1e, 0x92, 0x11,
+	0xfb, 0xd2, 0x1b, 0xbb, 0x9c, 0xe1, 0xf3, 0xe2, 0x4f, 0x0a, 0xcd, 0x3f, 0x2f, 0x40, 0x5d, 0x23,
+	0x91, 0x1f, 0x42, 0x99, 0x09, 0x16, 0x4a, 0x3d, 0xca, 0xf7, 0xde, 0x67, 0x74, 0xa1, 0x12, 0xfb,
+	0xaf, 0xd1, 0x06, 0x50, 0xa0, 0x45, 0xa5, 0x27, 0xa6, 0x4a, 0x77, 0x32, 0x42, 0x75, 0x7d, 0xfe,
+	0xa2, 0x60, 0xcc, 0x14, 0xea, 0xa3, 0x1b, 0xe9, 0x51, 0x7e, 0xca, 0x6e, 0xcf, 0x44, 0x5f, 0x47,
+	0x5c, 0x6c, 0xc6, 0x44, 0xbf, 0x2a, 0x40, 0x5d, 0x23, 0x91, 0x1f, 0x43, 0x85, 0x37, 0x84, 0x4e,
+	0x8f, 0xf3, 0xdd, 0x85, 0x7e, 0x5c, 0x29, 0xc1, 0xdd, 0x78, 0x0e, 0x75, 0x0d, 0xb6, 0xa8, 0xf5,
+	0x7d, 0x53, 0xad, 0x75, 0x63, 0xac, 0xba, 0x46, 0xff, 0x53, 0x80, 0x6a, 0x6a, 0x3c, 0xb2, 0x01,
+	0x45, 0x19, 0x2c, 0xc5, 0xf6, 0x11, 0x21, 0xb0, 0xf2, 0x35, 0xf5, 0x22, 0x26, 0xa4, 0xe6, 0xb2,
+	0xdf, 0x64, 0x07, 0x2a, 0x87, 0x93, 0xde, 0x25, 0x4d, 0x44, 0x04, 0x8a, 0x16, 0x86, 0x5b, 0xcb,
+	0x4b, 0xe8, 0x20, 0x8c, 0xae, 0x99, 0x7f, 0xd7, 0x5c, 0xd9, 0x26, 0xdb, 0x50, 0x3e, 0xf5, 0xa2,
+	0xe4, 0x5a, 0x38, 0x31, 0x6f, 0x90, 0xdf, 0x51, 0x4f, 0x76, 0x2a, 0x56, 0x17, 0x91, 0x3f, 0xf8,
+	0xe8, 0x25, 0x7b, 0xe3, 0x77, 0x61, 0xdd, 0x20, 0x59, 0x2c, 0xb0, 0xad, 0x5b, 0xa0, 0xa8, 0x0f,
+	0x39, 0x49, 0x8d, 0x3e, 0xd7, 0x78, 0xf5, 0x71, 0x95, 0x66, 0x8d, 0x6b, 0x45, 0x1f, 0xd7, 0x36,
+	0x94, 0x99, 0x7c, 0x36, 0xda, 0xa2, 0xcb, 0x1b, 0xcd, 0x7f, 0x2f, 0x00, 0x39, 0xa3, 0x5e, 0xd4,
+	0xbb, 0x68, 0xe3, 0x18, 0xd3, 0x1c, 0xb9, 0x09, 0xa5, 0x3f, 0x94, 0x3a, 0xe0, 0x4f, 0x23, 0x6f,
+	0x15, 0x33, 0x79, 0x8b, 0xc0, 0x4a, 0x97, 0x4e, 0x53, 0xd3, 0xb3, 0xdf, 0xb7, 0x99, 0x59, 0xf0,
+	0xd9, 0xa7, 0xde, 0x80, 0x9e, 0xf9, 0xbf, 0xa4, 0x4e, 0x65, 0xaf, 0xf0, 0xb4, 0xec, 0xca, 0x36,
+	0x4e, 0x7c, 0x6b, 0x12, 0xc5, 0x61, 0xe4, 0xac, 0xf2, 0x89, 0xe7, 0xad, 0xe6, 0x7f, 0x16, 0x61,
+	0xcb, 0x18, 0x98, 0xc8, 0xbf, 0x8b, 0x8d, 0xec, 0x63, 0x58, 0x75, 0x69, 0x3c, 0x19, 0x26, 0xb1,
+	0x53, 0x62, 0xbe, 0xb0, 0x25, 0x7c, 0x81, 0x8b, 0xe6, 0x34, 0x37, 0xe5, 0xb9, 0xd5, 0x41, 0xef,
+	0x41, 0xfd, 0x60, 0x3c, 0x8e, 0xc2, 0xa9, 0x3f, 0xf2, 0x12, 0x3e, 0xee, 0xaa, 0xab, 0x43, 0xe4,
+	0x21, 0xd4, 0x5a, 0x61, 0x14, 0xd1, 0x5e, 0x42, 0xfb, 0x62, 0xf4, 0x0a, 0x40, 0x2a, 0x9b, 0xe2,
+	0xe7, 0x7e, 0x12, 0x3b, 0x55, 0x66, 0x35, 0x05, 0x20, 0xf5, 0x38, 0x4e, 0x98, 0x9c, 0xbe, 0x53,
+	0x63, 0xb2, 0x15, 0x40, 0x1e, 0x03, 0xbc, 0xa2, 0xd3, 0x44, 0x18, 0x16, 0x98, 0x68, 0x0d, 0x69,
+	0xfe, 0x43, 0x01, 0xd6, 0x74, 0x0b, 0xe4, 0x5c, 0x56, 0x85, 0x63, 0xd1, 0x08, 0x47, 0x02, 0x2b,
+	0xaf, 0xbc, 0x11, 0x4d, 0x3d, 0x05, 0x7f, 0x23, 0xd6, 0xf2, 0xa5, 0xb7, 0xb2, 0xdf, 0xe8, 0xac,
+	0x67, 0x09, 0x0e, 0x5b, 0x84, 0x26, 0x6b, 0xe0, 0x4c, 0x1d, 0x8f, 0xc6, 0xc3, 0xf0, 0x9a, 0x46,
+	0xcc, 0x1e, 0x35, 0x57, 0xb6, 0xb1, 0x07, 0x06, 0x46, 0xec, 0xac, 0xee, 0x95, 0xb0, 0x07, 0x6b,
+	0x34, 0xff, 0xad, 0x00, 0x5b, 0x07, 0x93, 0x24, 0xec, 0x85, 0xa3, 0xf1, 0x90, 0x26, 0x74, 0x39,
+	0xff, 0xde, 0x81, 0xca, 0x69, 0x44, 0xcf, 0xfd, 0x69, 0x9a, 0x5c, 0x78, 0x0b, 0x9f, 0xf9, 0xc2,
+	0x1f, 0xf9, 0x09, 0x53, 0xbd, 0xec, 0xf2, 0x86, 0xe9, 0x04, 0xe5, 0x25, 0x9c, 0xa0, 0xa2, 0xde,
+	0xa9, 0xff, 0x51, 0x80, 0x6d, 0x53, 0xff, 0xa5, 0xdc, 0x78, 0xd6, 0x00, 0x34, 0xf7, 0x5e, 0x59,
+	0xd4, 0xbd, 0xdf, 0x70, 0x64, 0xff, 0x58, 0x80, 0xcd, 0x17, 0x61, 0x78, 0x39, 0x19, 0x77, 0x5e,
+	0xff, 0x7c, 0xb9, 0x69, 0x79, 0x08, 0xb5, 0xce, 0xeb, 0x9f, 0xd3, 0x5e, 0xd2, 0xee, 0xf3, 0xf0,
+	0xac, 0xb9, 0x0a, 0xb8, 0xd5, 0xa5, 0xcd, 0xbf, 0x16, 0xe0, 0xae, 0xa6, 0xec, 0x77, 0x2c, 0x95,
+	0x34, 0x7f, 0x55, 0x4c, 0xd5, 0x6f, 0x07, 0xfd, 0xab, 0xe5, 0x8c, 0xdd, 0x80, 0xaa, 0xb0, 0xed,
+	0x51, 0xfa, 0xc2, 0x49, 0xdb, 0x5a, 0xb4, 0xaf, 0x18, 0xd1, 0x2e, 0x63, 0xb2, 0xac, 0xc5, 0xa4,
+	0x39, 0xb2, 0xca, 0x12, 0x23, 0x5b, 0x55, 0x49, 0xf2, 0x21, 0xd4, 0xce, 0x12, 0x2f, 0x4a, 0xd8,
+	0xfb, 0xb1, 0xca, 0x70, 0x05, 0x10, 0x07, 0x56, 0x8f, 0x83, 0x3e, 0xa3, 0xd5, 0x18, 0x2d, 0x6d,
+	0x36, 0xff, 0xac, 0x08, 0x44, 0xb7, 0xc8, 0x52, 0x33, 0xba, 0x8c, 0x49, 0x3e, 0x03, 0x68, 0x07,
+	0x7d, 0xff, 0xca, 0xef, 0x4f, 0xc4, 0xab, 0xb8, 0xfe, 0xec, 0xae, 0x70, 0x04, 0x45, 0x70, 0x35,
+	0x26, 0x65, 0xc5, 0xca, 0x4c, 0x2b, 0xae, 0x2e, 0x61, 0xc5, 0xaa, 0xcd, 0x3f, 0x5a, 0x5e, 0xd0,
+	0x7f, 0xeb, 0x1f, 0x51, 0xf3, 0xbf, 0xa4, 0x7f, 0x70, 0x8b, 0xfc, 0x9f, 0xf9, 0xc7, 0x3e, 0xd4,
+	0xf0, 0x89, 0x7e, 0x3f, 0x7d, 0xf9, 0xd5, 0x9f, 0x6d, 0x0a, 0xf7, 0x90, 0xb8, 0xab, 0x58, 0xc8,
+	0x8f, 0x00, 0x4e, 0xfc, 0xc0, 0x0b, 0x7a, 0x3e, 0x2e, 0xd7, 0xb9, 0xb5, 0xee, 0xa5, 0x1d, 0x46,
+	0xe3, 0x40, 0x11, 0x5d, 0x8d, 0xd1, 0xfe, 0xb6, 0x34, 0x2d, 0x5f, 0x5d, 0xc2, 0xf2, 0x35, 0x65,
+	0xf9, 0x0f, 0xa0, 0xe2, 0x86, 0xc3, 0xe1, 0x64, 0xec, 0x80, 0xe1, 0xe4, 0xcc, 0x9e, 0x8c, 0xe0,
+	0x0a, 0x06, 0xdd, 0xfd, 0x46, 0xcb, 0xbe, 0xa2, 0xff, 0xdf, 0xba, 0xdf, 0x68, 0xe9, 0x97, 0xfe,
+	0xb2, 0xee, 0x17, 0x8e, 0x46, 0x7e, 0x92, 0xd0, 0x9c, 0xfb, 0xa5, 0xb8, 0xab, 0x58, 0x70, 0x96,
+	0xbb, 0xd3, 0x23, 0x2f, 0xf1, 0x9c, 0x8a, 0x39, 0xcb, 0xa3, 0x84, 0x72, 0x82, 0x2b, 0x18, 0x32,
+	0x9e, 0xba, 0x9a, 0xf1, 0xd4, 0x84, 0xde, 0xe4, 0xa9, 0xd5, 0x99, 0x93, 0x54, 0x5b, 0x62, 0x92,
+	0x40, 0x65, 0xbf, 0xcf, 0xa0, 0x26, 0xcb, 0xda, 0xdc, 0x42, 0x56, 0x56, 0x4d, 0x45, 0xbd, 0x6a,
+	0xfa, 0xdb, 0x8a, 0x9e, 0xc6, 0x6d, 0x05, 0x1b, 0x5b, 0xe5, 0x16, 0x2d, 0xab, 0xdc, 0x92, 0x6d,
+	0x95, 0xbb, 0xa2, 0xaf, 0x72, 0x37, 0xa1, 0xf4, 0x33, 0x7f, 0x9c, 0xbe, 0xbf, 0x7f, 0xe6, 0x8f,
+	0x71, 0x39, 0xde, 0xe9, 0xf5, 0x26, 0x63, 0x2f, 0xf1, 0xc3, 0x40, 0x2c, 0xa2, 0x34, 0xc4, 0x58,
+	0x17, 0xaf, 0x66, 0xd6, 0xc5, 0x4d, 0x58, 0xeb, 0x46, 0x5e, 0x10, 0x7b, 0x3d, 0x64, 0x4d, 0xcd,
+	0x68, 0x60, 0x58, 0x6a, 0xb0, 0x71, 0x75, 0x26, 0xc9, 0xc1, 0x28, 0x61, 0xf6, 0x2c, 0xba, 0x3a,
+	0xa4, 0x73, 0x74, 0xa7, 0xb1, 0x03, 0x26, 0x47, 0x77, 0x1a, 0xa3, 0x0e, 0x07, 0x57, 0x83, 0xee,
+	0xb4, 0x33, 0x49, 0x9c, 0x3a, 0x23, 0xcb, 0x36, 0xea, 0xcf, 0x58, 0xdb, 0x01, 0x8a, 0x5f, 0x63,
+	0x54, 0x0d, 0xd1, 0xe8, 0x28, 0x7c, 0xdd, 0xa0, 0xa3, 0x6c, 0x07, 0x56, 0x99, 0xac, 0x76, 0xe0,
+	0x6c, 0x30, 0x62, 0xda, 0xe4, 0x85, 0x4a, 0x72, 0xe8, 0x0d, 0xbd, 0xa0, 0x47, 0x9d, 0x3b, 0xbc,
+	0xa7, 0x42, 0xc8, 0x8f, 0x61, 0xdd, 0xa5, 0x3d, 0x7f, 0xec, 0xd3, 0x20, 0x89, 0xf1, 0xe1, 0x9b,
+	0x7b, 0x25, 0xcd, 0xa7, 0xd5, 0x6e, 0x88, 0xc9, 0x46, 0xfe, 0x40, 0xef, 0x87, 0x4a, 0xdd, 0x65,
+	0xfd, 0xde, 0xcd, 0xbd, 0xa9, 0xf7, 0x0d, 0x36, 0xbe, 0x21, 0x60, 0x76, 0x25, 0x9f, 0x02, 0x9c,
+	0xd1, 0xa0, 0x4f, 0x23, 0xa6, 0x00, 0x99, 0xa1, 0x80, 0xc6, 0x43, 0x0e, 0x64, 0x0f, 0x7c, 0xf4,
+	0x16, 0xeb, 0xf1, 0xbd, 0xfc, 0xa3, 0x15, 0x0f, 0x7f, 0xae, 0xd6, 0xa9, 0xf1, 0x05, 0x90, 0xbc,
+	0x66, 0x8b, 0xec, 0x47, 0x34, 0x7e, 0x1f, 0xee, 0x64, 0x1e, 0xb0, 0xd0, 0x76, 0xc6, 0xaf, 0x8b,
+	0x5a, 0x2a, 0x99, 0x2b, 0x42, 0x1a, 0x50, 0xed, 0x46, 0x34, 0xd6, 0xea, 0x43, 0xd9, 0x5e, 0xa0,
+	0x46, 0x14, 0xd1, 0x53, 0x51, 0xd1, 0xb3, 0x07, 0xf5, 0x23, 0x1a, 0xfb, 0x83, 0x80, 0x87, 0x0f,
+	0x0f, 0x10, 0x1d, 0x42, 0xe9, 0xdd, 0xeb, 0x31, 0x15, 0x19, 0x9a, 0xfd, 0x56, 0x9b, 0x28, 0x35,
+	0x7d, 0x13, 0xe5, 0x31, 0x26, 0xb1, 0xa1, 0x1f, 0x0c, 0x4e, 0x22, 0xfa, 0x8b, 0xb4, 0x30, 0x56,
+	0x08, 0x7a, 0x6a, 0x27, 0x1a, 0x30, 0x61, 0x75, 0x9e, 0xd2, 0x45, 0x13, 0xe3, 0xb0, 0x15, 0x06,
+	0x01, 0xab, 0xcd, 0x3b, 0xd1, 0x80, 0x45, 0x41, 0xcd, 0x35, 0x30, 0xb6, 0x97, 0xe1, 0x05, 0xfd,
+	0xf6, 0x91, 0xb3, 0x2e, 0xf6, 0x32, 0x58, 0xab, 0xf9, 0x4f, 0x55, 0x6d, 0x55, 0x30, 0x97, 0x2d,
+	0xa5, 0xf6, 0x25, 0x5d, 0x7b, 0xcc, 0x13, 0x43, 0xda, 0x4b, 0x82, 0xaf, 0x23, 0x51, 0xb2, 0xca,
+	0x36, 0x5a, 0xa9, 0x73, 0x7e, 0xee, 0xf7, 0xa8, 0x6e, 0x53, 0x1d, 0x42, 0xed, 0x78, 0x53, 0x18,
+	0x57, 0xb4, 0xd0, 0xe2, 0xa7, 0xad, 0x56, 0xfa, 0xda, 0x3b, 0x6d, 0xb5, 0xe4, 0x6c, 0x55, 0x6d,
+	0xb3, 0x55, 0xb3, 0xcc, 0x16, 0xa8, 0xd9, 0x7a, 0x0a, 0x77, 0x3a, 0xc9, 0x05, 0x8d, 0x0e, 0xce,
+	0xcf, 0xfd, 0xa1, 0xef, 0x25, 0x34, 0x76, 0xea, 0x2c, 0x65, 0x65, 0x61, 0xf2, 0x21, 0x6c, 0xea,
+	0x59, 0xec, 0x85, 0x1f, 0x63, 0x6e, 0x41, 0xd6, 0x1c, 0xce, 0x78, 0x31, 0xd4, 0x8e, 0x7c, 0xdc,
+	0x1d, 0xe1, 0x79, 0x88, 0xe7, 0x99, 0x1c, 0x9e, 0xe3, 0xc5, 0x18, 0xdc, 0xb0, 0xf0, 0x62, 0x6c,
+	0xe3, 0x26, 0xcd, 0xd5, 0x20, 0x45, 0x44, 0x02, 0xd2, 0x21, 0xf2, 0x11, 0xdc, 0xd5, 0x7a, 0x89,
+	0x0c, 0xbb, 0xc9, 0xf8, 0xf2, 0x84, 0x3c, 0x37, 0xcf, 0x3d, 0x16, 0x6e, 0x7c, 0x7a, 0x13, 0xd6,
+	0xe4, 0xa3, 0x30, 0xef, 0x12, 0xc6, 0x68, 0x60, 0x64, 0x1f, 0x88, 0xca, 0x87, 0x1c, 0xee, 0x4e,
+	0x9d, 0x2d, 0xc6, 0x69, 0xa1, 0x90, 0x23, 0xd8, 0xe6, 0xbf, 0x8d, 0x84, 0x18, 0x3b, 0xdb, 0x33,
+	0xf2, 0x96, 0x95, 0x9b, 0xfc, 0x11, 0x6c, 0x65, 0x71, 0x1c, 0xc9, 0x3d, 0x26, 0xe4, 0x83, 0xec,
+	0x82, 0x76, 0xdf, 0xc2, 0xcb, 0x53, 0x9a, 0x4d, 0x0a, 0xf9, 0x29, 0xdc, 0xe5, 0xb0, 0x4a, 0x99,
+	0xb1, 0xb3, 0x33, 0x43, 0xbf, 0x3c, 0x2b, 0x71, 0x61, 0xd3, 0x00, 0x51, 0xb3, 0xfb, 0xac, 0xfb,
+	0x7b, 0x33, 0x34, 0xcb, 0x66, 0xda, 0x5c, 0xff, 0xc6, 0x09, 0x38, 0xb3, 0x06, 0xb1, 0x50, 0xd6,
+	0x6d, 0xc1, 0x3d, 0xeb, 0x23, 0x17, 0xca, 0xbd, 0xbf, 0x59, 0x85, 0x0d, 0x73, 0xf1, 0xaf, 0xa5,
+	0x96, 0x82, 0x9e, 0x5a, 0xac, 0xc9, 0xc3, 0x81, 0x55, 0x96, 0x2f, 0x5a, 0x7d, 0x91, 0x3e, 0xd2,
+	0xe6, 0x8c, 0x9d, 0xe5, 0x77, 0x61, 0x9d, 0xd9, 0xdb, 0xa5, 0x3d, 0xea, 0x8f, 0x93, 0x58, 0xec,
+	0x30, 0x9b, 0x20, 0x5b, 0x42, 0x60, 0x58, 0x9e, 0x44, 0x07, 0x93, 0xe4, 0xc2, 0xa9, 0x88, 0x25,
+	0x84, 0x82, 0xa4, 0x9c, 0x23, 0x3f, 0x7e, 0x1d, 0xe3, 0x9c, 0xae, 0x6a, 0x72, 0x52, 0x50, 0xca,
+	0xe9, 0x86, 0x4c, 0x4e, 0x55, 0x93, 0xc3, 0x21, 0x36, 0xd6, 0xce, 0xf3, 0xc3, 0xce, 0xa9, 0x58,
+	0xc9, 0x88, 0x96, 0xc0, 0x5b, 0x9d, 0x53, 0xb1, 0x7e, 0x11, 0x2d, 0xb6, 0x8f, 0xea, 0x05, 0xfd,
+	0x56, 0x18, 0x24, 0xb1, 0x58, 0xbb, 0x28, 0x20, 0xa5, 0xbe, 0x08, 0xbd, 0x20, 0x16, 0x6b, 0x17,
+	0x05, 0xb0, 0xa5, 0x19, 0xe6, 0x25, 0x4e, 0x16, 0x4b, 0x17, 0x85, 0xe0, 0x98, 0x52, 0x66, 0x97,
+	0x8e, 0xbd, 0x6b, 0x91, 0x49, 0x4c, 0x90, 0xbc, 0x07, 0x1b, 0xb2, 0x0f, 0x67, 0xe3, 0x99, 0x24,
+	0x83, 0xf2, 0x57, 0xd9, 0xeb, 0x24, 0xee, 0x7c, 0x43, 0xfb, 0x87, 0xd7, 0x22, 0x8d, 0xe8, 0x10,
+	0x4a, 0x12, 0x0b, 0xa7, 0xfe, 0x15, 0x1f, 0x10, 0xcf, 0x1e, 0x19, 0x34, 0x9b, 0xee, 0x49, 0x3e,
+	0xdd, 0xa3, 0x4e, 0xac, 0x79, 0xe4, 0xc7, 0x49, 0xe4, 0xf7, 0x12, 0x96, 0x34, 0x6a, 0x6e, 0x06,
+	0xc5, 0x24, 0x74, 0x36, 0xa6, 0x3d, 0xf6, 0x22, 0xc1, 0xf7, 0xeb, 0x36, 0x7f, 0xb1, 0xe9, 0x18,
+	0xf2, 0x9c, 0x46, 0xfe, 0x48, 0xf2, 0xdc, 0xe3, 0x3c, 0x3a, 0x86, 0x1a, 0xb9, 0x93, 0x40, 0xb2,
+	0xec, 0x70, 0x8d, 0x34, 0x08, 0x39, 0xbe, 0xa4, 0x8a, 0xe3, 0x3e, 0xe7, 0xd0, 0x20, 0xd4, 0x59,
+	0x6b, 0x9e, 0xf6, 0x12, 0xc7, 0xe1, 0xa3, 0x37, 0x51, 0x69, 0x6f, 0xac, 0x3b, 0xb8, 0x95, 0x76,
+	0x35, 0x7b, 0x4b, 0x94, 0x7d, 0x78, 0x48, 0xae, 0x39, 0x47, 0x83, 0x2f, 0x6a, 0xd3, 0x36, 0xf9,
+	0x1c, 0xa0, 0x75, 0x35, 0x38, 0x0e, 0xfa, 0x47, 0x68, 0xc0, 0x07, 0x37, 0xd6, 0x20, 0x1a, 0x37,
+	0x8e, 0x84, 0xef, 0x3b, 0x9d, 0x4f, 0x82, 0x7e, 0xec, 0x3c, 0xe4, 0xf3, 0xa8, 0x41, 0xc8, 0xc1,
+	0x4b, 0x3f, 0xce, 0xf1, 0x88, 0x73, 0x68, 0x50, 0xf3, 0x37, 0x65, 0xd8, 0x30, 0xeb, 0x26, 0xe6,
+	0xe0, 0xa3, 0x84, 0x6a, 0x41, 0xce, 0x5a, 0xf9, 0x00, 0x2d, 0xda, 0x02, 0x14, 0x57, 0xe1, 0xd3,
+	0xf8, 0x24, 0x0a, 0x47, 0x07, 0xe7, 0xe7, 0x4e, 0x49, 0xac, 0xc2, 0x25, 0x82, 0x81, 0xa0, 0xbc,
+	0x6a, 0x85, 0x07, 0x82, 0x04, 0x64, 0x20, 0x70, 0x72, 0x59, 0x0b, 0x04, 0x69, 0xca, 0x34, 0xa6,
+	0x44, 0xec, 0xcb, 0xb6, 0x19, 0x62, 0xab, 0x96, 0x10, 0x63, 0x8a, 0x72, 0x72, 0x55, 0xab, 0x0e,
+	0x38, 0x3d, 0xfd, 0xd0, 0x81, 0x19, 0x42, 0x44, 0xbc, 0x02, 0x30, 0x99, 0x75, 0xa7, 0xdd, 0x10,
+	0x87, 0xc4, 0xa3, 0x3e, 0x6d, 0x66, 0x27, 0xa1, 0x9e, 0x9f, 0x84, 0x26, 0xac, 0xb1, 0x11, 0xa4,
+	0x2c, 0x3c, 0xfa, 0x0d, 0x0c, 0x9f, 0xae, 0xa2, 0x96, 0xc7, 0xbf, 0x02, 0xf0, 0xe9, 0x2d, 0x2f,
+	0xbe, 0xc0, 0x5c, 0x24, 0x2a, 0x17, 0xd1, 0x4c, 0x29, 0x98, 0x8d, 0xee, 0x28, 0x8a, 0x48, 0x47,
+	0x32, 0xa2, 0x45, 0x88, 0x2b, 0x00, 0x5d, 0xf7, 0x55, 0x18, 0x9c, 0xd0, 0x7e, 0x77, 0x1a, 0xbb,
+	0xb4, 0x77, 0xd5, 0x4f, 0x03, 0xdc, 0x44, 0x71, 0x1d, 0x85, 0xb6, 0xed, 0x86, 0xd2, 0xa5, 0xc5,
+	0xf2, 0x20, 0x0b, 0xa3, 0xd7, 0xb4, 0x83, 0xfe, 0xf1, 0x74, 0x2c, 0x56, 0x05, 0xa2, 0xc5, 0xbf,
+	0xba, 0x45, 0xc9, 0x35, 0x52, 0xb6, 0x85, 0xf3, 0x8b, 0x36, 0x4a, 0xe7, 0xcf, 0x3b, 0xbb, 0xf0,
+	0x22, 0xca, 0x3a, 0xdf, 0xe3, 0xd2, 0x33, 0x30, 0xf9, 0x3d, 0xa8, 0xb7, 0x42, 0x15, 0x27, 0x3b,
+	0x37, 0xc6, 0x89, 0xce, 0xde, 0xfc, 0xfb, 0x87, 0x00, 0x6a, 0x2f, 0x61, 0xa6, 0x83, 0xab, 0xb7,
+	0x5b, 0xd1, 0x78, 0xbb, 0xd9, 0x97, 0xc1, 0xfb, 0x40, 0xd0, 0x06, 0x91, 0xff, 0x7a, 0xc2, 0x56,
+	0x88, 0x7c, 0x39, 0xc8, 0x3d, 0xda, 0x42, 0xb1, 0xf0, 0x77, 0xa7, 0xa9, 0x8b, 0x5b, 0x28, 0xb8,
+	0x88, 0x3b, 0xb8, 0x1a, 0xe8, 0x84, 0x76, 0x20, 0x7c, 0x3e, 0x4f, 0x40, 0xe9, 0xc2, 0xa1, 0x78,
+	0x1c, 0x72, 0x6d, 0x78, 0x14, 0x58, 0x28, 0x16, 0xfe, 0xee, 0x34, 0x0d, 0x0b, 0x0b, 0x05, 0xc3,
+	0xe7, 0xe0, 0x6a, 0xc0, 0x08, 0xed, 0x40, 0xc4, 0x87, 0x86, 0xc8, 0xe5, 0x6e, 0x3b, 0xe8, 0x85,
+	0x23, 0x3f, 0x18, 0xe0, 0xd3, 0x41, 0x5b, 0xee, 0x6a, 0x78, 0x8e, 0xb7, 0x3b, 0x4d, 0xe3, 0x26,
+	0x87, 0x8b, 0xa5, 0x71, 0x8a, 0x88, 0xd8, 0xd1, 0x21, 0xb9, 0x35, 0x71, 0x2e, 0x4a, 0x63, 0x1e,
+	0x3d, 0x06, 0x66, 0xf0, 0xa8, 0x85, 0xb8, 0x81, 0x89, 0x27, 0xa5, 0x90, 0xb6, 0x08, 0x4f, 0x21,
+	0x96, 0x00, 0xd3, 0x1e, 0xac, 0x4e, 0xd8, 0x64, 0x75, 0x82, 0x09, 0xa2, 0x53, 0x1f, 0x4f, 0xc7,
+	0x34, 0xe8, 0xfb, 0xc9, 0x24, 0xa2, 0x4c, 0x25, 0x1e, 0x5b, 0x59, 0x38, 0xcb, 0x89, 0x8a, 0x91,
+	0x3c, 0x27, 0xea, 0xf6, 0x1e, 0x6c, 0x1c, 0x5c, 0x0d, 0x34, 0x54, 0x04, 0x59, 0x06, 0x95, 0x96,
+	0xed, 0x4c, 0x92, 0x41, 0x28, 0x66, 0x61, 0x5b, 0xb3, 0xac, 0x86, 0xe7, 0x78, 0xf9, 0xca, 0x3a,
+	0xcf, 0xab, 0x6c, 0x93, 0x22, 0xce, 0x8e, 0xb4, 0x4d, 0x0a, 0x65, 0xb6, 0x50, 0xee, 0xe7, 0xb6,
+	0x50, 0x9e, 0xc3, 0x4e, 0x37, 0x1c, 0xa7, 0x89, 0x9e, 0x39, 0x6e, 0xc8, 0xe7, 0xcb, 0x99, 0xb1,
+	0xe4, 0x9e, 0xc1, 0x4f, 0xa8, 0x55, 0x12, 0x6a, 0xbf, 0xcb, 0x24, 0x7d, 0x9c, 0xdb, 0x3c, 0xdc,
+	0xb7, 0xf3, 0xf3, 0x45, 0xf8, 0x0c, 0x61, 0xe4, 0x15, 0xec, 0x76, 0x43, 0xb6, 0x71, 0xda, 0x89,
+	0x06, 0x59, 0x9d, 0x1b, 0x33, 0x74, 0x9e, 0xdd, 0x85, 0x04, 0xb3, 0xe4, 0xa1, 0xe6, 0x0f, 0x98,
+	0xbc, 0x4f, 0xad, 0x9a, 0xdb, 0xbb, 0x70, 0xe5, 0x67, 0x8b, 0x24, 0x9f, 0xc3, 0x9d, 0xd4, 0x2f,
+	0x5d, 0xda, 0x63, 0x5a, 0x3f, 0x9c, 0xa1, 0x75, 0x96, 0x91, 0x9c, 0x9a, 0x7d, 0x51, 0xc3, 0x47,
+	0x66, 0x65, 0xa3, 0x69, 0x68, 0x32, 0x72, 0xbd, 0xb2, 0xdd, 0xc9, 0x21, 0x6c, 0x75, 0xc3, 0xf1,
+	0xf1, 0x74, 0x6c, 0xee, 0xa3, 0x3d, 0x9e, 0xa1, 0x91, 0x8d, 0x99, 0xfc, 0x71, 0x5e, 0x06, 0x6a,
+	0xf6, 0x0e, 0x93, 0xf1, 0xa1, 0xd5, 0x76, 0x59, 0x66, 0x51, 0x0e, 0x5a, 0x28, 0xe4, 0x25, 0x6c,
+	0xa4, 0x6b, 0x3b, 0x91, 0x3c, 0xf7, 0x98, 0xe0, 0x27, 0x79, 0xc1, 0x26, 0x1f, 0x97, 0x99, 0xe9,
+	0x9c, 0x11, 0x87, 0x7a, 0x7e, 0x6f, 0x0e, 0x71, 0x52, 0xc5, 0x4c, 0x67, 0x72, 0x04, 0x75, 0x3c,
+	0xa7, 0x72, 0xe8, 0x07, 0xcc, 0x6e, 0x4d, 0x26, 0xab, 0x99, 0x97, 0xa5, 0x31, 0x71, 0x41, 0x7a,
+	0x37, 0x5d, 0x0a, 0x6a, 0xf4, 0xfd, 0x9b, 0xa4, 0x48, 0x75, 0xf4, 0x6e, 0xe8, 0x1d, 0xd8, 0x3c,
+	0xa5, 0x51, 0x8f, 0x06, 0x89, 0x3f, 0xa4, 0xb1, 0xf3, 0xee, 0x2c, 0xef, 0xc8, 0x30, 0x0a, 0xef,
+	0xc8, 0xa0, 0xb8, 0x4f, 0xda, 0x0a, 0x83, 0xfe, 0xc4, 0x4f, 0xdf, 0x5b, 0x4f, 0x8c, 0x7d, 0x52,
+	0x4d, 0x9e, 0xc1, 0x26, 0xf6, 0x49, 0x0d, 0xcc, 0x94, 0x85, 0xa3, 0x7c, 0xef, 0x66, 0x59, 0x6a,
+	0xcf, 0xd5, 0xc0, 0x30, 0xed, 0x1e, 0x7b, 0xd1, 0xc8, 0x8b, 0x2e, 0x69, 0x9f, 0x2b, 0xf6, 0x3e,
+	0x4f, 0xbb, 0x26, 0x9a, 0xe1, 0xc3, 0x87, 0x3e, 0xcd, 0xf1, 0xa1, 0x3c, 0x4c, 0xf8, 0x29, 0x22,
+	0xf6, 0x70, 0x3e, 0x10, 0x09, 0xdf, 0x84, 0xb3, 0x9c, 0x28, 0xf2, 0xc3, 0x3c, 0x27, 0xca, 0xfc,
+	0x13, 0xd8, 0x16, 0x90, 0x19, 0x5a, 0x3f, 0x60, 0xc3, 0xfe, 0x81, 0xc5, 0xdd, 0x2c, 0xdc, 0x7c,
+	0xf4, 0x56, 0x41, 0xd6, 0x07, 0xa0, 0x3e, 0x1f, 0xcd, 0xfd, 0x00, 0x69, 0x5e, 0xab, 0x20, 0xdc,
+	0xa7, 0xee, 0x4e, 0xbf, 0xf2, 0x83, 0x3e, 0xd3, 0xfb, 0x63, 0x63, 0x9f, 0x5a, 0x0f, 0x67, 0xc9,
+	0xc3, 0x85, 0x69, 0x9d, 0x34, 0x11, 0xa8, 0xd9, 0xfe, 0x0d, 0x22, 0xa4, 0x3e, 0x5a, 0xa7, 0x46,
+	0x1b, 0x1e, 0x7c, 0xcb, 0x6b, 0x62, 0xa1, 0xdd, 0x97, 0x17, 0xf0, 0xf8, 0xdb, 0xf3, 0xf6, 0x42,
+	0xd2, 0x0e, 0x61, 0xdb, 0x96, 0x63, 0x17, 0x92, 0x71, 0x02, 0xce, 0xac, 0x6c, 0xb8, 0x90, 0x9c,
+	0x03, 0xd8, 0xb2, 0x24, 0xbf, 0x37, 0x10, 0xb1, 0x94, 0x16, 0x3f, 0x85, 0xcd, 0x6c, 0x9e, 0x5b,
+	0xb6, 0xff, 0xb2, 0x33, 0x62, 0xcb, 0x6b, 0x0b, 0xc9, 0xf8, 0x02, 0x88, 0xca, 0x35, 0x4b, 0x8d,
+	0xc2, 0x90, 0xb0, 0xd4, 0x38, 0xbe, 0x84, 0xdd, 0x99, 0xc9, 0xe0, 0x8d, 0x05, 0x2d, 0xfb, 0xb5,
+	0x28, 0x13, 0xe6, 0x4b, 0x76, 0x5f, 0x6a, 0xc3, 0xf3, 0xaf, 0x1f, 0x01, 0xa8, 0x83, 0x05, 0x33,
+	0x37, 0x3b, 0x65, 0x39, 0x58, 0xcc, 0x7c, 0xd3, 0x91, 0x1f, 0xaa, 0xd2, 0x73, 0x64, 0x1a, 0xf2,
+	0xb6, 0x5c, 0x34, 0xca, 0xc5, 0x67, 0xb0, 0xdd, 0x0e, 0x12, 0x1a, 0x05, 0xde, 0xd0, 0x28, 0xde,
+	0x78, 0xc9, 0x68, 0xa5, 0x59, 0xfb, 0xa8, 0xd2, 0xd1, 0x4a, 0xb3, 0x96, 0xa5, 0x6b, 0x0b, 0x94,
+	0xa5, 0xeb, 0xf3, 0x95, 0xa5, 0x1b, 0x37, 0x97, 0xa5, 0x77, 0xe6, 0x28, 0x4b, 0x37, 0x6f, 0x2e,
+	0x4b, 0xef, 0xe6, 0xcb, 0x52, 0x4b, 0xc1, 0x49, 0xe6, 0x2e, 0x38, 0xb7, 0xe6, 0x2d, 0x38, 0xb7,
+	0xe7, 0x2e, 0x38, 0xef, 0x2d, 0x50, 0x70, 0xee, 0xcc, 0x57, 0x70, 0xde, 0xbf, 0xa9, 0xe0, 0x74,
+	0x16, 0x28, 0x38, 0x77, 0x6f, 0xad, 0xe0, 0x6c, 0x98, 0x05, 0xa7, 0x4c, 0x1d, 0xb7, 0x5f, 0x70,
+	0x3e, 0xb8, 0xe5, 0x82, 0xf3, 0xa1, 0x59, 0x70, 0x1a, 0x9a, 0xdf, 0x5e, 0xc1, 0xf9, 0xe8, 0x0d,
+	0x0a, 0xce, 0xc7, 0xb9, 0x4f, 0x69, 0xa9, 0x86, 0x6f, 0x52, 0x70, 0xbe, 0x73, 0x0b, 0x05, 0xe7,
+	0x9e, 0x59, 0x70, 0x1a, 0xb6, 0x7b, 0xa3, 0x82, 0x33, 0x53, 0x21, 0x2a, 0xc1, 0xcb, 0x15, 0x9c,
+	0xcd, 0x39, 0xc4, 0xcd, 0x5b, 0x70, 0x66, 0x4a, 0x45, 0x25, 0x6b, 0xa1, 0x82, 0xf3, 0xdd, 0x9b,
+	0xa4, 0xcc, 0x5d, 0x70, 0x3e, 0x99, 0xe5, 0x1d, 0x4b, 0x16, 0x9c, 0x99, 0x22, 0x51, 0xc9, 0x5b,
+	0xa2, 0xe0, 0x7c, 0xff, 0x66, 0x59, 0xb3, 0x0a, 0x4e, 0x3c, 0xde, 0x91, 0x7e, 0x32, 0x44, 0xb5,
+	0x78, 0x19, 0x69, 0x60, 0x29, 0x0f, 0xfb, 0x6a, 0xa1, 0x2a, 0x48, 0x03, 0xc3, 0x54, 0x6b, 0x7c,
+	0x1a, 0x44, 0x3e, 0x5e, 0x3f, 0xe6, 0x70, 0xdc, 0xd5, 0x3c, 0xa3, 0xc3, 0xf3, 0x93, 0x49, 0xd0,
+	0xa7, 0x7d, 0x5e, 0x39, 0xb2, 0xcf, 0x3a, 0x06, 0x88, 0xaf, 0x0e, 0x05, 0xb0, 0x7d, 0x79, 0xe7,
+	0x23, 0xfe, 0xea, 0xc8, 0xc0, 0xf8, 0x9a, 0x96, 0xba, 0x74, 0x26, 0x49, 0x9c, 0xe0, 0x57, 0xf0,
+	0x60, 0xe0, 0x7c, 0xcc, 0x5f, 0xd3, 0x36, 0x1a, 0xce, 0xb0, 0xc4, 0x0f, 0xaf, 0xd9, 0x59, 0xc6,
+	0xfd, 0x59, 0x33, 0x9c, 0x61, 0x14, 0x33, 0x9c, 0x41, 0xc9, 0x19, 0xb7, 0x00, 0x1b, 0x65, 0x2a,
+	0xf2, 0x13, 0x26, 0xf2, 0x7d, 0xbb, 0x48, 0x9d, 0x53, 0x7c, 0x9e, 0xcf, 0xc2, 0x99, 0x4a, 0xf5,
+	0x53, 0xb3, 0xcc, 0x54, 0xe2, 0xe6, 0xaf, 0x54, 0x3f, 0xbb, 0x41, 0xc4, 0xdb, 0x4a, 0xf5, 0x6d,
+	0xa5, 0xfa, 0xb6, 0x52, 0x65, 0xe3, 0xb0, 0x85, 0xfd, 0xa2, 0x67, 0x62, 0xac, 0x71, 0xfe, 0x1d,
+	0x2a, 0x50, 0xff, 0xa5, 0x08, 0x9b, 0x27, 0x7e, 0xd0, 0x3f, 0xf5, 0x92, 0x8b, 0x78, 0xe9, 0x4b,
+	0x96, 0x2c, 0x17, 0x96, 0xcc, 0x5b, 0xa0, 0x67, 0xe1, 0x24, 0xea, 0xe1, 0x17, 0x51, 0x71, 0xbb,
+	0x35, 0x6d, 0x23, 0xad, 0xeb, 0x45, 0x03, 0x8a, 0xc7, 0xbf, 0xf9, 0x69, 0x3e, 0xd9, 0x26, 0x6b,
+	0x50, 0xf8, 0x4a, 0xdc, 0xa4, 0x2c, 0x7c, 0x85, 0x9f, 0xa2, 0x5f, 0x7a, 0xd3, 0xe7, 0xe1, 0x98,
+	0x7f, 0x7c, 0x2f, 0xbb, 0x69, 0x13, 0x0b, 0x69, 0xbc, 0xd0, 0x7a, 0x98, 0x1e, 0xe5, 0x13, 0x2d,
+	0xfc, 0x44, 0xfd, 0xd2, 0x0f, 0x0e, 0x46, 0xe1, 0x24, 0x48, 0x8f, 0x0b, 0x2b, 0xc0, 0x3c, 0x9c,
+	0x0d, 0x4b, 0x1c, 0xce, 0xae, 0xab, 0xc3, 0xd9, 0xff, 0x5c, 0x80, 0xbb, 0x9a, 0xe1, 0x96, 0x3a,
+	0x08, 0xff, 0x04, 0xcb, 0xfe, 0xe4, 0x22, 0xbd, 0x77, 0x95, 0x5e, 0xce, 0x3e, 0x19, 0x86, 0xdf,
+	0x20, 0xee, 0x72, 0xea, 0xad, 0xde, 0xb8, 0xfa, 0xd3, 0x02, 0x54, 0x53, 0xf9, 0xa4, 0x09, 0x2b,
+	0xcc, 0xb8, 0xfc, 0x32, 0xf5, 0x86, 0xf6, 0xf8, 0xe7, 0xe1, 0xd8, 0x65, 0x34, 0x2c, 0x8a, 0x0e,
+	0xc3, 0x24, 0x19, 0xd2, 0x80, 0xf6, 0x2e, 0x85, 0xff, 0x68, 0x08, 0x2b, 0xcb, 0x13, 0x9e, 0xe4,
+	0x69, 0x3f, 0x3d, 0x9c, 0xa1, 0x10, 0x76, 0xbc, 0x92, 0xbd, 0xdb, 0xf9, 0xbe, 0x04, 0x6f, 0x34,
+	0xff, 0xa6, 0x00, 0xab, 0xe2, 0x39, 0xe8, 0x3f, 0x78, 0x92, 0x43, 0x18, 0x8d, 0xfd, 0x46, 0xab,
+	0xe1, 0xff, 0xda, 0x09, 0x30, 0xd9, 0xc6, 0x63, 0xa6, 0xdd, 0x50, 0x78, 0x5b, 0xb1, 0x1b, 0xa2,
+	0x2f, 0x74, 0x43, 0xc6, 0x29, 0xae, 0x0c, 0xf0, 0x96, 0x3c, 0x3c, 0x5b, 0xd6, 0x0e, 0xcf, 0xee,
+	0x40, 0x45, 0x38, 0x07, 0xdf, 0xc7, 0x10, 0x2d, 0x34, 0x54, 0x77, 0xca, 0xbd, 0xac, 0xe8, 0xe2,
+	0x4f, 0x3c, 0x26, 0x8c, 0x7f, 0x24, 0xe1, 0x15, 0x4d, 0xbe, 0x09, 0xa3, 0xcb, 0xe5, 0x22, 0xe3,
+	0xdb, 0x2e, 0x3a, 0xc8, 0xeb, 0x03, 0x2b, 0xfa, 0xf5, 0x81, 0x6d, 0x28, 0x1f, 0xd1, 0x71, 0x72,
+	0xc1, 0x94, 0x2e, 0xbb, 0xbc, 0x61, 0x7a, 0x75, 0x25, 0xeb, 0xd5, 0x0d, 0xa8, 0xbe, 0xf4, 0xa6,
+	0xaf, 0xc2, 0x3e, 0x4d, 0xc3, 0x44, 0xb6, 0x8d, 0x0b, 0xca, 0xd5, 0xcc, 0x05, 0xe5, 0x87, 0x50,
+	0xc3, 0xdf, 0xdd, 0xf0, 0x92, 0x06, 0xe2, 0xf0, 0xab, 0x02, 0x6e, 0x35, 0x56, 0xfe, 0xaa, 0x04,
+	0x44, 0xb7, 0xe5, 0xad, 0xdf, 0x1a, 0xb1, 0x1b, 0xf3, 0x29, 0x94, 0xb9, 0x55, 0xca, 0x7b, 0x25,
+	0xed, 0x6f, 0x0d, 0x08, 0x35, 0x90, 0xe4, 0x72, 0x06, 0xe4, 0x3c, 0xee, 0x0f, 0x68, 0x7a, 0xaf,
+	0x3e, 0xc3, 0x89, 0x24, 0x97, 0x33, 0xc8, 0x33, 0x3f, 0xba, 0xb9, 0x35, 0x44, 0xd2, 0xb9, 0xb8,
+	0xaa, 0x46, 0xe7, 0xfd, 0xf1, 0x4c, 0x50, 0x34, 0x09, 0x7a, 0xfa, 0xf5, 0x66, 0x09, 0xe0, 0xc2,
+	0x19, 0x2f, 0x33, 0xab, 0x69, 0xe1, 0xe7, 0x8f, 0x4d, 0xd0, 0x9c, 0x9a, 0xfa, 0x12, 0x53, 0xb3,
+	0xa6, 0xa6, 0xe6, 0xd7, 0x05, 0xa8, 0x6b, 0x06, 0x99, 0xf7, 0xc6, 0x08, 0x0b, 0xac, 0x92, 0xed,
+	0x54, 0x7a, 0xf6, 0x6a, 0xbf, 0xe5, 0x24, 0xbc, 0x79, 0xeb, 0xa2, 0x92, 0xbb, 0x75, 0x91, 0xb9,
+	0xf5, 0xb1, 0x9a, 0xbf, 0xf5, 0x21, 0xc3, 0xa4, 0xaa, 0x85, 0x49, 0x33, 0x94, 0x43, 0x41, 0x5b,
+	0x5b, 0xf3, 0x0a, 0xcf, 0x1d, 0x45, 0x99, 0x3b, 0x6c, 0x43, 0x51, 0x39, 0x62, 0xc5, 0x96, 0x23,
+	0xca, 0x32, 0x47, 0x3c, 0xfb, 0xbb, 0x32, 0x94, 0xd9, 0x25, 0x7e, 0xf2, 0x05, 0xd4, 0xe4, 0x5f,
+	0x54, 0x21, 0xf7, 0x85, 0xfb, 0x64, 0xff, 0xc0, 0x4b, 0xc3, 0xc9, 0x13, 0x78, 0x28, 0x34, 0x7f,
+	0x8b, 0x9c, 0x40, 0x5d, 0xfb, 0xab, 0x00, 0x64, 0xd7, 0xb8, 0x83, 0xab, 0xff, 0x09, 0x84, 0x46,
+	0xc3, 0x46, 0x92, 0x72, 0xda, 0xb0, 0xa6, 0xdf, 0xcb, 0x26, 0x29, 0xb7, 0xe5, 0xb2, 0x79, 0xe3,
+	0x81, 0x95, 0x26, 0x45, 0x1d, 0xc1, 0xba, 0xbc, 0x5b, 0x4c, 0x7b, 0x89, 0x1a, 0x58, 0xf6, 0x7a,
+	0x74, 0xc3, 0xc9, 0x13, 0xb4, 0x81, 0xad, 0x7f, 0x49, 0x13, 0xed, 0x52, 0x92, 0xc9, 0xac, 0x5d,
+	0xfc, 0x6d, 0xec, 0x5a, 0x28, 0x52, 0xce, 0x31, 0xac, 0xa1, 0xdd, 0xe4, 0xcd, 0x0d, 0x53, 0x8c,
+	0x76, 0x41, 0xaf, 0xb1, 0x6b, 0xa1, 0x64, 0xc5, 0xc8, 0x4b, 0x0b, 0x19, 0x31, 0xea, 0x9a, 0x69,
+	0x63, 0xd7, 0x42, 0x91, 0x62, 0xbe, 0x80, 0x9a, 0x7c, 0xfb, 0x4b, 0xbb, 0x64, 0x17, 0x52, 0x0d,
+	0x27, 0x4f, 0x90, 0x12, 0x5a, 0x00, 0x2a, 0x27, 0x12, 0xcd, 0x35, 0xcc, 0x57, 0x4e, 0x63, 0xd7,
+	0x42, 0x91, 0x42, 0x9a, 0xb0, 0xf2, 0x2a, 0xec, 0x8c, 0xc9, 0x9a, 0x60, 0x62, 0x7f, 0x5c, 0xa8,
+	0x61, 0xb4, 0x5e, 0x57, 0x58, 0x4e, 0xf8, 0xe1, 0xff, 0x0e, 0x00, 0xd1, 0x42, 0xab, 0x7f, 0xb1,
+	0x48, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2997,6 +4705,8 @@ type IndexClient interface {
 	GetCaches(ctx context.Context, in *GetCachesRequest, opts ...grpc.CallOption) (*GetCachesResponse, error)
 	// take user-input search query and return a list of matching results
 	SearchIndex(ctx context.Context, in *SearchIndexRequest, opts ...grpc.CallOption) (*SearchIndexResponse, error)
+	// return the best-ranked names beginning with the user-input prefix
+	Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error)
 	// lookup object summary data for objec list by ID
 	LookupObjects(ctx context.Context, in *LookupObjRequest, opts ...grpc.CallOption) (*LookupObjResponse, error)
 	// get Individual datasets from DynamoDB
@@ -3005,6 +4715,10 @@ type IndexClient interface {
 	GetCommittee(ctx context.Context, in *LookupCmteRequest, opts ...grpc.CallOption) (*LookupCmteResponse, error)
 	// get Candidate datasets from DynamoDB
 	GetCandidate(ctx context.Context, in *LookupCandRequest, opts ...grpc.CallOption) (*LookupCandResponse, error)
+	// find the top money-flow paths between two entities
+	FindPaths(ctx context.Context, in *FindPathsRequest, opts ...grpc.CallOption) (*FindPathsResponse, error)
+	// get money-flow network graph nodes and weighted edges from root object
+	GetNetwork(ctx context.Context, in *GetNetworkRequest, opts ...grpc.CallOption) (*GetNetworkResponse, error)
 	// One empty request, ZERO processing, followed by one empty response
 	NoOp(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
@@ -3035,6 +4749,15 @@ func (c *indexClient) SearchIndex(ctx context.Context, in *SearchIndexRequest, o
 	return out, nil
 }
 
+func (c *indexClient) Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error) {
+	out := new(AutocompleteResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/Autocomplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexClient) LookupObjects(ctx context.Context, in *LookupObjRequest, opts ...grpc.CallOption) (*LookupObjResponse, error) {
 	out := new(LookupObjResponse)
 	err := c.cc.Invoke(ctx, "/index.Index/LookupObjects", in, out, opts...)
@@ -3071,6 +4794,24 @@ func (c *indexClient) GetCandidate(ctx context.Context, in *LookupCandRequest, o
 	return out, nil
 }
 
+func (c *indexClient) FindPaths(ctx context.Context, in *FindPathsRequest, opts ...grpc.CallOption) (*FindPathsResponse, error) {
+	out := new(FindPathsResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/FindPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *indexClient) GetNetwork(ctx context.Context, in *GetNetworkRequest, opts ...grpc.CallOption) (*GetNetworkResponse, error) {
+	out := new(GetNetworkResponse)
+	err := c.cc.Invoke(ctx, "/index.Index/GetNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *indexClient) NoOp(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/index.Index/NoOp", in, out, opts...)
@@ -3086,6 +4827,8 @@ type IndexServer interface {
 	GetCaches(context.Context, *GetCachesRequest) (*GetCachesResponse, error)
 	// take user-input search query and return a list of matching results
 	SearchIndex(context.Context, *SearchIndexRequest) (*SearchIndexResponse, error)
+	// return the best-ranked names beginning with the user-input prefix
+	Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
 	// lookup object summary data for objec list by ID
 	LookupObjects(context.Context, *LookupObjRequest) (*LookupObjResponse, error)
 	// get Individual datasets from DynamoDB
@@ -3094,6 +4837,10 @@ type IndexServer interface {
 	GetCommittee(context.Context, *LookupCmteRequest) (*LookupCmteResponse, error)
 	// get Candidate datasets from DynamoDB
 	GetCandidate(context.Context, *LookupCandRequest) (*LookupCandResponse, error)
+	// find the top money-flow paths between two entities
+	FindPaths(context.Context, *FindPathsRequest) (*FindPathsResponse, error)
+	// get money-flow network graph nodes and weighted edges from root object
+	GetNetwork(context.Context, *GetNetworkRequest) (*GetNetworkResponse, error)
 	// One empty request, ZERO processing, followed by one empty response
 	NoOp(context.Context, *Empty) (*Empty, error)
 }
@@ -3108,6 +4855,9 @@ func (*UnimplementedIndexServer) GetCaches(ctx context.Context, req *GetCachesRe
 func (*UnimplementedIndexServer) SearchIndex(ctx context.Context, req *SearchIndexRequest) (*SearchIndexResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchIndex not implemented")
 }
+func (*UnimplementedIndexServer) Autocomplete(ctx context.Context, req *AutocompleteRequest) (*AutocompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Autocomplete not implemented")
+}
 func (*UnimplementedIndexServer) LookupObjects(ctx context.Context, req *LookupObjRequest) (*LookupObjResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupObjects not implemented")
 }
@@ -3120,6 +4870,12 @@ func (*UnimplementedIndexServer) GetCommittee(ctx context.Context, req *LookupCm
 func (*UnimplementedIndexServer) GetCandidate(ctx context.Context, req *LookupCandRequest) (*LookupCandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCandidate not implemented")
 }
+func (*UnimplementedIndexServer) FindPaths(ctx context.Context, req *FindPathsRequest) (*FindPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPaths not implemented")
+}
+func (*UnimplementedIndexServer) GetNetwork(ctx context.Context, req *GetNetworkRequest) (*GetNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetwork not implemented")
+}
 func (*UnimplementedIndexServer) NoOp(ctx context.Context, req *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NoOp not implemented")
 }
@@ -3164,6 +4920,24 @@ func _Index_SearchIndex_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Index_Autocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).Autocomplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/Autocomplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).Autocomplete(ctx, req.(*AutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Index_LookupObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LookupObjRequest)
 	if err := dec(in); err != nil {
@@ -3236,6 +5010,42 @@ func _Index_GetCandidate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Index_FindPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).FindPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/FindPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).FindPaths(ctx, req.(*FindPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Index_GetNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IndexServer).GetNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/index.Index/GetNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IndexServer).GetNetwork(ctx, req.(*GetNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Index_NoOp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -3266,6 +5076,10 @@ var _Index_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchIndex",
 			Handler:    _Index_SearchIndex_Handler,
 		},
+		{
+			MethodName: "Autocomplete",
+			Handler:    _Index_Autocomplete_Handler,
+		},
 		{
 			MethodName: "LookupObjects",
 			Handler:    _Index_LookupObjects_Handler,
@@ -3282,6 +5096,14 @@ var _Index_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCandidate",
 			Handler:    _Index_GetCandidate_Handler,
 		},
+		{
+			MethodName: "FindPaths",
+			Handler:    _Index_FindPaths_Handler,
+		},
+		{
+			MethodName: "GetNetwork",
+			Handler:    _Index_GetNetwork_Handler,
+		},
 		{
 			MethodName: "NoOp",
 			Handler:    _Index_NoOp_Handler,
@@ -61,6 +61,8 @@ type SearchRequest struct {
 	Text                 string               `protobuf:"bytes,2,opt,name=Text,proto3" json:"Text,omitempty"`
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Msg                  string               `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	PageSize             int32                `protobuf:"varint,5,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	Cursor               string               `protobuf:"bytes,6,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -119,11 +121,30 @@ func (m *SearchRequest) GetMsg() string {
 	return ""
 }
 
+func (m *SearchRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type SearchResponse struct {
 	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
 	Results              []*SearchResult      `protobuf:"bytes,2,rep,name=Results,proto3" json:"Results,omitempty"`
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Msg                  string               `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Approximate          bool                 `protobuf:"varint,5,opt,name=Approximate,proto3" json:"Approximate,omitempty"`
+	Corrected            string               `protobuf:"bytes,6,opt,name=Corrected,proto3" json:"Corrected,omitempty"`
+	TotalHits            int32                `protobuf:"varint,7,opt,name=TotalHits,proto3" json:"TotalHits,omitempty"`
+	Estimated            bool                 `protobuf:"varint,8,opt,name=Estimated,proto3" json:"Estimated,omitempty"`
+	NextCursor           string               `protobuf:"bytes,9,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -182,6 +203,41 @@ func (m *SearchResponse) GetMsg() string {
 	return ""
 }
 
+func (m *SearchResponse) GetApproximate() bool {
+	if m != nil {
+		return m.Approximate
+	}
+	return false
+}
+
+func (m *SearchResponse) GetCorrected() string {
+	if m != nil {
+		return m.Corrected
+	}
+	return ""
+}
+
+func (m *SearchResponse) GetTotalHits() int32 {
+	if m != nil {
+		return m.TotalHits
+	}
+	return 0
+}
+
+func (m *SearchResponse) GetEstimated() bool {
+	if m != nil {
+		return m.Estimated
+	}
+	return false
+}
+
+func (m *SearchResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type SearchResult struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Bucket               string   `protobuf:"bytes,2,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
@@ -269,6 +325,148 @@ func (m *SearchResult) GetYears() []string {
 	return nil
 }
 
+type AutocompleteRequest struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	Prefix               string               `protobuf:"bytes,2,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Limit                int32                `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AutocompleteRequest) Reset()         { *m = AutocompleteRequest{} }
+func (m *AutocompleteRequest) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteRequest) ProtoMessage()    {}
+func (*AutocompleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{4}
+}
+
+func (m *AutocompleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutocompleteRequest.Unmarshal(m, b)
+}
+func (m *AutocompleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutocompleteRequest.Marshal(b, m, deterministic)
+}
+func (m *AutocompleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteRequest.Merge(m, src)
+}
+func (m *AutocompleteRequest) XXX_Size() int {
+	return xxx_messageInfo_AutocompleteRequest.Size(m)
+}
+func (m *AutocompleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteRequest proto.InternalMessageInfo
+
+func (m *AutocompleteRequest) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AutocompleteRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *AutocompleteRequest) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AutocompleteRequest) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type AutocompleteResponse struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	Prefix               string               `protobuf:"bytes,2,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Results              []*SearchResult      `protobuf:"bytes,3,rep,name=Results,proto3" json:"Results,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,5,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AutocompleteResponse) Reset()         { *m = AutocompleteResponse{} }
+func (m *AutocompleteResponse) String() string { return proto.CompactTextString(m) }
+func (*AutocompleteResponse) ProtoMessage()    {}
+func (*AutocompleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{5}
+}
+
+func (m *AutocompleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutocompleteResponse.Unmarshal(m, b)
+}
+func (m *AutocompleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutocompleteResponse.Marshal(b, m, deterministic)
+}
+func (m *AutocompleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutocompleteResponse.Merge(m, src)
+}
+func (m *AutocompleteResponse) XXX_Size() int {
+	return xxx_messageInfo_AutocompleteResponse.Size(m)
+}
+func (m *AutocompleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutocompleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutocompleteResponse proto.InternalMessageInfo
+
+func (m *AutocompleteResponse) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *AutocompleteResponse) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AutocompleteResponse) GetResults() []*SearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *AutocompleteResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *AutocompleteResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
 type RankingsRequest struct {
 	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
 	Year                 string               `protobuf:"bytes,2,opt,name=Year,proto3" json:"Year,omitempty"`
@@ -286,7 +484,7 @@ func (m *RankingsRequest) Reset()         { *m = RankingsRequest{} }
 func (m *RankingsRequest) String() string { return proto.CompactTextString(m) }
 func (*RankingsRequest) ProtoMessage()    {}
 func (*RankingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{4}
+	return fileDescriptor_ad098daeda4239f7, []int{6}
 }
 
 func (m *RankingsRequest) XXX_Unmarshal(b []byte) error {
@@ -370,7 +568,7 @@ func (m *RankingsResponse) Reset()         { *m = RankingsResponse{} }
 func (m *RankingsResponse) String() string { return proto.CompactTextString(m) }
 func (*RankingsResponse) ProtoMessage()    {}
 func (*RankingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{5}
+	return fileDescriptor_ad098daeda4239f7, []int{7}
 }
 
 func (m *RankingsResponse) XXX_Unmarshal(b []byte) error {
@@ -435,7 +633,7 @@ func (m *RankingsResult) Reset()         { *m = RankingsResult{} }
 func (m *RankingsResult) String() string { return proto.CompactTextString(m) }
 func (*RankingsResult) ProtoMessage()    {}
 func (*RankingsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{6}
+	return fileDescriptor_ad098daeda4239f7, []int{8}
 }
 
 func (m *RankingsResult) XXX_Unmarshal(b []byte) error {
@@ -514,7 +712,7 @@ func (m *RankingEntry) Reset()         { *m = RankingEntry{} }
 func (m *RankingEntry) String() string { return proto.CompactTextString(m) }
 func (*RankingEntry) ProtoMessage()    {}
 func (*RankingEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{7}
+	return fileDescriptor_ad098daeda4239f7, []int{9}
 }
 
 func (m *RankingEntry) XXX_Unmarshal(b []byte) error {
@@ -593,7 +791,7 @@ func (m *YrTotalRequest) Reset()         { *m = YrTotalRequest{} }
 func (m *YrTotalRequest) String() string { return proto.CompactTextString(m) }
 func (*YrTotalRequest) ProtoMessage()    {}
 func (*YrTotalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{8}
+	return fileDescriptor_ad098daeda4239f7, []int{10}
 }
 
 func (m *YrTotalRequest) XXX_Unmarshal(b []byte) error {
@@ -670,7 +868,7 @@ func (m *YrTotalResponse) Reset()         { *m = YrTotalResponse{} }
 func (m *YrTotalResponse) String() string { return proto.CompactTextString(m) }
 func (*YrTotalResponse) ProtoMessage()    {}
 func (*YrTotalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{9}
+	return fileDescriptor_ad098daeda4239f7, []int{11}
 }
 
 func (m *YrTotalResponse) XXX_Unmarshal(b []byte) error {
@@ -734,7 +932,7 @@ func (m *YrTotalResult) Reset()         { *m = YrTotalResult{} }
 func (m *YrTotalResult) String() string { return proto.CompactTextString(m) }
 func (*YrTotalResult) ProtoMessage()    {}
 func (*YrTotalResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{10}
+	return fileDescriptor_ad098daeda4239f7, []int{12}
 }
 
 func (m *YrTotalResult) XXX_Unmarshal(b []byte) error {
@@ -806,7 +1004,7 @@ func (m *GetObjRequest) Reset()         { *m = GetObjRequest{} }
 func (m *GetObjRequest) String() string { return proto.CompactTextString(m) }
 func (*GetObjRequest) ProtoMessage()    {}
 func (*GetObjRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{11}
+	return fileDescriptor_ad098daeda4239f7, []int{13}
 }
 
 func (m *GetObjRequest) XXX_Unmarshal(b []byte) error {
@@ -888,7 +1086,7 @@ func (m *GetObjResponse) Reset()         { *m = GetObjResponse{} }
 func (m *GetObjResponse) String() string { return proto.CompactTextString(m) }
 func (*GetObjResponse) ProtoMessage()    {}
 func (*GetObjResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{12}
+	return fileDescriptor_ad098daeda4239f7, []int{14}
 }
 
 func (m *GetObjResponse) XXX_Unmarshal(b []byte) error {
@@ -967,7 +1165,7 @@ func (m *GetIndvRequest) Reset()         { *m = GetIndvRequest{} }
 func (m *GetIndvRequest) String() string { return proto.CompactTextString(m) }
 func (*GetIndvRequest) ProtoMessage()    {}
 func (*GetIndvRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{13}
+	return fileDescriptor_ad098daeda4239f7, []int{15}
 }
 
 func (m *GetIndvRequest) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1248,7 @@ func (m *GetIndvResponse) Reset()         { *m = GetIndvResponse{} }
 func (m *GetIndvResponse) String() string { return proto.CompactTextString(m) }
 func (*GetIndvResponse) ProtoMessage()    {}
 func (*GetIndvResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{14}
+	return fileDescriptor_ad098daeda4239f7, []int{16}
 }
 
 func (m *GetIndvResponse) XXX_Unmarshal(b []byte) error {
@@ -1132,7 +1330,7 @@ func (m *TotalsMap) Reset()         { *m = TotalsMap{} }
 func (m *TotalsMap) String() string { return proto.CompactTextString(m) }
 func (*TotalsMap) ProtoMessage()    {}
 func (*TotalsMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{15}
+	return fileDescriptor_ad098daeda4239f7, []int{17}
 }
 
 func (m *TotalsMap) XXX_Unmarshal(b []byte) error {
@@ -1196,7 +1394,7 @@ func (m *Individual) Reset()         { *m = Individual{} }
 func (m *Individual) String() string { return proto.CompactTextString(m) }
 func (*Individual) ProtoMessage()    {}
 func (*Individual) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{16}
+	return fileDescriptor_ad098daeda4239f7, []int{18}
 }
 
 func (m *Individual) XXX_Unmarshal(b []byte) error {
@@ -1366,7 +1564,7 @@ func (m *GetCandRequest) Reset()         { *m = GetCandRequest{} }
 func (m *GetCandRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCandRequest) ProtoMessage()    {}
 func (*GetCandRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{17}
+	return fileDescriptor_ad098daeda4239f7, []int{19}
 }
 
 func (m *GetCandRequest) XXX_Unmarshal(b []byte) error {
@@ -1436,21 +1634,23 @@ type GetCandResponse struct {
 	// datasets for each year in request aggregated
 	// into singular object encoded on server prior
 	// to encoding response
-	Candidate            *Candidate           `protobuf:"bytes,4,opt,name=Candidate,proto3" json:"Candidate,omitempty"`
-	Financials           *CmpnFinancials      `protobuf:"bytes,5,opt,name=Financials,proto3" json:"Financials,omitempty"`
-	Years                []string             `protobuf:"bytes,6,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,8,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Candidate  *Candidate           `protobuf:"bytes,4,opt,name=Candidate,proto3" json:"Candidate,omitempty"`
+	Financials *CmpnFinancials      `protobuf:"bytes,5,opt,name=Financials,proto3" json:"Financials,omitempty"`
+	Years      []string             `protobuf:"bytes,6,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp  *timestamp.Timestamp `protobuf:"bytes,7,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg        string               `protobuf:"bytes,8,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// aggregated datasets for all of candidate's linked committees
+	Rollup               *CandRollup `protobuf:"bytes,9,opt,name=Rollup,proto3" json:"Rollup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *GetCandResponse) Reset()         { *m = GetCandResponse{} }
 func (m *GetCandResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCandResponse) ProtoMessage()    {}
 func (*GetCandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{18}
+	return fileDescriptor_ad098daeda4239f7, []int{20}
 }
 
 func (m *GetCandResponse) XXX_Unmarshal(b []byte) error {
@@ -1527,6 +1727,13 @@ func (m *GetCandResponse) GetMsg() string {
 	return ""
 }
 
+func (m *GetCandResponse) GetRollup() *CandRollup {
+	if m != nil {
+		return m.Rollup
+	}
+	return nil
+}
+
 type Candidate struct {
 	ID                   string             `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Name                 string             `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -1551,6 +1758,10 @@ type Candidate struct {
 	DirectRecipientsTxs  map[string]float32 `protobuf:"bytes,21,rep,name=DirectRecipientsTxs,proto3" json:"DirectRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	DirectSendersAmts    []*TotalsMap       `protobuf:"bytes,22,rep,name=DirectSendersAmts,proto3" json:"DirectSendersAmts,omitempty"`
 	DirectSendersTxs     map[string]float32 `protobuf:"bytes,23,rep,name=DirectSendersTxs,proto3" json:"DirectSendersTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	// size distribution of individual contributions to all linked committees
+	SizeBinsAmt          map[string]float32 `protobuf:"bytes,24,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs          map[string]float32 `protobuf:"bytes,25,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizePercentiles      map[string]float32 `protobuf:"bytes,26,rep,name=SizePercentiles,proto3" json:"SizePercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -1560,7 +1771,7 @@ func (m *Candidate) Reset()         { *m = Candidate{} }
 func (m *Candidate) String() string { return proto.CompactTextString(m) }
 func (*Candidate) ProtoMessage()    {}
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{19}
+	return fileDescriptor_ad098daeda4239f7, []int{21}
 }
 
 func (m *Candidate) XXX_Unmarshal(b []byte) error {
@@ -1742,6 +1953,27 @@ func (m *Candidate) GetDirectSendersTxs() map[string]float32 {
 	return nil
 }
 
+func (m *Candidate) GetSizeBinsAmt() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsAmt
+	}
+	return nil
+}
+
+func (m *Candidate) GetSizeBinsTxs() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsTxs
+	}
+	return nil
+}
+
+func (m *Candidate) GetSizePercentiles() map[string]float32 {
+	if m != nil {
+		return m.SizePercentiles
+	}
+	return nil
+}
+
 type CmpnFinancials struct {
 	CandID               string               `protobuf:"bytes,1,opt,name=CandID,proto3" json:"CandID,omitempty"`
 	Name                 string               `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
@@ -1781,7 +2013,7 @@ func (m *CmpnFinancials) Reset()         { *m = CmpnFinancials{} }
 func (m *CmpnFinancials) String() string { return proto.CompactTextString(m) }
 func (*CmpnFinancials) ProtoMessage()    {}
 func (*CmpnFinancials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{20}
+	return fileDescriptor_ad098daeda4239f7, []int{22}
 }
 
 func (m *CmpnFinancials) XXX_Unmarshal(b []byte) error {
@@ -2005,936 +2237,1860 @@ func (m *CmpnFinancials) GetCmteRefunds() float32 {
 	return 0
 }
 
-type GetCmteRequest struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ObjectID             string               `protobuf:"bytes,2,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
-	Bucket               string               `protobuf:"bytes,3,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
-	Years                []string             `protobuf:"bytes,4,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,6,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type CandRollup struct {
+	CandID                    string             `protobuf:"bytes,1,opt,name=CandID,proto3" json:"CandID,omitempty"`
+	Party                     string             `protobuf:"bytes,2,opt,name=Party,proto3" json:"Party,omitempty"`
+	Committees                []string           `protobuf:"bytes,3,rep,name=Committees,proto3" json:"Committees,omitempty"`
+	ContributionsInAmt        float32            `protobuf:"fixed32,4,opt,name=ContributionsInAmt,proto3" json:"ContributionsInAmt,omitempty"`
+	ContributionsInTxs        float32            `protobuf:"fixed32,5,opt,name=ContributionsInTxs,proto3" json:"ContributionsInTxs,omitempty"`
+	AvgContributionIn         float32            `protobuf:"fixed32,6,opt,name=AvgContributionIn,proto3" json:"AvgContributionIn,omitempty"`
+	OtherReceiptsInAmt        float32            `protobuf:"fixed32,7,opt,name=OtherReceiptsInAmt,proto3" json:"OtherReceiptsInAmt,omitempty"`
+	OtherReceiptsInTxs        float32            `protobuf:"fixed32,8,opt,name=OtherReceiptsInTxs,proto3" json:"OtherReceiptsInTxs,omitempty"`
+	AvgOtherIn                float32            `protobuf:"fixed32,9,opt,name=AvgOtherIn,proto3" json:"AvgOtherIn,omitempty"`
+	InternalTransfersAmt      float32            `protobuf:"fixed32,10,opt,name=InternalTransfersAmt,proto3" json:"InternalTransfersAmt,omitempty"`
+	InternalTransfersTxs      float32            `protobuf:"fixed32,11,opt,name=InternalTransfersTxs,proto3" json:"InternalTransfersTxs,omitempty"`
+	TotalIncomingAmt          float32            `protobuf:"fixed32,12,opt,name=TotalIncomingAmt,proto3" json:"TotalIncomingAmt,omitempty"`
+	TotalIncomingTxs          float32            `protobuf:"fixed32,13,opt,name=TotalIncomingTxs,proto3" json:"TotalIncomingTxs,omitempty"`
+	AvgIncoming               float32            `protobuf:"fixed32,14,opt,name=AvgIncoming,proto3" json:"AvgIncoming,omitempty"`
+	TransfersAmt              float32            `protobuf:"fixed32,15,opt,name=TransfersAmt,proto3" json:"TransfersAmt,omitempty"`
+	TransfersTxs              float32            `protobuf:"fixed32,16,opt,name=TransfersTxs,proto3" json:"TransfersTxs,omitempty"`
+	AvgTransfer               float32            `protobuf:"fixed32,17,opt,name=AvgTransfer,proto3" json:"AvgTransfer,omitempty"`
+	ExpendituresAmt           float32            `protobuf:"fixed32,18,opt,name=ExpendituresAmt,proto3" json:"ExpendituresAmt,omitempty"`
+	ExpendituresTxs           float32            `protobuf:"fixed32,19,opt,name=ExpendituresTxs,proto3" json:"ExpendituresTxs,omitempty"`
+	AvgExpenditure            float32            `protobuf:"fixed32,20,opt,name=AvgExpenditure,proto3" json:"AvgExpenditure,omitempty"`
+	TotalOutgoingAmt          float32            `protobuf:"fixed32,21,opt,name=TotalOutgoingAmt,proto3" json:"TotalOutgoingAmt,omitempty"`
+	TotalOutgoingTxs          float32            `protobuf:"fixed32,22,opt,name=TotalOutgoingTxs,proto3" json:"TotalOutgoingTxs,omitempty"`
+	AvgOutgoing               float32            `protobuf:"fixed32,23,opt,name=AvgOutgoing,proto3" json:"AvgOutgoing,omitempty"`
+	NetBalance                float32            `protobuf:"fixed32,24,opt,name=NetBalance,proto3" json:"NetBalance,omitempty"`
+	TopIndvContributorsAmt    []*TotalsMap       `protobuf:"bytes,25,rep,name=TopIndvContributorsAmt,proto3" json:"TopIndvContributorsAmt,omitempty"`
+	TopIndvContributorsTxs    map[string]float32 `protobuf:"bytes,26,rep,name=TopIndvContributorsTxs,proto3" json:"TopIndvContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCmteOrgContributorsAmt []*TotalsMap       `protobuf:"bytes,27,rep,name=TopCmteOrgContributorsAmt,proto3" json:"TopCmteOrgContributorsAmt,omitempty"`
+	TopCmteOrgContributorsTxs map[string]float32 `protobuf:"bytes,28,rep,name=TopCmteOrgContributorsTxs,proto3" json:"TopCmteOrgContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TransferRecsAmt           []*TotalsMap       `protobuf:"bytes,29,rep,name=TransferRecsAmt,proto3" json:"TransferRecsAmt,omitempty"`
+	TransferRecsTxs           map[string]float32 `protobuf:"bytes,30,rep,name=TransferRecsTxs,proto3" json:"TransferRecsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopExpRecipientsAmt       []*TotalsMap       `protobuf:"bytes,31,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty"`
+	TopExpRecipientsTxs       map[string]float32 `protobuf:"bytes,32,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInAmt            map[string]float32 `protobuf:"bytes,33,rep,name=ElectionsInAmt,proto3" json:"ElectionsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ElectionsInTxs            map[string]float32 `protobuf:"bytes,34,rep,name=ElectionsInTxs,proto3" json:"ElectionsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsAmt               map[string]float32 `protobuf:"bytes,35,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs               map[string]float32 `protobuf:"bytes,36,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizePercentiles           map[string]float32 `protobuf:"bytes,37,rep,name=SizePercentiles,proto3" json:"SizePercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInAmt             map[string]float32 `protobuf:"bytes,38,rep,name=ConduitsInAmt,proto3" json:"ConduitsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInTxs             map[string]float32 `protobuf:"bytes,39,rep,name=ConduitsInTxs,proto3" json:"ConduitsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CandContsAmt              float32            `protobuf:"fixed32,40,opt,name=CandContsAmt,proto3" json:"CandContsAmt,omitempty"`
+	CandLoansAmt              float32            `protobuf:"fixed32,41,opt,name=CandLoansAmt,proto3" json:"CandLoansAmt,omitempty"`
+	CandLoanRepayAmt          float32            `protobuf:"fixed32,42,opt,name=CandLoanRepayAmt,proto3" json:"CandLoanRepayAmt,omitempty"`
+	SelfFundedAmt             float32            `protobuf:"fixed32,43,opt,name=SelfFundedAmt,proto3" json:"SelfFundedAmt,omitempty"`
+	SelfFundedShare           float32            `protobuf:"fixed32,44,opt,name=SelfFundedShare,proto3" json:"SelfFundedShare,omitempty"`
+	CandLoansOutstanding      float32            `protobuf:"fixed32,45,opt,name=CandLoansOutstanding,proto3" json:"CandLoansOutstanding,omitempty"`
+	CandLoansByYear           map[string]float32 `protobuf:"bytes,46,rep,name=CandLoansByYear,proto3" json:"CandLoansByYear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CandRepaysByYear          map[string]float32 `protobuf:"bytes,47,rep,name=CandRepaysByYear,proto3" json:"CandRepaysByYear,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsAmt                map[string]float32 `protobuf:"bytes,48,rep,name=TxKindsAmt,proto3" json:"TxKindsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsTxs                map[string]float32 `protobuf:"bytes,49,rep,name=TxKindsTxs,proto3" json:"TxKindsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral      struct{}           `json:"-"`
+	XXX_unrecognized          []byte             `json:"-"`
+	XXX_sizecache             int32              `json:"-"`
 }
 
-func (m *GetCmteRequest) Reset()         { *m = GetCmteRequest{} }
-func (m *GetCmteRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCmteRequest) ProtoMessage()    {}
-func (*GetCmteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{21}
+func (m *CandRollup) Reset()         { *m = CandRollup{} }
+func (m *CandRollup) String() string { return proto.CompactTextString(m) }
+func (*CandRollup) ProtoMessage()    {}
+func (*CandRollup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{23}
 }
 
-func (m *GetCmteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCmteRequest.Unmarshal(m, b)
+func (m *CandRollup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandRollup.Unmarshal(m, b)
 }
-func (m *GetCmteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCmteRequest.Marshal(b, m, deterministic)
+func (m *CandRollup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandRollup.Marshal(b, m, deterministic)
 }
-func (m *GetCmteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCmteRequest.Merge(m, src)
+func (m *CandRollup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandRollup.Merge(m, src)
 }
-func (m *GetCmteRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCmteRequest.Size(m)
+func (m *CandRollup) XXX_Size() int {
+	return xxx_messageInfo_CandRollup.Size(m)
 }
-func (m *GetCmteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCmteRequest.DiscardUnknown(m)
+func (m *CandRollup) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandRollup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCmteRequest proto.InternalMessageInfo
+var xxx_messageInfo_CandRollup proto.InternalMessageInfo
 
-func (m *GetCmteRequest) GetUID() string {
+func (m *CandRollup) GetCandID() string {
 	if m != nil {
-		return m.UID
+		return m.CandID
 	}
 	return ""
 }
 
-func (m *GetCmteRequest) GetObjectID() string {
+func (m *CandRollup) GetParty() string {
 	if m != nil {
-		return m.ObjectID
+		return m.Party
 	}
 	return ""
 }
 
-func (m *GetCmteRequest) GetBucket() string {
+func (m *CandRollup) GetCommittees() []string {
 	if m != nil {
-		return m.Bucket
+		return m.Committees
 	}
-	return ""
+	return nil
 }
 
-func (m *GetCmteRequest) GetYears() []string {
+func (m *CandRollup) GetContributionsInAmt() float32 {
 	if m != nil {
-		return m.Years
+		return m.ContributionsInAmt
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteRequest) GetTimestamp() *timestamp.Timestamp {
+func (m *CandRollup) GetContributionsInTxs() float32 {
 	if m != nil {
-		return m.Timestamp
+		return m.ContributionsInTxs
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteRequest) GetMsg() string {
+func (m *CandRollup) GetAvgContributionIn() float32 {
 	if m != nil {
-		return m.Msg
+		return m.AvgContributionIn
 	}
-	return ""
+	return 0
 }
 
-type GetCmteResponse struct {
-	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ObjectID string `protobuf:"bytes,2,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
-	Bucket   string `protobuf:"bytes,3,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
-	// datasets for each year in request aggregated
-	// into singular object encoded on server prior
-	// to encoding response
-	Committee            *Committee           `protobuf:"bytes,4,opt,name=Committee,proto3" json:"Committee,omitempty"`
-	TxData               *CmteTxData          `protobuf:"bytes,5,opt,name=TxData,proto3" json:"TxData,omitempty"`
-	Financials           *CmteFinancials      `protobuf:"bytes,6,opt,name=Financials,proto3" json:"Financials,omitempty"`
-	Years                []string             `protobuf:"bytes,7,rep,name=Years,proto3" json:"Years,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,9,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *CandRollup) GetOtherReceiptsInAmt() float32 {
+	if m != nil {
+		return m.OtherReceiptsInAmt
+	}
+	return 0
 }
 
-func (m *GetCmteResponse) Reset()         { *m = GetCmteResponse{} }
-func (m *GetCmteResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCmteResponse) ProtoMessage()    {}
-func (*GetCmteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{22}
+func (m *CandRollup) GetOtherReceiptsInTxs() float32 {
+	if m != nil {
+		return m.OtherReceiptsInTxs
+	}
+	return 0
 }
 
-func (m *GetCmteResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCmteResponse.Unmarshal(m, b)
-}
-func (m *GetCmteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCmteResponse.Marshal(b, m, deterministic)
-}
-func (m *GetCmteResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCmteResponse.Merge(m, src)
-}
-func (m *GetCmteResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCmteResponse.Size(m)
-}
-func (m *GetCmteResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCmteResponse.DiscardUnknown(m)
+func (m *CandRollup) GetAvgOtherIn() float32 {
+	if m != nil {
+		return m.AvgOtherIn
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetCmteResponse proto.InternalMessageInfo
-
-func (m *GetCmteResponse) GetUID() string {
+func (m *CandRollup) GetInternalTransfersAmt() float32 {
 	if m != nil {
-		return m.UID
+		return m.InternalTransfersAmt
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCmteResponse) GetObjectID() string {
+func (m *CandRollup) GetInternalTransfersTxs() float32 {
 	if m != nil {
-		return m.ObjectID
+		return m.InternalTransfersTxs
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCmteResponse) GetBucket() string {
+func (m *CandRollup) GetTotalIncomingAmt() float32 {
 	if m != nil {
-		return m.Bucket
+		return m.TotalIncomingAmt
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCmteResponse) GetCommittee() *Committee {
+func (m *CandRollup) GetTotalIncomingTxs() float32 {
 	if m != nil {
-		return m.Committee
+		return m.TotalIncomingTxs
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteResponse) GetTxData() *CmteTxData {
+func (m *CandRollup) GetAvgIncoming() float32 {
 	if m != nil {
-		return m.TxData
+		return m.AvgIncoming
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteResponse) GetFinancials() *CmteFinancials {
+func (m *CandRollup) GetTransfersAmt() float32 {
 	if m != nil {
-		return m.Financials
+		return m.TransfersAmt
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteResponse) GetYears() []string {
+func (m *CandRollup) GetTransfersTxs() float32 {
 	if m != nil {
-		return m.Years
+		return m.TransfersTxs
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteResponse) GetTimestamp() *timestamp.Timestamp {
+func (m *CandRollup) GetAvgTransfer() float32 {
 	if m != nil {
-		return m.Timestamp
+		return m.AvgTransfer
 	}
-	return nil
+	return 0
 }
 
-func (m *GetCmteResponse) GetMsg() string {
+func (m *CandRollup) GetExpendituresAmt() float32 {
 	if m != nil {
-		return m.Msg
+		return m.ExpendituresAmt
 	}
-	return ""
+	return 0
 }
 
-type Committee struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	TresName             string   `protobuf:"bytes,3,opt,name=TresName,proto3" json:"TresName,omitempty"`
-	City                 string   `protobuf:"bytes,4,opt,name=City,proto3" json:"City,omitempty"`
-	State                string   `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
-	Zip                  string   `protobuf:"bytes,6,opt,name=Zip,proto3" json:"Zip,omitempty"`
-	Designation          string   `protobuf:"bytes,7,opt,name=Designation,proto3" json:"Designation,omitempty"`
-	Type                 string   `protobuf:"bytes,8,opt,name=Type,proto3" json:"Type,omitempty"`
-	Party                string   `protobuf:"bytes,9,opt,name=Party,proto3" json:"Party,omitempty"`
-	FilingFreq           string   `protobuf:"bytes,10,opt,name=FilingFreq,proto3" json:"FilingFreq,omitempty"`
-	OrgType              string   `protobuf:"bytes,11,opt,name=OrgType,proto3" json:"OrgType,omitempty"`
-	ConnectedOrg         string   `protobuf:"bytes,12,opt,name=ConnectedOrg,proto3" json:"ConnectedOrg,omitempty"`
-	CandID               string   `protobuf:"bytes,13,opt,name=CandID,proto3" json:"CandID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *CandRollup) GetExpendituresTxs() float32 {
+	if m != nil {
+		return m.ExpendituresTxs
+	}
+	return 0
 }
 
-func (m *Committee) Reset()         { *m = Committee{} }
-func (m *Committee) String() string { return proto.CompactTextString(m) }
-func (*Committee) ProtoMessage()    {}
-func (*Committee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{23}
+func (m *CandRollup) GetAvgExpenditure() float32 {
+	if m != nil {
+		return m.AvgExpenditure
+	}
+	return 0
 }
 
-func (m *Committee) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Committee.Unmarshal(m, b)
-}
-func (m *Committee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Committee.Marshal(b, m, deterministic)
-}
-func (m *Committee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Committee.Merge(m, src)
-}
-func (m *Committee) XXX_Size() int {
-	return xxx_messageInfo_Committee.Size(m)
-}
-func (m *Committee) XXX_DiscardUnknown() {
-	xxx_messageInfo_Committee.DiscardUnknown(m)
+func (m *CandRollup) GetTotalOutgoingAmt() float32 {
+	if m != nil {
+		return m.TotalOutgoingAmt
+	}
+	return 0
 }
 
-var xxx_messageInfo_Committee proto.InternalMessageInfo
-
-func (m *Committee) GetID() string {
+func (m *CandRollup) GetTotalOutgoingTxs() float32 {
 	if m != nil {
-		return m.ID
+		return m.TotalOutgoingTxs
 	}
-	return ""
+	return 0
 }
 
-func (m *Committee) GetName() string {
+func (m *CandRollup) GetAvgOutgoing() float32 {
 	if m != nil {
-		return m.Name
+		return m.AvgOutgoing
 	}
-	return ""
+	return 0
 }
 
-func (m *Committee) GetTresName() string {
+func (m *CandRollup) GetNetBalance() float32 {
 	if m != nil {
-		return m.TresName
+		return m.NetBalance
 	}
-	return ""
+	return 0
 }
 
-func (m *Committee) GetCity() string {
+func (m *CandRollup) GetTopIndvContributorsAmt() []*TotalsMap {
 	if m != nil {
-		return m.City
+		return m.TopIndvContributorsAmt
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetState() string {
+func (m *CandRollup) GetTopIndvContributorsTxs() map[string]float32 {
 	if m != nil {
-		return m.State
+		return m.TopIndvContributorsTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetZip() string {
+func (m *CandRollup) GetTopCmteOrgContributorsAmt() []*TotalsMap {
 	if m != nil {
-		return m.Zip
+		return m.TopCmteOrgContributorsAmt
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetDesignation() string {
+func (m *CandRollup) GetTopCmteOrgContributorsTxs() map[string]float32 {
 	if m != nil {
-		return m.Designation
+		return m.TopCmteOrgContributorsTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetType() string {
+func (m *CandRollup) GetTransferRecsAmt() []*TotalsMap {
 	if m != nil {
-		return m.Type
+		return m.TransferRecsAmt
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetParty() string {
+func (m *CandRollup) GetTransferRecsTxs() map[string]float32 {
 	if m != nil {
-		return m.Party
+		return m.TransferRecsTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetFilingFreq() string {
+func (m *CandRollup) GetTopExpRecipientsAmt() []*TotalsMap {
 	if m != nil {
-		return m.FilingFreq
+		return m.TopExpRecipientsAmt
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetOrgType() string {
+func (m *CandRollup) GetTopExpRecipientsTxs() map[string]float32 {
 	if m != nil {
-		return m.OrgType
+		return m.TopExpRecipientsTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetConnectedOrg() string {
+func (m *CandRollup) GetElectionsInAmt() map[string]float32 {
 	if m != nil {
-		return m.ConnectedOrg
+		return m.ElectionsInAmt
 	}
-	return ""
+	return nil
 }
 
-func (m *Committee) GetCandID() string {
+func (m *CandRollup) GetElectionsInTxs() map[string]float32 {
 	if m != nil {
-		return m.CandID
+		return m.ElectionsInTxs
 	}
-	return ""
+	return nil
 }
 
-type CmteFinancials struct {
-	CmteID               string               `protobuf:"bytes,1,opt,name=CmteID,proto3" json:"CmteID,omitempty"`
-	TotalReceipts        float32              `protobuf:"fixed32,2,opt,name=TotalReceipts,proto3" json:"TotalReceipts,omitempty"`
-	TxsFromAff           float32              `protobuf:"fixed32,3,opt,name=TxsFromAff,proto3" json:"TxsFromAff,omitempty"`
-	IndvConts            float32              `protobuf:"fixed32,4,opt,name=IndvConts,proto3" json:"IndvConts,omitempty"`
-	OtherConts           float32              `protobuf:"fixed32,5,opt,name=OtherConts,proto3" json:"OtherConts,omitempty"`
-	CandCont             float32              `protobuf:"fixed32,6,opt,name=CandCont,proto3" json:"CandCont,omitempty"`
-	CandLoans            float32              `protobuf:"fixed32,7,opt,name=CandLoans,proto3" json:"CandLoans,omitempty"`
-	TotalLoans           float32              `protobuf:"fixed32,8,opt,name=TotalLoans,proto3" json:"TotalLoans,omitempty"`
-	TotalDisb            float32              `protobuf:"fixed32,9,opt,name=TotalDisb,proto3" json:"TotalDisb,omitempty"`
-	TxToAff              float32              `protobuf:"fixed32,10,opt,name=TxToAff,proto3" json:"TxToAff,omitempty"`
-	IndvRefunds          float32              `protobuf:"fixed32,11,opt,name=IndvRefunds,proto3" json:"IndvRefunds,omitempty"`
-	OtherRefunds         float32              `protobuf:"fixed32,12,opt,name=OtherRefunds,proto3" json:"OtherRefunds,omitempty"`
-	LoanRepay            float32              `protobuf:"fixed32,13,opt,name=LoanRepay,proto3" json:"LoanRepay,omitempty"`
-	CashBOP              float32              `protobuf:"fixed32,14,opt,name=CashBOP,proto3" json:"CashBOP,omitempty"`
-	CashCOP              float32              `protobuf:"fixed32,15,opt,name=CashCOP,proto3" json:"CashCOP,omitempty"`
-	DebtsOwed            float32              `protobuf:"fixed32,16,opt,name=DebtsOwed,proto3" json:"DebtsOwed,omitempty"`
-	NonFedTxsRecvd       float32              `protobuf:"fixed32,17,opt,name=NonFedTxsRecvd,proto3" json:"NonFedTxsRecvd,omitempty"`
-	ContToOtherCmte      float32              `protobuf:"fixed32,18,opt,name=ContToOtherCmte,proto3" json:"ContToOtherCmte,omitempty"`
-	IndExp               float32              `protobuf:"fixed32,19,opt,name=IndExp,proto3" json:"IndExp,omitempty"`
-	PartyExp             float32              `protobuf:"fixed32,20,opt,name=PartyExp,proto3" json:"PartyExp,omitempty"`
-	NonFedSharedExp      float32              `protobuf:"fixed32,21,opt,name=NonFedSharedExp,proto3" json:"NonFedSharedExp,omitempty"`
-	CovgEndDate          *timestamp.Timestamp `protobuf:"bytes,22,opt,name=CovgEndDate,proto3" json:"CovgEndDate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *CandRollup) GetSizeBinsAmt() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsAmt
+	}
+	return nil
 }
 
-func (m *CmteFinancials) Reset()         { *m = CmteFinancials{} }
-func (m *CmteFinancials) String() string { return proto.CompactTextString(m) }
-func (*CmteFinancials) ProtoMessage()    {}
-func (*CmteFinancials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{24}
+func (m *CandRollup) GetSizeBinsTxs() map[string]float32 {
+	if m != nil {
+		return m.SizeBinsTxs
+	}
+	return nil
 }
 
-func (m *CmteFinancials) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CmteFinancials.Unmarshal(m, b)
-}
-func (m *CmteFinancials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CmteFinancials.Marshal(b, m, deterministic)
-}
-func (m *CmteFinancials) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CmteFinancials.Merge(m, src)
-}
-func (m *CmteFinancials) XXX_Size() int {
-	return xxx_messageInfo_CmteFinancials.Size(m)
-}
-func (m *CmteFinancials) XXX_DiscardUnknown() {
-	xxx_messageInfo_CmteFinancials.DiscardUnknown(m)
+func (m *CandRollup) GetSizePercentiles() map[string]float32 {
+	if m != nil {
+		return m.SizePercentiles
+	}
+	return nil
 }
 
-var xxx_messageInfo_CmteFinancials proto.InternalMessageInfo
+func (m *CandRollup) GetConduitsInAmt() map[string]float32 {
+	if m != nil {
+		return m.ConduitsInAmt
+	}
+	return nil
+}
 
-func (m *CmteFinancials) GetCmteID() string {
+func (m *CandRollup) GetConduitsInTxs() map[string]float32 {
 	if m != nil {
-		return m.CmteID
+		return m.ConduitsInTxs
 	}
-	return ""
+	return nil
 }
 
-func (m *CmteFinancials) GetTotalReceipts() float32 {
+func (m *CandRollup) GetCandContsAmt() float32 {
 	if m != nil {
-		return m.TotalReceipts
+		return m.CandContsAmt
 	}
 	return 0
 }
 
-func (m *CmteFinancials) GetTxsFromAff() float32 {
+func (m *CandRollup) GetCandLoansAmt() float32 {
+	if m != nil {
+		return m.CandLoansAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoanRepayAmt() float32 {
+	if m != nil {
+		return m.CandLoanRepayAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetSelfFundedAmt() float32 {
+	if m != nil {
+		return m.SelfFundedAmt
+	}
+	return 0
+}
+
+func (m *CandRollup) GetSelfFundedShare() float32 {
+	if m != nil {
+		return m.SelfFundedShare
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansOutstanding() float32 {
+	if m != nil {
+		return m.CandLoansOutstanding
+	}
+	return 0
+}
+
+func (m *CandRollup) GetCandLoansByYear() map[string]float32 {
+	if m != nil {
+		return m.CandLoansByYear
+	}
+	return nil
+}
+
+func (m *CandRollup) GetCandRepaysByYear() map[string]float32 {
+	if m != nil {
+		return m.CandRepaysByYear
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTxKindsAmt() map[string]float32 {
+	if m != nil {
+		return m.TxKindsAmt
+	}
+	return nil
+}
+
+func (m *CandRollup) GetTxKindsTxs() map[string]float32 {
+	if m != nil {
+		return m.TxKindsTxs
+	}
+	return nil
+}
+
+type GetCmteRequest struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ObjectID             string               `protobuf:"bytes,2,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Bucket               string               `protobuf:"bytes,3,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	Years                []string             `protobuf:"bytes,4,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,6,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetCmteRequest) Reset()         { *m = GetCmteRequest{} }
+func (m *GetCmteRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCmteRequest) ProtoMessage()    {}
+func (*GetCmteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{24}
+}
+
+func (m *GetCmteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCmteRequest.Unmarshal(m, b)
+}
+func (m *GetCmteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCmteRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCmteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCmteRequest.Merge(m, src)
+}
+func (m *GetCmteRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCmteRequest.Size(m)
+}
+func (m *GetCmteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCmteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCmteRequest proto.InternalMessageInfo
+
+func (m *GetCmteRequest) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *GetCmteRequest) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
+	}
+	return ""
+}
+
+func (m *GetCmteRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *GetCmteRequest) GetYears() []string {
+	if m != nil {
+		return m.Years
+	}
+	return nil
+}
+
+func (m *GetCmteRequest) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *GetCmteRequest) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type GetCmteResponse struct {
+	UID      string `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ObjectID string `protobuf:"bytes,2,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Bucket   string `protobuf:"bytes,3,opt,name=Bucket,proto3" json:"Bucket,omitempty"`
+	// datasets for each year in request aggregated
+	// into singular object encoded on server prior
+	// to encoding response
+	Committee            *Committee           `protobuf:"bytes,4,opt,name=Committee,proto3" json:"Committee,omitempty"`
+	TxData               *CmteTxData          `protobuf:"bytes,5,opt,name=TxData,proto3" json:"TxData,omitempty"`
+	Financials           *CmteFinancials      `protobuf:"bytes,6,opt,name=Financials,proto3" json:"Financials,omitempty"`
+	Years                []string             `protobuf:"bytes,7,rep,name=Years,proto3" json:"Years,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,9,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetCmteResponse) Reset()         { *m = GetCmteResponse{} }
+func (m *GetCmteResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCmteResponse) ProtoMessage()    {}
+func (*GetCmteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{25}
+}
+
+func (m *GetCmteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCmteResponse.Unmarshal(m, b)
+}
+func (m *GetCmteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCmteResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCmteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCmteResponse.Merge(m, src)
+}
+func (m *GetCmteResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCmteResponse.Size(m)
+}
+func (m *GetCmteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCmteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCmteResponse proto.InternalMessageInfo
+
+func (m *GetCmteResponse) GetUID() string {
+	if m != nil {
+		return m.UID
+	}
+	return ""
+}
+
+func (m *GetCmteResponse) GetObjectID() string {
+	if m != nil {
+		return m.ObjectID
+	}
+	return ""
+}
+
+func (m *GetCmteResponse) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *GetCmteResponse) GetCommittee() *Committee {
+	if m != nil {
+		return m.Committee
+	}
+	return nil
+}
+
+func (m *GetCmteResponse) GetTxData() *CmteTxData {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func (m *GetCmteResponse) GetFinancials() *CmteFinancials {
+	if m != nil {
+		return m.Financials
+	}
+	return nil
+}
+
+func (m *GetCmteResponse) GetYears() []string {
+	if m != nil {
+		return m.Years
+	}
+	return nil
+}
+
+func (m *GetCmteResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *GetCmteResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type Committee struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	TresName             string   `protobuf:"bytes,3,opt,name=TresName,proto3" json:"TresName,omitempty"`
+	City                 string   `protobuf:"bytes,4,opt,name=City,proto3" json:"City,omitempty"`
+	State                string   `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
+	Zip                  string   `protobuf:"bytes,6,opt,name=Zip,proto3" json:"Zip,omitempty"`
+	Designation          string   `protobuf:"bytes,7,opt,name=Designation,proto3" json:"Designation,omitempty"`
+	Type                 string   `protobuf:"bytes,8,opt,name=Type,proto3" json:"Type,omitempty"`
+	Party                string   `protobuf:"bytes,9,opt,name=Party,proto3" json:"Party,omitempty"`
+	FilingFreq           string   `protobuf:"bytes,10,opt,name=FilingFreq,proto3" json:"FilingFreq,omitempty"`
+	OrgType              string   `protobuf:"bytes,11,opt,name=OrgType,proto3" json:"OrgType,omitempty"`
+	ConnectedOrg         string   `protobuf:"bytes,12,opt,name=ConnectedOrg,proto3" json:"ConnectedOrg,omitempty"`
+	CandID               string   `protobuf:"bytes,13,opt,name=CandID,proto3" json:"CandID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Committee) Reset()         { *m = Committee{} }
+func (m *Committee) String() string { return proto.CompactTextString(m) }
+func (*Committee) ProtoMessage()    {}
+func (*Committee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{26}
+}
+
+func (m *Committee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Committee.Unmarshal(m, b)
+}
+func (m *Committee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Committee.Marshal(b, m, deterministic)
+}
+func (m *Committee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Committee.Merge(m, src)
+}
+func (m *Committee) XXX_Size() int {
+	return xxx_messageInfo_Committee.Size(m)
+}
+func (m *Committee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Committee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Committee proto.InternalMessageInfo
+
+func (m *Committee) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Committee) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Committee) GetTresName() string {
+	if m != nil {
+		return m.TresName
+	}
+	return ""
+}
+
+func (m *Committee) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *Committee) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Committee) GetZip() string {
+	if m != nil {
+		return m.Zip
+	}
+	return ""
+}
+
+func (m *Committee) GetDesignation() string {
+	if m != nil {
+		return m.Designation
+	}
+	return ""
+}
+
+func (m *Committee) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Committee) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *Committee) GetFilingFreq() string {
+	if m != nil {
+		return m.FilingFreq
+	}
+	return ""
+}
+
+func (m *Committee) GetOrgType() string {
+	if m != nil {
+		return m.OrgType
+	}
+	return ""
+}
+
+func (m *Committee) GetConnectedOrg() string {
+	if m != nil {
+		return m.ConnectedOrg
+	}
+	return ""
+}
+
+func (m *Committee) GetCandID() string {
+	if m != nil {
+		return m.CandID
+	}
+	return ""
+}
+
+type CmteFinancials struct {
+	CmteID               string               `protobuf:"bytes,1,opt,name=CmteID,proto3" json:"CmteID,omitempty"`
+	TotalReceipts        float32              `protobuf:"fixed32,2,opt,name=TotalReceipts,proto3" json:"TotalReceipts,omitempty"`
+	TxsFromAff           float32              `protobuf:"fixed32,3,opt,name=TxsFromAff,proto3" json:"TxsFromAff,omitempty"`
+	IndvConts            float32              `protobuf:"fixed32,4,opt,name=IndvConts,proto3" json:"IndvConts,omitempty"`
+	OtherConts           float32              `protobuf:"fixed32,5,opt,name=OtherConts,proto3" json:"OtherConts,omitempty"`
+	CandCont             float32              `protobuf:"fixed32,6,opt,name=CandCont,proto3" json:"CandCont,omitempty"`
+	CandLoans            float32              `protobuf:"fixed32,7,opt,name=CandLoans,proto3" json:"CandLoans,omitempty"`
+	TotalLoans           float32              `protobuf:"fixed32,8,opt,name=TotalLoans,proto3" json:"TotalLoans,omitempty"`
+	TotalDisb            float32              `protobuf:"fixed32,9,opt,name=TotalDisb,proto3" json:"TotalDisb,omitempty"`
+	TxToAff              float32              `protobuf:"fixed32,10,opt,name=TxToAff,proto3" json:"TxToAff,omitempty"`
+	IndvRefunds          float32              `protobuf:"fixed32,11,opt,name=IndvRefunds,proto3" json:"IndvRefunds,omitempty"`
+	OtherRefunds         float32              `protobuf:"fixed32,12,opt,name=OtherRefunds,proto3" json:"OtherRefunds,omitempty"`
+	LoanRepay            float32              `protobuf:"fixed32,13,opt,name=LoanRepay,proto3" json:"LoanRepay,omitempty"`
+	CashBOP              float32              `protobuf:"fixed32,14,opt,name=CashBOP,proto3" json:"CashBOP,omitempty"`
+	CashCOP              float32              `protobuf:"fixed32,15,opt,name=CashCOP,proto3" json:"CashCOP,omitempty"`
+	DebtsOwed            float32              `protobuf:"fixed32,16,opt,name=DebtsOwed,proto3" json:"DebtsOwed,omitempty"`
+	NonFedTxsRecvd       float32              `protobuf:"fixed32,17,opt,name=NonFedTxsRecvd,proto3" json:"NonFedTxsRecvd,omitempty"`
+	ContToOtherCmte      float32              `protobuf:"fixed32,18,opt,name=ContToOtherCmte,proto3" json:"ContToOtherCmte,omitempty"`
+	IndExp               float32              `protobuf:"fixed32,19,opt,name=IndExp,proto3" json:"IndExp,omitempty"`
+	PartyExp             float32              `protobuf:"fixed32,20,opt,name=PartyExp,proto3" json:"PartyExp,omitempty"`
+	NonFedSharedExp      float32              `protobuf:"fixed32,21,opt,name=NonFedSharedExp,proto3" json:"NonFedSharedExp,omitempty"`
+	CovgEndDate          *timestamp.Timestamp `protobuf:"bytes,22,opt,name=CovgEndDate,proto3" json:"CovgEndDate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CmteFinancials) Reset()         { *m = CmteFinancials{} }
+func (m *CmteFinancials) String() string { return proto.CompactTextString(m) }
+func (*CmteFinancials) ProtoMessage()    {}
+func (*CmteFinancials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{27}
+}
+
+func (m *CmteFinancials) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CmteFinancials.Unmarshal(m, b)
+}
+func (m *CmteFinancials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CmteFinancials.Marshal(b, m, deterministic)
+}
+func (m *CmteFinancials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CmteFinancials.Merge(m, src)
+}
+func (m *CmteFinancials) XXX_Size() int {
+	return xxx_messageInfo_CmteFinancials.Size(m)
+}
+func (m *CmteFinancials) XXX_DiscardUnknown() {
+	xxx_messageInfo_CmteFinancials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CmteFinancials proto.InternalMessageInfo
+
+func (m *CmteFinancials) GetCmteID() string {
+	if m != nil {
+		return m.CmteID
+	}
+	return ""
+}
+
+func (m *CmteFinancials) GetTotalReceipts() float32 {
+	if m != nil {
+		return m.TotalReceipts
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetTxsFromAff() float32 {
+	if m != nil {
+		return m.TxsFromAff
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetIndvConts() float32 {
+	if m != nil {
+		return m.IndvConts
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetOtherConts() float32 {
+	if m != nil {
+		return m.OtherConts
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetCandCont() float32 {
+	if m != nil {
+		return m.CandCont
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetCandLoans() float32 {
+	if m != nil {
+		return m.CandLoans
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetTotalLoans() float32 {
+	if m != nil {
+		return m.TotalLoans
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetTotalDisb() float32 {
+	if m != nil {
+		return m.TotalDisb
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetTxToAff() float32 {
+	if m != nil {
+		return m.TxToAff
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetIndvRefunds() float32 {
+	if m != nil {
+		return m.IndvRefunds
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetOtherRefunds() float32 {
+	if m != nil {
+		return m.OtherRefunds
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetLoanRepay() float32 {
+	if m != nil {
+		return m.LoanRepay
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetCashBOP() float32 {
+	if m != nil {
+		return m.CashBOP
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetCashCOP() float32 {
+	if m != nil {
+		return m.CashCOP
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetDebtsOwed() float32 {
+	if m != nil {
+		return m.DebtsOwed
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetNonFedTxsRecvd() float32 {
+	if m != nil {
+		return m.NonFedTxsRecvd
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetContToOtherCmte() float32 {
+	if m != nil {
+		return m.ContToOtherCmte
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetIndExp() float32 {
+	if m != nil {
+		return m.IndExp
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetPartyExp() float32 {
+	if m != nil {
+		return m.PartyExp
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetNonFedSharedExp() float32 {
+	if m != nil {
+		return m.NonFedSharedExp
+	}
+	return 0
+}
+
+func (m *CmteFinancials) GetCovgEndDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CovgEndDate
+	}
+	return nil
+}
+
+type CmteTxData struct {
+	CmteID                    string             `protobuf:"bytes,1,opt,name=CmteID,proto3" json:"CmteID,omitempty"`
+	CandID                    string             `protobuf:"bytes,2,opt,name=CandID,proto3" json:"CandID,omitempty"`
+	Party                     string             `protobuf:"bytes,3,opt,name=Party,proto3" json:"Party,omitempty"`
+	ContributionsInAmt        float32            `protobuf:"fixed32,4,opt,name=ContributionsInAmt,proto3" json:"ContributionsInAmt,omitempty"`
+	ContributionsInTxs        float32            `protobuf:"fixed32,5,opt,name=ContributionsInTxs,proto3" json:"ContributionsInTxs,omitempty"`
+	AvgContributionIn         float32            `protobuf:"fixed32,6,opt,name=AvgContributionIn,proto3" json:"AvgContributionIn,omitempty"`
+	OtherReceiptsInAmt        float32            `protobuf:"fixed32,7,opt,name=OtherReceiptsInAmt,proto3" json:"OtherReceiptsInAmt,omitempty"`
+	OtherReceiptsInTxs        float32            `protobuf:"fixed32,8,opt,name=OtherReceiptsInTxs,proto3" json:"OtherReceiptsInTxs,omitempty"`
+	AvgOtherIn                float32            `protobuf:"fixed32,9,opt,name=AvgOtherIn,proto3" json:"AvgOtherIn,omitempty"`
+	TotalIncomingAmt          float32            `protobuf:"fixed32,10,opt,name=TotalIncomingAmt,proto3" json:"TotalIncomingAmt,omitempty"`
+	TotalIncomingTxs          float32            `protobuf:"fixed32,11,opt,name=TotalIncomingTxs,proto3" json:"TotalIncomingTxs,omitempty"`
+	AvgIncoming               float32            `protobuf:"fixed32,12,opt,name=AvgIncoming,proto3" json:"AvgIncoming,omitempty"`
+	TransfersAmt              float32            `protobuf:"fixed32,13,opt,name=TransfersAmt,proto3" json:"TransfersAmt,omitempty"`
+	TransfersTxs              float32            `protobuf:"fixed32,14,opt,name=TransfersTxs,proto3" json:"TransfersTxs,omitempty"`
+	AvgTransfer               float32            `protobuf:"fixed32,15,opt,name=AvgTransfer,proto3" json:"AvgTransfer,omitempty"`
+	TransfersList             []string           `protobuf:"bytes,16,rep,name=TransfersList,proto3" json:"TransfersList,omitempty"`
+	ExpendituresAmt           float32            `protobuf:"fixed32,17,opt,name=ExpendituresAmt,proto3" json:"ExpendituresAmt,omitempty"`
+	ExpendituresTxs           float32            `protobuf:"fixed32,18,opt,name=ExpendituresTxs,proto3" json:"ExpendituresTxs,omitempty"`
+	AvgExpenditure            float32            `protobuf:"fixed32,19,opt,name=AvgExpenditure,proto3" json:"AvgExpenditure,omitempty"`
+	TotalOutgoingAmt          float32            `protobuf:"fixed32,20,opt,name=TotalOutgoingAmt,proto3" json:"TotalOutgoingAmt,omitempty"`
+	TotalOutgoingTxs          float32            `protobuf:"fixed32,21,opt,name=TotalOutgoingTxs,proto3" json:"TotalOutgoingTxs,omitempty"`
+	AvgOutgoing               float32            `protobuf:"fixed32,22,opt,name=AvgOutgoing,proto3" json:"AvgOutgoing,omitempty"`
+	NetBalance                float32            `protobuf:"fixed32,23,opt,name=NetBalance,proto3" json:"NetBalance,omitempty"`
+	TopIndvContributorsAmt    []*TotalsMap       `protobuf:"bytes,24,rep,name=TopIndvContributorsAmt,proto3" json:"TopIndvContributorsAmt,omitempty"`
+	TopIndvContributorsTxs    map[string]float32 `protobuf:"bytes,25,rep,name=TopIndvContributorsTxs,proto3" json:"TopIndvContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopCmteOrgContributorsAmt []*TotalsMap       `protobuf:"bytes,26,rep,name=TopCmteOrgContributorsAmt,proto3" json:"TopCmteOrgContributorsAmt,omitempty"`
+	TopCmteOrgContributorsTxs map[string]float32 `protobuf:"bytes,27,rep,name=TopCmteOrgContributorsTxs,proto3" json:"TopCmteOrgContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TransferRecsAmt           []*TotalsMap       `protobuf:"bytes,28,rep,name=TransferRecsAmt,proto3" json:"TransferRecsAmt,omitempty"`
+	TransferRecsTxs           map[string]float32 `protobuf:"bytes,29,rep,name=TransferRecsTxs,proto3" json:"TransferRecsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TopExpRecipientsAmt       []*TotalsMap       `protobuf:"bytes,30,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty"`
+	TopExpRecipientsTxs       map[string]float32 `protobuf:"bytes,31,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsAmt               map[string]float32 `protobuf:"bytes,32,rep,name=SizeBinsAmt,proto3" json:"SizeBinsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizeBinsTxs               map[string]float32 `protobuf:"bytes,33,rep,name=SizeBinsTxs,proto3" json:"SizeBinsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	SizePercentiles           map[string]float32 `protobuf:"bytes,34,rep,name=SizePercentiles,proto3" json:"SizePercentiles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInAmt             map[string]float32 `protobuf:"bytes,35,rep,name=ConduitsInAmt,proto3" json:"ConduitsInAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	ConduitsInTxs             map[string]float32 `protobuf:"bytes,36,rep,name=ConduitsInTxs,proto3" json:"ConduitsInTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkedInAmt            float32            `protobuf:"fixed32,37,opt,name=EarmarkedInAmt,proto3" json:"EarmarkedInAmt,omitempty"`
+	EarmarkedInTxs            float32            `protobuf:"fixed32,38,opt,name=EarmarkedInTxs,proto3" json:"EarmarkedInTxs,omitempty"`
+	EarmarkedOutAmt           float32            `protobuf:"fixed32,39,opt,name=EarmarkedOutAmt,proto3" json:"EarmarkedOutAmt,omitempty"`
+	EarmarkedOutTxs           float32            `protobuf:"fixed32,40,opt,name=EarmarkedOutTxs,proto3" json:"EarmarkedOutTxs,omitempty"`
+	EarmarkRecipientsAmt      map[string]float32 `protobuf:"bytes,41,rep,name=EarmarkRecipientsAmt,proto3" json:"EarmarkRecipientsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	EarmarkRecipientsTxs      map[string]float32 `protobuf:"bytes,42,rep,name=EarmarkRecipientsTxs,proto3" json:"EarmarkRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsAmt                map[string]float32 `protobuf:"bytes,43,rep,name=TxKindsAmt,proto3" json:"TxKindsAmt,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	TxKindsTxs                map[string]float32 `protobuf:"bytes,44,rep,name=TxKindsTxs,proto3" json:"TxKindsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral      struct{}           `json:"-"`
+	XXX_unrecognized          []byte             `json:"-"`
+	XXX_sizecache             int32              `json:"-"`
+}
+
+func (m *CmteTxData) Reset()         { *m = CmteTxData{} }
+func (m *CmteTxData) String() string { return proto.CompactTextString(m) }
+func (*CmteTxData) ProtoMessage()    {}
+func (*CmteTxData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{28}
+}
+
+func (m *CmteTxData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CmteTxData.Unmarshal(m, b)
+}
+func (m *CmteTxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CmteTxData.Marshal(b, m, deterministic)
+}
+func (m *CmteTxData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CmteTxData.Merge(m, src)
+}
+func (m *CmteTxData) XXX_Size() int {
+	return xxx_messageInfo_CmteTxData.Size(m)
+}
+func (m *CmteTxData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CmteTxData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CmteTxData proto.InternalMessageInfo
+
+func (m *CmteTxData) GetCmteID() string {
+	if m != nil {
+		return m.CmteID
+	}
+	return ""
+}
+
+func (m *CmteTxData) GetCandID() string {
+	if m != nil {
+		return m.CandID
+	}
+	return ""
+}
+
+func (m *CmteTxData) GetParty() string {
+	if m != nil {
+		return m.Party
+	}
+	return ""
+}
+
+func (m *CmteTxData) GetContributionsInAmt() float32 {
+	if m != nil {
+		return m.ContributionsInAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetContributionsInTxs() float32 {
+	if m != nil {
+		return m.ContributionsInTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetAvgContributionIn() float32 {
+	if m != nil {
+		return m.AvgContributionIn
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetOtherReceiptsInAmt() float32 {
+	if m != nil {
+		return m.OtherReceiptsInAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetOtherReceiptsInTxs() float32 {
+	if m != nil {
+		return m.OtherReceiptsInTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetAvgOtherIn() float32 {
+	if m != nil {
+		return m.AvgOtherIn
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTotalIncomingAmt() float32 {
+	if m != nil {
+		return m.TotalIncomingAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTotalIncomingTxs() float32 {
+	if m != nil {
+		return m.TotalIncomingTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetAvgIncoming() float32 {
+	if m != nil {
+		return m.AvgIncoming
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTransfersAmt() float32 {
+	if m != nil {
+		return m.TransfersAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTransfersTxs() float32 {
+	if m != nil {
+		return m.TransfersTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetAvgTransfer() float32 {
+	if m != nil {
+		return m.AvgTransfer
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTransfersList() []string {
+	if m != nil {
+		return m.TransfersList
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetExpendituresAmt() float32 {
+	if m != nil {
+		return m.ExpendituresAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetExpendituresTxs() float32 {
+	if m != nil {
+		return m.ExpendituresTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetAvgExpenditure() float32 {
+	if m != nil {
+		return m.AvgExpenditure
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTotalOutgoingAmt() float32 {
+	if m != nil {
+		return m.TotalOutgoingAmt
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTotalOutgoingTxs() float32 {
+	if m != nil {
+		return m.TotalOutgoingTxs
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetAvgOutgoing() float32 {
+	if m != nil {
+		return m.AvgOutgoing
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetNetBalance() float32 {
+	if m != nil {
+		return m.NetBalance
+	}
+	return 0
+}
+
+func (m *CmteTxData) GetTopIndvContributorsAmt() []*TotalsMap {
+	if m != nil {
+		return m.TopIndvContributorsAmt
+	}
+	return nil
+}
+
+func (m *CmteTxData) GetTopIndvContributorsTxs() map[string]float32 {
 	if m != nil {
-		return m.TxsFromAff
+		return m.TopIndvContributorsTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetIndvConts() float32 {
+func (m *CmteTxData) GetTopCmteOrgContributorsAmt() []*TotalsMap {
 	if m != nil {
-		return m.IndvConts
+		return m.TopCmteOrgContributorsAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetOtherConts() float32 {
+func (m *CmteTxData) GetTopCmteOrgContributorsTxs() map[string]float32 {
 	if m != nil {
-		return m.OtherConts
+		return m.TopCmteOrgContributorsTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetCandCont() float32 {
+func (m *CmteTxData) GetTransferRecsAmt() []*TotalsMap {
 	if m != nil {
-		return m.CandCont
+		return m.TransferRecsAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetCandLoans() float32 {
+func (m *CmteTxData) GetTransferRecsTxs() map[string]float32 {
 	if m != nil {
-		return m.CandLoans
+		return m.TransferRecsTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetTotalLoans() float32 {
+func (m *CmteTxData) GetTopExpRecipientsAmt() []*TotalsMap {
 	if m != nil {
-		return m.TotalLoans
+		return m.TopExpRecipientsAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetTotalDisb() float32 {
+func (m *CmteTxData) GetTopExpRecipientsTxs() map[string]float32 {
 	if m != nil {
-		return m.TotalDisb
+		return m.TopExpRecipientsTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetTxToAff() float32 {
+func (m *CmteTxData) GetSizeBinsAmt() map[string]float32 {
 	if m != nil {
-		return m.TxToAff
+		return m.SizeBinsAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetIndvRefunds() float32 {
+func (m *CmteTxData) GetSizeBinsTxs() map[string]float32 {
 	if m != nil {
-		return m.IndvRefunds
+		return m.SizeBinsTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetOtherRefunds() float32 {
+func (m *CmteTxData) GetSizePercentiles() map[string]float32 {
 	if m != nil {
-		return m.OtherRefunds
+		return m.SizePercentiles
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetLoanRepay() float32 {
+func (m *CmteTxData) GetConduitsInAmt() map[string]float32 {
 	if m != nil {
-		return m.LoanRepay
+		return m.ConduitsInAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetCashBOP() float32 {
+func (m *CmteTxData) GetConduitsInTxs() map[string]float32 {
 	if m != nil {
-		return m.CashBOP
+		return m.ConduitsInTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetCashCOP() float32 {
+func (m *CmteTxData) GetEarmarkedInAmt() float32 {
 	if m != nil {
-		return m.CashCOP
+		return m.EarmarkedInAmt
 	}
 	return 0
 }
 
-func (m *CmteFinancials) GetDebtsOwed() float32 {
+func (m *CmteTxData) GetEarmarkedInTxs() float32 {
 	if m != nil {
-		return m.DebtsOwed
+		return m.EarmarkedInTxs
 	}
 	return 0
 }
 
-func (m *CmteFinancials) GetNonFedTxsRecvd() float32 {
+func (m *CmteTxData) GetEarmarkedOutAmt() float32 {
 	if m != nil {
-		return m.NonFedTxsRecvd
+		return m.EarmarkedOutAmt
 	}
 	return 0
 }
 
-func (m *CmteFinancials) GetContToOtherCmte() float32 {
+func (m *CmteTxData) GetEarmarkedOutTxs() float32 {
 	if m != nil {
-		return m.ContToOtherCmte
+		return m.EarmarkedOutTxs
 	}
 	return 0
 }
 
-func (m *CmteFinancials) GetIndExp() float32 {
+func (m *CmteTxData) GetEarmarkRecipientsAmt() map[string]float32 {
 	if m != nil {
-		return m.IndExp
+		return m.EarmarkRecipientsAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetPartyExp() float32 {
+func (m *CmteTxData) GetEarmarkRecipientsTxs() map[string]float32 {
 	if m != nil {
-		return m.PartyExp
+		return m.EarmarkRecipientsTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetNonFedSharedExp() float32 {
+func (m *CmteTxData) GetTxKindsAmt() map[string]float32 {
 	if m != nil {
-		return m.NonFedSharedExp
+		return m.TxKindsAmt
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteFinancials) GetCovgEndDate() *timestamp.Timestamp {
+func (m *CmteTxData) GetTxKindsTxs() map[string]float32 {
 	if m != nil {
-		return m.CovgEndDate
+		return m.TxKindsTxs
 	}
 	return nil
 }
 
-type CmteTxData struct {
-	CmteID                    string             `protobuf:"bytes,1,opt,name=CmteID,proto3" json:"CmteID,omitempty"`
-	CandID                    string             `protobuf:"bytes,2,opt,name=CandID,proto3" json:"CandID,omitempty"`
-	Party                     string             `protobuf:"bytes,3,opt,name=Party,proto3" json:"Party,omitempty"`
-	ContributionsInAmt        float32            `protobuf:"fixed32,4,opt,name=ContributionsInAmt,proto3" json:"ContributionsInAmt,omitempty"`
-	ContributionsInTxs        float32            `protobuf:"fixed32,5,opt,name=ContributionsInTxs,proto3" json:"ContributionsInTxs,omitempty"`
-	AvgContributionIn         float32            `protobuf:"fixed32,6,opt,name=AvgContributionIn,proto3" json:"AvgContributionIn,omitempty"`
-	OtherReceiptsInAmt        float32            `protobuf:"fixed32,7,opt,name=OtherReceiptsInAmt,proto3" json:"OtherReceiptsInAmt,omitempty"`
-	OtherReceiptsInTxs        float32            `protobuf:"fixed32,8,opt,name=OtherReceiptsInTxs,proto3" json:"OtherReceiptsInTxs,omitempty"`
-	AvgOtherIn                float32            `protobuf:"fixed32,9,opt,name=AvgOtherIn,proto3" json:"AvgOtherIn,omitempty"`
-	TotalIncomingAmt          float32            `protobuf:"fixed32,10,opt,name=TotalIncomingAmt,proto3" json:"TotalIncomingAmt,omitempty"`
-	TotalIncomingTxs          float32            `protobuf:"fixed32,11,opt,name=TotalIncomingTxs,proto3" json:"TotalIncomingTxs,omitempty"`
-	AvgIncoming               float32            `protobuf:"fixed32,12,opt,name=AvgIncoming,proto3" json:"AvgIncoming,omitempty"`
-	TransfersAmt              float32            `protobuf:"fixed32,13,opt,name=TransfersAmt,proto3" json:"TransfersAmt,omitempty"`
-	TransfersTxs              float32            `protobuf:"fixed32,14,opt,name=TransfersTxs,proto3" json:"TransfersTxs,omitempty"`
-	AvgTransfer               float32            `protobuf:"fixed32,15,opt,name=AvgTransfer,proto3" json:"AvgTransfer,omitempty"`
-	TransfersList             []string           `protobuf:"bytes,16,rep,name=TransfersList,proto3" json:"TransfersList,omitempty"`
-	ExpendituresAmt           float32            `protobuf:"fixed32,17,opt,name=ExpendituresAmt,proto3" json:"ExpendituresAmt,omitempty"`
-	ExpendituresTxs           float32            `protobuf:"fixed32,18,opt,name=ExpendituresTxs,proto3" json:"ExpendituresTxs,omitempty"`
-	AvgExpenditure            float32            `protobuf:"fixed32,19,opt,name=AvgExpenditure,proto3" json:"AvgExpenditure,omitempty"`
-	TotalOutgoingAmt          float32            `protobuf:"fixed32,20,opt,name=TotalOutgoingAmt,proto3" json:"TotalOutgoingAmt,omitempty"`
-	TotalOutgoingTxs          float32            `protobuf:"fixed32,21,opt,name=TotalOutgoingTxs,proto3" json:"TotalOutgoingTxs,omitempty"`
-	AvgOutgoing               float32            `protobuf:"fixed32,22,opt,name=AvgOutgoing,proto3" json:"AvgOutgoing,omitempty"`
-	NetBalance                float32            `protobuf:"fixed32,23,opt,name=NetBalance,proto3" json:"NetBalance,omitempty"`
-	TopIndvContributorsAmt    []*TotalsMap       `protobuf:"bytes,24,rep,name=TopIndvContributorsAmt,proto3" json:"TopIndvContributorsAmt,omitempty"`
-	TopIndvContributorsTxs    map[string]float32 `protobuf:"bytes,25,rep,name=TopIndvContributorsTxs,proto3" json:"TopIndvContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
-	TopCmteOrgContributorsAmt []*TotalsMap       `protobuf:"bytes,26,rep,name=TopCmteOrgContributorsAmt,proto3" json:"TopCmteOrgContributorsAmt,omitempty"`
-	TopCmteOrgContributorsTxs map[string]float32 `protobuf:"bytes,27,rep,name=TopCmteOrgContributorsTxs,proto3" json:"TopCmteOrgContributorsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
-	TransferRecsAmt           []*TotalsMap       `protobuf:"bytes,28,rep,name=TransferRecsAmt,proto3" json:"TransferRecsAmt,omitempty"`
-	TransferRecsTxs           map[string]float32 `protobuf:"bytes,29,rep,name=TransferRecsTxs,proto3" json:"TransferRecsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
-	TopExpRecipientsAmt       []*TotalsMap       `protobuf:"bytes,30,rep,name=TopExpRecipientsAmt,proto3" json:"TopExpRecipientsAmt,omitempty"`
-	TopExpRecipientsTxs       map[string]float32 `protobuf:"bytes,31,rep,name=TopExpRecipientsTxs,proto3" json:"TopExpRecipientsTxs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral      struct{}           `json:"-"`
-	XXX_unrecognized          []byte             `json:"-"`
-	XXX_sizecache             int32              `json:"-"`
+type LookupRequest struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ObjectIds            []string             `protobuf:"bytes,2,rep,name=ObjectIds,proto3" json:"ObjectIds,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CmteTxData) Reset()         { *m = CmteTxData{} }
-func (m *CmteTxData) String() string { return proto.CompactTextString(m) }
-func (*CmteTxData) ProtoMessage()    {}
-func (*CmteTxData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{25}
+func (m *LookupRequest) Reset()         { *m = LookupRequest{} }
+func (m *LookupRequest) String() string { return proto.CompactTextString(m) }
+func (*LookupRequest) ProtoMessage()    {}
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{29}
 }
 
-func (m *CmteTxData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CmteTxData.Unmarshal(m, b)
+func (m *LookupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupRequest.Unmarshal(m, b)
 }
-func (m *CmteTxData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CmteTxData.Marshal(b, m, deterministic)
+func (m *LookupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupRequest.Marshal(b, m, deterministic)
 }
-func (m *CmteTxData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CmteTxData.Merge(m, src)
+func (m *LookupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupRequest.Merge(m, src)
 }
-func (m *CmteTxData) XXX_Size() int {
-	return xxx_messageInfo_CmteTxData.Size(m)
+func (m *LookupRequest) XXX_Size() int {
+	return xxx_messageInfo_LookupRequest.Size(m)
 }
-func (m *CmteTxData) XXX_DiscardUnknown() {
-	xxx_messageInfo_CmteTxData.DiscardUnknown(m)
+func (m *LookupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CmteTxData proto.InternalMessageInfo
+var xxx_messageInfo_LookupRequest proto.InternalMessageInfo
 
-func (m *CmteTxData) GetCmteID() string {
+func (m *LookupRequest) GetUID() string {
 	if m != nil {
-		return m.CmteID
+		return m.UID
 	}
 	return ""
 }
 
-func (m *CmteTxData) GetCandID() string {
+func (m *LookupRequest) GetObjectIds() []string {
 	if m != nil {
-		return m.CandID
+		return m.ObjectIds
 	}
-	return ""
+	return nil
 }
 
-func (m *CmteTxData) GetParty() string {
+func (m *LookupRequest) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.Party
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *LookupRequest) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
 	return ""
 }
 
-func (m *CmteTxData) GetContributionsInAmt() float32 {
+type LookupResponse struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	Results              []*SearchResult      `protobuf:"bytes,2,rep,name=Results,proto3" json:"Results,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LookupResponse) Reset()         { *m = LookupResponse{} }
+func (m *LookupResponse) String() string { return proto.CompactTextString(m) }
+func (*LookupResponse) ProtoMessage()    {}
+func (*LookupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{30}
+}
+
+func (m *LookupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LookupResponse.Unmarshal(m, b)
+}
+func (m *LookupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LookupResponse.Marshal(b, m, deterministic)
+}
+func (m *LookupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LookupResponse.Merge(m, src)
+}
+func (m *LookupResponse) XXX_Size() int {
+	return xxx_messageInfo_LookupResponse.Size(m)
+}
+func (m *LookupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LookupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LookupResponse proto.InternalMessageInfo
+
+func (m *LookupResponse) GetUID() string {
 	if m != nil {
-		return m.ContributionsInAmt
+		return m.UID
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetContributionsInTxs() float32 {
+func (m *LookupResponse) GetResults() []*SearchResult {
 	if m != nil {
-		return m.ContributionsInTxs
+		return m.Results
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteTxData) GetAvgContributionIn() float32 {
+func (m *LookupResponse) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.AvgContributionIn
+		return m.Timestamp
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteTxData) GetOtherReceiptsInAmt() float32 {
+func (m *LookupResponse) GetMsg() string {
 	if m != nil {
-		return m.OtherReceiptsInAmt
+		return m.Msg
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetOtherReceiptsInTxs() float32 {
+type GetNetworkRequest struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ObjectID             string               `protobuf:"bytes,2,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Years                []string             `protobuf:"bytes,3,rep,name=Years,proto3" json:"Years,omitempty"`
+	Depth                int32                `protobuf:"varint,4,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	MinAmount            float32              `protobuf:"fixed32,5,opt,name=MinAmount,proto3" json:"MinAmount,omitempty"`
+	MaxNodes             int32                `protobuf:"varint,6,opt,name=MaxNodes,proto3" json:"MaxNodes,omitempty"`
+	PageSize             int32                `protobuf:"varint,7,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageToken            string               `protobuf:"bytes,8,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,10,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetNetworkRequest) Reset()         { *m = GetNetworkRequest{} }
+func (m *GetNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkRequest) ProtoMessage()    {}
+func (*GetNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{31}
+}
+
+func (m *GetNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkRequest.Unmarshal(m, b)
+}
+func (m *GetNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkRequest.Merge(m, src)
+}
+func (m *GetNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkRequest.Size(m)
+}
+func (m *GetNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNetworkRequest proto.InternalMessageInfo
+
+func (m *GetNetworkRequest) GetUID() string {
 	if m != nil {
-		return m.OtherReceiptsInTxs
+		return m.UID
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetAvgOtherIn() float32 {
+func (m *GetNetworkRequest) GetObjectID() string {
 	if m != nil {
-		return m.AvgOtherIn
+		return m.ObjectID
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetTotalIncomingAmt() float32 {
+func (m *GetNetworkRequest) GetYears() []string {
 	if m != nil {
-		return m.TotalIncomingAmt
+		return m.Years
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteTxData) GetTotalIncomingTxs() float32 {
+func (m *GetNetworkRequest) GetDepth() int32 {
 	if m != nil {
-		return m.TotalIncomingTxs
+		return m.Depth
 	}
 	return 0
 }
 
-func (m *CmteTxData) GetAvgIncoming() float32 {
+func (m *GetNetworkRequest) GetMinAmount() float32 {
 	if m != nil {
-		return m.AvgIncoming
+		return m.MinAmount
 	}
 	return 0
 }
 
-func (m *CmteTxData) GetTransfersAmt() float32 {
+func (m *GetNetworkRequest) GetMaxNodes() int32 {
 	if m != nil {
-		return m.TransfersAmt
+		return m.MaxNodes
 	}
 	return 0
 }
 
-func (m *CmteTxData) GetTransfersTxs() float32 {
+func (m *GetNetworkRequest) GetPageSize() int32 {
 	if m != nil {
-		return m.TransfersTxs
+		return m.PageSize
 	}
 	return 0
 }
 
-func (m *CmteTxData) GetAvgTransfer() float32 {
+func (m *GetNetworkRequest) GetPageToken() string {
 	if m != nil {
-		return m.AvgTransfer
+		return m.PageToken
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetTransfersList() []string {
+func (m *GetNetworkRequest) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.TransfersList
+		return m.Timestamp
 	}
 	return nil
 }
 
-func (m *CmteTxData) GetExpendituresAmt() float32 {
+func (m *GetNetworkRequest) GetMsg() string {
 	if m != nil {
-		return m.ExpendituresAmt
+		return m.Msg
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetExpendituresTxs() float32 {
-	if m != nil {
-		return m.ExpendituresTxs
-	}
-	return 0
+type GetNetworkResponse struct {
+	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
+	ObjectID             string               `protobuf:"bytes,2,opt,name=ObjectID,proto3" json:"ObjectID,omitempty"`
+	Years                []string             `protobuf:"bytes,3,rep,name=Years,proto3" json:"Years,omitempty"`
+	Nodes                []*NetworkNode       `protobuf:"bytes,4,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Edges                []*NetworkEdge       `protobuf:"bytes,5,rep,name=Edges,proto3" json:"Edges,omitempty"`
+	TotalNodes           int32                `protobuf:"varint,6,opt,name=TotalNodes,proto3" json:"TotalNodes,omitempty"`
+	TotalEdges           int32                `protobuf:"varint,7,opt,name=TotalEdges,proto3" json:"TotalEdges,omitempty"`
+	Truncated            bool                 `protobuf:"varint,8,opt,name=Truncated,proto3" json:"Truncated,omitempty"`
+	NextPageToken        string               `protobuf:"bytes,9,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Msg                  string               `protobuf:"bytes,11,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CmteTxData) GetAvgExpenditure() float32 {
-	if m != nil {
-		return m.AvgExpenditure
-	}
-	return 0
+func (m *GetNetworkResponse) Reset()         { *m = GetNetworkResponse{} }
+func (m *GetNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkResponse) ProtoMessage()    {}
+func (*GetNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{32}
 }
 
-func (m *CmteTxData) GetTotalOutgoingAmt() float32 {
-	if m != nil {
-		return m.TotalOutgoingAmt
-	}
-	return 0
+func (m *GetNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNetworkResponse.Unmarshal(m, b)
+}
+func (m *GetNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNetworkResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkResponse.Merge(m, src)
+}
+func (m *GetNetworkResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNetworkResponse.Size(m)
+}
+func (m *GetNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkResponse.DiscardUnknown(m)
 }
 
-func (m *CmteTxData) GetTotalOutgoingTxs() float32 {
+var xxx_messageInfo_GetNetworkResponse proto.InternalMessageInfo
+
+func (m *GetNetworkResponse) GetUID() string {
 	if m != nil {
-		return m.TotalOutgoingTxs
+		return m.UID
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetAvgOutgoing() float32 {
+func (m *GetNetworkResponse) GetObjectID() string {
 	if m != nil {
-		return m.AvgOutgoing
+		return m.ObjectID
 	}
-	return 0
+	return ""
 }
 
-func (m *CmteTxData) GetNetBalance() float32 {
+func (m *GetNetworkResponse) GetYears() []string {
 	if m != nil {
-		return m.NetBalance
+		return m.Years
 	}
-	return 0
+	return nil
 }
 
-func (m *CmteTxData) GetTopIndvContributorsAmt() []*TotalsMap {
+func (m *GetNetworkResponse) GetNodes() []*NetworkNode {
 	if m != nil {
-		return m.TopIndvContributorsAmt
+		return m.Nodes
 	}
 	return nil
 }
 
-func (m *CmteTxData) GetTopIndvContributorsTxs() map[string]float32 {
+func (m *GetNetworkResponse) GetEdges() []*NetworkEdge {
 	if m != nil {
-		return m.TopIndvContributorsTxs
+		return m.Edges
 	}
 	return nil
 }
 
-func (m *CmteTxData) GetTopCmteOrgContributorsAmt() []*TotalsMap {
+func (m *GetNetworkResponse) GetTotalNodes() int32 {
 	if m != nil {
-		return m.TopCmteOrgContributorsAmt
+		return m.TotalNodes
 	}
-	return nil
+	return 0
 }
 
-func (m *CmteTxData) GetTopCmteOrgContributorsTxs() map[string]float32 {
+func (m *GetNetworkResponse) GetTotalEdges() int32 {
 	if m != nil {
-		return m.TopCmteOrgContributorsTxs
+		return m.TotalEdges
 	}
-	return nil
+	return 0
 }
 
-func (m *CmteTxData) GetTransferRecsAmt() []*TotalsMap {
+func (m *GetNetworkResponse) GetTruncated() bool {
 	if m != nil {
-		return m.TransferRecsAmt
+		return m.Truncated
 	}
-	return nil
+	return false
 }
 
-func (m *CmteTxData) GetTransferRecsTxs() map[string]float32 {
+func (m *GetNetworkResponse) GetNextPageToken() string {
 	if m != nil {
-		return m.TransferRecsTxs
+		return m.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-func (m *CmteTxData) GetTopExpRecipientsAmt() []*TotalsMap {
+func (m *GetNetworkResponse) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.TopExpRecipientsAmt
+		return m.Timestamp
 	}
 	return nil
 }
 
-func (m *CmteTxData) GetTopExpRecipientsTxs() map[string]float32 {
+func (m *GetNetworkResponse) GetMsg() string {
 	if m != nil {
-		return m.TopExpRecipientsTxs
+		return m.Msg
 	}
-	return nil
+	return ""
 }
 
-type LookupRequest struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	ObjectIds            []string             `protobuf:"bytes,2,rep,name=ObjectIds,proto3" json:"ObjectIds,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type NetworkNode struct {
+	ID          string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type        string  `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Party       string  `protobuf:"bytes,4,opt,name=Party,proto3" json:"Party,omitempty"`
+	State       string  `protobuf:"bytes,5,opt,name=State,proto3" json:"State,omitempty"`
+	TotalInAmt  float32 `protobuf:"fixed32,6,opt,name=TotalInAmt,proto3" json:"TotalInAmt,omitempty"`
+	TotalOutAmt float32 `protobuf:"fixed32,7,opt,name=TotalOutAmt,proto3" json:"TotalOutAmt,omitempty"`
+	// # of hops from root node
+	Depth                int32    `protobuf:"varint,8,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LookupRequest) Reset()         { *m = LookupRequest{} }
-func (m *LookupRequest) String() string { return proto.CompactTextString(m) }
-func (*LookupRequest) ProtoMessage()    {}
-func (*LookupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{26}
+func (m *NetworkNode) Reset()         { *m = NetworkNode{} }
+func (m *NetworkNode) String() string { return proto.CompactTextString(m) }
+func (*NetworkNode) ProtoMessage()    {}
+func (*NetworkNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{33}
 }
 
-func (m *LookupRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupRequest.Unmarshal(m, b)
+func (m *NetworkNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkNode.Unmarshal(m, b)
 }
-func (m *LookupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupRequest.Marshal(b, m, deterministic)
+func (m *NetworkNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkNode.Marshal(b, m, deterministic)
 }
-func (m *LookupRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupRequest.Merge(m, src)
+func (m *NetworkNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkNode.Merge(m, src)
 }
-func (m *LookupRequest) XXX_Size() int {
-	return xxx_messageInfo_LookupRequest.Size(m)
+func (m *NetworkNode) XXX_Size() int {
+	return xxx_messageInfo_NetworkNode.Size(m)
 }
-func (m *LookupRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupRequest.DiscardUnknown(m)
+func (m *NetworkNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupRequest proto.InternalMessageInfo
+var xxx_messageInfo_NetworkNode proto.InternalMessageInfo
 
-func (m *LookupRequest) GetUID() string {
+func (m *NetworkNode) GetID() string {
 	if m != nil {
-		return m.UID
+		return m.ID
 	}
 	return ""
 }
 
-func (m *LookupRequest) GetObjectIds() []string {
+func (m *NetworkNode) GetName() string {
 	if m != nil {
-		return m.ObjectIds
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *LookupRequest) GetTimestamp() *timestamp.Timestamp {
+func (m *NetworkNode) GetType() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-func (m *LookupRequest) GetMsg() string {
+func (m *NetworkNode) GetParty() string {
 	if m != nil {
-		return m.Msg
+		return m.Party
 	}
 	return ""
 }
 
-type LookupResponse struct {
-	UID                  string               `protobuf:"bytes,1,opt,name=UID,proto3" json:"UID,omitempty"`
-	Results              []*SearchResult      `protobuf:"bytes,2,rep,name=Results,proto3" json:"Results,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
-	Msg                  string               `protobuf:"bytes,4,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *NetworkNode) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
 }
 
-func (m *LookupResponse) Reset()         { *m = LookupResponse{} }
-func (m *LookupResponse) String() string { return proto.CompactTextString(m) }
-func (*LookupResponse) ProtoMessage()    {}
-func (*LookupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad098daeda4239f7, []int{27}
+func (m *NetworkNode) GetTotalInAmt() float32 {
+	if m != nil {
+		return m.TotalInAmt
+	}
+	return 0
 }
 
-func (m *LookupResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LookupResponse.Unmarshal(m, b)
+func (m *NetworkNode) GetTotalOutAmt() float32 {
+	if m != nil {
+		return m.TotalOutAmt
+	}
+	return 0
 }
-func (m *LookupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LookupResponse.Marshal(b, m, deterministic)
+
+func (m *NetworkNode) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
-func (m *LookupResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LookupResponse.Merge(m, src)
+
+type NetworkEdge struct {
+	From                 string   `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	Amount               float32  `protobuf:"fixed32,4,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Txs                  float32  `protobuf:"fixed32,5,opt,name=Txs,proto3" json:"Txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *LookupResponse) XXX_Size() int {
-	return xxx_messageInfo_LookupResponse.Size(m)
+
+func (m *NetworkEdge) Reset()         { *m = NetworkEdge{} }
+func (m *NetworkEdge) String() string { return proto.CompactTextString(m) }
+func (*NetworkEdge) ProtoMessage()    {}
+func (*NetworkEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad098daeda4239f7, []int{34}
 }
-func (m *LookupResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LookupResponse.DiscardUnknown(m)
+
+func (m *NetworkEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkEdge.Unmarshal(m, b)
+}
+func (m *NetworkEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkEdge.Marshal(b, m, deterministic)
+}
+func (m *NetworkEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkEdge.Merge(m, src)
+}
+func (m *NetworkEdge) XXX_Size() int {
+	return xxx_messageInfo_NetworkEdge.Size(m)
+}
+func (m *NetworkEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkEdge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LookupResponse proto.InternalMessageInfo
+var xxx_messageInfo_NetworkEdge proto.InternalMessageInfo
 
-func (m *LookupResponse) GetUID() string {
+func (m *NetworkEdge) GetFrom() string {
 	if m != nil {
-		return m.UID
+		return m.From
 	}
 	return ""
 }
 
-func (m *LookupResponse) GetResults() []*SearchResult {
+func (m *NetworkEdge) GetTo() string {
 	if m != nil {
-		return m.Results
+		return m.To
 	}
-	return nil
+	return ""
 }
 
-func (m *LookupResponse) GetTimestamp() *timestamp.Timestamp {
+func (m *NetworkEdge) GetType() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Type
 	}
-	return nil
+	return ""
 }
 
-func (m *LookupResponse) GetMsg() string {
+func (m *NetworkEdge) GetAmount() float32 {
 	if m != nil {
-		return m.Msg
+		return m.Amount
 	}
-	return ""
+	return 0
+}
+
+func (m *NetworkEdge) GetTxs() float32 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
 }
 
 func init() {
@@ -2942,6 +4098,8 @@ func init() {
 	proto.RegisterType((*SearchRequest)(nil), "proto.SearchRequest")
 	proto.RegisterType((*SearchResponse)(nil), "proto.SearchResponse")
 	proto.RegisterType((*SearchResult)(nil), "proto.SearchResult")
+	proto.RegisterType((*AutocompleteRequest)(nil), "proto.AutocompleteRequest")
+	proto.RegisterType((*AutocompleteResponse)(nil), "proto.AutocompleteResponse")
 	proto.RegisterType((*RankingsRequest)(nil), "proto.RankingsRequest")
 	proto.RegisterType((*RankingsResponse)(nil), "proto.RankingsResponse")
 	proto.RegisterType((*RankingsResult)(nil), "proto.RankingsResult")
@@ -2962,186 +4120,290 @@ func init() {
 	proto.RegisterType((*Candidate)(nil), "proto.Candidate")
 	proto.RegisterMapType((map[string]float32)(nil), "proto.Candidate.DirectRecipientsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "proto.Candidate.DirectSendersTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.Candidate.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.Candidate.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.Candidate.SizePercentilesEntry")
 	proto.RegisterType((*CmpnFinancials)(nil), "proto.CmpnFinancials")
+	proto.RegisterType((*CandRollup)(nil), "proto.CandRollup")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.CandLoansByYearEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.CandRepaysByYearEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.ConduitsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.ConduitsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.ElectionsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.ElectionsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.SizePercentilesEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.TopCmteOrgContributorsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.TopExpRecipientsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.TopIndvContributorsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.TransferRecsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.TxKindsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CandRollup.TxKindsTxsEntry")
 	proto.RegisterType((*GetCmteRequest)(nil), "proto.GetCmteRequest")
 	proto.RegisterType((*GetCmteResponse)(nil), "proto.GetCmteResponse")
 	proto.RegisterType((*Committee)(nil), "proto.Committee")
 	proto.RegisterType((*CmteFinancials)(nil), "proto.CmteFinancials")
 	proto.RegisterType((*CmteTxData)(nil), "proto.CmteTxData")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.ConduitsInAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.ConduitsInTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.EarmarkRecipientsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.EarmarkRecipientsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.SizeBinsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.SizeBinsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.SizePercentilesEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.TopCmteOrgContributorsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.TopExpRecipientsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.TopIndvContributorsTxsEntry")
 	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.TransferRecsTxsEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.TxKindsAmtEntry")
+	proto.RegisterMapType((map[string]float32)(nil), "proto.CmteTxData.TxKindsTxsEntry")
 	proto.RegisterType((*LookupRequest)(nil), "proto.LookupRequest")
 	proto.RegisterType((*LookupResponse)(nil), "proto.LookupResponse")
+	proto.RegisterType((*GetNetworkRequest)(nil), "proto.GetNetworkRequest")
+	proto.RegisterType((*GetNetworkResponse)(nil), "proto.GetNetworkResponse")
+	proto.RegisterType((*NetworkNode)(nil), "proto.NetworkNode")
+	proto.RegisterType((*NetworkEdge)(nil), "proto.NetworkEdge")
 }
 
 func init() { proto.RegisterFile("server.proto", fileDescriptor_ad098daeda4239f7) }
 
 var fileDescriptor_ad098daeda4239f7 = []byte{
-	// 2581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0x37, 0x29, 0xf1, 0xdf, 0x50, 0xa2, 0xa4, 0xb5, 0x24, 0x5f, 0x68, 0xc7, 0x51, 0x89, 0xc0,
-	0x70, 0x82, 0x84, 0x89, 0x5d, 0x34, 0x0d, 0x8c, 0x36, 0x0d, 0x45, 0x49, 0x09, 0x0b, 0xdb, 0x54,
-	0xcf, 0x6c, 0x01, 0xb7, 0x7d, 0x39, 0x91, 0x2b, 0xfa, 0x6c, 0xf2, 0x8e, 0xb9, 0x5b, 0x32, 0x24,
-	0xfa, 0xd4, 0xd7, 0xf6, 0x33, 0x14, 0x08, 0x50, 0xa0, 0x7d, 0xea, 0x5b, 0x81, 0x16, 0xfd, 0x00,
-	0x05, 0x8a, 0xa2, 0x5f, 0x22, 0xcf, 0x7d, 0xeb, 0x17, 0x28, 0x66, 0xff, 0xdd, 0xde, 0x3f, 0xdb,
-	0xb2, 0x55, 0xa0, 0x7e, 0xe2, 0xed, 0x6f, 0x7f, 0x3b, 0x3b, 0x3b, 0x3b, 0x3b, 0x33, 0x7b, 0x3c,
-	0xd8, 0x08, 0x69, 0xb0, 0xa0, 0x41, 0x7b, 0x16, 0xf8, 0xcc, 0x27, 0x25, 0xfe, 0xd3, 0x7c, 0x67,
-	0xec, 0xfb, 0xe3, 0x09, 0xfd, 0x88, 0xb7, 0xce, 0xe6, 0xe7, 0x1f, 0x31, 0x77, 0x4a, 0x43, 0xe6,
-	0x4c, 0x67, 0x82, 0xd7, 0xaa, 0x40, 0xe9, 0x78, 0x3a, 0x63, 0xab, 0xd6, 0xaf, 0x0b, 0xb0, 0xf9,
-	0x88, 0x3a, 0xc1, 0xf0, 0x89, 0x4d, 0xbf, 0x9a, 0xd3, 0x90, 0x91, 0x6d, 0x58, 0xfb, 0x69, 0xef,
-	0xc8, 0x2a, 0x1c, 0x14, 0x6e, 0xd7, 0x6c, 0x7c, 0x24, 0x04, 0xd6, 0x07, 0x74, 0xc9, 0xac, 0x22,
-	0x87, 0xf8, 0x33, 0xf9, 0x14, 0x6a, 0x03, 0x25, 0xd3, 0x5a, 0x3b, 0x28, 0xdc, 0xae, 0xdf, 0x6d,
-	0xb6, 0xc5, 0xac, 0x6d, 0x35, 0x6b, 0x5b, 0x33, 0xec, 0x88, 0x8c, 0xf2, 0x1f, 0x84, 0x63, 0x6b,
-	0x5d, 0xc8, 0x7f, 0x10, 0x8e, 0x5b, 0xbf, 0x2b, 0x40, 0x43, 0xe9, 0x10, 0xce, 0x7c, 0x2f, 0xa4,
-	0x19, 0x4a, 0x7c, 0x08, 0x15, 0x9b, 0x86, 0xf3, 0x09, 0x0b, 0xad, 0xe2, 0xc1, 0xda, 0xed, 0xfa,
-	0xdd, 0xab, 0x62, 0x9e, 0xb6, 0x1e, 0x39, 0x9f, 0x30, 0x5b, 0x71, 0x2e, 0x55, 0xbf, 0x3f, 0x14,
-	0x60, 0xc3, 0x9c, 0x85, 0x34, 0xa0, 0xa8, 0x95, 0x2b, 0xf6, 0x8e, 0xc8, 0x3e, 0x94, 0x0f, 0xe7,
-	0xc3, 0x67, 0x54, 0x99, 0x48, 0xb6, 0xd0, 0x70, 0x0f, 0x9d, 0x29, 0xe5, 0xf3, 0xd7, 0x6c, 0xfe,
-	0x8c, 0x58, 0xd7, 0x65, 0x2b, 0x29, 0x9f, 0x3f, 0x93, 0x5d, 0x28, 0x3d, 0x62, 0x0e, 0xa3, 0x56,
-	0x89, 0x83, 0xa2, 0x41, 0x9a, 0x50, 0x3d, 0x9e, 0xce, 0x26, 0xfe, 0x8a, 0x06, 0x56, 0x99, 0x77,
-	0xe8, 0x36, 0x8e, 0x78, 0x4c, 0x9d, 0x20, 0xb4, 0x2a, 0x07, 0x6b, 0x38, 0x82, 0x37, 0x5a, 0xff,
-	0x2a, 0xc0, 0x96, 0xed, 0x78, 0xcf, 0x5c, 0x6f, 0x1c, 0x3e, 0x77, 0x3b, 0x91, 0xae, 0xb6, 0x13,
-	0x9f, 0x8d, 0x15, 0xac, 0xc5, 0x56, 0xd0, 0x84, 0x6a, 0xd7, 0x61, 0x74, 0xec, 0x07, 0x4a, 0x63,
-	0xdd, 0x46, 0x1d, 0x4e, 0x9d, 0x80, 0xad, 0x94, 0xd6, 0xbc, 0x11, 0x37, 0x7c, 0xf9, 0x15, 0x0c,
-	0x5f, 0x89, 0x0c, 0xff, 0xfb, 0x02, 0x6c, 0x47, 0xeb, 0xc9, 0x75, 0x8d, 0x3b, 0x50, 0x55, 0x2c,
-	0xbe, 0xa8, 0xfa, 0xdd, 0x3d, 0xe9, 0x1b, 0xc6, 0x60, 0xf4, 0x0e, 0x4d, 0x7b, 0x7d, 0xf7, 0xa8,
-	0x46, 0x5a, 0xfe, 0xa5, 0x00, 0x8d, 0xf8, 0x44, 0x29, 0x07, 0xf9, 0xdf, 0x9a, 0xfc, 0xfb, 0xb0,
-	0xa1, 0xe6, 0xbf, 0xef, 0x86, 0xcc, 0x2a, 0xc7, 0xce, 0x87, 0xec, 0x3a, 0xf6, 0x58, 0xb0, 0xb2,
-	0x63, 0xc4, 0xd6, 0x6f, 0x0a, 0x7a, 0x24, 0xef, 0xce, 0xd2, 0x9b, 0x3b, 0x70, 0x31, 0xc3, 0x81,
-	0xd7, 0xb2, 0x1c, 0x78, 0xdd, 0x74, 0x60, 0xed, 0xa4, 0x25, 0xc3, 0x49, 0x71, 0xdd, 0x9d, 0xa9,
-	0x3f, 0xf7, 0x18, 0xf7, 0x8e, 0xa2, 0x2d, 0x5b, 0xad, 0x3f, 0x17, 0xa0, 0xf1, 0x38, 0x18, 0xf8,
-	0xcc, 0x99, 0x5c, 0xcc, 0x77, 0x4d, 0x83, 0xad, 0xe5, 0x19, 0x6c, 0x3d, 0xd7, 0x47, 0x4b, 0xaf,
-	0xb0, 0xfb, 0xe5, 0x68, 0xf7, 0xff, 0x58, 0x80, 0x2d, 0xad, 0x76, 0xae, 0x8b, 0x7e, 0x02, 0x75,
-	0xd4, 0x75, 0xb2, 0xe2, 0x44, 0x19, 0xc1, 0x76, 0xe5, 0x0e, 0x45, 0xc3, 0xd1, 0x49, 0x4d, 0xe2,
-	0xeb, 0xfb, 0xa9, 0x71, 0x9a, 0x7e, 0x05, 0x9b, 0xb1, 0x99, 0x5e, 0xca, 0x4b, 0x2f, 0x6e, 0xdc,
-	0x5d, 0x28, 0x89, 0x45, 0x96, 0xf8, 0xf6, 0x8a, 0x06, 0x1e, 0x92, 0xcd, 0x2f, 0x28, 0xeb, 0x9f,
-	0x3d, 0xcd, 0xdf, 0xdc, 0x26, 0x54, 0xfb, 0x67, 0x4f, 0xe9, 0x90, 0xf5, 0x8e, 0xa4, 0x0e, 0xba,
-	0x9d, 0x7b, 0x5a, 0xb4, 0x8f, 0xad, 0x9b, 0x3e, 0x76, 0x99, 0x1b, 0xfc, 0xb7, 0x02, 0x34, 0x94,
-	0xe6, 0xb9, 0xfb, 0xfb, 0x2a, 0xaa, 0xef, 0x43, 0x59, 0x70, 0xb8, 0xfd, 0x36, 0x6c, 0xd9, 0xba,
-	0x54, 0xe5, 0xff, 0x2a, 0x94, 0xef, 0x79, 0xa3, 0xc5, 0x9b, 0x66, 0xf7, 0x7f, 0x17, 0x60, 0x4b,
-	0xab, 0x7e, 0xa9, 0x86, 0xbf, 0x03, 0xd0, 0xf3, 0x46, 0xee, 0xc2, 0x1d, 0xcd, 0x9d, 0x09, 0x37,
-	0x7e, 0xfd, 0xee, 0x8e, 0x3c, 0x8b, 0x51, 0x87, 0x6d, 0x90, 0x72, 0x42, 0xd9, 0x65, 0xe6, 0xba,
-	0x3b, 0x50, 0xe3, 0x27, 0x25, 0x7c, 0xe0, 0xcc, 0x52, 0x27, 0x53, 0x9f, 0xa9, 0xa2, 0x79, 0xa6,
-	0xbe, 0x29, 0x9b, 0x0b, 0xb9, 0xe4, 0xe0, 0xbd, 0x0d, 0x6b, 0x3f, 0x77, 0x67, 0x32, 0xd1, 0xe0,
-	0x23, 0xb9, 0x09, 0xd0, 0x1f, 0x0e, 0xe7, 0x33, 0x87, 0xb9, 0xbe, 0x27, 0x77, 0xca, 0x40, 0x62,
-	0xf5, 0x4a, 0x25, 0x51, 0xaf, 0xb4, 0x60, 0x63, 0x10, 0x38, 0x5e, 0xe8, 0x0c, 0x91, 0x1a, 0x5a,
-	0x55, 0x6e, 0xc6, 0x18, 0x46, 0x0e, 0xa0, 0xce, 0xd7, 0xd5, 0x9f, 0xb3, 0xce, 0x94, 0x59, 0x35,
-	0xbe, 0x54, 0x13, 0x32, 0x19, 0x83, 0x65, 0x68, 0x41, 0x9c, 0x31, 0x58, 0x86, 0xa8, 0x43, 0x67,
-	0x31, 0x1e, 0x2c, 0xfb, 0x73, 0x66, 0xd5, 0x79, 0xb7, 0x6e, 0xa3, 0xfe, 0x9c, 0xda, 0xf3, 0x50,
-	0xfc, 0x06, 0xef, 0x35, 0x10, 0xa3, 0x1f, 0x85, 0x6f, 0xc6, 0xfa, 0x51, 0xb6, 0x05, 0x15, 0x2e,
-	0xab, 0xe7, 0x59, 0x0d, 0xde, 0xa9, 0x9a, 0x38, 0xf2, 0x21, 0x65, 0x87, 0xce, 0xc4, 0xf1, 0x86,
-	0xd4, 0xda, 0x12, 0x23, 0x23, 0x84, 0x7c, 0x02, 0x9b, 0x36, 0x1d, 0xba, 0x33, 0x97, 0x7a, 0x2c,
-	0xc4, 0xc9, 0xb7, 0x79, 0xfc, 0xdf, 0x96, 0x3e, 0xa7, 0xf7, 0xdd, 0x8e, 0xd3, 0xc8, 0x8f, 0xcd,
-	0x71, 0xa8, 0xd4, 0x0e, 0x1f, 0xf7, 0x6e, 0xca, 0x57, 0xdb, 0x31, 0x9a, 0x48, 0xf5, 0xf1, 0xa1,
-	0xe4, 0x63, 0x80, 0x47, 0xd4, 0x1b, 0xd1, 0x80, 0x2b, 0x40, 0x72, 0x14, 0x30, 0x38, 0xa4, 0xa3,
-	0x47, 0xe0, 0xd4, 0x57, 0xf9, 0x88, 0xef, 0xa4, 0xa7, 0x8e, 0x38, 0x62, 0x5e, 0x63, 0x50, 0xf3,
-	0x73, 0x20, 0x69, 0xcd, 0xd0, 0xb5, 0x9e, 0xd1, 0x95, 0x3a, 0xc5, 0xcf, 0x28, 0x77, 0xc1, 0x85,
-	0x33, 0x99, 0x53, 0xe5, 0xdf, 0xbc, 0x71, 0xaf, 0xf8, 0x69, 0xa1, 0xf9, 0x43, 0xd8, 0x4a, 0x4c,
-	0x70, 0x91, 0xe1, 0x2a, 0xfe, 0x75, 0x1d, 0x6f, 0xf4, 0xa6, 0xc5, 0xbf, 0x6f, 0x8a, 0x3c, 0xfe,
-	0x09, 0xd5, 0x2f, 0x35, 0xfe, 0xb5, 0xa1, 0x86, 0x52, 0xdd, 0x91, 0x3a, 0xf4, 0x91, 0x27, 0x68,
-	0xdc, 0x8e, 0x28, 0xe4, 0x7b, 0x00, 0x27, 0xae, 0xe7, 0x78, 0x43, 0xd7, 0x99, 0x84, 0x72, 0x59,
-	0xaa, 0xc2, 0xee, 0x4e, 0x67, 0x5e, 0xd4, 0x69, 0x1b, 0xc4, 0xc8, 0x44, 0xe5, 0x5c, 0x13, 0x55,
-	0x5e, 0xaf, 0xf2, 0xfe, 0x53, 0xd5, 0x58, 0xc9, 0x4b, 0xc5, 0x3f, 0x5d, 0xb2, 0xac, 0x99, 0x25,
-	0x0b, 0x46, 0xae, 0x09, 0x1d, 0x32, 0xef, 0x71, 0xc0, 0xed, 0x51, 0xb2, 0x75, 0x1b, 0x63, 0x4e,
-	0xff, 0xfc, 0xdc, 0x1d, 0x52, 0xf3, 0x86, 0x66, 0x42, 0x3c, 0x8f, 0xf3, 0xa6, 0xdc, 0x3d, 0xd9,
-	0x42, 0x7d, 0x4f, 0xbb, 0x5d, 0x15, 0xe3, 0x4f, 0xbb, 0x5d, 0x1d, 0x7d, 0xab, 0x59, 0xd1, 0xb7,
-	0x96, 0x11, 0x7d, 0x21, 0x8a, 0xbe, 0xb7, 0x61, 0xab, 0xcf, 0x9e, 0xd0, 0xa0, 0x73, 0x7e, 0xee,
-	0x4e, 0x5c, 0x87, 0xd1, 0xd0, 0xaa, 0x73, 0xbb, 0x26, 0x61, 0xf2, 0x3e, 0x6c, 0x9b, 0x71, 0x95,
-	0x5f, 0x09, 0x36, 0x38, 0x35, 0x85, 0x73, 0x2e, 0x1e, 0xfe, 0x23, 0x37, 0x40, 0x9f, 0xe1, 0x91,
-	0x51, 0x44, 0xbe, 0x14, 0x9e, 0xe2, 0x62, 0x54, 0x68, 0x64, 0x70, 0x31, 0xda, 0x1c, 0x40, 0xbd,
-	0xb3, 0x18, 0x2b, 0x44, 0x86, 0x44, 0x13, 0x22, 0x1f, 0xc0, 0x8e, 0x31, 0x4a, 0xc6, 0xfc, 0x6d,
-	0xce, 0x4b, 0x77, 0xa4, 0xd9, 0x22, 0x1a, 0x66, 0xb0, 0x71, 0xf6, 0x16, 0x6c, 0xe8, 0xa9, 0x30,
-	0x13, 0x10, 0x4e, 0x8c, 0x61, 0xa4, 0x0d, 0x24, 0x8a, 0xd0, 0x02, 0x1e, 0x2c, 0xad, 0xab, 0x9c,
-	0x99, 0xd1, 0x43, 0x8e, 0x60, 0x57, 0x3c, 0xc7, 0x42, 0x74, 0x68, 0xed, 0xe6, 0x44, 0xd2, 0x4c,
-	0x36, 0xf9, 0x05, 0x5c, 0x4d, 0xe2, 0xb8, 0x92, 0x3d, 0x2e, 0xe4, 0xbd, 0xe4, 0x21, 0x6c, 0x67,
-	0x70, 0x45, 0x90, 0xcd, 0x92, 0x42, 0x3e, 0x83, 0x1d, 0x01, 0x47, 0x41, 0x3c, 0xb4, 0xf6, 0x73,
-	0xf4, 0x4b, 0x53, 0x89, 0x0d, 0xdb, 0x31, 0x10, 0x35, 0xbb, 0xc6, 0x87, 0xdf, 0xca, 0xd1, 0x2c,
-	0x19, 0xfb, 0x53, 0xe3, 0x9b, 0x27, 0x60, 0xe5, 0x2d, 0xe2, 0x42, 0x79, 0xa0, 0x0b, 0x7b, 0x99,
-	0x53, 0x5e, 0x28, 0x1b, 0x7c, 0x5b, 0x81, 0x46, 0x3c, 0x60, 0xe1, 0xe1, 0xc5, 0x45, 0xe9, 0xc0,
-	0x21, 0x5b, 0x99, 0xc1, 0xc3, 0x82, 0x0a, 0x8f, 0x17, 0xdd, 0x91, 0x0c, 0x1f, 0xaa, 0x99, 0x73,
-	0x13, 0x7a, 0x17, 0x36, 0xe5, 0x75, 0x6b, 0x48, 0xdd, 0x19, 0x0b, 0xe5, 0x8d, 0x28, 0x0e, 0xf2,
-	0xa2, 0x06, 0x8f, 0xe5, 0x49, 0xd0, 0x99, 0xb3, 0x27, 0xf2, 0x52, 0x6c, 0x42, 0x5a, 0xce, 0x91,
-	0x1b, 0x9e, 0x85, 0xb8, 0xa7, 0x15, 0x43, 0x8e, 0x02, 0xb5, 0x9c, 0x81, 0xcf, 0xe5, 0x54, 0x0d,
-	0x39, 0x02, 0xe2, 0x6b, 0xed, 0x7f, 0x79, 0xd8, 0x3f, 0x95, 0xb5, 0x95, 0x6c, 0x49, 0xbc, 0xdb,
-	0x3f, 0x95, 0x15, 0x95, 0x6c, 0x91, 0x1b, 0x22, 0xba, 0x76, 0x7d, 0x8f, 0x85, 0xb2, 0x9a, 0x8a,
-	0x00, 0xd5, 0x7b, 0xdf, 0x77, 0xbc, 0x50, 0x56, 0x53, 0x11, 0xc0, 0x8b, 0x45, 0x8c, 0x4b, 0xa2,
-	0x5b, 0x16, 0x53, 0x11, 0x82, 0x6b, 0x52, 0x64, 0x9b, 0xce, 0x9c, 0x95, 0x8c, 0x24, 0x71, 0x90,
-	0xdc, 0x82, 0x86, 0x1e, 0x23, 0x68, 0x22, 0x92, 0x24, 0x50, 0x5c, 0xfb, 0x11, 0x3d, 0x63, 0x61,
-	0xff, 0x6b, 0x3a, 0x3a, 0x5c, 0xc9, 0x30, 0x62, 0x42, 0x28, 0x49, 0x96, 0x72, 0xa3, 0x85, 0x58,
-	0x90, 0x88, 0x1e, 0x09, 0x34, 0x19, 0xee, 0x49, 0x3a, 0xdc, 0xa3, 0x4e, 0xbc, 0x79, 0xe4, 0x86,
-	0x2c, 0x70, 0x87, 0x8c, 0x07, 0x8d, 0x9a, 0x9d, 0x40, 0x31, 0x08, 0x3d, 0x9a, 0xd1, 0x21, 0x4f,
-	0x24, 0x58, 0x30, 0xef, 0x72, 0x56, 0x0c, 0x43, 0xce, 0x69, 0xe0, 0x4e, 0x35, 0x67, 0x4f, 0x70,
-	0x4c, 0x0c, 0x35, 0xb2, 0xe7, 0x9e, 0xa6, 0xec, 0x0b, 0x8d, 0x0c, 0x08, 0x19, 0x5f, 0xd0, 0x88,
-	0x71, 0x4d, 0x30, 0x0c, 0x08, 0x75, 0x36, 0x9a, 0xa7, 0x43, 0x66, 0x59, 0x62, 0xf5, 0x71, 0x54,
-	0xdb, 0xbb, 0x3b, 0x65, 0x54, 0x58, 0xe9, 0x2d, 0xc3, 0xde, 0x1a, 0xc5, 0x84, 0x79, 0xca, 0x56,
-	0x82, 0xd1, 0x14, 0x65, 0xb6, 0x6a, 0x93, 0x7b, 0x00, 0xdd, 0xc5, 0xf8, 0xd8, 0x1b, 0x1d, 0xa1,
-	0x01, 0xaf, 0xbf, 0x30, 0xc3, 0x1b, 0x6c, 0x5c, 0x89, 0xb8, 0xef, 0x9d, 0xcf, 0xbd, 0x51, 0x68,
-	0xdd, 0x10, 0xfb, 0x68, 0x40, 0xc8, 0x40, 0x35, 0x14, 0xe3, 0x6d, 0xc1, 0x30, 0x20, 0x5d, 0xf2,
-	0x71, 0xe8, 0xcd, 0x2a, 0xf9, 0xfe, 0x29, 0x4b, 0x3e, 0xae, 0xfa, 0xa5, 0x97, 0x7c, 0xfe, 0x74,
-	0xea, 0x32, 0x46, 0x53, 0x25, 0x9f, 0xc2, 0xed, 0x88, 0x42, 0xde, 0x83, 0xf2, 0x60, 0x79, 0xe4,
-	0x30, 0x47, 0x2e, 0x69, 0x47, 0x97, 0x7b, 0x8c, 0x8a, 0x0e, 0x5b, 0x12, 0x12, 0xd5, 0x61, 0x39,
-	0x51, 0x1d, 0x32, 0xfa, 0xa2, 0xea, 0xb0, 0x92, 0x6b, 0xcd, 0xea, 0x2b, 0x58, 0xb3, 0x16, 0x59,
-	0xf3, 0x1f, 0x45, 0x63, 0xd1, 0x2f, 0x55, 0x1d, 0x36, 0xa1, 0x3a, 0x08, 0x68, 0x68, 0xbc, 0xb3,
-	0xd7, 0xed, 0x0b, 0xbc, 0xb7, 0x97, 0xb5, 0x5b, 0x39, 0xaa, 0xdd, 0x78, 0x78, 0x0a, 0xdd, 0xb1,
-	0x27, 0xae, 0xce, 0xa2, 0x22, 0x34, 0x21, 0xfe, 0x17, 0xcb, 0x6a, 0x46, 0x55, 0x65, 0x88, 0xcf,
-	0x51, 0x52, 0xa9, 0x99, 0x49, 0xe5, 0x26, 0x9a, 0x7b, 0xe2, 0x7a, 0xe3, 0x93, 0x80, 0x7e, 0x25,
-	0x0b, 0x44, 0x03, 0xc1, 0x24, 0xd5, 0x0f, 0xc6, 0x5c, 0x58, 0x5d, 0x24, 0x29, 0xd9, 0xc4, 0x60,
-	0xd3, 0xf5, 0x3d, 0x8f, 0x0e, 0x19, 0x1d, 0xf5, 0x83, 0x31, 0x8f, 0xd9, 0x35, 0x3b, 0x86, 0x19,
-	0xe9, 0x70, 0xd3, 0x4c, 0x87, 0xad, 0x6f, 0x4b, 0x98, 0x39, 0xcd, 0xcd, 0xe4, 0xd4, 0x29, 0xa3,
-	0x46, 0xe6, 0xe4, 0xad, 0x74, 0xd6, 0x2b, 0x66, 0x65, 0x3d, 0xbc, 0x6c, 0x2f, 0xc3, 0x93, 0xc0,
-	0x9f, 0x76, 0xce, 0xcf, 0xb9, 0xb1, 0xf1, 0xb2, 0xad, 0x11, 0xcc, 0x2e, 0x51, 0xa8, 0x5e, 0x17,
-	0xd9, 0x25, 0x8a, 0xd2, 0x2a, 0xbb, 0x88, 0xee, 0x92, 0x91, 0x5d, 0x74, 0x7c, 0x52, 0x89, 0x4a,
-	0x26, 0x54, 0xdd, 0x8e, 0xe7, 0xad, 0x4a, 0x46, 0xde, 0xe2, 0x8a, 0x8a, 0xee, 0xaa, 0xf1, 0x12,
-	0x40, 0xf4, 0xdf, 0x90, 0xaf, 0x69, 0x30, 0xed, 0xca, 0x34, 0x1a, 0x01, 0x68, 0xfc, 0xc1, 0x72,
-	0xe0, 0xe3, 0x92, 0x44, 0x2a, 0x55, 0xcd, 0x64, 0x64, 0xab, 0xa7, 0x23, 0x5b, 0x0b, 0x36, 0xf8,
-	0x0a, 0x14, 0x45, 0xa4, 0xd4, 0x18, 0x86, 0xb3, 0x47, 0xa9, 0x50, 0x24, 0xd5, 0x08, 0xc0, 0xd9,
-	0xbb, 0x4e, 0xf8, 0x04, 0x13, 0xbc, 0x7c, 0x41, 0x21, 0x9b, 0xaa, 0x07, 0x53, 0xfc, 0x56, 0xd4,
-	0x23, 0x73, 0xbc, 0x4e, 0x93, 0x32, 0x6f, 0x46, 0x00, 0xe6, 0x83, 0x87, 0xbe, 0x77, 0x42, 0x47,
-	0x83, 0x65, 0x68, 0xd3, 0xe1, 0x62, 0xa4, 0xb2, 0x66, 0x1c, 0xc5, 0xcb, 0x09, 0xda, 0x76, 0xe0,
-	0xeb, 0x3c, 0x21, 0x6b, 0xee, 0x24, 0x8c, 0x5e, 0xd3, 0xf3, 0x46, 0xc7, 0xcb, 0x99, 0x2c, 0xb5,
-	0x65, 0x8b, 0x67, 0x14, 0xf4, 0x6f, 0xec, 0xd9, 0x95, 0x19, 0x45, 0xb6, 0x51, 0xba, 0x98, 0xef,
-	0xd1, 0x13, 0x27, 0xa0, 0x7c, 0xf0, 0x9e, 0x90, 0x9e, 0x80, 0xc9, 0x0f, 0xa0, 0xde, 0xf5, 0xa3,
-	0xe4, 0xb3, 0xff, 0xc2, 0x00, 0x62, 0xd2, 0x5b, 0x7f, 0x6f, 0x00, 0x44, 0x01, 0x2e, 0xd7, 0xc1,
-	0xa3, 0x33, 0x52, 0x8c, 0x95, 0x8c, 0xd9, 0x77, 0xcb, 0x36, 0x10, 0xb4, 0x41, 0xe0, 0x9e, 0xcd,
-	0xf9, 0xb5, 0x4b, 0xdc, 0xb1, 0x84, 0x47, 0x67, 0xf4, 0x64, 0xf0, 0xb1, 0x0c, 0x2f, 0x65, 0xf2,
-	0xb1, 0xe8, 0xff, 0x00, 0x76, 0x3a, 0x8b, 0xb1, 0xd9, 0xd1, 0xf3, 0xa4, 0xcf, 0xa7, 0x3b, 0x50,
-	0xba, 0x74, 0x28, 0x71, 0x0e, 0x85, 0x36, 0xe2, 0x14, 0x64, 0xf4, 0x64, 0xf0, 0x51, 0x9b, 0x6a,
-	0x26, 0x1f, 0xb5, 0xb9, 0x09, 0xd0, 0x59, 0x8c, 0x79, 0x47, 0xcf, 0x93, 0xe7, 0xc3, 0x40, 0xf4,
-	0x1d, 0xb2, 0xe7, 0x0d, 0xfd, 0xa9, 0xeb, 0x8d, 0x71, 0x76, 0x30, 0xee, 0x90, 0x06, 0x9e, 0xe2,
-	0xe2, 0xcc, 0xf5, 0x0c, 0x6e, 0x74, 0xdf, 0x54, 0x88, 0x3c, 0x3b, 0x26, 0xa4, 0xdf, 0x40, 0x9e,
-	0xcb, 0x37, 0x60, 0xe2, 0xf4, 0xc4, 0xb0, 0x18, 0x27, 0xba, 0xdd, 0xc6, 0x30, 0x39, 0x93, 0x82,
-	0x8c, 0x9b, 0xad, 0x82, 0x78, 0x00, 0x54, 0x23, 0xf8, 0xe5, 0x7b, 0x9b, 0x67, 0xb8, 0x38, 0x88,
-	0x4e, 0x7d, 0xbc, 0x9c, 0x51, 0x6f, 0xe4, 0xb2, 0x79, 0x40, 0xb9, 0x4a, 0xe2, 0x6c, 0x25, 0xe1,
-	0x24, 0x13, 0x15, 0x23, 0x69, 0x26, 0xea, 0x76, 0x0b, 0x1a, 0x9d, 0xc5, 0xd8, 0x40, 0xe5, 0x21,
-	0x4b, 0xa0, 0xda, 0xb2, 0xfd, 0x39, 0x1b, 0xfb, 0x72, 0x17, 0x76, 0x0d, 0xcb, 0x1a, 0x78, 0x8a,
-	0x2b, 0xae, 0xab, 0x69, 0x6e, 0x64, 0x1b, 0x85, 0xf0, 0xe3, 0x27, 0x6c, 0xa3, 0xa0, 0xc4, 0x9b,
-	0xd2, 0x6b, 0xa9, 0x37, 0xa5, 0x5f, 0xc2, 0xfe, 0xc0, 0x9f, 0xa9, 0x40, 0xcf, 0x1d, 0xd7, 0x17,
-	0xfb, 0x65, 0xe5, 0xdc, 0x63, 0x73, 0xf8, 0x84, 0x66, 0x4a, 0x42, 0xed, 0xdf, 0xe2, 0x92, 0x3e,
-	0x4c, 0x55, 0x34, 0xed, 0x6c, 0xbe, 0xb8, 0xd9, 0xe6, 0x08, 0x23, 0x0f, 0xe1, 0xad, 0x81, 0x3f,
-	0x43, 0x21, 0xfd, 0x60, 0x9c, 0xd4, 0xb9, 0x99, 0xa3, 0x73, 0xfe, 0x10, 0xe2, 0xe5, 0xc9, 0x43,
-	0xcd, 0xaf, 0x73, 0x79, 0x1f, 0x67, 0x6a, 0x9e, 0x3d, 0x44, 0x28, 0x9f, 0x2f, 0x92, 0xdc, 0x83,
-	0x2d, 0xe5, 0x97, 0x36, 0x1d, 0x72, 0xad, 0x6f, 0xe4, 0x68, 0x9d, 0x24, 0x92, 0xd3, 0xf8, 0x58,
-	0xd4, 0xf0, 0xed, 0xf8, 0xeb, 0x02, 0x43, 0xc3, 0x38, 0x51, 0xe8, 0x95, 0x1c, 0x4e, 0x0e, 0xe1,
-	0xea, 0xc0, 0x9f, 0x1d, 0x2f, 0x67, 0xf1, 0xd7, 0xe5, 0x37, 0x73, 0x34, 0xca, 0x22, 0x93, 0x5f,
-	0xa6, 0x65, 0xa0, 0x66, 0xef, 0x70, 0x19, 0xef, 0x67, 0xda, 0x2e, 0x49, 0x96, 0xef, 0x58, 0x32,
-	0x7a, 0x9a, 0x3d, 0xb8, 0xfe, 0x1c, 0x37, 0xb9, 0xd0, 0x2b, 0x8d, 0xfb, 0x70, 0xf3, 0xf9, 0xfb,
-	0x76, 0x21, 0x69, 0x87, 0xb0, 0x9b, 0x65, 0xe3, 0x0b, 0xc9, 0x38, 0x01, 0x2b, 0xcf, 0x1a, 0x17,
-	0x7a, 0xcf, 0xf2, 0xdb, 0x02, 0x6c, 0xde, 0xf7, 0xfd, 0x67, 0xf3, 0x59, 0xfe, 0x0d, 0xec, 0x06,
-	0xd4, 0xe4, 0xad, 0x65, 0x24, 0xbe, 0xe8, 0xa9, 0xd9, 0x11, 0x70, 0xe9, 0x9f, 0x17, 0x29, 0x6d,
-	0xfe, 0x0f, 0x3f, 0x2f, 0xba, 0xfb, 0x9f, 0x35, 0x58, 0xff, 0x99, 0x4b, 0xbf, 0xc6, 0xea, 0x45,
-	0xcc, 0xf6, 0x93, 0x39, 0x0d, 0x56, 0x64, 0x37, 0xa1, 0x01, 0xb7, 0x64, 0x73, 0x2f, 0xa9, 0x17,
-	0x5f, 0x51, 0xeb, 0x0a, 0xe9, 0xc0, 0x06, 0x4a, 0xd1, 0x9f, 0xb8, 0xec, 0xa7, 0xbe, 0x81, 0x11,
-	0x02, 0xae, 0xa5, 0xbf, 0x8d, 0x51, 0x22, 0x7e, 0x24, 0x44, 0xc8, 0xaf, 0x04, 0x42, 0xb2, 0x97,
-	0xfc, 0x40, 0x41, 0x48, 0xd8, 0x4f, 0x7d, 0xb7, 0x10, 0xe9, 0xd0, 0x40, 0x01, 0xc6, 0x9f, 0x92,
-	0x4a, 0x44, 0xfc, 0x4f, 0x68, 0x2d, 0x22, 0xf1, 0x07, 0x6f, 0xeb, 0x0a, 0xf9, 0x1c, 0x36, 0x51,
-	0x44, 0x74, 0x71, 0x33, 0x24, 0x18, 0x77, 0x7a, 0x53, 0x82, 0x79, 0x5f, 0x36, 0x24, 0xe8, 0x3f,
-	0x06, 0x4c, 0x09, 0xd1, 0x1f, 0x41, 0x31, 0x09, 0xc6, 0x9f, 0x2c, 0xad, 0x2b, 0xe4, 0x33, 0xe5,
-	0xbe, 0xfd, 0xb3, 0xa7, 0x87, 0x2b, 0x2c, 0xed, 0x24, 0x35, 0xe6, 0xd4, 0x7a, 0x2b, 0xe2, 0xce,
-	0xd5, 0xba, 0x42, 0x5a, 0xb0, 0xfe, 0xd0, 0xef, 0xcf, 0xc8, 0x86, 0x24, 0xf0, 0x4f, 0xed, 0x9a,
-	0xb1, 0xd6, 0x59, 0x99, 0x37, 0xbe, 0xfb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x78, 0x7c, 0x7d,
-	0xdc, 0xc0, 0x27, 0x00, 0x00,
+	// 3738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5b, 0x73, 0x23, 0x47,
+	0x15, 0x46, 0xb2, 0x65, 0x5b, 0x47, 0xb6, 0xec, 0x1d, 0x5f, 0x76, 0x56, 0x7b, 0xc9, 0x46, 0xd9,
+	0x6c, 0xbc, 0x9b, 0xc4, 0xc9, 0x2e, 0x45, 0x48, 0xa5, 0x20, 0xc4, 0x96, 0xed, 0x8d, 0x61, 0x77,
+	0x65, 0xc6, 0x82, 0xaa, 0x04, 0xaa, 0xa8, 0xb1, 0xd4, 0xd6, 0x4e, 0x2c, 0xcd, 0x28, 0x33, 0x23,
+	0x47, 0x86, 0x17, 0x1e, 0x78, 0x82, 0xdf, 0x00, 0x05, 0x45, 0x15, 0x3c, 0xf1, 0x06, 0xc5, 0xed,
+	0x17, 0x50, 0xc0, 0x9f, 0xc8, 0x33, 0x3f, 0x81, 0x07, 0xea, 0xf4, 0xbd, 0xe7, 0xb2, 0xb6, 0x64,
+	0x53, 0x24, 0x45, 0x9e, 0xac, 0xfe, 0xfa, 0xf4, 0xe9, 0xd3, 0xa7, 0xbb, 0x4f, 0x77, 0x7f, 0x3d,
+	0x6d, 0x98, 0x8f, 0x48, 0x78, 0x42, 0xc2, 0x8d, 0x41, 0x18, 0xc4, 0x81, 0x55, 0xa2, 0x7f, 0x6a,
+	0x2f, 0x74, 0x83, 0xa0, 0xdb, 0x23, 0x6f, 0xd0, 0xd4, 0xe1, 0xf0, 0xe8, 0x8d, 0xd8, 0xeb, 0x93,
+	0x28, 0x76, 0xfb, 0x03, 0x26, 0x57, 0x9f, 0x85, 0xd2, 0x4e, 0x7f, 0x10, 0x9f, 0xd6, 0xff, 0x50,
+	0x80, 0x85, 0x03, 0xe2, 0x86, 0xed, 0x67, 0x0e, 0xf9, 0x78, 0x48, 0xa2, 0xd8, 0x5a, 0x82, 0xa9,
+	0xef, 0xec, 0x6d, 0xdb, 0x85, 0xdb, 0x85, 0xf5, 0xb2, 0x83, 0x3f, 0x2d, 0x0b, 0xa6, 0x5b, 0x64,
+	0x14, 0xdb, 0x45, 0x0a, 0xd1, 0xdf, 0xd6, 0xdb, 0x50, 0x6e, 0x09, 0x9d, 0xf6, 0xd4, 0xed, 0xc2,
+	0x7a, 0xe5, 0x61, 0x6d, 0x83, 0xd5, 0xba, 0x21, 0x6a, 0xdd, 0x90, 0x12, 0x8e, 0x12, 0x46, 0xfd,
+	0x4f, 0xa2, 0xae, 0x3d, 0xcd, 0xf4, 0x3f, 0x89, 0xba, 0x56, 0x0d, 0xe6, 0xf6, 0xdd, 0x2e, 0x39,
+	0xf0, 0x7e, 0x48, 0xec, 0xd2, 0xed, 0xc2, 0x7a, 0xc9, 0x91, 0x69, 0x6b, 0x0d, 0x66, 0x1a, 0xc3,
+	0x30, 0x0a, 0x42, 0x7b, 0x86, 0x16, 0xe0, 0xa9, 0xfa, 0x9f, 0x8b, 0x50, 0x15, 0x76, 0x47, 0x83,
+	0xc0, 0x8f, 0x48, 0x86, 0xe1, 0xaf, 0xc3, 0xac, 0x43, 0xa2, 0x61, 0x2f, 0x8e, 0xec, 0xe2, 0xed,
+	0xa9, 0xf5, 0xca, 0xc3, 0x65, 0x66, 0xdb, 0x86, 0x2c, 0x39, 0xec, 0xc5, 0x8e, 0x90, 0xb9, 0xd4,
+	0x36, 0xdd, 0x86, 0xca, 0xe6, 0x60, 0x10, 0x06, 0x23, 0xaf, 0xef, 0xc6, 0xac, 0x59, 0x73, 0x8e,
+	0x0e, 0x59, 0x37, 0xa0, 0xdc, 0x08, 0xc2, 0x90, 0xb4, 0x63, 0xd2, 0xe1, 0x8d, 0x53, 0x00, 0xe6,
+	0xb6, 0x82, 0xd8, 0xed, 0xbd, 0xef, 0xc5, 0x91, 0x3d, 0x4b, 0x9d, 0xa2, 0x00, 0xcc, 0xdd, 0x89,
+	0x62, 0xaa, 0xa7, 0x63, 0xcf, 0x51, 0xdd, 0x0a, 0xb0, 0x6e, 0x01, 0x3c, 0x25, 0xa3, 0x98, 0xfb,
+	0xad, 0x4c, 0x55, 0x6b, 0x48, 0xfd, 0x37, 0x05, 0x98, 0xd7, 0x3d, 0x60, 0x55, 0xa1, 0x28, 0x1d,
+	0x57, 0xdc, 0xdb, 0x46, 0xa7, 0x6f, 0x0d, 0xdb, 0xc7, 0x44, 0x74, 0x39, 0x4f, 0xe1, 0x40, 0x78,
+	0xea, 0xf6, 0x09, 0xf5, 0x4d, 0xd9, 0xa1, 0xbf, 0x11, 0x6b, 0x78, 0xf1, 0x29, 0x6f, 0x3b, 0xfd,
+	0x6d, 0xad, 0x40, 0xe9, 0x20, 0x16, 0xcd, 0x2e, 0x3b, 0x2c, 0x81, 0xdd, 0xbc, 0xd3, 0x1f, 0xf4,
+	0x82, 0x53, 0x22, 0x3a, 0x53, 0xa6, 0xb1, 0xc4, 0x07, 0xc4, 0x0d, 0xb1, 0xa9, 0x53, 0x58, 0x82,
+	0x26, 0xea, 0xbf, 0x2a, 0xc0, 0xf2, 0xe6, 0x30, 0x0e, 0xda, 0x41, 0x7f, 0xd0, 0x23, 0x31, 0xc9,
+	0x1f, 0xa2, 0x6b, 0x30, 0xb3, 0x1f, 0x92, 0x23, 0x6f, 0x24, 0x2c, 0x66, 0x29, 0xd4, 0xfb, 0xd8,
+	0xeb, 0x7b, 0x31, 0x35, 0xb9, 0xe4, 0xb0, 0x84, 0xd9, 0xd1, 0xd3, 0x13, 0x74, 0x74, 0x49, 0x76,
+	0x74, 0xfd, 0xaf, 0x05, 0x58, 0x31, 0x6d, 0xcc, 0x1d, 0x8e, 0x79, 0x46, 0x6a, 0xc3, 0x74, 0x6a,
+	0xdc, 0x61, 0x7a, 0x41, 0xeb, 0xff, 0x59, 0x80, 0x45, 0xc7, 0xf5, 0x8f, 0x3d, 0xbf, 0x1b, 0x3d,
+	0x37, 0x00, 0x60, 0x87, 0x88, 0x00, 0x80, 0xbf, 0xb5, 0x31, 0x32, 0x65, 0x8c, 0x91, 0x1a, 0xcc,
+	0x35, 0xdc, 0x98, 0x74, 0x83, 0x50, 0x8c, 0x09, 0x99, 0xc6, 0xde, 0xd8, 0x77, 0xc3, 0xf8, 0x54,
+	0x8c, 0x0b, 0x9a, 0x30, 0xdb, 0x33, 0x33, 0x41, 0x7b, 0x66, 0x55, 0x7b, 0x7e, 0x5d, 0x80, 0x25,
+	0xd5, 0x9e, 0xdc, 0x9e, 0x78, 0x00, 0x73, 0x42, 0x8a, 0x36, 0xaa, 0xf2, 0x70, 0x95, 0xbb, 0x5c,
+	0x2b, 0x8c, 0x4e, 0x97, 0x62, 0x17, 0x0f, 0x0e, 0x73, 0xca, 0xca, 0x3f, 0x16, 0xa0, 0x6a, 0x56,
+	0x94, 0x9a, 0x82, 0xff, 0x5d, 0x97, 0x7f, 0x15, 0xe6, 0x45, 0xfd, 0x8f, 0xbd, 0x28, 0xb6, 0x67,
+	0x8c, 0x61, 0xc7, 0xb3, 0x76, 0xfc, 0x38, 0x3c, 0x75, 0x0c, 0xc1, 0xfa, 0x4f, 0x0b, 0x30, 0xaf,
+	0x67, 0x67, 0xd9, 0x4d, 0x43, 0x44, 0x31, 0x23, 0x44, 0x4c, 0x65, 0x85, 0x88, 0x69, 0x3d, 0x44,
+	0xc8, 0x30, 0x50, 0xd2, 0xc2, 0x00, 0xb6, 0x7b, 0xb3, 0x1f, 0x0c, 0xfd, 0x98, 0x8e, 0x8e, 0xa2,
+	0xc3, 0x53, 0xf5, 0xdf, 0x17, 0xa0, 0xfa, 0x41, 0x48, 0xa3, 0xe2, 0x78, 0x63, 0x57, 0x77, 0xd8,
+	0x54, 0x9e, 0xc3, 0xa6, 0x73, 0xc7, 0x68, 0x69, 0x82, 0xde, 0x9f, 0x51, 0xbd, 0xff, 0xdb, 0x02,
+	0x2c, 0x4a, 0xb3, 0x73, 0x87, 0xe8, 0x5b, 0x50, 0x41, 0x5b, 0x7b, 0xa7, 0x54, 0x90, 0xaf, 0x5f,
+	0x2b, 0xbc, 0x87, 0x54, 0x71, 0x1c, 0xa4, 0xba, 0xe0, 0xc5, 0xc7, 0xa9, 0x36, 0x9b, 0x7e, 0x04,
+	0x0b, 0x46, 0x4d, 0xe7, 0x1a, 0xa5, 0xe3, 0x3b, 0x77, 0x05, 0x4a, 0xac, 0x91, 0x25, 0xda, 0xbd,
+	0x2c, 0x81, 0x93, 0x64, 0xe1, 0x11, 0x89, 0x9b, 0x87, 0x1f, 0xe5, 0x77, 0x6e, 0x0d, 0xe6, 0x9a,
+	0x87, 0x1f, 0x91, 0x76, 0xbc, 0xb7, 0xcd, 0x6d, 0x90, 0xe9, 0xdc, 0xd9, 0x22, 0xc7, 0xd8, 0xb4,
+	0x3e, 0xc6, 0x2e, 0xb3, 0x83, 0xff, 0x52, 0x80, 0xaa, 0xb0, 0x3c, 0xb7, 0x7f, 0x27, 0x31, 0x7d,
+	0x0d, 0x66, 0x98, 0x0c, 0xf5, 0xdf, 0xbc, 0xc3, 0x53, 0x97, 0x6a, 0xfc, 0x9f, 0x98, 0xf1, 0x7b,
+	0x7e, 0xe7, 0xe4, 0xf3, 0xe6, 0xf7, 0x7f, 0x15, 0x60, 0x51, 0x9a, 0x7e, 0xa9, 0x8e, 0x7f, 0x00,
+	0xb0, 0xe7, 0x77, 0xbc, 0x13, 0xaf, 0x33, 0x74, 0x7b, 0x7c, 0xcd, 0xbd, 0xc2, 0xe7, 0xa2, 0xca,
+	0x70, 0x34, 0xa1, 0x9c, 0x50, 0x76, 0x99, 0x6b, 0xdd, 0x03, 0xbe, 0x45, 0x8c, 0x9e, 0xb8, 0x83,
+	0xd4, 0xcc, 0x94, 0x73, 0xaa, 0xa8, 0xcf, 0xa9, 0x5f, 0xce, 0xe8, 0x0d, 0xb9, 0xe4, 0xe0, 0xbd,
+	0x04, 0x53, 0x1f, 0x7a, 0x03, 0xb1, 0xbb, 0xf8, 0xd0, 0x1b, 0xe0, 0x46, 0xb4, 0xd9, 0x6e, 0x0f,
+	0x07, 0x6e, 0xec, 0x05, 0x3e, 0xef, 0x29, 0x0d, 0x31, 0x76, 0x84, 0xb3, 0x89, 0x1d, 0x61, 0x1d,
+	0xe6, 0x5b, 0xa1, 0xeb, 0x47, 0x6e, 0x1b, 0x45, 0x23, 0x7b, 0x8e, 0xba, 0xd1, 0xc0, 0x70, 0x93,
+	0x4d, 0xdb, 0xd5, 0x1c, 0xc6, 0x9b, 0xfd, 0x98, 0xee, 0x74, 0x8b, 0x8e, 0x0e, 0xe9, 0x12, 0xad,
+	0x51, 0x64, 0x83, 0x29, 0xd1, 0x1a, 0x45, 0x68, 0xc3, 0xe6, 0x49, 0xb7, 0x35, 0x6a, 0x0e, 0x63,
+	0xbb, 0x42, 0xb3, 0x65, 0x1a, 0xed, 0xa7, 0xa2, 0x7b, 0x3e, 0xaa, 0x9f, 0xa7, 0xb9, 0x1a, 0xa2,
+	0xe5, 0xa3, 0xf2, 0x05, 0x23, 0x1f, 0x75, 0xdb, 0x30, 0x4b, 0x75, 0xed, 0xf9, 0x76, 0x95, 0x66,
+	0x8a, 0x24, 0xdb, 0xa2, 0xc7, 0x5b, 0x6e, 0xcf, 0xf5, 0xdb, 0xc4, 0x5e, 0x64, 0x25, 0x15, 0x62,
+	0xbd, 0x05, 0x0b, 0x0e, 0x69, 0x7b, 0x03, 0x8f, 0xf8, 0x71, 0x84, 0x95, 0x2f, 0xd1, 0xf8, 0xbf,
+	0xc4, 0xc7, 0x9c, 0xec, 0x77, 0xc7, 0x14, 0xb3, 0xbe, 0xa9, 0x97, 0x43, 0xa3, 0xae, 0xd0, 0x72,
+	0x77, 0x52, 0x63, 0x75, 0xc3, 0x10, 0x63, 0x4b, 0xbd, 0x59, 0xd4, 0x7a, 0x13, 0xe0, 0x80, 0xf8,
+	0x1d, 0x12, 0x52, 0x03, 0xac, 0x1c, 0x03, 0x34, 0x19, 0x6b, 0x53, 0x96, 0xc0, 0xaa, 0x97, 0x69,
+	0x89, 0x17, 0xd3, 0x55, 0x2b, 0x19, 0x56, 0xaf, 0x56, 0xa8, 0xf6, 0x1e, 0x58, 0x69, 0xcb, 0x70,
+	0x68, 0x1d, 0x93, 0x53, 0x31, 0x8b, 0x8f, 0x09, 0x1d, 0x82, 0x27, 0x6e, 0x6f, 0x48, 0xc4, 0xf8,
+	0xa6, 0x89, 0x77, 0x8a, 0x6f, 0x17, 0x6a, 0x5f, 0x87, 0xc5, 0x44, 0x05, 0xe3, 0x14, 0x17, 0xf1,
+	0xaf, 0xe1, 0xfa, 0x9d, 0xcf, 0x5b, 0xfc, 0xfb, 0x7b, 0x11, 0x16, 0xa5, 0xe9, 0x97, 0x1a, 0xff,
+	0x36, 0xa0, 0x8c, 0x5a, 0xbd, 0x8e, 0x98, 0xf4, 0x6a, 0x24, 0x48, 0xdc, 0x51, 0x22, 0xd6, 0x57,
+	0x00, 0x76, 0x3d, 0xdf, 0xf5, 0xdb, 0x9e, 0xdb, 0x8b, 0xec, 0x92, 0xb1, 0xc3, 0x6e, 0xf4, 0x07,
+	0xbe, 0xca, 0x74, 0x34, 0x41, 0xe5, 0xa2, 0x99, 0x5c, 0x17, 0xcd, 0x5e, 0x68, 0xe7, 0x6d, 0xdd,
+	0x83, 0x19, 0x27, 0xe8, 0xf5, 0x86, 0x03, 0xbb, 0x6c, 0x04, 0x71, 0xea, 0x33, 0x9a, 0xe1, 0x70,
+	0x81, 0xfa, 0x3f, 0x2a, 0x5a, 0xa3, 0xcf, 0x15, 0x2a, 0xe5, 0xee, 0x66, 0x4a, 0xdf, 0xdd, 0x60,
+	0x90, 0xeb, 0x91, 0x76, 0xec, 0x7f, 0x10, 0x52, 0xd7, 0x95, 0x1c, 0x99, 0xc6, 0xf0, 0xd4, 0x3c,
+	0x3a, 0xf2, 0xda, 0x44, 0x3f, 0x2e, 0xeb, 0x10, 0x5d, 0xf2, 0x69, 0x52, 0xf0, 0x1f, 0x2c, 0x85,
+	0x4d, 0xdb, 0x6f, 0x34, 0xc4, 0x72, 0xb0, 0xdf, 0x68, 0xc8, 0x40, 0x3d, 0x97, 0x15, 0xa8, 0xcb,
+	0x19, 0x81, 0x1a, 0x54, 0xa0, 0x5e, 0x87, 0xc5, 0x66, 0xfc, 0x8c, 0x84, 0x9b, 0x47, 0x47, 0x5e,
+	0xcf, 0x73, 0x63, 0x12, 0xd9, 0x15, 0xda, 0x05, 0x49, 0xd8, 0xba, 0x0f, 0x4b, 0x7a, 0x08, 0xa6,
+	0xa7, 0x87, 0x79, 0x2a, 0x9a, 0xc2, 0xa9, 0x2c, 0xc6, 0x89, 0x6d, 0x0f, 0x49, 0x0d, 0x16, 0x44,
+	0x59, 0x90, 0x4c, 0xe1, 0x29, 0x59, 0x0c, 0x20, 0xd5, 0x0c, 0x59, 0x0c, 0x4c, 0xc8, 0xad, 0x9c,
+	0x74, 0x05, 0xc2, 0xa3, 0xa7, 0x0e, 0x59, 0xaf, 0xc1, 0x15, 0xad, 0x14, 0x5f, 0x1e, 0x96, 0xa8,
+	0x5c, 0x3a, 0x23, 0x2d, 0xcd, 0x02, 0x67, 0x86, 0x34, 0xd6, 0x5e, 0x87, 0x79, 0x59, 0x15, 0x2e,
+	0x1a, 0x16, 0x15, 0x34, 0x30, 0x6b, 0x03, 0x2c, 0x15, 0xcc, 0x19, 0xdc, 0x1a, 0xd9, 0xcb, 0x54,
+	0x32, 0x23, 0xc7, 0xda, 0x86, 0x15, 0xf6, 0xdb, 0x88, 0xe6, 0x91, 0xbd, 0x92, 0x13, 0x74, 0x33,
+	0xa5, 0xad, 0xef, 0xc1, 0x72, 0x12, 0xc7, 0x96, 0xac, 0x52, 0x25, 0xf7, 0x92, 0xf3, 0x75, 0x23,
+	0x43, 0x96, 0xc5, 0xe3, 0x2c, 0x2d, 0xd6, 0xbb, 0x70, 0x85, 0xc1, 0x2a, 0xde, 0x47, 0xf6, 0x5a,
+	0x8e, 0x7d, 0x69, 0x51, 0xcb, 0x81, 0x25, 0x03, 0x44, 0xcb, 0xae, 0xd2, 0xe2, 0x77, 0x73, 0x2c,
+	0x4b, 0x2e, 0x13, 0xa9, 0xf2, 0x56, 0x03, 0x2a, 0x48, 0x12, 0x6e, 0x79, 0x3e, 0x5d, 0xa2, 0x6c,
+	0x63, 0xc1, 0x51, 0xea, 0x34, 0x19, 0xa6, 0x49, 0x2f, 0xa5, 0x2b, 0x41, 0x9b, 0xae, 0x9d, 0xa1,
+	0x44, 0x9a, 0xa3, 0x97, 0xb2, 0x9a, 0xb0, 0x88, 0xc9, 0x7d, 0x12, 0xb6, 0x89, 0x1f, 0x7b, 0x3d,
+	0x12, 0xd9, 0x35, 0xaa, 0xe8, 0xe5, 0x4c, 0x45, 0x9a, 0x1c, 0x53, 0x96, 0x2c, 0x5d, 0xdb, 0x05,
+	0x3b, 0xaf, 0x7f, 0xc6, 0x5a, 0x0d, 0x1b, 0xb0, 0x9a, 0xe9, 0xcd, 0xb1, 0x94, 0xbc, 0x0b, 0x4b,
+	0x49, 0x1f, 0x4e, 0x5a, 0x7e, 0xa2, 0xfa, 0xb7, 0x60, 0x25, 0xcb, 0x6b, 0x63, 0xad, 0xeb, 0x9f,
+	0xce, 0x42, 0xd5, 0x5c, 0x7a, 0x28, 0xb7, 0xec, 0xfa, 0x1d, 0x19, 0xd7, 0x79, 0x2a, 0x33, 0xb6,
+	0xdb, 0x30, 0x4b, 0xc3, 0x79, 0xa3, 0xc3, 0xa3, 0xbb, 0x48, 0xe6, 0x9c, 0x69, 0xef, 0xc0, 0x02,
+	0x3f, 0x38, 0xb7, 0x89, 0x37, 0x88, 0x23, 0x7e, 0xb6, 0x35, 0x41, 0xba, 0x3d, 0xc5, 0xa8, 0xb9,
+	0x1b, 0x6e, 0x0e, 0xe3, 0x67, 0x9c, 0xde, 0xd0, 0x21, 0xa9, 0x67, 0xdb, 0x8b, 0x0e, 0xa3, 0x3e,
+	0xe7, 0x82, 0x8b, 0x8e, 0x09, 0x4a, 0x3d, 0xad, 0x80, 0xea, 0x99, 0xd3, 0xf4, 0x30, 0x88, 0xb6,
+	0xb5, 0xf9, 0xfe, 0x56, 0x73, 0x9f, 0xef, 0x92, 0x79, 0x8a, 0xe3, 0x8d, 0xe6, 0x3e, 0xdf, 0x1b,
+	0xf3, 0x14, 0x65, 0xa7, 0x5d, 0xbf, 0xd3, 0x08, 0xfc, 0x38, 0xe2, 0xfb, 0x62, 0x05, 0x88, 0xdc,
+	0xc7, 0x81, 0xeb, 0x47, 0x7c, 0x5f, 0xac, 0x00, 0xba, 0xed, 0xc7, 0x65, 0x83, 0x65, 0xf3, 0x6d,
+	0xb1, 0x42, 0xb0, 0x4d, 0x42, 0xd8, 0x21, 0x03, 0xf7, 0x94, 0x07, 0x7a, 0x13, 0xb4, 0xee, 0x42,
+	0x55, 0x96, 0x61, 0x62, 0x2c, 0xd0, 0x27, 0x50, 0x6c, 0xfb, 0x36, 0x39, 0x8c, 0xa3, 0xe6, 0x27,
+	0xa4, 0xb3, 0x75, 0xca, 0xa3, 0xbc, 0x0e, 0xa1, 0x26, 0xbe, 0x29, 0xef, 0x9c, 0xb0, 0x06, 0xb1,
+	0xe0, 0x9e, 0x40, 0x93, 0xab, 0xb1, 0x95, 0x5e, 0x8d, 0xd1, 0x26, 0x9a, 0xdc, 0xf6, 0xa2, 0x38,
+	0xf4, 0xda, 0x31, 0x8d, 0xe9, 0x65, 0x27, 0x81, 0xe2, 0x1a, 0x71, 0x30, 0x20, 0x6d, 0xba, 0xce,
+	0xe3, 0xd1, 0x67, 0x85, 0x4a, 0x19, 0x18, 0xca, 0xec, 0x87, 0x5e, 0x5f, 0xca, 0xac, 0x32, 0x19,
+	0x1d, 0x43, 0x8b, 0x9c, 0xa1, 0x2f, 0x45, 0xd6, 0x98, 0x45, 0x1a, 0x84, 0x12, 0x8f, 0x88, 0x92,
+	0xb8, 0xca, 0x24, 0x34, 0x08, 0x6d, 0xd6, 0x92, 0xfb, 0x6d, 0x8c, 0x93, 0xb4, 0xf5, 0x26, 0x2a,
+	0xfd, 0xdd, 0xe8, 0xc7, 0x84, 0x79, 0xe9, 0x9a, 0xe6, 0x6f, 0x89, 0xd2, 0xdb, 0x9a, 0xf8, 0x94,
+	0x49, 0xd4, 0xd8, 0x81, 0x49, 0xa4, 0xad, 0x77, 0x00, 0x1a, 0x27, 0xdd, 0x1d, 0xbf, 0xb3, 0x8d,
+	0x0e, 0xbc, 0x7e, 0xe6, 0x5e, 0x4d, 0x93, 0xc6, 0x96, 0xb0, 0x93, 0xfb, 0xd1, 0xd0, 0xef, 0x44,
+	0xf6, 0x0d, 0xd6, 0x8f, 0x1a, 0x84, 0x12, 0x68, 0x86, 0x90, 0xb8, 0xc9, 0x24, 0x34, 0xa8, 0xfe,
+	0x8b, 0x9b, 0x00, 0x6a, 0x2b, 0x97, 0x3b, 0xc1, 0xe5, 0x94, 0x2d, 0xea, 0x53, 0xf6, 0x16, 0x40,
+	0x23, 0xe8, 0xf7, 0xbd, 0x38, 0x26, 0x84, 0x31, 0xf1, 0x65, 0x47, 0x43, 0x70, 0x51, 0xc7, 0x56,
+	0x86, 0xde, 0xe1, 0x90, 0xee, 0x71, 0xd8, 0x86, 0x66, 0x9a, 0x2d, 0xea, 0xe9, 0x9c, 0x0c, 0xf9,
+	0xd6, 0x48, 0xc4, 0x81, 0x8c, 0x1c, 0xdc, 0x86, 0x6c, 0x9e, 0x74, 0xf5, 0x8c, 0x3d, 0x9f, 0x87,
+	0x84, 0x74, 0x06, 0x6a, 0xa7, 0x1d, 0x23, 0x62, 0x09, 0xb3, 0x86, 0x45, 0x87, 0x8c, 0x9c, 0x0c,
+	0x79, 0xb4, 0x66, 0x2e, 0x53, 0x1e, 0xad, 0xb9, 0x05, 0xb0, 0x79, 0xd2, 0xa5, 0x19, 0x7b, 0x3e,
+	0x0f, 0x1a, 0x1a, 0x62, 0x3d, 0x84, 0x95, 0x3d, 0x3f, 0x26, 0xa1, 0xef, 0xf6, 0x68, 0x9c, 0x39,
+	0xe2, 0xe7, 0x44, 0x16, 0x46, 0x32, 0xf3, 0x32, 0xcb, 0xb4, 0x46, 0x22, 0xbe, 0x64, 0xe6, 0xc9,
+	0x8d, 0xe1, 0x9e, 0xdf, 0x0e, 0xfa, 0x9e, 0xdf, 0x55, 0x27, 0xf1, 0x14, 0x9e, 0x92, 0x55, 0xa7,
+	0xf2, 0x14, 0xce, 0x37, 0x91, 0x02, 0xe1, 0x21, 0x48, 0x87, 0x24, 0x03, 0x21, 0x5a, 0xc6, 0xc2,
+	0x8f, 0x81, 0x19, 0x32, 0x58, 0xdb, 0x52, 0x42, 0x46, 0xd5, 0x24, 0x20, 0x1e, 0x7b, 0x74, 0x08,
+	0xb7, 0xdf, 0x3b, 0xa3, 0x01, 0xf1, 0x3b, 0x5e, 0x3c, 0x0c, 0x09, 0x3f, 0x6e, 0xa3, 0x54, 0x12,
+	0x4e, 0x4a, 0xb2, 0x63, 0x76, 0x4a, 0x12, 0x6b, 0xbd, 0x0b, 0xd5, 0xcd, 0x93, 0xae, 0x86, 0xd2,
+	0x20, 0x54, 0x74, 0x12, 0xa8, 0xf4, 0x59, 0x73, 0x18, 0x77, 0x03, 0xee, 0xdf, 0x55, 0xcd, 0x67,
+	0x1a, 0x9e, 0x92, 0xc5, 0xea, 0xd7, 0x32, 0x64, 0x55, 0xab, 0x05, 0x62, 0x5f, 0x95, 0xad, 0x16,
+	0x50, 0x82, 0x03, 0xb1, 0x53, 0x1c, 0xc8, 0xfb, 0xb0, 0xd6, 0x0a, 0x06, 0x22, 0x3c, 0xd3, 0xa1,
+	0x1f, 0xb0, 0x9e, 0xb8, 0x96, 0xb3, 0xed, 0xcc, 0x91, 0xb7, 0x48, 0xa6, 0xa6, 0xd6, 0x48, 0x6c,
+	0xd2, 0x5e, 0x4f, 0x9d, 0x02, 0x37, 0xb2, 0xe5, 0xd9, 0x66, 0x2d, 0x47, 0x99, 0xf5, 0x14, 0xae,
+	0xb5, 0x82, 0x01, 0xc6, 0xa3, 0x66, 0xd8, 0x4d, 0xda, 0x7c, 0x3d, 0xc7, 0xe6, 0xfc, 0x22, 0x96,
+	0x9f, 0xa7, 0x0f, 0x2d, 0xbf, 0x41, 0xf5, 0xbd, 0x99, 0x69, 0x79, 0x76, 0x11, 0x66, 0x7c, 0xbe,
+	0x4a, 0xeb, 0x1d, 0x58, 0x14, 0x43, 0xd2, 0x21, 0x6d, 0x6a, 0xf5, 0xcd, 0x1c, 0xab, 0x93, 0x82,
+	0xd6, 0xbe, 0x59, 0x16, 0x2d, 0xbc, 0x95, 0xda, 0xdd, 0x0b, 0x0b, 0x4d, 0x41, 0xbe, 0x03, 0x4e,
+	0xa0, 0xd6, 0x16, 0x2c, 0xb7, 0x82, 0xc1, 0xce, 0x68, 0x60, 0x12, 0x61, 0x2f, 0xe4, 0x58, 0x94,
+	0x25, 0x6c, 0x7d, 0x3f, 0xad, 0x03, 0x2d, 0xbb, 0x4d, 0x75, 0xdc, 0xcf, 0xf4, 0x5d, 0x52, 0x98,
+	0x1f, 0x89, 0x32, 0x72, 0xac, 0x27, 0x50, 0x15, 0x0b, 0x28, 0x0f, 0xbf, 0x2f, 0xa6, 0xf6, 0xfc,
+	0x5c, 0xb1, 0x29, 0xc7, 0x74, 0x26, 0x0a, 0x27, 0xd4, 0xa1, 0x9d, 0xf5, 0x73, 0xa8, 0x93, 0x26,
+	0x26, 0x0a, 0x5b, 0xdb, 0xe6, 0xe1, 0xe8, 0x25, 0xaa, 0xab, 0x9e, 0xd6, 0xf5, 0xfc, 0xd3, 0xd1,
+	0xb6, 0x79, 0x3a, 0xba, 0x73, 0x96, 0x96, 0xec, 0xe3, 0xd1, 0x7e, 0xfa, 0x78, 0xf4, 0x72, 0xde,
+	0xe8, 0x38, 0xd7, 0xf9, 0x08, 0x89, 0xce, 0x46, 0xe0, 0x77, 0x86, 0x9e, 0x58, 0xf9, 0xee, 0x1a,
+	0x44, 0xa7, 0xa6, 0xcf, 0x10, 0xe3, 0x44, 0xa7, 0x81, 0x99, 0xba, 0xb0, 0x95, 0xaf, 0x9c, 0xad,
+	0x4b, 0x91, 0xa6, 0x06, 0x86, 0x0b, 0x82, 0xdc, 0x26, 0xa3, 0x59, 0xeb, 0x6c, 0x41, 0xd0, 0x31,
+	0x21, 0x43, 0x37, 0xc3, 0x28, 0x73, 0x4f, 0xc9, 0x08, 0x0c, 0x43, 0xad, 0xb1, 0x1d, 0x46, 0xb9,
+	0xfb, 0x2c, 0xd4, 0x26, 0x71, 0xdc, 0x4f, 0x1f, 0x90, 0xde, 0xd1, 0xee, 0xd0, 0xef, 0x90, 0x0e,
+	0x0a, 0xbe, 0xca, 0xf6, 0xd3, 0x06, 0x88, 0x4b, 0x87, 0x02, 0x0e, 0x9e, 0xb9, 0x21, 0xb1, 0x5f,
+	0x63, 0x4b, 0x47, 0x02, 0xc6, 0x65, 0x5a, 0xda, 0xd2, 0x1c, 0xc6, 0x51, 0x8c, 0x67, 0x57, 0xbf,
+	0x6b, 0xbf, 0xce, 0x96, 0xe9, 0xac, 0x3c, 0xec, 0x61, 0x89, 0x6f, 0x9d, 0xd2, 0x4b, 0xc1, 0x8d,
+	0xbc, 0x1e, 0x4e, 0x08, 0xf2, 0x1e, 0x4e, 0xa0, 0xd6, 0x01, 0xf3, 0x00, 0x6d, 0xa5, 0x50, 0xf9,
+	0x06, 0x55, 0xf9, 0x4a, 0xb6, 0x4a, 0x5d, 0x92, 0x33, 0x06, 0x49, 0x18, 0x19, 0xea, 0xd6, 0xe8,
+	0x5b, 0x9e, 0xdf, 0xa1, 0x8e, 0x7f, 0x33, 0x75, 0xd6, 0x17, 0x71, 0x40, 0xca, 0x30, 0x45, 0x5a,
+	0x21, 0x4d, 0x05, 0x0e, 0x95, 0x07, 0x67, 0xa8, 0x50, 0x24, 0xb7, 0x02, 0x6a, 0x7b, 0x70, 0xfd,
+	0x39, 0xeb, 0xcb, 0x58, 0x47, 0xe3, 0xc7, 0x70, 0xeb, 0xf9, 0x01, 0x7f, 0xdc, 0x83, 0x76, 0x56,
+	0x70, 0x1e, 0x4b, 0xc7, 0x2e, 0xd8, 0x79, 0x61, 0x74, 0x2c, 0x3d, 0x9b, 0xb0, 0x9c, 0x11, 0x35,
+	0x2f, 0xa0, 0xe2, 0xff, 0x95, 0xfa, 0xc0, 0x3b, 0x95, 0x74, 0x10, 0x9c, 0x5c, 0xc3, 0xa4, 0xed,
+	0xc8, 0x9a, 0xf6, 0xe3, 0x72, 0x59, 0x99, 0xf3, 0x7c, 0xdc, 0xeb, 0xa1, 0xc4, 0xec, 0x9e, 0xb0,
+	0xf8, 0x85, 0x6e, 0x97, 0xfa, 0xcf, 0xfb, 0x98, 0xed, 0xb3, 0x7d, 0xbb, 0xd4, 0x7f, 0xee, 0x37,
+	0x6e, 0x93, 0xde, 0x2e, 0x89, 0x63, 0x76, 0xf2, 0x76, 0x49, 0xe0, 0x8e, 0x12, 0xc1, 0x4b, 0x9c,
+	0xd6, 0x68, 0xdb, 0x8d, 0x5d, 0xbb, 0x64, 0x5e, 0xe2, 0xf4, 0x63, 0xc2, 0x32, 0x1c, 0x2e, 0x90,
+	0xb8, 0x88, 0x9a, 0x49, 0x5c, 0x44, 0xc5, 0xe4, 0xac, 0x8b, 0xa8, 0xd9, 0x5c, 0x6f, 0xce, 0x4d,
+	0xe0, 0xcd, 0xb2, 0xf2, 0xe6, 0xdf, 0x8a, 0x5a, 0xa3, 0xcf, 0x75, 0xbb, 0x54, 0x83, 0xb9, 0x56,
+	0x48, 0x22, 0xed, 0x03, 0x4c, 0x99, 0x1e, 0xe3, 0x23, 0x4c, 0x7e, 0xf7, 0x33, 0xa3, 0xee, 0x7e,
+	0x28, 0x7f, 0x16, 0x79, 0x5d, 0x9f, 0xdd, 0xd2, 0xb3, 0x1b, 0x25, 0x1d, 0x42, 0xed, 0xad, 0xd3,
+	0x01, 0x11, 0x37, 0x4b, 0xf8, 0x5b, 0x51, 0x28, 0xe5, 0x04, 0x85, 0xb2, 0xeb, 0xf5, 0x3c, 0xbf,
+	0xbb, 0x1b, 0x92, 0x8f, 0xf9, 0x05, 0x93, 0x86, 0x20, 0x8b, 0xda, 0x0c, 0xbb, 0x54, 0x59, 0x85,
+	0xb1, 0xa8, 0x3c, 0x49, 0xf7, 0x44, 0x81, 0xef, 0xd3, 0x8f, 0x5f, 0x9b, 0x61, 0x97, 0x1e, 0xf1,
+	0xcb, 0x8e, 0x81, 0x69, 0x74, 0xce, 0x82, 0x4e, 0xe7, 0xd4, 0x3f, 0x2d, 0x41, 0xd5, 0xec, 0x4c,
+	0x2a, 0xda, 0x8f, 0x89, 0xc6, 0xfc, 0xd0, 0x54, 0x9a, 0x96, 0x2d, 0x66, 0xd1, 0xb2, 0x78, 0xaf,
+	0x3f, 0x8a, 0x76, 0xc3, 0xa0, 0xbf, 0x79, 0x74, 0x64, 0x4f, 0xf1, 0x7b, 0x7d, 0x89, 0x20, 0xfd,
+	0xa9, 0xb8, 0x44, 0x46, 0x00, 0x29, 0x40, 0xd2, 0x9f, 0x2c, 0xbb, 0xa4, 0xd1, 0x9f, 0x92, 0x40,
+	0x13, 0xdb, 0x41, 0x4e, 0xef, 0xc8, 0xb4, 0x49, 0xac, 0xce, 0x66, 0x10, 0xab, 0xd4, 0x50, 0x96,
+	0x3d, 0xa7, 0x7d, 0x6f, 0xc0, 0xf2, 0xc5, 0x47, 0xc3, 0xc8, 0x0b, 0x73, 0xca, 0x46, 0x01, 0xe8,
+	0xfc, 0xd6, 0xa8, 0x15, 0x60, 0x93, 0x18, 0x49, 0x23, 0x92, 0x49, 0xea, 0xad, 0x92, 0xa6, 0xde,
+	0xea, 0x30, 0xcf, 0x39, 0x22, 0x26, 0xc2, 0x18, 0x18, 0x03, 0xc3, 0xda, 0x15, 0x57, 0xcb, 0x68,
+	0x17, 0x05, 0x60, 0xed, 0x0d, 0x37, 0x7a, 0x86, 0x0c, 0x34, 0xff, 0x16, 0x82, 0x27, 0x45, 0x0e,
+	0x72, 0xd0, 0x8b, 0x2a, 0x87, 0x93, 0xd0, 0x92, 0xc7, 0xe5, 0xd4, 0x8a, 0x02, 0x90, 0xe1, 0x78,
+	0x1a, 0xf8, 0xbb, 0xa4, 0xd3, 0x1a, 0x45, 0x0e, 0x69, 0x9f, 0x74, 0x04, 0xad, 0x6b, 0xa2, 0xb8,
+	0xf1, 0x45, 0xdf, 0xb6, 0x02, 0x49, 0x64, 0x0a, 0x76, 0x25, 0x01, 0xe3, 0xa8, 0xd9, 0xf3, 0x3b,
+	0x3b, 0xa3, 0x01, 0x27, 0x55, 0x78, 0x8a, 0x7d, 0xa0, 0x1e, 0xc6, 0xa7, 0x98, 0xb3, 0xc2, 0x29,
+	0x4f, 0x9e, 0x46, 0xed, 0xac, 0x3e, 0xba, 0x77, 0xa6, 0x85, 0x19, 0x7d, 0x92, 0x84, 0xad, 0xaf,
+	0x41, 0xa5, 0x11, 0x28, 0x76, 0x74, 0xed, 0xcc, 0x00, 0xa2, 0x8b, 0xd7, 0x7f, 0x5c, 0x03, 0x50,
+	0x01, 0x2e, 0x77, 0x80, 0xab, 0x39, 0x52, 0xcc, 0xa6, 0x3c, 0x8d, 0xbb, 0xe9, 0x2f, 0x28, 0x4d,
+	0x0d, 0xc9, 0xa4, 0x1a, 0x61, 0x0c, 0xaa, 0xb1, 0x72, 0x3e, 0xaa, 0x71, 0xfe, 0x6c, 0xaa, 0x71,
+	0xe1, 0x1c, 0x54, 0x63, 0xf5, 0x6c, 0xaa, 0x71, 0x31, 0x4d, 0x35, 0x62, 0x00, 0x14, 0x25, 0xe8,
+	0xe5, 0xfd, 0x12, 0x5d, 0xe1, 0x4c, 0x30, 0x8b, 0x90, 0xbc, 0x72, 0x6e, 0x42, 0xd2, 0x3a, 0x2f,
+	0x21, 0xb9, 0x7c, 0x6e, 0x42, 0x72, 0x65, 0x0c, 0x42, 0x72, 0xf5, 0x7c, 0x84, 0xe4, 0xda, 0x59,
+	0x84, 0xe4, 0xd5, 0x31, 0x08, 0x49, 0xfb, 0xd2, 0x08, 0xc9, 0x6b, 0x26, 0x21, 0x29, 0x27, 0xfc,
+	0xe5, 0x13, 0x92, 0xb5, 0x4b, 0x26, 0x24, 0xaf, 0x9b, 0x84, 0xa4, 0x61, 0xf9, 0xe5, 0x11, 0x92,
+	0x37, 0x2e, 0x40, 0x48, 0xde, 0x34, 0x09, 0x09, 0xcd, 0xc2, 0x8b, 0x10, 0x92, 0xb7, 0x2e, 0x81,
+	0x90, 0x7c, 0xc1, 0x24, 0x24, 0x0d, 0xdf, 0x8d, 0x41, 0x48, 0x26, 0x28, 0xbf, 0xdb, 0x26, 0x59,
+	0xa7, 0xb4, 0x8e, 0x45, 0xf9, 0xbd, 0x78, 0x96, 0x96, 0x73, 0x53, 0x7e, 0xf5, 0x3c, 0xff, 0x4f,
+	0x48, 0xf9, 0xbd, 0x64, 0xd2, 0x74, 0x4a, 0xdf, 0x04, 0x94, 0xdf, 0x9d, 0xb3, 0x75, 0xe5, 0x51,
+	0x7e, 0x77, 0xa1, 0xba, 0xe3, 0x86, 0x7d, 0x37, 0x3c, 0x26, 0x1d, 0x66, 0xd8, 0xcb, 0x2c, 0xb0,
+	0x99, 0x68, 0x42, 0x0e, 0x2b, 0xbd, 0x9b, 0x92, 0x43, 0x7d, 0x18, 0x52, 0x05, 0xc2, 0x3f, 0x5d,
+	0x7a, 0x85, 0x87, 0x54, 0x13, 0x4e, 0x4a, 0xa2, 0xca, 0xf5, 0xb4, 0x24, 0xea, 0xfc, 0x01, 0xac,
+	0x70, 0xc8, 0x1c, 0xbc, 0xf7, 0x68, 0xb3, 0x5f, 0x4d, 0x37, 0x3b, 0x4b, 0x9a, 0xb5, 0x3e, 0x53,
+	0x51, 0x66, 0x05, 0x68, 0xcf, 0xfd, 0x73, 0x57, 0x20, 0xdd, 0x9b, 0xa9, 0x28, 0xc1, 0xdc, 0xbd,
+	0x6a, 0xd2, 0x6e, 0xda, 0x84, 0x39, 0x37, 0x73, 0xf7, 0xda, 0x19, 0x2a, 0xbe, 0x60, 0xee, 0x26,
+	0x65, 0xee, 0xbe, 0xe0, 0xcc, 0x2e, 0x87, 0x33, 0x7b, 0x04, 0xd7, 0x72, 0xa7, 0xe1, 0x85, 0x15,
+	0x4d, 0xfa, 0x6d, 0xf5, 0xff, 0x90, 0x3c, 0xfb, 0x59, 0x01, 0x16, 0x1e, 0x07, 0xc1, 0xf1, 0x70,
+	0x90, 0xcf, 0x9d, 0xdd, 0x80, 0x32, 0xe7, 0x9b, 0x3a, 0xec, 0xd1, 0x6f, 0xd9, 0x51, 0xc0, 0x65,
+	0xbe, 0xf0, 0xad, 0xff, 0xbc, 0x00, 0x55, 0x61, 0xcd, 0x67, 0xf0, 0x05, 0x72, 0xfd, 0x77, 0x45,
+	0xb8, 0xf2, 0x88, 0xc4, 0x4f, 0x49, 0xfc, 0x49, 0x10, 0x1e, 0xe7, 0x7b, 0xec, 0x79, 0x8c, 0x9d,
+	0xe4, 0xc1, 0xa6, 0x74, 0x1e, 0x6c, 0x05, 0x4a, 0xdb, 0x64, 0x10, 0x3f, 0xe3, 0x9f, 0x33, 0xb3,
+	0x04, 0x7a, 0xfe, 0x89, 0xe7, 0xf3, 0x87, 0x7a, 0xec, 0x48, 0xa8, 0x00, 0xac, 0xe5, 0x89, 0x3b,
+	0x7a, 0x1a, 0x74, 0x08, 0xa3, 0xe1, 0x4a, 0x8e, 0x4c, 0x1b, 0xef, 0xbf, 0x67, 0x13, 0xef, 0xbf,
+	0x6f, 0x40, 0x19, 0x7f, 0xb7, 0x82, 0x63, 0xe2, 0x73, 0x02, 0x4a, 0x01, 0xa6, 0xbf, 0xca, 0x13,
+	0xf8, 0x0b, 0x94, 0xbf, 0xfe, 0x5d, 0x04, 0x4b, 0xf7, 0xd7, 0x44, 0x14, 0x67, 0xb6, 0xc3, 0xd6,
+	0xa1, 0xc4, 0x5a, 0x3e, 0x4d, 0xc7, 0x80, 0xc5, 0xc7, 0x00, 0xaf, 0x0a, 0xb3, 0x1c, 0x26, 0x80,
+	0x92, 0x3b, 0x9d, 0x2e, 0x61, 0xaf, 0x86, 0x52, 0x92, 0x98, 0xe5, 0x30, 0x01, 0xc9, 0x05, 0xe9,
+	0x2e, 0xd5, 0x10, 0x99, 0xcf, 0xd4, 0xcd, 0x6a, 0xf9, 0xac, 0x3c, 0x72, 0x45, 0xe1, 0xd0, 0x6f,
+	0xeb, 0x4f, 0xc8, 0x25, 0x80, 0xc7, 0x44, 0x7c, 0x30, 0xae, 0x5c, 0xcf, 0x68, 0x3e, 0x13, 0x34,
+	0xdd, 0x0f, 0x13, 0xb8, 0xbf, 0xa2, 0x11, 0xa2, 0x05, 0xa8, 0x68, 0x0e, 0x39, 0xef, 0xdb, 0x24,
+	0xca, 0x25, 0x4e, 0x65, 0x11, 0x93, 0xc9, 0x27, 0x86, 0x19, 0x64, 0xa8, 0xf9, 0xbe, 0x67, 0x26,
+	0xf5, 0xbe, 0x27, 0xf1, 0xbe, 0x68, 0x36, 0xfd, 0xbe, 0x48, 0x4e, 0x85, 0x39, 0x6d, 0x2a, 0xd4,
+	0x03, 0xd9, 0x14, 0xf4, 0x35, 0x9a, 0x89, 0xcc, 0x21, 0x6f, 0x0c, 0xfd, 0x8d, 0xcd, 0x6b, 0x05,
+	0xbc, 0x31, 0xc5, 0x56, 0x90, 0xd9, 0x14, 0xf5, 0xee, 0x75, 0x5a, 0x7f, 0xf7, 0x8a, 0xce, 0x53,
+	0xb4, 0x0b, 0xfe, 0x7c, 0xf8, 0x93, 0x12, 0x4c, 0x7f, 0xd7, 0x23, 0x9f, 0x20, 0xc7, 0xc4, 0x22,
+	0xcb, 0xb7, 0x87, 0x04, 0xdf, 0x5b, 0x26, 0xa2, 0x0d, 0x8d, 0x01, 0xb5, 0xd5, 0x04, 0xca, 0x46,
+	0x7a, 0xfd, 0x4b, 0xd6, 0x1e, 0xcc, 0xeb, 0x4f, 0xd9, 0xad, 0x1a, 0x17, 0xcc, 0x78, 0x83, 0x5f,
+	0xbb, 0x9e, 0x99, 0x27, 0x55, 0x6d, 0xc2, 0x3c, 0x1a, 0x24, 0x9f, 0x4f, 0xaf, 0xa5, 0xde, 0x57,
+	0x33, 0x35, 0x57, 0x53, 0xb8, 0x54, 0xf1, 0x0d, 0xa6, 0x82, 0xbf, 0x40, 0x8d, 0xac, 0xd5, 0xe4,
+	0xe3, 0x57, 0xa6, 0x61, 0x2d, 0x09, 0x6b, 0x36, 0x54, 0x51, 0x81, 0xf6, 0xe0, 0x4d, 0xa8, 0x30,
+	0x1f, 0x38, 0xd6, 0xd6, 0x92, 0xb0, 0x54, 0xf1, 0x1e, 0x2c, 0xa0, 0x0a, 0xc5, 0xd4, 0x6b, 0x1a,
+	0xb4, 0x4b, 0x9c, 0xda, 0x5a, 0x12, 0x4e, 0x69, 0x90, 0x2f, 0x49, 0x74, 0x0d, 0xea, 0x91, 0x51,
+	0x6d, 0x2d, 0x09, 0x4b, 0x0d, 0xef, 0x8a, 0x55, 0xaf, 0x79, 0xf8, 0xd1, 0xd6, 0x29, 0x06, 0x19,
+	0x2e, 0x6a, 0xac, 0x85, 0xb5, 0xd5, 0x04, 0x2a, 0xcb, 0x6f, 0x43, 0x05, 0x2d, 0xe0, 0x23, 0xd2,
+	0xb2, 0x55, 0x45, 0xe6, 0xda, 0x50, 0xbb, 0x96, 0x91, 0x23, 0xb5, 0xd4, 0x61, 0xfa, 0x69, 0xd0,
+	0x1c, 0x58, 0xf3, 0x5c, 0x88, 0xfe, 0xfb, 0x90, 0x9a, 0x91, 0x3a, 0x9c, 0xa1, 0x89, 0x2f, 0xff,
+	0x67, 0x00, 0x58, 0x62, 0x9b, 0xa1, 0x94, 0x44, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3158,6 +4420,8 @@ const _ = grpc.SupportPackageIsVersion6
 type ViewClient interface {
 	// take user-input search query and return a list of matching results
 	SearchQuery(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// retrieve name suggestions for partial search query
+	Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error)
 	// retrieve rankings list matching specified criteria
 	ViewRankings(ctx context.Context, in *RankingsRequest, opts ...grpc.CallOption) (*RankingsResponse, error)
 	// retrieve yearly total matching specified criteria
@@ -3170,6 +4434,8 @@ type ViewClient interface {
 	ViewCandidate(ctx context.Context, in *GetCandRequest, opts ...grpc.CallOption) (*GetCandResponse, error)
 	// lookup object by ID
 	LookupObjByID(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error)
+	// retrieve money-flow network graph from index service
+	ViewNetwork(ctx context.Context, in *GetNetworkRequest, opts ...grpc.CallOption) (*GetNetworkResponse, error)
 	// One empty request, ZERO processing, followed by one empty response
 	// (minimum effort to do message serialization).
 	NoOp(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
@@ -3192,6 +4458,15 @@ func (c *viewClient) SearchQuery(ctx context.Context, in *SearchRequest, opts ..
 	return out, nil
 }
 
+func (c *viewClient) Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error) {
+	out := new(AutocompleteResponse)
+	err := c.cc.Invoke(ctx, "/proto.View/Autocomplete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *viewClient) ViewRankings(ctx context.Context, in *RankingsRequest, opts ...grpc.CallOption) (*RankingsResponse, error) {
 	out := new(RankingsResponse)
 	err := c.cc.Invoke(ctx, "/proto.View/ViewRankings", in, out, opts...)
@@ -3246,6 +4521,15 @@ func (c *viewClient) LookupObjByID(ctx context.Context, in *LookupRequest, opts
 	return out, nil
 }
 
+func (c *viewClient) ViewNetwork(ctx context.Context, in *GetNetworkRequest, opts ...grpc.CallOption) (*GetNetworkResponse, error) {
+	out := new(GetNetworkResponse)
+	err := c.cc.Invoke(ctx, "/proto.View/ViewNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *viewClient) NoOp(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/proto.View/NoOp", in, out, opts...)
@@ -3259,6 +4543,8 @@ func (c *viewClient) NoOp(ctx context.Context, in *Empty, opts ...grpc.CallOptio
 type ViewServer interface {
 	// take user-input search query and return a list of matching results
 	SearchQuery(context.Context, *SearchRequest) (*SearchResponse, error)
+	// retrieve name suggestions for partial search query
+	Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
 	// retrieve rankings list matching specified criteria
 	ViewRankings(context.Context, *RankingsRequest) (*RankingsResponse, error)
 	// retrieve yearly total matching specified criteria
@@ -3271,6 +4557,8 @@ type ViewServer interface {
 	ViewCandidate(context.Context, *GetCandRequest) (*GetCandResponse, error)
 	// lookup object by ID
 	LookupObjByID(context.Context, *LookupRequest) (*LookupResponse, error)
+	// retrieve money-flow network graph from index service
+	ViewNetwork(context.Context, *GetNetworkRequest) (*GetNetworkResponse, error)
 	// One empty request, ZERO processing, followed by one empty response
 	// (minimum effort to do message serialization).
 	NoOp(context.Context, *Empty) (*Empty, error)
@@ -3283,6 +4571,9 @@ type UnimplementedViewServer struct {
 func (*UnimplementedViewServer) SearchQuery(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchQuery not implemented")
 }
+func (*UnimplementedViewServer) Autocomplete(ctx context.Context, req *AutocompleteRequest) (*AutocompleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Autocomplete not implemented")
+}
 func (*UnimplementedViewServer) ViewRankings(ctx context.Context, req *RankingsRequest) (*RankingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewRankings not implemented")
 }
@@ -3301,6 +4592,9 @@ func (*UnimplementedViewServer) ViewCandidate(ctx context.Context, req *GetCandR
 func (*UnimplementedViewServer) LookupObjByID(ctx context.Context, req *LookupRequest) (*LookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupObjByID not implemented")
 }
+func (*UnimplementedViewServer) ViewNetwork(ctx context.Context, req *GetNetworkRequest) (*GetNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewNetwork not implemented")
+}
 func (*UnimplementedViewServer) NoOp(ctx context.Context, req *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NoOp not implemented")
 }
@@ -3327,6 +4621,24 @@ func _View_SearchQuery_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _View_Autocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutocompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServer).Autocomplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.View/Autocomplete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServer).Autocomplete(ctx, req.(*AutocompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _View_ViewRankings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RankingsRequest)
 	if err := dec(in); err != nil {
@@ -3435,6 +4747,24 @@ func _View_LookupObjByID_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _View_ViewNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViewServer).ViewNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.View/ViewNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViewServer).ViewNetwork(ctx, req.(*GetNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _View_NoOp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -3461,6 +4791,10 @@ var _View_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchQuery",
 			Handler:    _View_SearchQuery_Handler,
 		},
+		{
+			MethodName: "Autocomplete",
+			Handler:    _View_Autocomplete_Handler,
+		},
 		{
 			MethodName: "ViewRankings",
 			Handler:    _View_ViewRankings_Handler,
@@ -3485,6 +4819,10 @@ var _View_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupObjByID",
 			Handler:    _View_LookupObjByID_Handler,
 		},
+		{
+			MethodName: "ViewNetwork",
+			Handler:    _View_ViewNetwork_Handler,
+		},
 		{
 			MethodName: "NoOp",
 			Handler:    _View_NoOp_Handler,
@@ -210,3 +210,23 @@ func GetDynamoQuery() map[string]string {
 	q := map[string]string{prt: srt}
 	return q
 }
+
+// GetNumber gets a non-negative numeric value from the user.
+func GetNumber(prompt string) float64 {
+	var s string
+	for {
+		fmt.Printf("%s: ", prompt)
+		_, err := fmt.Scan(&s)
+		if err != nil {
+			panic(err)
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil || f < 0 {
+			fmt.Println("Invalid number - please try again")
+			continue
+		}
+
+		return f
+	}
+}